@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/clustlight/animatrix-api/ent/audiotrack"
+	"github.com/clustlight/animatrix-api/ent/episode"
+)
+
+// AudioTrack is the model entity for the AudioTrack schema.
+type AudioTrack struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Language holds the value of the "language" field.
+	Language string `json:"language,omitempty"`
+	// Format holds the value of the "format" field.
+	Format string `json:"format,omitempty"`
+	// Label holds the value of the "label" field.
+	Label string `json:"label,omitempty"`
+	// IsDefault holds the value of the "is_default" field.
+	IsDefault bool `json:"is_default,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AudioTrackQuery when eager-loading is set.
+	Edges                AudioTrackEdges `json:"edges"`
+	episode_audio_tracks *int
+	selectValues         sql.SelectValues
+}
+
+// AudioTrackEdges holds the relations/edges for other nodes in the graph.
+type AudioTrackEdges struct {
+	// Episode holds the value of the episode edge.
+	Episode *Episode `json:"episode,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EpisodeOrErr returns the Episode value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AudioTrackEdges) EpisodeOrErr() (*Episode, error) {
+	if e.Episode != nil {
+		return e.Episode, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: episode.Label}
+	}
+	return nil, &NotLoadedError{edge: "episode"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AudioTrack) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case audiotrack.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case audiotrack.FieldID:
+			values[i] = new(sql.NullInt64)
+		case audiotrack.FieldLanguage, audiotrack.FieldFormat, audiotrack.FieldLabel, audiotrack.FieldPath:
+			values[i] = new(sql.NullString)
+		case audiotrack.FieldCreatedAt, audiotrack.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case audiotrack.ForeignKeys[0]: // episode_audio_tracks
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AudioTrack fields.
+func (at *AudioTrack) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case audiotrack.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			at.ID = int(value.Int64)
+		case audiotrack.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				at.CreatedAt = value.Time
+			}
+		case audiotrack.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				at.UpdatedAt = value.Time
+			}
+		case audiotrack.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				at.Language = value.String
+			}
+		case audiotrack.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				at.Format = value.String
+			}
+		case audiotrack.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				at.Label = value.String
+			}
+		case audiotrack.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				at.IsDefault = value.Bool
+			}
+		case audiotrack.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				at.Path = value.String
+			}
+		case audiotrack.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field episode_audio_tracks", value)
+			} else if value.Valid {
+				at.episode_audio_tracks = new(int)
+				*at.episode_audio_tracks = int(value.Int64)
+			}
+		default:
+			at.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AudioTrack.
+// This includes values selected through modifiers, order, etc.
+func (at *AudioTrack) Value(name string) (ent.Value, error) {
+	return at.selectValues.Get(name)
+}
+
+// QueryEpisode queries the "episode" edge of the AudioTrack entity.
+func (at *AudioTrack) QueryEpisode() *EpisodeQuery {
+	return NewAudioTrackClient(at.config).QueryEpisode(at)
+}
+
+// Update returns a builder for updating this AudioTrack.
+// Note that you need to call AudioTrack.Unwrap() before calling this method if this AudioTrack
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (at *AudioTrack) Update() *AudioTrackUpdateOne {
+	return NewAudioTrackClient(at.config).UpdateOne(at)
+}
+
+// Unwrap unwraps the AudioTrack entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (at *AudioTrack) Unwrap() *AudioTrack {
+	_tx, ok := at.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AudioTrack is not a transactional entity")
+	}
+	at.config.driver = _tx.drv
+	return at
+}
+
+// String implements the fmt.Stringer.
+func (at *AudioTrack) String() string {
+	var builder strings.Builder
+	builder.WriteString("AudioTrack(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", at.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(at.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(at.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(at.Language)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(at.Format)
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(at.Label)
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", at.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(at.Path)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AudioTracks is a parsable slice of AudioTrack.
+type AudioTracks []*AudioTrack
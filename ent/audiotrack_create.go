@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/audiotrack"
+	"github.com/clustlight/animatrix-api/ent/episode"
+)
+
+// AudioTrackCreate is the builder for creating a AudioTrack entity.
+type AudioTrackCreate struct {
+	config
+	mutation *AudioTrackMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (atc *AudioTrackCreate) SetCreatedAt(t time.Time) *AudioTrackCreate {
+	atc.mutation.SetCreatedAt(t)
+	return atc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (atc *AudioTrackCreate) SetNillableCreatedAt(t *time.Time) *AudioTrackCreate {
+	if t != nil {
+		atc.SetCreatedAt(*t)
+	}
+	return atc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atc *AudioTrackCreate) SetUpdatedAt(t time.Time) *AudioTrackCreate {
+	atc.mutation.SetUpdatedAt(t)
+	return atc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (atc *AudioTrackCreate) SetNillableUpdatedAt(t *time.Time) *AudioTrackCreate {
+	if t != nil {
+		atc.SetUpdatedAt(*t)
+	}
+	return atc
+}
+
+// SetLanguage sets the "language" field.
+func (atc *AudioTrackCreate) SetLanguage(s string) *AudioTrackCreate {
+	atc.mutation.SetLanguage(s)
+	return atc
+}
+
+// SetFormat sets the "format" field.
+func (atc *AudioTrackCreate) SetFormat(s string) *AudioTrackCreate {
+	atc.mutation.SetFormat(s)
+	return atc
+}
+
+// SetLabel sets the "label" field.
+func (atc *AudioTrackCreate) SetLabel(s string) *AudioTrackCreate {
+	atc.mutation.SetLabel(s)
+	return atc
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (atc *AudioTrackCreate) SetNillableLabel(s *string) *AudioTrackCreate {
+	if s != nil {
+		atc.SetLabel(*s)
+	}
+	return atc
+}
+
+// SetIsDefault sets the "is_default" field.
+func (atc *AudioTrackCreate) SetIsDefault(b bool) *AudioTrackCreate {
+	atc.mutation.SetIsDefault(b)
+	return atc
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (atc *AudioTrackCreate) SetNillableIsDefault(b *bool) *AudioTrackCreate {
+	if b != nil {
+		atc.SetIsDefault(*b)
+	}
+	return atc
+}
+
+// SetPath sets the "path" field.
+func (atc *AudioTrackCreate) SetPath(s string) *AudioTrackCreate {
+	atc.mutation.SetPath(s)
+	return atc
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (atc *AudioTrackCreate) SetEpisodeID(id int) *AudioTrackCreate {
+	atc.mutation.SetEpisodeID(id)
+	return atc
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (atc *AudioTrackCreate) SetEpisode(e *Episode) *AudioTrackCreate {
+	return atc.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the AudioTrackMutation object of the builder.
+func (atc *AudioTrackCreate) Mutation() *AudioTrackMutation {
+	return atc.mutation
+}
+
+// Save creates the AudioTrack in the database.
+func (atc *AudioTrackCreate) Save(ctx context.Context) (*AudioTrack, error) {
+	atc.defaults()
+	return withHooks(ctx, atc.sqlSave, atc.mutation, atc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (atc *AudioTrackCreate) SaveX(ctx context.Context) *AudioTrack {
+	v, err := atc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atc *AudioTrackCreate) Exec(ctx context.Context) error {
+	_, err := atc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atc *AudioTrackCreate) ExecX(ctx context.Context) {
+	if err := atc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atc *AudioTrackCreate) defaults() {
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		v := audiotrack.DefaultCreatedAt()
+		atc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := atc.mutation.UpdatedAt(); !ok {
+		v := audiotrack.DefaultUpdatedAt()
+		atc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := atc.mutation.IsDefault(); !ok {
+		v := audiotrack.DefaultIsDefault
+		atc.mutation.SetIsDefault(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atc *AudioTrackCreate) check() error {
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AudioTrack.created_at"`)}
+	}
+	if _, ok := atc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AudioTrack.updated_at"`)}
+	}
+	if _, ok := atc.mutation.Language(); !ok {
+		return &ValidationError{Name: "language", err: errors.New(`ent: missing required field "AudioTrack.language"`)}
+	}
+	if v, ok := atc.mutation.Language(); ok {
+		if err := audiotrack.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "AudioTrack.language": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "AudioTrack.format"`)}
+	}
+	if _, ok := atc.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "AudioTrack.is_default"`)}
+	}
+	if _, ok := atc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "AudioTrack.path"`)}
+	}
+	if v, ok := atc.mutation.Path(); ok {
+		if err := audiotrack.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "AudioTrack.path": %w`, err)}
+		}
+	}
+	if len(atc.mutation.EpisodeIDs()) == 0 {
+		return &ValidationError{Name: "episode", err: errors.New(`ent: missing required edge "AudioTrack.episode"`)}
+	}
+	return nil
+}
+
+func (atc *AudioTrackCreate) sqlSave(ctx context.Context) (*AudioTrack, error) {
+	if err := atc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := atc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, atc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	atc.mutation.id = &_node.ID
+	atc.mutation.done = true
+	return _node, nil
+}
+
+func (atc *AudioTrackCreate) createSpec() (*AudioTrack, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AudioTrack{config: atc.config}
+		_spec = sqlgraph.NewCreateSpec(audiotrack.Table, sqlgraph.NewFieldSpec(audiotrack.FieldID, field.TypeInt))
+	)
+	if value, ok := atc.mutation.CreatedAt(); ok {
+		_spec.SetField(audiotrack.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := atc.mutation.UpdatedAt(); ok {
+		_spec.SetField(audiotrack.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := atc.mutation.Language(); ok {
+		_spec.SetField(audiotrack.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := atc.mutation.Format(); ok {
+		_spec.SetField(audiotrack.FieldFormat, field.TypeString, value)
+		_node.Format = value
+	}
+	if value, ok := atc.mutation.Label(); ok {
+		_spec.SetField(audiotrack.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := atc.mutation.IsDefault(); ok {
+		_spec.SetField(audiotrack.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := atc.mutation.Path(); ok {
+		_spec.SetField(audiotrack.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if nodes := atc.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   audiotrack.EpisodeTable,
+			Columns: []string{audiotrack.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.episode_audio_tracks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AudioTrackCreateBulk is the builder for creating many AudioTrack entities in bulk.
+type AudioTrackCreateBulk struct {
+	config
+	err      error
+	builders []*AudioTrackCreate
+}
+
+// Save creates the AudioTrack entities in the database.
+func (atcb *AudioTrackCreateBulk) Save(ctx context.Context) ([]*AudioTrack, error) {
+	if atcb.err != nil {
+		return nil, atcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(atcb.builders))
+	nodes := make([]*AudioTrack, len(atcb.builders))
+	mutators := make([]Mutator, len(atcb.builders))
+	for i := range atcb.builders {
+		func(i int, root context.Context) {
+			builder := atcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AudioTrackMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, atcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, atcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, atcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atcb *AudioTrackCreateBulk) SaveX(ctx context.Context) []*AudioTrack {
+	v, err := atcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atcb *AudioTrackCreateBulk) Exec(ctx context.Context) error {
+	_, err := atcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atcb *AudioTrackCreateBulk) ExecX(ctx context.Context) {
+	if err := atcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
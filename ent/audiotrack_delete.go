@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/audiotrack"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+)
+
+// AudioTrackDelete is the builder for deleting a AudioTrack entity.
+type AudioTrackDelete struct {
+	config
+	hooks    []Hook
+	mutation *AudioTrackMutation
+}
+
+// Where appends a list predicates to the AudioTrackDelete builder.
+func (atd *AudioTrackDelete) Where(ps ...predicate.AudioTrack) *AudioTrackDelete {
+	atd.mutation.Where(ps...)
+	return atd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (atd *AudioTrackDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, atd.sqlExec, atd.mutation, atd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atd *AudioTrackDelete) ExecX(ctx context.Context) int {
+	n, err := atd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (atd *AudioTrackDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(audiotrack.Table, sqlgraph.NewFieldSpec(audiotrack.FieldID, field.TypeInt))
+	if ps := atd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, atd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	atd.mutation.done = true
+	return affected, err
+}
+
+// AudioTrackDeleteOne is the builder for deleting a single AudioTrack entity.
+type AudioTrackDeleteOne struct {
+	atd *AudioTrackDelete
+}
+
+// Where appends a list predicates to the AudioTrackDelete builder.
+func (atdo *AudioTrackDeleteOne) Where(ps ...predicate.AudioTrack) *AudioTrackDeleteOne {
+	atdo.atd.mutation.Where(ps...)
+	return atdo
+}
+
+// Exec executes the deletion query.
+func (atdo *AudioTrackDeleteOne) Exec(ctx context.Context) error {
+	n, err := atdo.atd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{audiotrack.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atdo *AudioTrackDeleteOne) ExecX(ctx context.Context) {
+	if err := atdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/audiotrack"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+)
+
+// AudioTrackUpdate is the builder for updating AudioTrack entities.
+type AudioTrackUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AudioTrackMutation
+}
+
+// Where appends a list predicates to the AudioTrackUpdate builder.
+func (atu *AudioTrackUpdate) Where(ps ...predicate.AudioTrack) *AudioTrackUpdate {
+	atu.mutation.Where(ps...)
+	return atu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atu *AudioTrackUpdate) SetUpdatedAt(t time.Time) *AudioTrackUpdate {
+	atu.mutation.SetUpdatedAt(t)
+	return atu
+}
+
+// SetLanguage sets the "language" field.
+func (atu *AudioTrackUpdate) SetLanguage(s string) *AudioTrackUpdate {
+	atu.mutation.SetLanguage(s)
+	return atu
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (atu *AudioTrackUpdate) SetNillableLanguage(s *string) *AudioTrackUpdate {
+	if s != nil {
+		atu.SetLanguage(*s)
+	}
+	return atu
+}
+
+// SetFormat sets the "format" field.
+func (atu *AudioTrackUpdate) SetFormat(s string) *AudioTrackUpdate {
+	atu.mutation.SetFormat(s)
+	return atu
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (atu *AudioTrackUpdate) SetNillableFormat(s *string) *AudioTrackUpdate {
+	if s != nil {
+		atu.SetFormat(*s)
+	}
+	return atu
+}
+
+// SetLabel sets the "label" field.
+func (atu *AudioTrackUpdate) SetLabel(s string) *AudioTrackUpdate {
+	atu.mutation.SetLabel(s)
+	return atu
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (atu *AudioTrackUpdate) SetNillableLabel(s *string) *AudioTrackUpdate {
+	if s != nil {
+		atu.SetLabel(*s)
+	}
+	return atu
+}
+
+// ClearLabel clears the value of the "label" field.
+func (atu *AudioTrackUpdate) ClearLabel() *AudioTrackUpdate {
+	atu.mutation.ClearLabel()
+	return atu
+}
+
+// SetIsDefault sets the "is_default" field.
+func (atu *AudioTrackUpdate) SetIsDefault(b bool) *AudioTrackUpdate {
+	atu.mutation.SetIsDefault(b)
+	return atu
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (atu *AudioTrackUpdate) SetNillableIsDefault(b *bool) *AudioTrackUpdate {
+	if b != nil {
+		atu.SetIsDefault(*b)
+	}
+	return atu
+}
+
+// SetPath sets the "path" field.
+func (atu *AudioTrackUpdate) SetPath(s string) *AudioTrackUpdate {
+	atu.mutation.SetPath(s)
+	return atu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (atu *AudioTrackUpdate) SetNillablePath(s *string) *AudioTrackUpdate {
+	if s != nil {
+		atu.SetPath(*s)
+	}
+	return atu
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (atu *AudioTrackUpdate) SetEpisodeID(id int) *AudioTrackUpdate {
+	atu.mutation.SetEpisodeID(id)
+	return atu
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (atu *AudioTrackUpdate) SetEpisode(e *Episode) *AudioTrackUpdate {
+	return atu.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the AudioTrackMutation object of the builder.
+func (atu *AudioTrackUpdate) Mutation() *AudioTrackMutation {
+	return atu.mutation
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (atu *AudioTrackUpdate) ClearEpisode() *AudioTrackUpdate {
+	atu.mutation.ClearEpisode()
+	return atu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (atu *AudioTrackUpdate) Save(ctx context.Context) (int, error) {
+	atu.defaults()
+	return withHooks(ctx, atu.sqlSave, atu.mutation, atu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atu *AudioTrackUpdate) SaveX(ctx context.Context) int {
+	affected, err := atu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (atu *AudioTrackUpdate) Exec(ctx context.Context) error {
+	_, err := atu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atu *AudioTrackUpdate) ExecX(ctx context.Context) {
+	if err := atu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atu *AudioTrackUpdate) defaults() {
+	if _, ok := atu.mutation.UpdatedAt(); !ok {
+		v := audiotrack.UpdateDefaultUpdatedAt()
+		atu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atu *AudioTrackUpdate) check() error {
+	if v, ok := atu.mutation.Language(); ok {
+		if err := audiotrack.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "AudioTrack.language": %w`, err)}
+		}
+	}
+	if v, ok := atu.mutation.Path(); ok {
+		if err := audiotrack.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "AudioTrack.path": %w`, err)}
+		}
+	}
+	if atu.mutation.EpisodeCleared() && len(atu.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AudioTrack.episode"`)
+	}
+	return nil
+}
+
+func (atu *AudioTrackUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := atu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(audiotrack.Table, audiotrack.Columns, sqlgraph.NewFieldSpec(audiotrack.FieldID, field.TypeInt))
+	if ps := atu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atu.mutation.UpdatedAt(); ok {
+		_spec.SetField(audiotrack.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := atu.mutation.Language(); ok {
+		_spec.SetField(audiotrack.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := atu.mutation.Format(); ok {
+		_spec.SetField(audiotrack.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := atu.mutation.Label(); ok {
+		_spec.SetField(audiotrack.FieldLabel, field.TypeString, value)
+	}
+	if atu.mutation.LabelCleared() {
+		_spec.ClearField(audiotrack.FieldLabel, field.TypeString)
+	}
+	if value, ok := atu.mutation.IsDefault(); ok {
+		_spec.SetField(audiotrack.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := atu.mutation.Path(); ok {
+		_spec.SetField(audiotrack.FieldPath, field.TypeString, value)
+	}
+	if atu.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   audiotrack.EpisodeTable,
+			Columns: []string{audiotrack.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atu.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   audiotrack.EpisodeTable,
+			Columns: []string{audiotrack.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, atu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{audiotrack.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	atu.mutation.done = true
+	return n, nil
+}
+
+// AudioTrackUpdateOne is the builder for updating a single AudioTrack entity.
+type AudioTrackUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AudioTrackMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atuo *AudioTrackUpdateOne) SetUpdatedAt(t time.Time) *AudioTrackUpdateOne {
+	atuo.mutation.SetUpdatedAt(t)
+	return atuo
+}
+
+// SetLanguage sets the "language" field.
+func (atuo *AudioTrackUpdateOne) SetLanguage(s string) *AudioTrackUpdateOne {
+	atuo.mutation.SetLanguage(s)
+	return atuo
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (atuo *AudioTrackUpdateOne) SetNillableLanguage(s *string) *AudioTrackUpdateOne {
+	if s != nil {
+		atuo.SetLanguage(*s)
+	}
+	return atuo
+}
+
+// SetFormat sets the "format" field.
+func (atuo *AudioTrackUpdateOne) SetFormat(s string) *AudioTrackUpdateOne {
+	atuo.mutation.SetFormat(s)
+	return atuo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (atuo *AudioTrackUpdateOne) SetNillableFormat(s *string) *AudioTrackUpdateOne {
+	if s != nil {
+		atuo.SetFormat(*s)
+	}
+	return atuo
+}
+
+// SetLabel sets the "label" field.
+func (atuo *AudioTrackUpdateOne) SetLabel(s string) *AudioTrackUpdateOne {
+	atuo.mutation.SetLabel(s)
+	return atuo
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (atuo *AudioTrackUpdateOne) SetNillableLabel(s *string) *AudioTrackUpdateOne {
+	if s != nil {
+		atuo.SetLabel(*s)
+	}
+	return atuo
+}
+
+// ClearLabel clears the value of the "label" field.
+func (atuo *AudioTrackUpdateOne) ClearLabel() *AudioTrackUpdateOne {
+	atuo.mutation.ClearLabel()
+	return atuo
+}
+
+// SetIsDefault sets the "is_default" field.
+func (atuo *AudioTrackUpdateOne) SetIsDefault(b bool) *AudioTrackUpdateOne {
+	atuo.mutation.SetIsDefault(b)
+	return atuo
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (atuo *AudioTrackUpdateOne) SetNillableIsDefault(b *bool) *AudioTrackUpdateOne {
+	if b != nil {
+		atuo.SetIsDefault(*b)
+	}
+	return atuo
+}
+
+// SetPath sets the "path" field.
+func (atuo *AudioTrackUpdateOne) SetPath(s string) *AudioTrackUpdateOne {
+	atuo.mutation.SetPath(s)
+	return atuo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (atuo *AudioTrackUpdateOne) SetNillablePath(s *string) *AudioTrackUpdateOne {
+	if s != nil {
+		atuo.SetPath(*s)
+	}
+	return atuo
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (atuo *AudioTrackUpdateOne) SetEpisodeID(id int) *AudioTrackUpdateOne {
+	atuo.mutation.SetEpisodeID(id)
+	return atuo
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (atuo *AudioTrackUpdateOne) SetEpisode(e *Episode) *AudioTrackUpdateOne {
+	return atuo.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the AudioTrackMutation object of the builder.
+func (atuo *AudioTrackUpdateOne) Mutation() *AudioTrackMutation {
+	return atuo.mutation
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (atuo *AudioTrackUpdateOne) ClearEpisode() *AudioTrackUpdateOne {
+	atuo.mutation.ClearEpisode()
+	return atuo
+}
+
+// Where appends a list predicates to the AudioTrackUpdate builder.
+func (atuo *AudioTrackUpdateOne) Where(ps ...predicate.AudioTrack) *AudioTrackUpdateOne {
+	atuo.mutation.Where(ps...)
+	return atuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (atuo *AudioTrackUpdateOne) Select(field string, fields ...string) *AudioTrackUpdateOne {
+	atuo.fields = append([]string{field}, fields...)
+	return atuo
+}
+
+// Save executes the query and returns the updated AudioTrack entity.
+func (atuo *AudioTrackUpdateOne) Save(ctx context.Context) (*AudioTrack, error) {
+	atuo.defaults()
+	return withHooks(ctx, atuo.sqlSave, atuo.mutation, atuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atuo *AudioTrackUpdateOne) SaveX(ctx context.Context) *AudioTrack {
+	node, err := atuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (atuo *AudioTrackUpdateOne) Exec(ctx context.Context) error {
+	_, err := atuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuo *AudioTrackUpdateOne) ExecX(ctx context.Context) {
+	if err := atuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atuo *AudioTrackUpdateOne) defaults() {
+	if _, ok := atuo.mutation.UpdatedAt(); !ok {
+		v := audiotrack.UpdateDefaultUpdatedAt()
+		atuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atuo *AudioTrackUpdateOne) check() error {
+	if v, ok := atuo.mutation.Language(); ok {
+		if err := audiotrack.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "AudioTrack.language": %w`, err)}
+		}
+	}
+	if v, ok := atuo.mutation.Path(); ok {
+		if err := audiotrack.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "AudioTrack.path": %w`, err)}
+		}
+	}
+	if atuo.mutation.EpisodeCleared() && len(atuo.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AudioTrack.episode"`)
+	}
+	return nil
+}
+
+func (atuo *AudioTrackUpdateOne) sqlSave(ctx context.Context) (_node *AudioTrack, err error) {
+	if err := atuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(audiotrack.Table, audiotrack.Columns, sqlgraph.NewFieldSpec(audiotrack.FieldID, field.TypeInt))
+	id, ok := atuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AudioTrack.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := atuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, audiotrack.FieldID)
+		for _, f := range fields {
+			if !audiotrack.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != audiotrack.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := atuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(audiotrack.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := atuo.mutation.Language(); ok {
+		_spec.SetField(audiotrack.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := atuo.mutation.Format(); ok {
+		_spec.SetField(audiotrack.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := atuo.mutation.Label(); ok {
+		_spec.SetField(audiotrack.FieldLabel, field.TypeString, value)
+	}
+	if atuo.mutation.LabelCleared() {
+		_spec.ClearField(audiotrack.FieldLabel, field.TypeString)
+	}
+	if value, ok := atuo.mutation.IsDefault(); ok {
+		_spec.SetField(audiotrack.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := atuo.mutation.Path(); ok {
+		_spec.SetField(audiotrack.FieldPath, field.TypeString, value)
+	}
+	if atuo.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   audiotrack.EpisodeTable,
+			Columns: []string{audiotrack.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atuo.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   audiotrack.EpisodeTable,
+			Columns: []string{audiotrack.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AudioTrack{config: atuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, atuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{audiotrack.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	atuo.mutation.done = true
+	return _node, nil
+}
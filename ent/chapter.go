@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/clustlight/animatrix-api/ent/chapter"
+	"github.com/clustlight/animatrix-api/ent/episode"
+)
+
+// Chapter is the model entity for the Chapter schema.
+type Chapter struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Start holds the value of the "start" field.
+	Start float64 `json:"start,omitempty"`
+	// End holds the value of the "end" field.
+	End float64 `json:"end,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChapterQuery when eager-loading is set.
+	Edges            ChapterEdges `json:"edges"`
+	episode_chapters *int
+	selectValues     sql.SelectValues
+}
+
+// ChapterEdges holds the relations/edges for other nodes in the graph.
+type ChapterEdges struct {
+	// Episode holds the value of the episode edge.
+	Episode *Episode `json:"episode,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EpisodeOrErr returns the Episode value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChapterEdges) EpisodeOrErr() (*Episode, error) {
+	if e.Episode != nil {
+		return e.Episode, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: episode.Label}
+	}
+	return nil, &NotLoadedError{edge: "episode"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Chapter) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chapter.FieldStart, chapter.FieldEnd:
+			values[i] = new(sql.NullFloat64)
+		case chapter.FieldID:
+			values[i] = new(sql.NullInt64)
+		case chapter.FieldKind, chapter.FieldTitle:
+			values[i] = new(sql.NullString)
+		case chapter.FieldCreatedAt, chapter.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case chapter.ForeignKeys[0]: // episode_chapters
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Chapter fields.
+func (c *Chapter) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chapter.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case chapter.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case chapter.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		case chapter.FieldStart:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field start", values[i])
+			} else if value.Valid {
+				c.Start = value.Float64
+			}
+		case chapter.FieldEnd:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field end", values[i])
+			} else if value.Valid {
+				c.End = value.Float64
+			}
+		case chapter.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				c.Kind = value.String
+			}
+		case chapter.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				c.Title = value.String
+			}
+		case chapter.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field episode_chapters", value)
+			} else if value.Valid {
+				c.episode_chapters = new(int)
+				*c.episode_chapters = int(value.Int64)
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Chapter.
+// This includes values selected through modifiers, order, etc.
+func (c *Chapter) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryEpisode queries the "episode" edge of the Chapter entity.
+func (c *Chapter) QueryEpisode() *EpisodeQuery {
+	return NewChapterClient(c.config).QueryEpisode(c)
+}
+
+// Update returns a builder for updating this Chapter.
+// Note that you need to call Chapter.Unwrap() before calling this method if this Chapter
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Chapter) Update() *ChapterUpdateOne {
+	return NewChapterClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Chapter entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Chapter) Unwrap() *Chapter {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Chapter is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Chapter) String() string {
+	var builder strings.Builder
+	builder.WriteString("Chapter(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("start=")
+	builder.WriteString(fmt.Sprintf("%v", c.Start))
+	builder.WriteString(", ")
+	builder.WriteString("end=")
+	builder.WriteString(fmt.Sprintf("%v", c.End))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(c.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(c.Title)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Chapters is a parsable slice of Chapter.
+type Chapters []*Chapter
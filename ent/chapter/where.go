@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chapter
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Chapter {
+	return predicate.Chapter(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Chapter {
+	return predicate.Chapter(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Chapter {
+	return predicate.Chapter(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Chapter {
+	return predicate.Chapter(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Chapter {
+	return predicate.Chapter(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Chapter {
+	return predicate.Chapter(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Chapter {
+	return predicate.Chapter(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Start applies equality check predicate on the "start" field. It's identical to StartEQ.
+func Start(v float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEQ(FieldStart, v))
+}
+
+// End applies equality check predicate on the "end" field. It's identical to EndEQ.
+func End(v float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEQ(FieldEnd, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEQ(FieldKind, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEQ(FieldTitle, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Chapter {
+	return predicate.Chapter(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StartEQ applies the EQ predicate on the "start" field.
+func StartEQ(v float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEQ(FieldStart, v))
+}
+
+// StartNEQ applies the NEQ predicate on the "start" field.
+func StartNEQ(v float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldNEQ(FieldStart, v))
+}
+
+// StartIn applies the In predicate on the "start" field.
+func StartIn(vs ...float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldIn(FieldStart, vs...))
+}
+
+// StartNotIn applies the NotIn predicate on the "start" field.
+func StartNotIn(vs ...float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldNotIn(FieldStart, vs...))
+}
+
+// StartGT applies the GT predicate on the "start" field.
+func StartGT(v float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldGT(FieldStart, v))
+}
+
+// StartGTE applies the GTE predicate on the "start" field.
+func StartGTE(v float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldGTE(FieldStart, v))
+}
+
+// StartLT applies the LT predicate on the "start" field.
+func StartLT(v float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldLT(FieldStart, v))
+}
+
+// StartLTE applies the LTE predicate on the "start" field.
+func StartLTE(v float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldLTE(FieldStart, v))
+}
+
+// EndEQ applies the EQ predicate on the "end" field.
+func EndEQ(v float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEQ(FieldEnd, v))
+}
+
+// EndNEQ applies the NEQ predicate on the "end" field.
+func EndNEQ(v float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldNEQ(FieldEnd, v))
+}
+
+// EndIn applies the In predicate on the "end" field.
+func EndIn(vs ...float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldIn(FieldEnd, vs...))
+}
+
+// EndNotIn applies the NotIn predicate on the "end" field.
+func EndNotIn(vs ...float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldNotIn(FieldEnd, vs...))
+}
+
+// EndGT applies the GT predicate on the "end" field.
+func EndGT(v float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldGT(FieldEnd, v))
+}
+
+// EndGTE applies the GTE predicate on the "end" field.
+func EndGTE(v float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldGTE(FieldEnd, v))
+}
+
+// EndLT applies the LT predicate on the "end" field.
+func EndLT(v float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldLT(FieldEnd, v))
+}
+
+// EndLTE applies the LTE predicate on the "end" field.
+func EndLTE(v float64) predicate.Chapter {
+	return predicate.Chapter(sql.FieldLTE(FieldEnd, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldContainsFold(FieldKind, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.Chapter {
+	return predicate.Chapter(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.Chapter {
+	return predicate.Chapter(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Chapter {
+	return predicate.Chapter(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// HasEpisode applies the HasEdge predicate on the "episode" edge.
+func HasEpisode() predicate.Chapter {
+	return predicate.Chapter(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EpisodeTable, EpisodeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEpisodeWith applies the HasEdge predicate on the "episode" edge with a given conditions (other predicates).
+func HasEpisodeWith(preds ...predicate.Episode) predicate.Chapter {
+	return predicate.Chapter(func(s *sql.Selector) {
+		step := newEpisodeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Chapter) predicate.Chapter {
+	return predicate.Chapter(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Chapter) predicate.Chapter {
+	return predicate.Chapter(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Chapter) predicate.Chapter {
+	return predicate.Chapter(sql.NotPredicates(p))
+}
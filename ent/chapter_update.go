@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/chapter"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+)
+
+// ChapterUpdate is the builder for updating Chapter entities.
+type ChapterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChapterMutation
+}
+
+// Where appends a list predicates to the ChapterUpdate builder.
+func (cu *ChapterUpdate) Where(ps ...predicate.Chapter) *ChapterUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *ChapterUpdate) SetUpdatedAt(t time.Time) *ChapterUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetStart sets the "start" field.
+func (cu *ChapterUpdate) SetStart(f float64) *ChapterUpdate {
+	cu.mutation.ResetStart()
+	cu.mutation.SetStart(f)
+	return cu
+}
+
+// SetNillableStart sets the "start" field if the given value is not nil.
+func (cu *ChapterUpdate) SetNillableStart(f *float64) *ChapterUpdate {
+	if f != nil {
+		cu.SetStart(*f)
+	}
+	return cu
+}
+
+// AddStart adds f to the "start" field.
+func (cu *ChapterUpdate) AddStart(f float64) *ChapterUpdate {
+	cu.mutation.AddStart(f)
+	return cu
+}
+
+// SetEnd sets the "end" field.
+func (cu *ChapterUpdate) SetEnd(f float64) *ChapterUpdate {
+	cu.mutation.ResetEnd()
+	cu.mutation.SetEnd(f)
+	return cu
+}
+
+// SetNillableEnd sets the "end" field if the given value is not nil.
+func (cu *ChapterUpdate) SetNillableEnd(f *float64) *ChapterUpdate {
+	if f != nil {
+		cu.SetEnd(*f)
+	}
+	return cu
+}
+
+// AddEnd adds f to the "end" field.
+func (cu *ChapterUpdate) AddEnd(f float64) *ChapterUpdate {
+	cu.mutation.AddEnd(f)
+	return cu
+}
+
+// SetKind sets the "kind" field.
+func (cu *ChapterUpdate) SetKind(s string) *ChapterUpdate {
+	cu.mutation.SetKind(s)
+	return cu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (cu *ChapterUpdate) SetNillableKind(s *string) *ChapterUpdate {
+	if s != nil {
+		cu.SetKind(*s)
+	}
+	return cu
+}
+
+// SetTitle sets the "title" field.
+func (cu *ChapterUpdate) SetTitle(s string) *ChapterUpdate {
+	cu.mutation.SetTitle(s)
+	return cu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (cu *ChapterUpdate) SetNillableTitle(s *string) *ChapterUpdate {
+	if s != nil {
+		cu.SetTitle(*s)
+	}
+	return cu
+}
+
+// ClearTitle clears the value of the "title" field.
+func (cu *ChapterUpdate) ClearTitle() *ChapterUpdate {
+	cu.mutation.ClearTitle()
+	return cu
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (cu *ChapterUpdate) SetEpisodeID(id int) *ChapterUpdate {
+	cu.mutation.SetEpisodeID(id)
+	return cu
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (cu *ChapterUpdate) SetEpisode(e *Episode) *ChapterUpdate {
+	return cu.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the ChapterMutation object of the builder.
+func (cu *ChapterUpdate) Mutation() *ChapterMutation {
+	return cu.mutation
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (cu *ChapterUpdate) ClearEpisode() *ChapterUpdate {
+	cu.mutation.ClearEpisode()
+	return cu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *ChapterUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *ChapterUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *ChapterUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *ChapterUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *ChapterUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := chapter.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *ChapterUpdate) check() error {
+	if cu.mutation.EpisodeCleared() && len(cu.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Chapter.episode"`)
+	}
+	return nil
+}
+
+func (cu *ChapterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chapter.Table, chapter.Columns, sqlgraph.NewFieldSpec(chapter.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(chapter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.Start(); ok {
+		_spec.SetField(chapter.FieldStart, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.AddedStart(); ok {
+		_spec.AddField(chapter.FieldStart, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.End(); ok {
+		_spec.SetField(chapter.FieldEnd, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.AddedEnd(); ok {
+		_spec.AddField(chapter.FieldEnd, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.Kind(); ok {
+		_spec.SetField(chapter.FieldKind, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Title(); ok {
+		_spec.SetField(chapter.FieldTitle, field.TypeString, value)
+	}
+	if cu.mutation.TitleCleared() {
+		_spec.ClearField(chapter.FieldTitle, field.TypeString)
+	}
+	if cu.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chapter.EpisodeTable,
+			Columns: []string{chapter.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chapter.EpisodeTable,
+			Columns: []string{chapter.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chapter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// ChapterUpdateOne is the builder for updating a single Chapter entity.
+type ChapterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChapterMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *ChapterUpdateOne) SetUpdatedAt(t time.Time) *ChapterUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetStart sets the "start" field.
+func (cuo *ChapterUpdateOne) SetStart(f float64) *ChapterUpdateOne {
+	cuo.mutation.ResetStart()
+	cuo.mutation.SetStart(f)
+	return cuo
+}
+
+// SetNillableStart sets the "start" field if the given value is not nil.
+func (cuo *ChapterUpdateOne) SetNillableStart(f *float64) *ChapterUpdateOne {
+	if f != nil {
+		cuo.SetStart(*f)
+	}
+	return cuo
+}
+
+// AddStart adds f to the "start" field.
+func (cuo *ChapterUpdateOne) AddStart(f float64) *ChapterUpdateOne {
+	cuo.mutation.AddStart(f)
+	return cuo
+}
+
+// SetEnd sets the "end" field.
+func (cuo *ChapterUpdateOne) SetEnd(f float64) *ChapterUpdateOne {
+	cuo.mutation.ResetEnd()
+	cuo.mutation.SetEnd(f)
+	return cuo
+}
+
+// SetNillableEnd sets the "end" field if the given value is not nil.
+func (cuo *ChapterUpdateOne) SetNillableEnd(f *float64) *ChapterUpdateOne {
+	if f != nil {
+		cuo.SetEnd(*f)
+	}
+	return cuo
+}
+
+// AddEnd adds f to the "end" field.
+func (cuo *ChapterUpdateOne) AddEnd(f float64) *ChapterUpdateOne {
+	cuo.mutation.AddEnd(f)
+	return cuo
+}
+
+// SetKind sets the "kind" field.
+func (cuo *ChapterUpdateOne) SetKind(s string) *ChapterUpdateOne {
+	cuo.mutation.SetKind(s)
+	return cuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (cuo *ChapterUpdateOne) SetNillableKind(s *string) *ChapterUpdateOne {
+	if s != nil {
+		cuo.SetKind(*s)
+	}
+	return cuo
+}
+
+// SetTitle sets the "title" field.
+func (cuo *ChapterUpdateOne) SetTitle(s string) *ChapterUpdateOne {
+	cuo.mutation.SetTitle(s)
+	return cuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (cuo *ChapterUpdateOne) SetNillableTitle(s *string) *ChapterUpdateOne {
+	if s != nil {
+		cuo.SetTitle(*s)
+	}
+	return cuo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (cuo *ChapterUpdateOne) ClearTitle() *ChapterUpdateOne {
+	cuo.mutation.ClearTitle()
+	return cuo
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (cuo *ChapterUpdateOne) SetEpisodeID(id int) *ChapterUpdateOne {
+	cuo.mutation.SetEpisodeID(id)
+	return cuo
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (cuo *ChapterUpdateOne) SetEpisode(e *Episode) *ChapterUpdateOne {
+	return cuo.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the ChapterMutation object of the builder.
+func (cuo *ChapterUpdateOne) Mutation() *ChapterMutation {
+	return cuo.mutation
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (cuo *ChapterUpdateOne) ClearEpisode() *ChapterUpdateOne {
+	cuo.mutation.ClearEpisode()
+	return cuo
+}
+
+// Where appends a list predicates to the ChapterUpdate builder.
+func (cuo *ChapterUpdateOne) Where(ps ...predicate.Chapter) *ChapterUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *ChapterUpdateOne) Select(field string, fields ...string) *ChapterUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Chapter entity.
+func (cuo *ChapterUpdateOne) Save(ctx context.Context) (*Chapter, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *ChapterUpdateOne) SaveX(ctx context.Context) *Chapter {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *ChapterUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *ChapterUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *ChapterUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := chapter.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *ChapterUpdateOne) check() error {
+	if cuo.mutation.EpisodeCleared() && len(cuo.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Chapter.episode"`)
+	}
+	return nil
+}
+
+func (cuo *ChapterUpdateOne) sqlSave(ctx context.Context) (_node *Chapter, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chapter.Table, chapter.Columns, sqlgraph.NewFieldSpec(chapter.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Chapter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chapter.FieldID)
+		for _, f := range fields {
+			if !chapter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chapter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(chapter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.Start(); ok {
+		_spec.SetField(chapter.FieldStart, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.AddedStart(); ok {
+		_spec.AddField(chapter.FieldStart, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.End(); ok {
+		_spec.SetField(chapter.FieldEnd, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.AddedEnd(); ok {
+		_spec.AddField(chapter.FieldEnd, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.Kind(); ok {
+		_spec.SetField(chapter.FieldKind, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Title(); ok {
+		_spec.SetField(chapter.FieldTitle, field.TypeString, value)
+	}
+	if cuo.mutation.TitleCleared() {
+		_spec.ClearField(chapter.FieldTitle, field.TypeString)
+	}
+	if cuo.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chapter.EpisodeTable,
+			Columns: []string{chapter.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chapter.EpisodeTable,
+			Columns: []string{chapter.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Chapter{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chapter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
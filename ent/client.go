@@ -15,9 +15,20 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/clustlight/animatrix-api/ent/apikey"
+	"github.com/clustlight/animatrix-api/ent/audiotrack"
+	"github.com/clustlight/animatrix-api/ent/chapter"
 	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/favorite"
+	"github.com/clustlight/animatrix-api/ent/rating"
+	"github.com/clustlight/animatrix-api/ent/rendition"
 	"github.com/clustlight/animatrix-api/ent/season"
 	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/subtitle"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/ent/watchlist"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
+	"github.com/clustlight/animatrix-api/ent/watchprogress"
 )
 
 // Client is the client that holds all ent builders.
@@ -25,12 +36,34 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// AudioTrack is the client for interacting with the AudioTrack builders.
+	AudioTrack *AudioTrackClient
+	// Chapter is the client for interacting with the Chapter builders.
+	Chapter *ChapterClient
 	// Episode is the client for interacting with the Episode builders.
 	Episode *EpisodeClient
+	// Favorite is the client for interacting with the Favorite builders.
+	Favorite *FavoriteClient
+	// Rating is the client for interacting with the Rating builders.
+	Rating *RatingClient
+	// Rendition is the client for interacting with the Rendition builders.
+	Rendition *RenditionClient
 	// Season is the client for interacting with the Season builders.
 	Season *SeasonClient
 	// Series is the client for interacting with the Series builders.
 	Series *SeriesClient
+	// Subtitle is the client for interacting with the Subtitle builders.
+	Subtitle *SubtitleClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
+	// WatchProgress is the client for interacting with the WatchProgress builders.
+	WatchProgress *WatchProgressClient
+	// Watchlist is the client for interacting with the Watchlist builders.
+	Watchlist *WatchlistClient
+	// WatchlistItem is the client for interacting with the WatchlistItem builders.
+	WatchlistItem *WatchlistItemClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -42,9 +75,20 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIKey = NewAPIKeyClient(c.config)
+	c.AudioTrack = NewAudioTrackClient(c.config)
+	c.Chapter = NewChapterClient(c.config)
 	c.Episode = NewEpisodeClient(c.config)
+	c.Favorite = NewFavoriteClient(c.config)
+	c.Rating = NewRatingClient(c.config)
+	c.Rendition = NewRenditionClient(c.config)
 	c.Season = NewSeasonClient(c.config)
 	c.Series = NewSeriesClient(c.config)
+	c.Subtitle = NewSubtitleClient(c.config)
+	c.User = NewUserClient(c.config)
+	c.WatchProgress = NewWatchProgressClient(c.config)
+	c.Watchlist = NewWatchlistClient(c.config)
+	c.WatchlistItem = NewWatchlistItemClient(c.config)
 }
 
 type (
@@ -135,11 +179,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Episode: NewEpisodeClient(cfg),
-		Season:  NewSeasonClient(cfg),
-		Series:  NewSeriesClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		APIKey:        NewAPIKeyClient(cfg),
+		AudioTrack:    NewAudioTrackClient(cfg),
+		Chapter:       NewChapterClient(cfg),
+		Episode:       NewEpisodeClient(cfg),
+		Favorite:      NewFavoriteClient(cfg),
+		Rating:        NewRatingClient(cfg),
+		Rendition:     NewRenditionClient(cfg),
+		Season:        NewSeasonClient(cfg),
+		Series:        NewSeriesClient(cfg),
+		Subtitle:      NewSubtitleClient(cfg),
+		User:          NewUserClient(cfg),
+		WatchProgress: NewWatchProgressClient(cfg),
+		Watchlist:     NewWatchlistClient(cfg),
+		WatchlistItem: NewWatchlistItemClient(cfg),
 	}, nil
 }
 
@@ -157,18 +212,29 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Episode: NewEpisodeClient(cfg),
-		Season:  NewSeasonClient(cfg),
-		Series:  NewSeriesClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		APIKey:        NewAPIKeyClient(cfg),
+		AudioTrack:    NewAudioTrackClient(cfg),
+		Chapter:       NewChapterClient(cfg),
+		Episode:       NewEpisodeClient(cfg),
+		Favorite:      NewFavoriteClient(cfg),
+		Rating:        NewRatingClient(cfg),
+		Rendition:     NewRenditionClient(cfg),
+		Season:        NewSeasonClient(cfg),
+		Series:        NewSeriesClient(cfg),
+		Subtitle:      NewSubtitleClient(cfg),
+		User:          NewUserClient(cfg),
+		WatchProgress: NewWatchProgressClient(cfg),
+		Watchlist:     NewWatchlistClient(cfg),
+		WatchlistItem: NewWatchlistItemClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Episode.
+//		APIKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -190,134 +256,164 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Episode.Use(hooks...)
-	c.Season.Use(hooks...)
-	c.Series.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.APIKey, c.AudioTrack, c.Chapter, c.Episode, c.Favorite, c.Rating, c.Rendition,
+		c.Season, c.Series, c.Subtitle, c.User, c.WatchProgress, c.Watchlist,
+		c.WatchlistItem,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Episode.Intercept(interceptors...)
-	c.Season.Intercept(interceptors...)
-	c.Series.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.APIKey, c.AudioTrack, c.Chapter, c.Episode, c.Favorite, c.Rating, c.Rendition,
+		c.Season, c.Series, c.Subtitle, c.User, c.WatchProgress, c.Watchlist,
+		c.WatchlistItem,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APIKeyMutation:
+		return c.APIKey.mutate(ctx, m)
+	case *AudioTrackMutation:
+		return c.AudioTrack.mutate(ctx, m)
+	case *ChapterMutation:
+		return c.Chapter.mutate(ctx, m)
 	case *EpisodeMutation:
 		return c.Episode.mutate(ctx, m)
+	case *FavoriteMutation:
+		return c.Favorite.mutate(ctx, m)
+	case *RatingMutation:
+		return c.Rating.mutate(ctx, m)
+	case *RenditionMutation:
+		return c.Rendition.mutate(ctx, m)
 	case *SeasonMutation:
 		return c.Season.mutate(ctx, m)
 	case *SeriesMutation:
 		return c.Series.mutate(ctx, m)
+	case *SubtitleMutation:
+		return c.Subtitle.mutate(ctx, m)
+	case *UserMutation:
+		return c.User.mutate(ctx, m)
+	case *WatchProgressMutation:
+		return c.WatchProgress.mutate(ctx, m)
+	case *WatchlistMutation:
+		return c.Watchlist.mutate(ctx, m)
+	case *WatchlistItemMutation:
+		return c.WatchlistItem.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
-// EpisodeClient is a client for the Episode schema.
-type EpisodeClient struct {
+// APIKeyClient is a client for the APIKey schema.
+type APIKeyClient struct {
 	config
 }
 
-// NewEpisodeClient returns a client for the Episode from the given config.
-func NewEpisodeClient(c config) *EpisodeClient {
-	return &EpisodeClient{config: c}
+// NewAPIKeyClient returns a client for the APIKey from the given config.
+func NewAPIKeyClient(c config) *APIKeyClient {
+	return &APIKeyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `episode.Hooks(f(g(h())))`.
-func (c *EpisodeClient) Use(hooks ...Hook) {
-	c.hooks.Episode = append(c.hooks.Episode, hooks...)
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *APIKeyClient) Use(hooks ...Hook) {
+	c.hooks.APIKey = append(c.hooks.APIKey, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `episode.Intercept(f(g(h())))`.
-func (c *EpisodeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Episode = append(c.inters.Episode, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *APIKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIKey = append(c.inters.APIKey, interceptors...)
 }
 
-// Create returns a builder for creating a Episode entity.
-func (c *EpisodeClient) Create() *EpisodeCreate {
-	mutation := newEpisodeMutation(c.config, OpCreate)
-	return &EpisodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a APIKey entity.
+func (c *APIKeyClient) Create() *APIKeyCreate {
+	mutation := newAPIKeyMutation(c.config, OpCreate)
+	return &APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Episode entities.
-func (c *EpisodeClient) CreateBulk(builders ...*EpisodeCreate) *EpisodeCreateBulk {
-	return &EpisodeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of APIKey entities.
+func (c *APIKeyClient) CreateBulk(builders ...*APIKeyCreate) *APIKeyCreateBulk {
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EpisodeClient) MapCreateBulk(slice any, setFunc func(*EpisodeCreate, int)) *EpisodeCreateBulk {
+func (c *APIKeyClient) MapCreateBulk(slice any, setFunc func(*APIKeyCreate, int)) *APIKeyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EpisodeCreateBulk{err: fmt.Errorf("calling to EpisodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &APIKeyCreateBulk{err: fmt.Errorf("calling to APIKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EpisodeCreate, rv.Len())
+	builders := make([]*APIKeyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EpisodeCreateBulk{config: c.config, builders: builders}
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Episode.
-func (c *EpisodeClient) Update() *EpisodeUpdate {
-	mutation := newEpisodeMutation(c.config, OpUpdate)
-	return &EpisodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for APIKey.
+func (c *APIKeyClient) Update() *APIKeyUpdate {
+	mutation := newAPIKeyMutation(c.config, OpUpdate)
+	return &APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EpisodeClient) UpdateOne(e *Episode) *EpisodeUpdateOne {
-	mutation := newEpisodeMutation(c.config, OpUpdateOne, withEpisode(e))
-	return &EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *APIKeyClient) UpdateOne(ak *APIKey) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKey(ak))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EpisodeClient) UpdateOneID(id int) *EpisodeUpdateOne {
-	mutation := newEpisodeMutation(c.config, OpUpdateOne, withEpisodeID(id))
-	return &EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *APIKeyClient) UpdateOneID(id int) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKeyID(id))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Episode.
-func (c *EpisodeClient) Delete() *EpisodeDelete {
-	mutation := newEpisodeMutation(c.config, OpDelete)
-	return &EpisodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for APIKey.
+func (c *APIKeyClient) Delete() *APIKeyDelete {
+	mutation := newAPIKeyMutation(c.config, OpDelete)
+	return &APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EpisodeClient) DeleteOne(e *Episode) *EpisodeDeleteOne {
-	return c.DeleteOneID(e.ID)
+func (c *APIKeyClient) DeleteOne(ak *APIKey) *APIKeyDeleteOne {
+	return c.DeleteOneID(ak.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EpisodeClient) DeleteOneID(id int) *EpisodeDeleteOne {
-	builder := c.Delete().Where(episode.ID(id))
+func (c *APIKeyClient) DeleteOneID(id int) *APIKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EpisodeDeleteOne{builder}
+	return &APIKeyDeleteOne{builder}
 }
 
-// Query returns a query builder for Episode.
-func (c *EpisodeClient) Query() *EpisodeQuery {
-	return &EpisodeQuery{
+// Query returns a query builder for APIKey.
+func (c *APIKeyClient) Query() *APIKeyQuery {
+	return &APIKeyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEpisode},
+		ctx:    &QueryContext{Type: TypeAPIKey},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Episode entity by its id.
-func (c *EpisodeClient) Get(ctx context.Context, id int) (*Episode, error) {
-	return c.Query().Where(episode.ID(id)).Only(ctx)
+// Get returns a APIKey entity by its id.
+func (c *APIKeyClient) Get(ctx context.Context, id int) (*APIKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EpisodeClient) GetX(ctx context.Context, id int) *Episode {
+func (c *APIKeyClient) GetX(ctx context.Context, id int) *APIKey {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -325,148 +421,148 @@ func (c *EpisodeClient) GetX(ctx context.Context, id int) *Episode {
 	return obj
 }
 
-// QuerySeason queries the season edge of a Episode.
-func (c *EpisodeClient) QuerySeason(e *Episode) *SeasonQuery {
-	query := (&SeasonClient{config: c.config}).Query()
+// QueryUser queries the user edge of a APIKey.
+func (c *APIKeyClient) QueryUser(ak *APIKey) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
+		id := ak.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(episode.Table, episode.FieldID, id),
-			sqlgraph.To(season.Table, season.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, episode.SeasonTable, episode.SeasonColumn),
+			sqlgraph.From(apikey.Table, apikey.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apikey.UserTable, apikey.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ak.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *EpisodeClient) Hooks() []Hook {
-	return c.hooks.Episode
+func (c *APIKeyClient) Hooks() []Hook {
+	return c.hooks.APIKey
 }
 
 // Interceptors returns the client interceptors.
-func (c *EpisodeClient) Interceptors() []Interceptor {
-	return c.inters.Episode
+func (c *APIKeyClient) Interceptors() []Interceptor {
+	return c.inters.APIKey
 }
 
-func (c *EpisodeClient) mutate(ctx context.Context, m *EpisodeMutation) (Value, error) {
+func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EpisodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EpisodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EpisodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Episode mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown APIKey mutation op: %q", m.Op())
 	}
 }
 
-// SeasonClient is a client for the Season schema.
-type SeasonClient struct {
+// AudioTrackClient is a client for the AudioTrack schema.
+type AudioTrackClient struct {
 	config
 }
 
-// NewSeasonClient returns a client for the Season from the given config.
-func NewSeasonClient(c config) *SeasonClient {
-	return &SeasonClient{config: c}
+// NewAudioTrackClient returns a client for the AudioTrack from the given config.
+func NewAudioTrackClient(c config) *AudioTrackClient {
+	return &AudioTrackClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `season.Hooks(f(g(h())))`.
-func (c *SeasonClient) Use(hooks ...Hook) {
-	c.hooks.Season = append(c.hooks.Season, hooks...)
+// A call to `Use(f, g, h)` equals to `audiotrack.Hooks(f(g(h())))`.
+func (c *AudioTrackClient) Use(hooks ...Hook) {
+	c.hooks.AudioTrack = append(c.hooks.AudioTrack, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `season.Intercept(f(g(h())))`.
-func (c *SeasonClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Season = append(c.inters.Season, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `audiotrack.Intercept(f(g(h())))`.
+func (c *AudioTrackClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AudioTrack = append(c.inters.AudioTrack, interceptors...)
 }
 
-// Create returns a builder for creating a Season entity.
-func (c *SeasonClient) Create() *SeasonCreate {
-	mutation := newSeasonMutation(c.config, OpCreate)
-	return &SeasonCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AudioTrack entity.
+func (c *AudioTrackClient) Create() *AudioTrackCreate {
+	mutation := newAudioTrackMutation(c.config, OpCreate)
+	return &AudioTrackCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Season entities.
-func (c *SeasonClient) CreateBulk(builders ...*SeasonCreate) *SeasonCreateBulk {
-	return &SeasonCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AudioTrack entities.
+func (c *AudioTrackClient) CreateBulk(builders ...*AudioTrackCreate) *AudioTrackCreateBulk {
+	return &AudioTrackCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SeasonClient) MapCreateBulk(slice any, setFunc func(*SeasonCreate, int)) *SeasonCreateBulk {
+func (c *AudioTrackClient) MapCreateBulk(slice any, setFunc func(*AudioTrackCreate, int)) *AudioTrackCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SeasonCreateBulk{err: fmt.Errorf("calling to SeasonClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AudioTrackCreateBulk{err: fmt.Errorf("calling to AudioTrackClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SeasonCreate, rv.Len())
+	builders := make([]*AudioTrackCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SeasonCreateBulk{config: c.config, builders: builders}
+	return &AudioTrackCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Season.
-func (c *SeasonClient) Update() *SeasonUpdate {
-	mutation := newSeasonMutation(c.config, OpUpdate)
-	return &SeasonUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AudioTrack.
+func (c *AudioTrackClient) Update() *AudioTrackUpdate {
+	mutation := newAudioTrackMutation(c.config, OpUpdate)
+	return &AudioTrackUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SeasonClient) UpdateOne(s *Season) *SeasonUpdateOne {
-	mutation := newSeasonMutation(c.config, OpUpdateOne, withSeason(s))
-	return &SeasonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AudioTrackClient) UpdateOne(at *AudioTrack) *AudioTrackUpdateOne {
+	mutation := newAudioTrackMutation(c.config, OpUpdateOne, withAudioTrack(at))
+	return &AudioTrackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SeasonClient) UpdateOneID(id int) *SeasonUpdateOne {
-	mutation := newSeasonMutation(c.config, OpUpdateOne, withSeasonID(id))
-	return &SeasonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AudioTrackClient) UpdateOneID(id int) *AudioTrackUpdateOne {
+	mutation := newAudioTrackMutation(c.config, OpUpdateOne, withAudioTrackID(id))
+	return &AudioTrackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Season.
-func (c *SeasonClient) Delete() *SeasonDelete {
-	mutation := newSeasonMutation(c.config, OpDelete)
-	return &SeasonDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AudioTrack.
+func (c *AudioTrackClient) Delete() *AudioTrackDelete {
+	mutation := newAudioTrackMutation(c.config, OpDelete)
+	return &AudioTrackDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SeasonClient) DeleteOne(s *Season) *SeasonDeleteOne {
-	return c.DeleteOneID(s.ID)
+func (c *AudioTrackClient) DeleteOne(at *AudioTrack) *AudioTrackDeleteOne {
+	return c.DeleteOneID(at.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SeasonClient) DeleteOneID(id int) *SeasonDeleteOne {
-	builder := c.Delete().Where(season.ID(id))
+func (c *AudioTrackClient) DeleteOneID(id int) *AudioTrackDeleteOne {
+	builder := c.Delete().Where(audiotrack.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SeasonDeleteOne{builder}
+	return &AudioTrackDeleteOne{builder}
 }
 
-// Query returns a query builder for Season.
-func (c *SeasonClient) Query() *SeasonQuery {
-	return &SeasonQuery{
+// Query returns a query builder for AudioTrack.
+func (c *AudioTrackClient) Query() *AudioTrackQuery {
+	return &AudioTrackQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSeason},
+		ctx:    &QueryContext{Type: TypeAudioTrack},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Season entity by its id.
-func (c *SeasonClient) Get(ctx context.Context, id int) (*Season, error) {
-	return c.Query().Where(season.ID(id)).Only(ctx)
+// Get returns a AudioTrack entity by its id.
+func (c *AudioTrackClient) Get(ctx context.Context, id int) (*AudioTrack, error) {
+	return c.Query().Where(audiotrack.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SeasonClient) GetX(ctx context.Context, id int) *Season {
+func (c *AudioTrackClient) GetX(ctx context.Context, id int) *AudioTrack {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -474,164 +570,148 @@ func (c *SeasonClient) GetX(ctx context.Context, id int) *Season {
 	return obj
 }
 
-// QuerySeries queries the series edge of a Season.
-func (c *SeasonClient) QuerySeries(s *Season) *SeriesQuery {
-	query := (&SeriesClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := s.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(season.Table, season.FieldID, id),
-			sqlgraph.To(series.Table, series.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, season.SeriesTable, season.SeriesColumn),
-		)
-		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEpisodes queries the episodes edge of a Season.
-func (c *SeasonClient) QueryEpisodes(s *Season) *EpisodeQuery {
+// QueryEpisode queries the episode edge of a AudioTrack.
+func (c *AudioTrackClient) QueryEpisode(at *AudioTrack) *EpisodeQuery {
 	query := (&EpisodeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := s.ID
+		id := at.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(season.Table, season.FieldID, id),
+			sqlgraph.From(audiotrack.Table, audiotrack.FieldID, id),
 			sqlgraph.To(episode.Table, episode.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, season.EpisodesTable, season.EpisodesColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, audiotrack.EpisodeTable, audiotrack.EpisodeColumn),
 		)
-		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(at.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SeasonClient) Hooks() []Hook {
-	return c.hooks.Season
+func (c *AudioTrackClient) Hooks() []Hook {
+	return c.hooks.AudioTrack
 }
 
 // Interceptors returns the client interceptors.
-func (c *SeasonClient) Interceptors() []Interceptor {
-	return c.inters.Season
+func (c *AudioTrackClient) Interceptors() []Interceptor {
+	return c.inters.AudioTrack
 }
 
-func (c *SeasonClient) mutate(ctx context.Context, m *SeasonMutation) (Value, error) {
+func (c *AudioTrackClient) mutate(ctx context.Context, m *AudioTrackMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SeasonCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AudioTrackCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SeasonUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AudioTrackUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SeasonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AudioTrackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SeasonDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AudioTrackDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Season mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AudioTrack mutation op: %q", m.Op())
 	}
 }
 
-// SeriesClient is a client for the Series schema.
-type SeriesClient struct {
+// ChapterClient is a client for the Chapter schema.
+type ChapterClient struct {
 	config
 }
 
-// NewSeriesClient returns a client for the Series from the given config.
-func NewSeriesClient(c config) *SeriesClient {
-	return &SeriesClient{config: c}
+// NewChapterClient returns a client for the Chapter from the given config.
+func NewChapterClient(c config) *ChapterClient {
+	return &ChapterClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `series.Hooks(f(g(h())))`.
-func (c *SeriesClient) Use(hooks ...Hook) {
-	c.hooks.Series = append(c.hooks.Series, hooks...)
+// A call to `Use(f, g, h)` equals to `chapter.Hooks(f(g(h())))`.
+func (c *ChapterClient) Use(hooks ...Hook) {
+	c.hooks.Chapter = append(c.hooks.Chapter, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `series.Intercept(f(g(h())))`.
-func (c *SeriesClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Series = append(c.inters.Series, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `chapter.Intercept(f(g(h())))`.
+func (c *ChapterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Chapter = append(c.inters.Chapter, interceptors...)
 }
 
-// Create returns a builder for creating a Series entity.
-func (c *SeriesClient) Create() *SeriesCreate {
-	mutation := newSeriesMutation(c.config, OpCreate)
-	return &SeriesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Chapter entity.
+func (c *ChapterClient) Create() *ChapterCreate {
+	mutation := newChapterMutation(c.config, OpCreate)
+	return &ChapterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Series entities.
-func (c *SeriesClient) CreateBulk(builders ...*SeriesCreate) *SeriesCreateBulk {
-	return &SeriesCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Chapter entities.
+func (c *ChapterClient) CreateBulk(builders ...*ChapterCreate) *ChapterCreateBulk {
+	return &ChapterCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SeriesClient) MapCreateBulk(slice any, setFunc func(*SeriesCreate, int)) *SeriesCreateBulk {
+func (c *ChapterClient) MapCreateBulk(slice any, setFunc func(*ChapterCreate, int)) *ChapterCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SeriesCreateBulk{err: fmt.Errorf("calling to SeriesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ChapterCreateBulk{err: fmt.Errorf("calling to ChapterClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SeriesCreate, rv.Len())
+	builders := make([]*ChapterCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SeriesCreateBulk{config: c.config, builders: builders}
+	return &ChapterCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Series.
-func (c *SeriesClient) Update() *SeriesUpdate {
-	mutation := newSeriesMutation(c.config, OpUpdate)
-	return &SeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Chapter.
+func (c *ChapterClient) Update() *ChapterUpdate {
+	mutation := newChapterMutation(c.config, OpUpdate)
+	return &ChapterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SeriesClient) UpdateOne(s *Series) *SeriesUpdateOne {
-	mutation := newSeriesMutation(c.config, OpUpdateOne, withSeries(s))
-	return &SeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChapterClient) UpdateOne(ch *Chapter) *ChapterUpdateOne {
+	mutation := newChapterMutation(c.config, OpUpdateOne, withChapter(ch))
+	return &ChapterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SeriesClient) UpdateOneID(id int) *SeriesUpdateOne {
-	mutation := newSeriesMutation(c.config, OpUpdateOne, withSeriesID(id))
-	return &SeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChapterClient) UpdateOneID(id int) *ChapterUpdateOne {
+	mutation := newChapterMutation(c.config, OpUpdateOne, withChapterID(id))
+	return &ChapterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Series.
-func (c *SeriesClient) Delete() *SeriesDelete {
-	mutation := newSeriesMutation(c.config, OpDelete)
-	return &SeriesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Chapter.
+func (c *ChapterClient) Delete() *ChapterDelete {
+	mutation := newChapterMutation(c.config, OpDelete)
+	return &ChapterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SeriesClient) DeleteOne(s *Series) *SeriesDeleteOne {
-	return c.DeleteOneID(s.ID)
+func (c *ChapterClient) DeleteOne(ch *Chapter) *ChapterDeleteOne {
+	return c.DeleteOneID(ch.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SeriesClient) DeleteOneID(id int) *SeriesDeleteOne {
-	builder := c.Delete().Where(series.ID(id))
+func (c *ChapterClient) DeleteOneID(id int) *ChapterDeleteOne {
+	builder := c.Delete().Where(chapter.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SeriesDeleteOne{builder}
+	return &ChapterDeleteOne{builder}
 }
 
-// Query returns a query builder for Series.
-func (c *SeriesClient) Query() *SeriesQuery {
-	return &SeriesQuery{
+// Query returns a query builder for Chapter.
+func (c *ChapterClient) Query() *ChapterQuery {
+	return &ChapterQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSeries},
+		ctx:    &QueryContext{Type: TypeChapter},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Series entity by its id.
-func (c *SeriesClient) Get(ctx context.Context, id int) (*Series, error) {
-	return c.Query().Where(series.ID(id)).Only(ctx)
+// Get returns a Chapter entity by its id.
+func (c *ChapterClient) Get(ctx context.Context, id int) (*Chapter, error) {
+	return c.Query().Where(chapter.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SeriesClient) GetX(ctx context.Context, id int) *Series {
+func (c *ChapterClient) GetX(ctx context.Context, id int) *Chapter {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -639,53 +719,2079 @@ func (c *SeriesClient) GetX(ctx context.Context, id int) *Series {
 	return obj
 }
 
-// QuerySeasons queries the seasons edge of a Series.
-func (c *SeriesClient) QuerySeasons(s *Series) *SeasonQuery {
-	query := (&SeasonClient{config: c.config}).Query()
+// QueryEpisode queries the episode edge of a Chapter.
+func (c *ChapterClient) QueryEpisode(ch *Chapter) *EpisodeQuery {
+	query := (&EpisodeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := s.ID
+		id := ch.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(series.Table, series.FieldID, id),
-			sqlgraph.To(season.Table, season.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, series.SeasonsTable, series.SeasonsColumn),
+			sqlgraph.From(chapter.Table, chapter.FieldID, id),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, chapter.EpisodeTable, chapter.EpisodeColumn),
 		)
-		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SeriesClient) Hooks() []Hook {
-	return c.hooks.Series
+func (c *ChapterClient) Hooks() []Hook {
+	return c.hooks.Chapter
 }
 
 // Interceptors returns the client interceptors.
-func (c *SeriesClient) Interceptors() []Interceptor {
-	return c.inters.Series
+func (c *ChapterClient) Interceptors() []Interceptor {
+	return c.inters.Chapter
 }
 
-func (c *SeriesClient) mutate(ctx context.Context, m *SeriesMutation) (Value, error) {
+func (c *ChapterClient) mutate(ctx context.Context, m *ChapterMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SeriesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChapterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChapterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChapterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SeriesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ChapterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Series mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Chapter mutation op: %q", m.Op())
+	}
+}
+
+// EpisodeClient is a client for the Episode schema.
+type EpisodeClient struct {
+	config
+}
+
+// NewEpisodeClient returns a client for the Episode from the given config.
+func NewEpisodeClient(c config) *EpisodeClient {
+	return &EpisodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `episode.Hooks(f(g(h())))`.
+func (c *EpisodeClient) Use(hooks ...Hook) {
+	c.hooks.Episode = append(c.hooks.Episode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `episode.Intercept(f(g(h())))`.
+func (c *EpisodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Episode = append(c.inters.Episode, interceptors...)
+}
+
+// Create returns a builder for creating a Episode entity.
+func (c *EpisodeClient) Create() *EpisodeCreate {
+	mutation := newEpisodeMutation(c.config, OpCreate)
+	return &EpisodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Episode entities.
+func (c *EpisodeClient) CreateBulk(builders ...*EpisodeCreate) *EpisodeCreateBulk {
+	return &EpisodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EpisodeClient) MapCreateBulk(slice any, setFunc func(*EpisodeCreate, int)) *EpisodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EpisodeCreateBulk{err: fmt.Errorf("calling to EpisodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EpisodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EpisodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Episode.
+func (c *EpisodeClient) Update() *EpisodeUpdate {
+	mutation := newEpisodeMutation(c.config, OpUpdate)
+	return &EpisodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EpisodeClient) UpdateOne(e *Episode) *EpisodeUpdateOne {
+	mutation := newEpisodeMutation(c.config, OpUpdateOne, withEpisode(e))
+	return &EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EpisodeClient) UpdateOneID(id int) *EpisodeUpdateOne {
+	mutation := newEpisodeMutation(c.config, OpUpdateOne, withEpisodeID(id))
+	return &EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Episode.
+func (c *EpisodeClient) Delete() *EpisodeDelete {
+	mutation := newEpisodeMutation(c.config, OpDelete)
+	return &EpisodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EpisodeClient) DeleteOne(e *Episode) *EpisodeDeleteOne {
+	return c.DeleteOneID(e.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EpisodeClient) DeleteOneID(id int) *EpisodeDeleteOne {
+	builder := c.Delete().Where(episode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EpisodeDeleteOne{builder}
+}
+
+// Query returns a query builder for Episode.
+func (c *EpisodeClient) Query() *EpisodeQuery {
+	return &EpisodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEpisode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Episode entity by its id.
+func (c *EpisodeClient) Get(ctx context.Context, id int) (*Episode, error) {
+	return c.Query().Where(episode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EpisodeClient) GetX(ctx context.Context, id int) *Episode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySeason queries the season edge of a Episode.
+func (c *EpisodeClient) QuerySeason(e *Episode) *SeasonQuery {
+	query := (&SeasonClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, id),
+			sqlgraph.To(season.Table, season.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, episode.SeasonTable, episode.SeasonColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRenditions queries the renditions edge of a Episode.
+func (c *EpisodeClient) QueryRenditions(e *Episode) *RenditionQuery {
+	query := (&RenditionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, id),
+			sqlgraph.To(rendition.Table, rendition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, episode.RenditionsTable, episode.RenditionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySubtitles queries the subtitles edge of a Episode.
+func (c *EpisodeClient) QuerySubtitles(e *Episode) *SubtitleQuery {
+	query := (&SubtitleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, id),
+			sqlgraph.To(subtitle.Table, subtitle.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, episode.SubtitlesTable, episode.SubtitlesColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAudioTracks queries the audio_tracks edge of a Episode.
+func (c *EpisodeClient) QueryAudioTracks(e *Episode) *AudioTrackQuery {
+	query := (&AudioTrackClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, id),
+			sqlgraph.To(audiotrack.Table, audiotrack.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, episode.AudioTracksTable, episode.AudioTracksColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChapters queries the chapters edge of a Episode.
+func (c *EpisodeClient) QueryChapters(e *Episode) *ChapterQuery {
+	query := (&ChapterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, id),
+			sqlgraph.To(chapter.Table, chapter.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, episode.ChaptersTable, episode.ChaptersColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWatchProgress queries the watch_progress edge of a Episode.
+func (c *EpisodeClient) QueryWatchProgress(e *Episode) *WatchProgressQuery {
+	query := (&WatchProgressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, id),
+			sqlgraph.To(watchprogress.Table, watchprogress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, episode.WatchProgressTable, episode.WatchProgressColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EpisodeClient) Hooks() []Hook {
+	return c.hooks.Episode
+}
+
+// Interceptors returns the client interceptors.
+func (c *EpisodeClient) Interceptors() []Interceptor {
+	return c.inters.Episode
+}
+
+func (c *EpisodeClient) mutate(ctx context.Context, m *EpisodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EpisodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EpisodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EpisodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Episode mutation op: %q", m.Op())
+	}
+}
+
+// FavoriteClient is a client for the Favorite schema.
+type FavoriteClient struct {
+	config
+}
+
+// NewFavoriteClient returns a client for the Favorite from the given config.
+func NewFavoriteClient(c config) *FavoriteClient {
+	return &FavoriteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `favorite.Hooks(f(g(h())))`.
+func (c *FavoriteClient) Use(hooks ...Hook) {
+	c.hooks.Favorite = append(c.hooks.Favorite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `favorite.Intercept(f(g(h())))`.
+func (c *FavoriteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Favorite = append(c.inters.Favorite, interceptors...)
+}
+
+// Create returns a builder for creating a Favorite entity.
+func (c *FavoriteClient) Create() *FavoriteCreate {
+	mutation := newFavoriteMutation(c.config, OpCreate)
+	return &FavoriteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Favorite entities.
+func (c *FavoriteClient) CreateBulk(builders ...*FavoriteCreate) *FavoriteCreateBulk {
+	return &FavoriteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FavoriteClient) MapCreateBulk(slice any, setFunc func(*FavoriteCreate, int)) *FavoriteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FavoriteCreateBulk{err: fmt.Errorf("calling to FavoriteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FavoriteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FavoriteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Favorite.
+func (c *FavoriteClient) Update() *FavoriteUpdate {
+	mutation := newFavoriteMutation(c.config, OpUpdate)
+	return &FavoriteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FavoriteClient) UpdateOne(f *Favorite) *FavoriteUpdateOne {
+	mutation := newFavoriteMutation(c.config, OpUpdateOne, withFavorite(f))
+	return &FavoriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FavoriteClient) UpdateOneID(id int) *FavoriteUpdateOne {
+	mutation := newFavoriteMutation(c.config, OpUpdateOne, withFavoriteID(id))
+	return &FavoriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Favorite.
+func (c *FavoriteClient) Delete() *FavoriteDelete {
+	mutation := newFavoriteMutation(c.config, OpDelete)
+	return &FavoriteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FavoriteClient) DeleteOne(f *Favorite) *FavoriteDeleteOne {
+	return c.DeleteOneID(f.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FavoriteClient) DeleteOneID(id int) *FavoriteDeleteOne {
+	builder := c.Delete().Where(favorite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FavoriteDeleteOne{builder}
+}
+
+// Query returns a query builder for Favorite.
+func (c *FavoriteClient) Query() *FavoriteQuery {
+	return &FavoriteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFavorite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Favorite entity by its id.
+func (c *FavoriteClient) Get(ctx context.Context, id int) (*Favorite, error) {
+	return c.Query().Where(favorite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FavoriteClient) GetX(ctx context.Context, id int) *Favorite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Favorite.
+func (c *FavoriteClient) QueryUser(f *Favorite) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(favorite.Table, favorite.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, favorite.UserTable, favorite.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySeries queries the series edge of a Favorite.
+func (c *FavoriteClient) QuerySeries(f *Favorite) *SeriesQuery {
+	query := (&SeriesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(favorite.Table, favorite.FieldID, id),
+			sqlgraph.To(series.Table, series.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, favorite.SeriesTable, favorite.SeriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySeason queries the season edge of a Favorite.
+func (c *FavoriteClient) QuerySeason(f *Favorite) *SeasonQuery {
+	query := (&SeasonClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(favorite.Table, favorite.FieldID, id),
+			sqlgraph.To(season.Table, season.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, favorite.SeasonTable, favorite.SeasonColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FavoriteClient) Hooks() []Hook {
+	return c.hooks.Favorite
+}
+
+// Interceptors returns the client interceptors.
+func (c *FavoriteClient) Interceptors() []Interceptor {
+	return c.inters.Favorite
+}
+
+func (c *FavoriteClient) mutate(ctx context.Context, m *FavoriteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FavoriteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FavoriteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FavoriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FavoriteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Favorite mutation op: %q", m.Op())
+	}
+}
+
+// RatingClient is a client for the Rating schema.
+type RatingClient struct {
+	config
+}
+
+// NewRatingClient returns a client for the Rating from the given config.
+func NewRatingClient(c config) *RatingClient {
+	return &RatingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rating.Hooks(f(g(h())))`.
+func (c *RatingClient) Use(hooks ...Hook) {
+	c.hooks.Rating = append(c.hooks.Rating, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rating.Intercept(f(g(h())))`.
+func (c *RatingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Rating = append(c.inters.Rating, interceptors...)
+}
+
+// Create returns a builder for creating a Rating entity.
+func (c *RatingClient) Create() *RatingCreate {
+	mutation := newRatingMutation(c.config, OpCreate)
+	return &RatingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Rating entities.
+func (c *RatingClient) CreateBulk(builders ...*RatingCreate) *RatingCreateBulk {
+	return &RatingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RatingClient) MapCreateBulk(slice any, setFunc func(*RatingCreate, int)) *RatingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RatingCreateBulk{err: fmt.Errorf("calling to RatingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RatingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RatingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Rating.
+func (c *RatingClient) Update() *RatingUpdate {
+	mutation := newRatingMutation(c.config, OpUpdate)
+	return &RatingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RatingClient) UpdateOne(r *Rating) *RatingUpdateOne {
+	mutation := newRatingMutation(c.config, OpUpdateOne, withRating(r))
+	return &RatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RatingClient) UpdateOneID(id int) *RatingUpdateOne {
+	mutation := newRatingMutation(c.config, OpUpdateOne, withRatingID(id))
+	return &RatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Rating.
+func (c *RatingClient) Delete() *RatingDelete {
+	mutation := newRatingMutation(c.config, OpDelete)
+	return &RatingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RatingClient) DeleteOne(r *Rating) *RatingDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RatingClient) DeleteOneID(id int) *RatingDeleteOne {
+	builder := c.Delete().Where(rating.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RatingDeleteOne{builder}
+}
+
+// Query returns a query builder for Rating.
+func (c *RatingClient) Query() *RatingQuery {
+	return &RatingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRating},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Rating entity by its id.
+func (c *RatingClient) Get(ctx context.Context, id int) (*Rating, error) {
+	return c.Query().Where(rating.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RatingClient) GetX(ctx context.Context, id int) *Rating {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Rating.
+func (c *RatingClient) QueryUser(r *Rating) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rating.Table, rating.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rating.UserTable, rating.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySeries queries the series edge of a Rating.
+func (c *RatingClient) QuerySeries(r *Rating) *SeriesQuery {
+	query := (&SeriesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rating.Table, rating.FieldID, id),
+			sqlgraph.To(series.Table, series.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rating.SeriesTable, rating.SeriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySeason queries the season edge of a Rating.
+func (c *RatingClient) QuerySeason(r *Rating) *SeasonQuery {
+	query := (&SeasonClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rating.Table, rating.FieldID, id),
+			sqlgraph.To(season.Table, season.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rating.SeasonTable, rating.SeasonColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RatingClient) Hooks() []Hook {
+	return c.hooks.Rating
+}
+
+// Interceptors returns the client interceptors.
+func (c *RatingClient) Interceptors() []Interceptor {
+	return c.inters.Rating
+}
+
+func (c *RatingClient) mutate(ctx context.Context, m *RatingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RatingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RatingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RatingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Rating mutation op: %q", m.Op())
+	}
+}
+
+// RenditionClient is a client for the Rendition schema.
+type RenditionClient struct {
+	config
+}
+
+// NewRenditionClient returns a client for the Rendition from the given config.
+func NewRenditionClient(c config) *RenditionClient {
+	return &RenditionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rendition.Hooks(f(g(h())))`.
+func (c *RenditionClient) Use(hooks ...Hook) {
+	c.hooks.Rendition = append(c.hooks.Rendition, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rendition.Intercept(f(g(h())))`.
+func (c *RenditionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Rendition = append(c.inters.Rendition, interceptors...)
+}
+
+// Create returns a builder for creating a Rendition entity.
+func (c *RenditionClient) Create() *RenditionCreate {
+	mutation := newRenditionMutation(c.config, OpCreate)
+	return &RenditionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Rendition entities.
+func (c *RenditionClient) CreateBulk(builders ...*RenditionCreate) *RenditionCreateBulk {
+	return &RenditionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RenditionClient) MapCreateBulk(slice any, setFunc func(*RenditionCreate, int)) *RenditionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RenditionCreateBulk{err: fmt.Errorf("calling to RenditionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RenditionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RenditionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Rendition.
+func (c *RenditionClient) Update() *RenditionUpdate {
+	mutation := newRenditionMutation(c.config, OpUpdate)
+	return &RenditionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RenditionClient) UpdateOne(r *Rendition) *RenditionUpdateOne {
+	mutation := newRenditionMutation(c.config, OpUpdateOne, withRendition(r))
+	return &RenditionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RenditionClient) UpdateOneID(id int) *RenditionUpdateOne {
+	mutation := newRenditionMutation(c.config, OpUpdateOne, withRenditionID(id))
+	return &RenditionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Rendition.
+func (c *RenditionClient) Delete() *RenditionDelete {
+	mutation := newRenditionMutation(c.config, OpDelete)
+	return &RenditionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RenditionClient) DeleteOne(r *Rendition) *RenditionDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RenditionClient) DeleteOneID(id int) *RenditionDeleteOne {
+	builder := c.Delete().Where(rendition.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RenditionDeleteOne{builder}
+}
+
+// Query returns a query builder for Rendition.
+func (c *RenditionClient) Query() *RenditionQuery {
+	return &RenditionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRendition},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Rendition entity by its id.
+func (c *RenditionClient) Get(ctx context.Context, id int) (*Rendition, error) {
+	return c.Query().Where(rendition.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RenditionClient) GetX(ctx context.Context, id int) *Rendition {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEpisode queries the episode edge of a Rendition.
+func (c *RenditionClient) QueryEpisode(r *Rendition) *EpisodeQuery {
+	query := (&EpisodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rendition.Table, rendition.FieldID, id),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rendition.EpisodeTable, rendition.EpisodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RenditionClient) Hooks() []Hook {
+	return c.hooks.Rendition
+}
+
+// Interceptors returns the client interceptors.
+func (c *RenditionClient) Interceptors() []Interceptor {
+	return c.inters.Rendition
+}
+
+func (c *RenditionClient) mutate(ctx context.Context, m *RenditionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RenditionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RenditionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RenditionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RenditionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Rendition mutation op: %q", m.Op())
+	}
+}
+
+// SeasonClient is a client for the Season schema.
+type SeasonClient struct {
+	config
+}
+
+// NewSeasonClient returns a client for the Season from the given config.
+func NewSeasonClient(c config) *SeasonClient {
+	return &SeasonClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `season.Hooks(f(g(h())))`.
+func (c *SeasonClient) Use(hooks ...Hook) {
+	c.hooks.Season = append(c.hooks.Season, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `season.Intercept(f(g(h())))`.
+func (c *SeasonClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Season = append(c.inters.Season, interceptors...)
+}
+
+// Create returns a builder for creating a Season entity.
+func (c *SeasonClient) Create() *SeasonCreate {
+	mutation := newSeasonMutation(c.config, OpCreate)
+	return &SeasonCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Season entities.
+func (c *SeasonClient) CreateBulk(builders ...*SeasonCreate) *SeasonCreateBulk {
+	return &SeasonCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SeasonClient) MapCreateBulk(slice any, setFunc func(*SeasonCreate, int)) *SeasonCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SeasonCreateBulk{err: fmt.Errorf("calling to SeasonClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SeasonCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SeasonCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Season.
+func (c *SeasonClient) Update() *SeasonUpdate {
+	mutation := newSeasonMutation(c.config, OpUpdate)
+	return &SeasonUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SeasonClient) UpdateOne(s *Season) *SeasonUpdateOne {
+	mutation := newSeasonMutation(c.config, OpUpdateOne, withSeason(s))
+	return &SeasonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SeasonClient) UpdateOneID(id int) *SeasonUpdateOne {
+	mutation := newSeasonMutation(c.config, OpUpdateOne, withSeasonID(id))
+	return &SeasonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Season.
+func (c *SeasonClient) Delete() *SeasonDelete {
+	mutation := newSeasonMutation(c.config, OpDelete)
+	return &SeasonDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SeasonClient) DeleteOne(s *Season) *SeasonDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SeasonClient) DeleteOneID(id int) *SeasonDeleteOne {
+	builder := c.Delete().Where(season.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SeasonDeleteOne{builder}
+}
+
+// Query returns a query builder for Season.
+func (c *SeasonClient) Query() *SeasonQuery {
+	return &SeasonQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSeason},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Season entity by its id.
+func (c *SeasonClient) Get(ctx context.Context, id int) (*Season, error) {
+	return c.Query().Where(season.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SeasonClient) GetX(ctx context.Context, id int) *Season {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySeries queries the series edge of a Season.
+func (c *SeasonClient) QuerySeries(s *Season) *SeriesQuery {
+	query := (&SeriesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(season.Table, season.FieldID, id),
+			sqlgraph.To(series.Table, series.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, season.SeriesTable, season.SeriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEpisodes queries the episodes edge of a Season.
+func (c *SeasonClient) QueryEpisodes(s *Season) *EpisodeQuery {
+	query := (&EpisodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(season.Table, season.FieldID, id),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, season.EpisodesTable, season.EpisodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFavorites queries the favorites edge of a Season.
+func (c *SeasonClient) QueryFavorites(s *Season) *FavoriteQuery {
+	query := (&FavoriteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(season.Table, season.FieldID, id),
+			sqlgraph.To(favorite.Table, favorite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, season.FavoritesTable, season.FavoritesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRatings queries the ratings edge of a Season.
+func (c *SeasonClient) QueryRatings(s *Season) *RatingQuery {
+	query := (&RatingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(season.Table, season.FieldID, id),
+			sqlgraph.To(rating.Table, rating.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, season.RatingsTable, season.RatingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWatchlistItems queries the watchlist_items edge of a Season.
+func (c *SeasonClient) QueryWatchlistItems(s *Season) *WatchlistItemQuery {
+	query := (&WatchlistItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(season.Table, season.FieldID, id),
+			sqlgraph.To(watchlistitem.Table, watchlistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, season.WatchlistItemsTable, season.WatchlistItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SeasonClient) Hooks() []Hook {
+	return c.hooks.Season
+}
+
+// Interceptors returns the client interceptors.
+func (c *SeasonClient) Interceptors() []Interceptor {
+	return c.inters.Season
+}
+
+func (c *SeasonClient) mutate(ctx context.Context, m *SeasonMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SeasonCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SeasonUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SeasonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SeasonDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Season mutation op: %q", m.Op())
+	}
+}
+
+// SeriesClient is a client for the Series schema.
+type SeriesClient struct {
+	config
+}
+
+// NewSeriesClient returns a client for the Series from the given config.
+func NewSeriesClient(c config) *SeriesClient {
+	return &SeriesClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `series.Hooks(f(g(h())))`.
+func (c *SeriesClient) Use(hooks ...Hook) {
+	c.hooks.Series = append(c.hooks.Series, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `series.Intercept(f(g(h())))`.
+func (c *SeriesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Series = append(c.inters.Series, interceptors...)
+}
+
+// Create returns a builder for creating a Series entity.
+func (c *SeriesClient) Create() *SeriesCreate {
+	mutation := newSeriesMutation(c.config, OpCreate)
+	return &SeriesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Series entities.
+func (c *SeriesClient) CreateBulk(builders ...*SeriesCreate) *SeriesCreateBulk {
+	return &SeriesCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SeriesClient) MapCreateBulk(slice any, setFunc func(*SeriesCreate, int)) *SeriesCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SeriesCreateBulk{err: fmt.Errorf("calling to SeriesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SeriesCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SeriesCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Series.
+func (c *SeriesClient) Update() *SeriesUpdate {
+	mutation := newSeriesMutation(c.config, OpUpdate)
+	return &SeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SeriesClient) UpdateOne(s *Series) *SeriesUpdateOne {
+	mutation := newSeriesMutation(c.config, OpUpdateOne, withSeries(s))
+	return &SeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SeriesClient) UpdateOneID(id int) *SeriesUpdateOne {
+	mutation := newSeriesMutation(c.config, OpUpdateOne, withSeriesID(id))
+	return &SeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Series.
+func (c *SeriesClient) Delete() *SeriesDelete {
+	mutation := newSeriesMutation(c.config, OpDelete)
+	return &SeriesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SeriesClient) DeleteOne(s *Series) *SeriesDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SeriesClient) DeleteOneID(id int) *SeriesDeleteOne {
+	builder := c.Delete().Where(series.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SeriesDeleteOne{builder}
+}
+
+// Query returns a query builder for Series.
+func (c *SeriesClient) Query() *SeriesQuery {
+	return &SeriesQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSeries},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Series entity by its id.
+func (c *SeriesClient) Get(ctx context.Context, id int) (*Series, error) {
+	return c.Query().Where(series.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SeriesClient) GetX(ctx context.Context, id int) *Series {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySeasons queries the seasons edge of a Series.
+func (c *SeriesClient) QuerySeasons(s *Series) *SeasonQuery {
+	query := (&SeasonClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(series.Table, series.FieldID, id),
+			sqlgraph.To(season.Table, season.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, series.SeasonsTable, series.SeasonsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFavorites queries the favorites edge of a Series.
+func (c *SeriesClient) QueryFavorites(s *Series) *FavoriteQuery {
+	query := (&FavoriteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(series.Table, series.FieldID, id),
+			sqlgraph.To(favorite.Table, favorite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, series.FavoritesTable, series.FavoritesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRatings queries the ratings edge of a Series.
+func (c *SeriesClient) QueryRatings(s *Series) *RatingQuery {
+	query := (&RatingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(series.Table, series.FieldID, id),
+			sqlgraph.To(rating.Table, rating.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, series.RatingsTable, series.RatingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWatchlistItems queries the watchlist_items edge of a Series.
+func (c *SeriesClient) QueryWatchlistItems(s *Series) *WatchlistItemQuery {
+	query := (&WatchlistItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(series.Table, series.FieldID, id),
+			sqlgraph.To(watchlistitem.Table, watchlistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, series.WatchlistItemsTable, series.WatchlistItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SeriesClient) Hooks() []Hook {
+	return c.hooks.Series
+}
+
+// Interceptors returns the client interceptors.
+func (c *SeriesClient) Interceptors() []Interceptor {
+	return c.inters.Series
+}
+
+func (c *SeriesClient) mutate(ctx context.Context, m *SeriesMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SeriesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SeriesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Series mutation op: %q", m.Op())
+	}
+}
+
+// SubtitleClient is a client for the Subtitle schema.
+type SubtitleClient struct {
+	config
+}
+
+// NewSubtitleClient returns a client for the Subtitle from the given config.
+func NewSubtitleClient(c config) *SubtitleClient {
+	return &SubtitleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `subtitle.Hooks(f(g(h())))`.
+func (c *SubtitleClient) Use(hooks ...Hook) {
+	c.hooks.Subtitle = append(c.hooks.Subtitle, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `subtitle.Intercept(f(g(h())))`.
+func (c *SubtitleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Subtitle = append(c.inters.Subtitle, interceptors...)
+}
+
+// Create returns a builder for creating a Subtitle entity.
+func (c *SubtitleClient) Create() *SubtitleCreate {
+	mutation := newSubtitleMutation(c.config, OpCreate)
+	return &SubtitleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Subtitle entities.
+func (c *SubtitleClient) CreateBulk(builders ...*SubtitleCreate) *SubtitleCreateBulk {
+	return &SubtitleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SubtitleClient) MapCreateBulk(slice any, setFunc func(*SubtitleCreate, int)) *SubtitleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SubtitleCreateBulk{err: fmt.Errorf("calling to SubtitleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SubtitleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SubtitleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Subtitle.
+func (c *SubtitleClient) Update() *SubtitleUpdate {
+	mutation := newSubtitleMutation(c.config, OpUpdate)
+	return &SubtitleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SubtitleClient) UpdateOne(s *Subtitle) *SubtitleUpdateOne {
+	mutation := newSubtitleMutation(c.config, OpUpdateOne, withSubtitle(s))
+	return &SubtitleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SubtitleClient) UpdateOneID(id int) *SubtitleUpdateOne {
+	mutation := newSubtitleMutation(c.config, OpUpdateOne, withSubtitleID(id))
+	return &SubtitleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Subtitle.
+func (c *SubtitleClient) Delete() *SubtitleDelete {
+	mutation := newSubtitleMutation(c.config, OpDelete)
+	return &SubtitleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SubtitleClient) DeleteOne(s *Subtitle) *SubtitleDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SubtitleClient) DeleteOneID(id int) *SubtitleDeleteOne {
+	builder := c.Delete().Where(subtitle.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SubtitleDeleteOne{builder}
+}
+
+// Query returns a query builder for Subtitle.
+func (c *SubtitleClient) Query() *SubtitleQuery {
+	return &SubtitleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSubtitle},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Subtitle entity by its id.
+func (c *SubtitleClient) Get(ctx context.Context, id int) (*Subtitle, error) {
+	return c.Query().Where(subtitle.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SubtitleClient) GetX(ctx context.Context, id int) *Subtitle {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEpisode queries the episode edge of a Subtitle.
+func (c *SubtitleClient) QueryEpisode(s *Subtitle) *EpisodeQuery {
+	query := (&EpisodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subtitle.Table, subtitle.FieldID, id),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subtitle.EpisodeTable, subtitle.EpisodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SubtitleClient) Hooks() []Hook {
+	return c.hooks.Subtitle
+}
+
+// Interceptors returns the client interceptors.
+func (c *SubtitleClient) Interceptors() []Interceptor {
+	return c.inters.Subtitle
+}
+
+func (c *SubtitleClient) mutate(ctx context.Context, m *SubtitleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SubtitleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SubtitleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SubtitleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SubtitleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Subtitle mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
+	return c.DeleteOneID(u.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWatchProgress queries the watch_progress edge of a User.
+func (c *UserClient) QueryWatchProgress(u *User) *WatchProgressQuery {
+	query := (&WatchProgressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(watchprogress.Table, watchprogress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WatchProgressTable, user.WatchProgressColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFavorites queries the favorites edge of a User.
+func (c *UserClient) QueryFavorites(u *User) *FavoriteQuery {
+	query := (&FavoriteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(favorite.Table, favorite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.FavoritesTable, user.FavoritesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRatings queries the ratings edge of a User.
+func (c *UserClient) QueryRatings(u *User) *RatingQuery {
+	query := (&RatingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(rating.Table, rating.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RatingsTable, user.RatingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWatchlists queries the watchlists edge of a User.
+func (c *UserClient) QueryWatchlists(u *User) *WatchlistQuery {
+	query := (&WatchlistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(watchlist.Table, watchlist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WatchlistsTable, user.WatchlistsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPIKeys queries the api_keys edge of a User.
+func (c *UserClient) QueryAPIKeys(u *User) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.APIKeysTable, user.APIKeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+	}
+}
+
+// WatchProgressClient is a client for the WatchProgress schema.
+type WatchProgressClient struct {
+	config
+}
+
+// NewWatchProgressClient returns a client for the WatchProgress from the given config.
+func NewWatchProgressClient(c config) *WatchProgressClient {
+	return &WatchProgressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `watchprogress.Hooks(f(g(h())))`.
+func (c *WatchProgressClient) Use(hooks ...Hook) {
+	c.hooks.WatchProgress = append(c.hooks.WatchProgress, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `watchprogress.Intercept(f(g(h())))`.
+func (c *WatchProgressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WatchProgress = append(c.inters.WatchProgress, interceptors...)
+}
+
+// Create returns a builder for creating a WatchProgress entity.
+func (c *WatchProgressClient) Create() *WatchProgressCreate {
+	mutation := newWatchProgressMutation(c.config, OpCreate)
+	return &WatchProgressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WatchProgress entities.
+func (c *WatchProgressClient) CreateBulk(builders ...*WatchProgressCreate) *WatchProgressCreateBulk {
+	return &WatchProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WatchProgressClient) MapCreateBulk(slice any, setFunc func(*WatchProgressCreate, int)) *WatchProgressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WatchProgressCreateBulk{err: fmt.Errorf("calling to WatchProgressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WatchProgressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WatchProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WatchProgress.
+func (c *WatchProgressClient) Update() *WatchProgressUpdate {
+	mutation := newWatchProgressMutation(c.config, OpUpdate)
+	return &WatchProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WatchProgressClient) UpdateOne(wp *WatchProgress) *WatchProgressUpdateOne {
+	mutation := newWatchProgressMutation(c.config, OpUpdateOne, withWatchProgress(wp))
+	return &WatchProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WatchProgressClient) UpdateOneID(id int) *WatchProgressUpdateOne {
+	mutation := newWatchProgressMutation(c.config, OpUpdateOne, withWatchProgressID(id))
+	return &WatchProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WatchProgress.
+func (c *WatchProgressClient) Delete() *WatchProgressDelete {
+	mutation := newWatchProgressMutation(c.config, OpDelete)
+	return &WatchProgressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WatchProgressClient) DeleteOne(wp *WatchProgress) *WatchProgressDeleteOne {
+	return c.DeleteOneID(wp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WatchProgressClient) DeleteOneID(id int) *WatchProgressDeleteOne {
+	builder := c.Delete().Where(watchprogress.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WatchProgressDeleteOne{builder}
+}
+
+// Query returns a query builder for WatchProgress.
+func (c *WatchProgressClient) Query() *WatchProgressQuery {
+	return &WatchProgressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWatchProgress},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WatchProgress entity by its id.
+func (c *WatchProgressClient) Get(ctx context.Context, id int) (*WatchProgress, error) {
+	return c.Query().Where(watchprogress.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WatchProgressClient) GetX(ctx context.Context, id int) *WatchProgress {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a WatchProgress.
+func (c *WatchProgressClient) QueryUser(wp *WatchProgress) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(watchprogress.Table, watchprogress.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, watchprogress.UserTable, watchprogress.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(wp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEpisode queries the episode edge of a WatchProgress.
+func (c *WatchProgressClient) QueryEpisode(wp *WatchProgress) *EpisodeQuery {
+	query := (&EpisodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(watchprogress.Table, watchprogress.FieldID, id),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, watchprogress.EpisodeTable, watchprogress.EpisodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(wp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WatchProgressClient) Hooks() []Hook {
+	return c.hooks.WatchProgress
+}
+
+// Interceptors returns the client interceptors.
+func (c *WatchProgressClient) Interceptors() []Interceptor {
+	return c.inters.WatchProgress
+}
+
+func (c *WatchProgressClient) mutate(ctx context.Context, m *WatchProgressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WatchProgressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WatchProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WatchProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WatchProgressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WatchProgress mutation op: %q", m.Op())
+	}
+}
+
+// WatchlistClient is a client for the Watchlist schema.
+type WatchlistClient struct {
+	config
+}
+
+// NewWatchlistClient returns a client for the Watchlist from the given config.
+func NewWatchlistClient(c config) *WatchlistClient {
+	return &WatchlistClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `watchlist.Hooks(f(g(h())))`.
+func (c *WatchlistClient) Use(hooks ...Hook) {
+	c.hooks.Watchlist = append(c.hooks.Watchlist, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `watchlist.Intercept(f(g(h())))`.
+func (c *WatchlistClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Watchlist = append(c.inters.Watchlist, interceptors...)
+}
+
+// Create returns a builder for creating a Watchlist entity.
+func (c *WatchlistClient) Create() *WatchlistCreate {
+	mutation := newWatchlistMutation(c.config, OpCreate)
+	return &WatchlistCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Watchlist entities.
+func (c *WatchlistClient) CreateBulk(builders ...*WatchlistCreate) *WatchlistCreateBulk {
+	return &WatchlistCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WatchlistClient) MapCreateBulk(slice any, setFunc func(*WatchlistCreate, int)) *WatchlistCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WatchlistCreateBulk{err: fmt.Errorf("calling to WatchlistClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WatchlistCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WatchlistCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Watchlist.
+func (c *WatchlistClient) Update() *WatchlistUpdate {
+	mutation := newWatchlistMutation(c.config, OpUpdate)
+	return &WatchlistUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WatchlistClient) UpdateOne(w *Watchlist) *WatchlistUpdateOne {
+	mutation := newWatchlistMutation(c.config, OpUpdateOne, withWatchlist(w))
+	return &WatchlistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WatchlistClient) UpdateOneID(id int) *WatchlistUpdateOne {
+	mutation := newWatchlistMutation(c.config, OpUpdateOne, withWatchlistID(id))
+	return &WatchlistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Watchlist.
+func (c *WatchlistClient) Delete() *WatchlistDelete {
+	mutation := newWatchlistMutation(c.config, OpDelete)
+	return &WatchlistDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WatchlistClient) DeleteOne(w *Watchlist) *WatchlistDeleteOne {
+	return c.DeleteOneID(w.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WatchlistClient) DeleteOneID(id int) *WatchlistDeleteOne {
+	builder := c.Delete().Where(watchlist.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WatchlistDeleteOne{builder}
+}
+
+// Query returns a query builder for Watchlist.
+func (c *WatchlistClient) Query() *WatchlistQuery {
+	return &WatchlistQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWatchlist},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Watchlist entity by its id.
+func (c *WatchlistClient) Get(ctx context.Context, id int) (*Watchlist, error) {
+	return c.Query().Where(watchlist.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WatchlistClient) GetX(ctx context.Context, id int) *Watchlist {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Watchlist.
+func (c *WatchlistClient) QueryUser(w *Watchlist) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(watchlist.Table, watchlist.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, watchlist.UserTable, watchlist.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItems queries the items edge of a Watchlist.
+func (c *WatchlistClient) QueryItems(w *Watchlist) *WatchlistItemQuery {
+	query := (&WatchlistItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(watchlist.Table, watchlist.FieldID, id),
+			sqlgraph.To(watchlistitem.Table, watchlistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, watchlist.ItemsTable, watchlist.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WatchlistClient) Hooks() []Hook {
+	return c.hooks.Watchlist
+}
+
+// Interceptors returns the client interceptors.
+func (c *WatchlistClient) Interceptors() []Interceptor {
+	return c.inters.Watchlist
+}
+
+func (c *WatchlistClient) mutate(ctx context.Context, m *WatchlistMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WatchlistCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WatchlistUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WatchlistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WatchlistDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Watchlist mutation op: %q", m.Op())
+	}
+}
+
+// WatchlistItemClient is a client for the WatchlistItem schema.
+type WatchlistItemClient struct {
+	config
+}
+
+// NewWatchlistItemClient returns a client for the WatchlistItem from the given config.
+func NewWatchlistItemClient(c config) *WatchlistItemClient {
+	return &WatchlistItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `watchlistitem.Hooks(f(g(h())))`.
+func (c *WatchlistItemClient) Use(hooks ...Hook) {
+	c.hooks.WatchlistItem = append(c.hooks.WatchlistItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `watchlistitem.Intercept(f(g(h())))`.
+func (c *WatchlistItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WatchlistItem = append(c.inters.WatchlistItem, interceptors...)
+}
+
+// Create returns a builder for creating a WatchlistItem entity.
+func (c *WatchlistItemClient) Create() *WatchlistItemCreate {
+	mutation := newWatchlistItemMutation(c.config, OpCreate)
+	return &WatchlistItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WatchlistItem entities.
+func (c *WatchlistItemClient) CreateBulk(builders ...*WatchlistItemCreate) *WatchlistItemCreateBulk {
+	return &WatchlistItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WatchlistItemClient) MapCreateBulk(slice any, setFunc func(*WatchlistItemCreate, int)) *WatchlistItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WatchlistItemCreateBulk{err: fmt.Errorf("calling to WatchlistItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WatchlistItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WatchlistItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WatchlistItem.
+func (c *WatchlistItemClient) Update() *WatchlistItemUpdate {
+	mutation := newWatchlistItemMutation(c.config, OpUpdate)
+	return &WatchlistItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WatchlistItemClient) UpdateOne(wi *WatchlistItem) *WatchlistItemUpdateOne {
+	mutation := newWatchlistItemMutation(c.config, OpUpdateOne, withWatchlistItem(wi))
+	return &WatchlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WatchlistItemClient) UpdateOneID(id int) *WatchlistItemUpdateOne {
+	mutation := newWatchlistItemMutation(c.config, OpUpdateOne, withWatchlistItemID(id))
+	return &WatchlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WatchlistItem.
+func (c *WatchlistItemClient) Delete() *WatchlistItemDelete {
+	mutation := newWatchlistItemMutation(c.config, OpDelete)
+	return &WatchlistItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WatchlistItemClient) DeleteOne(wi *WatchlistItem) *WatchlistItemDeleteOne {
+	return c.DeleteOneID(wi.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WatchlistItemClient) DeleteOneID(id int) *WatchlistItemDeleteOne {
+	builder := c.Delete().Where(watchlistitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WatchlistItemDeleteOne{builder}
+}
+
+// Query returns a query builder for WatchlistItem.
+func (c *WatchlistItemClient) Query() *WatchlistItemQuery {
+	return &WatchlistItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWatchlistItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WatchlistItem entity by its id.
+func (c *WatchlistItemClient) Get(ctx context.Context, id int) (*WatchlistItem, error) {
+	return c.Query().Where(watchlistitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WatchlistItemClient) GetX(ctx context.Context, id int) *WatchlistItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWatchlist queries the watchlist edge of a WatchlistItem.
+func (c *WatchlistItemClient) QueryWatchlist(wi *WatchlistItem) *WatchlistQuery {
+	query := (&WatchlistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(watchlistitem.Table, watchlistitem.FieldID, id),
+			sqlgraph.To(watchlist.Table, watchlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, watchlistitem.WatchlistTable, watchlistitem.WatchlistColumn),
+		)
+		fromV = sqlgraph.Neighbors(wi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySeries queries the series edge of a WatchlistItem.
+func (c *WatchlistItemClient) QuerySeries(wi *WatchlistItem) *SeriesQuery {
+	query := (&SeriesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(watchlistitem.Table, watchlistitem.FieldID, id),
+			sqlgraph.To(series.Table, series.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, watchlistitem.SeriesTable, watchlistitem.SeriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(wi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySeason queries the season edge of a WatchlistItem.
+func (c *WatchlistItemClient) QuerySeason(wi *WatchlistItem) *SeasonQuery {
+	query := (&SeasonClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(watchlistitem.Table, watchlistitem.FieldID, id),
+			sqlgraph.To(season.Table, season.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, watchlistitem.SeasonTable, watchlistitem.SeasonColumn),
+		)
+		fromV = sqlgraph.Neighbors(wi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WatchlistItemClient) Hooks() []Hook {
+	return c.hooks.WatchlistItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *WatchlistItemClient) Interceptors() []Interceptor {
+	return c.inters.WatchlistItem
+}
+
+func (c *WatchlistItemClient) mutate(ctx context.Context, m *WatchlistItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WatchlistItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WatchlistItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WatchlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WatchlistItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WatchlistItem mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Episode, Season, Series []ent.Hook
+		APIKey, AudioTrack, Chapter, Episode, Favorite, Rating, Rendition, Season,
+		Series, Subtitle, User, WatchProgress, Watchlist, WatchlistItem []ent.Hook
 	}
 	inters struct {
-		Episode, Season, Series []ent.Interceptor
+		APIKey, AudioTrack, Chapter, Episode, Favorite, Rating, Rendition, Season,
+		Series, Subtitle, User, WatchProgress, Watchlist,
+		WatchlistItem []ent.Interceptor
 	}
 )
@@ -12,9 +12,20 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/clustlight/animatrix-api/ent/apikey"
+	"github.com/clustlight/animatrix-api/ent/audiotrack"
+	"github.com/clustlight/animatrix-api/ent/chapter"
 	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/favorite"
+	"github.com/clustlight/animatrix-api/ent/rating"
+	"github.com/clustlight/animatrix-api/ent/rendition"
 	"github.com/clustlight/animatrix-api/ent/season"
 	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/subtitle"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/ent/watchlist"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
+	"github.com/clustlight/animatrix-api/ent/watchprogress"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -75,9 +86,20 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			episode.Table: episode.ValidColumn,
-			season.Table:  season.ValidColumn,
-			series.Table:  series.ValidColumn,
+			apikey.Table:        apikey.ValidColumn,
+			audiotrack.Table:    audiotrack.ValidColumn,
+			chapter.Table:       chapter.ValidColumn,
+			episode.Table:       episode.ValidColumn,
+			favorite.Table:      favorite.ValidColumn,
+			rating.Table:        rating.ValidColumn,
+			rendition.Table:     rendition.ValidColumn,
+			season.Table:        season.ValidColumn,
+			series.Table:        series.ValidColumn,
+			subtitle.Table:      subtitle.ValidColumn,
+			user.Table:          user.ValidColumn,
+			watchprogress.Table: watchprogress.ValidColumn,
+			watchlist.Table:     watchlist.ValidColumn,
+			watchlistitem.Table: watchlistitem.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -18,6 +19,10 @@ type Episode struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// EpisodeID holds the value of the "episode_id" field.
 	EpisodeID string `json:"episode_id,omitempty"`
 	// Title holds the value of the "title" field.
@@ -42,6 +47,8 @@ type Episode struct {
 	DynamicRange string `json:"dynamic_range,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata string `json:"metadata,omitempty"`
+	// Media holds the value of the "media" field.
+	Media map[string]string `json:"media,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EpisodeQuery when eager-loading is set.
 	Edges           EpisodeEdges `json:"edges"`
@@ -53,9 +60,19 @@ type Episode struct {
 type EpisodeEdges struct {
 	// Season holds the value of the season edge.
 	Season *Season `json:"season,omitempty"`
+	// Renditions holds the value of the renditions edge.
+	Renditions []*Rendition `json:"renditions,omitempty"`
+	// Subtitles holds the value of the subtitles edge.
+	Subtitles []*Subtitle `json:"subtitles,omitempty"`
+	// AudioTracks holds the value of the audio_tracks edge.
+	AudioTracks []*AudioTrack `json:"audio_tracks,omitempty"`
+	// Chapters holds the value of the chapters edge.
+	Chapters []*Chapter `json:"chapters,omitempty"`
+	// WatchProgress holds the value of the watch_progress edge.
+	WatchProgress []*WatchProgress `json:"watch_progress,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [6]bool
 }
 
 // SeasonOrErr returns the Season value or an error if the edge
@@ -69,18 +86,65 @@ func (e EpisodeEdges) SeasonOrErr() (*Season, error) {
 	return nil, &NotLoadedError{edge: "season"}
 }
 
+// RenditionsOrErr returns the Renditions value or an error if the edge
+// was not loaded in eager-loading.
+func (e EpisodeEdges) RenditionsOrErr() ([]*Rendition, error) {
+	if e.loadedTypes[1] {
+		return e.Renditions, nil
+	}
+	return nil, &NotLoadedError{edge: "renditions"}
+}
+
+// SubtitlesOrErr returns the Subtitles value or an error if the edge
+// was not loaded in eager-loading.
+func (e EpisodeEdges) SubtitlesOrErr() ([]*Subtitle, error) {
+	if e.loadedTypes[2] {
+		return e.Subtitles, nil
+	}
+	return nil, &NotLoadedError{edge: "subtitles"}
+}
+
+// AudioTracksOrErr returns the AudioTracks value or an error if the edge
+// was not loaded in eager-loading.
+func (e EpisodeEdges) AudioTracksOrErr() ([]*AudioTrack, error) {
+	if e.loadedTypes[3] {
+		return e.AudioTracks, nil
+	}
+	return nil, &NotLoadedError{edge: "audio_tracks"}
+}
+
+// ChaptersOrErr returns the Chapters value or an error if the edge
+// was not loaded in eager-loading.
+func (e EpisodeEdges) ChaptersOrErr() ([]*Chapter, error) {
+	if e.loadedTypes[4] {
+		return e.Chapters, nil
+	}
+	return nil, &NotLoadedError{edge: "chapters"}
+}
+
+// WatchProgressOrErr returns the WatchProgress value or an error if the edge
+// was not loaded in eager-loading.
+func (e EpisodeEdges) WatchProgressOrErr() ([]*WatchProgress, error) {
+	if e.loadedTypes[5] {
+		return e.WatchProgress, nil
+	}
+	return nil, &NotLoadedError{edge: "watch_progress"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Episode) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case episode.FieldMedia:
+			values[i] = new([]byte)
 		case episode.FieldDuration:
 			values[i] = new(sql.NullFloat64)
 		case episode.FieldID, episode.FieldEpisodeNumber, episode.FieldWidth, episode.FieldHeight:
 			values[i] = new(sql.NullInt64)
 		case episode.FieldEpisodeID, episode.FieldTitle, episode.FieldDescription, episode.FieldDurationString, episode.FieldFormatID, episode.FieldDynamicRange, episode.FieldMetadata:
 			values[i] = new(sql.NullString)
-		case episode.FieldTimestamp:
+		case episode.FieldCreatedAt, episode.FieldUpdatedAt, episode.FieldTimestamp:
 			values[i] = new(sql.NullTime)
 		case episode.ForeignKeys[0]: // season_episodes
 			values[i] = new(sql.NullInt64)
@@ -105,6 +169,18 @@ func (e *Episode) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			e.ID = int(value.Int64)
+		case episode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				e.CreatedAt = value.Time
+			}
+		case episode.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				e.UpdatedAt = value.Time
+			}
 		case episode.FieldEpisodeID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field episode_id", values[i])
@@ -177,6 +253,14 @@ func (e *Episode) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				e.Metadata = value.String
 			}
+		case episode.FieldMedia:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field media", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &e.Media); err != nil {
+					return fmt.Errorf("unmarshal field media: %w", err)
+				}
+			}
 		case episode.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field season_episodes", value)
@@ -202,6 +286,31 @@ func (e *Episode) QuerySeason() *SeasonQuery {
 	return NewEpisodeClient(e.config).QuerySeason(e)
 }
 
+// QueryRenditions queries the "renditions" edge of the Episode entity.
+func (e *Episode) QueryRenditions() *RenditionQuery {
+	return NewEpisodeClient(e.config).QueryRenditions(e)
+}
+
+// QuerySubtitles queries the "subtitles" edge of the Episode entity.
+func (e *Episode) QuerySubtitles() *SubtitleQuery {
+	return NewEpisodeClient(e.config).QuerySubtitles(e)
+}
+
+// QueryAudioTracks queries the "audio_tracks" edge of the Episode entity.
+func (e *Episode) QueryAudioTracks() *AudioTrackQuery {
+	return NewEpisodeClient(e.config).QueryAudioTracks(e)
+}
+
+// QueryChapters queries the "chapters" edge of the Episode entity.
+func (e *Episode) QueryChapters() *ChapterQuery {
+	return NewEpisodeClient(e.config).QueryChapters(e)
+}
+
+// QueryWatchProgress queries the "watch_progress" edge of the Episode entity.
+func (e *Episode) QueryWatchProgress() *WatchProgressQuery {
+	return NewEpisodeClient(e.config).QueryWatchProgress(e)
+}
+
 // Update returns a builder for updating this Episode.
 // Note that you need to call Episode.Unwrap() before calling this method if this Episode
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -225,6 +334,12 @@ func (e *Episode) String() string {
 	var builder strings.Builder
 	builder.WriteString("Episode(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", e.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(e.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(e.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("episode_id=")
 	builder.WriteString(e.EpisodeID)
 	builder.WriteString(", ")
@@ -260,6 +375,9 @@ func (e *Episode) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(e.Metadata)
+	builder.WriteString(", ")
+	builder.WriteString("media=")
+	builder.WriteString(fmt.Sprintf("%v", e.Media))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package episode
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -12,6 +14,10 @@ const (
 	Label = "episode"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
 	// FieldEpisodeID holds the string denoting the episode_id field in the database.
 	FieldEpisodeID = "episode_id"
 	// FieldTitle holds the string denoting the title field in the database.
@@ -36,8 +42,20 @@ const (
 	FieldDynamicRange = "dynamic_range"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
+	// FieldMedia holds the string denoting the media field in the database.
+	FieldMedia = "media"
 	// EdgeSeason holds the string denoting the season edge name in mutations.
 	EdgeSeason = "season"
+	// EdgeRenditions holds the string denoting the renditions edge name in mutations.
+	EdgeRenditions = "renditions"
+	// EdgeSubtitles holds the string denoting the subtitles edge name in mutations.
+	EdgeSubtitles = "subtitles"
+	// EdgeAudioTracks holds the string denoting the audio_tracks edge name in mutations.
+	EdgeAudioTracks = "audio_tracks"
+	// EdgeChapters holds the string denoting the chapters edge name in mutations.
+	EdgeChapters = "chapters"
+	// EdgeWatchProgress holds the string denoting the watch_progress edge name in mutations.
+	EdgeWatchProgress = "watch_progress"
 	// Table holds the table name of the episode in the database.
 	Table = "episodes"
 	// SeasonTable is the table that holds the season relation/edge.
@@ -47,11 +65,48 @@ const (
 	SeasonInverseTable = "seasons"
 	// SeasonColumn is the table column denoting the season relation/edge.
 	SeasonColumn = "season_episodes"
+	// RenditionsTable is the table that holds the renditions relation/edge.
+	RenditionsTable = "renditions"
+	// RenditionsInverseTable is the table name for the Rendition entity.
+	// It exists in this package in order to avoid circular dependency with the "rendition" package.
+	RenditionsInverseTable = "renditions"
+	// RenditionsColumn is the table column denoting the renditions relation/edge.
+	RenditionsColumn = "episode_renditions"
+	// SubtitlesTable is the table that holds the subtitles relation/edge.
+	SubtitlesTable = "subtitles"
+	// SubtitlesInverseTable is the table name for the Subtitle entity.
+	// It exists in this package in order to avoid circular dependency with the "subtitle" package.
+	SubtitlesInverseTable = "subtitles"
+	// SubtitlesColumn is the table column denoting the subtitles relation/edge.
+	SubtitlesColumn = "episode_subtitles"
+	// AudioTracksTable is the table that holds the audio_tracks relation/edge.
+	AudioTracksTable = "audio_tracks"
+	// AudioTracksInverseTable is the table name for the AudioTrack entity.
+	// It exists in this package in order to avoid circular dependency with the "audiotrack" package.
+	AudioTracksInverseTable = "audio_tracks"
+	// AudioTracksColumn is the table column denoting the audio_tracks relation/edge.
+	AudioTracksColumn = "episode_audio_tracks"
+	// ChaptersTable is the table that holds the chapters relation/edge.
+	ChaptersTable = "chapters"
+	// ChaptersInverseTable is the table name for the Chapter entity.
+	// It exists in this package in order to avoid circular dependency with the "chapter" package.
+	ChaptersInverseTable = "chapters"
+	// ChaptersColumn is the table column denoting the chapters relation/edge.
+	ChaptersColumn = "episode_chapters"
+	// WatchProgressTable is the table that holds the watch_progress relation/edge.
+	WatchProgressTable = "watch_progresses"
+	// WatchProgressInverseTable is the table name for the WatchProgress entity.
+	// It exists in this package in order to avoid circular dependency with the "watchprogress" package.
+	WatchProgressInverseTable = "watch_progresses"
+	// WatchProgressColumn is the table column denoting the watch_progress relation/edge.
+	WatchProgressColumn = "episode_watch_progress"
 )
 
 // Columns holds all SQL columns for episode fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
 	FieldEpisodeID,
 	FieldTitle,
 	FieldDescription,
@@ -64,6 +119,7 @@ var Columns = []string{
 	FieldHeight,
 	FieldDynamicRange,
 	FieldMetadata,
+	FieldMedia,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "episodes"
@@ -88,6 +144,12 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
 	// EpisodeIDValidator is a validator for the "episode_id" field. It is called by the builders before save.
 	EpisodeIDValidator func(string) error
 	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
@@ -102,6 +164,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
 // ByEpisodeID orders the results by the episode_id field.
 func ByEpisodeID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEpisodeID, opts...).ToFunc()
@@ -168,6 +240,76 @@ func BySeasonField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSeasonStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByRenditionsCount orders the results by renditions count.
+func ByRenditionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRenditionsStep(), opts...)
+	}
+}
+
+// ByRenditions orders the results by renditions terms.
+func ByRenditions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRenditionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySubtitlesCount orders the results by subtitles count.
+func BySubtitlesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSubtitlesStep(), opts...)
+	}
+}
+
+// BySubtitles orders the results by subtitles terms.
+func BySubtitles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSubtitlesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAudioTracksCount orders the results by audio_tracks count.
+func ByAudioTracksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAudioTracksStep(), opts...)
+	}
+}
+
+// ByAudioTracks orders the results by audio_tracks terms.
+func ByAudioTracks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAudioTracksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChaptersCount orders the results by chapters count.
+func ByChaptersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChaptersStep(), opts...)
+	}
+}
+
+// ByChapters orders the results by chapters terms.
+func ByChapters(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChaptersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWatchProgressCount orders the results by watch_progress count.
+func ByWatchProgressCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWatchProgressStep(), opts...)
+	}
+}
+
+// ByWatchProgress orders the results by watch_progress terms.
+func ByWatchProgress(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWatchProgressStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSeasonStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -175,3 +317,38 @@ func newSeasonStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, SeasonTable, SeasonColumn),
 	)
 }
+func newRenditionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RenditionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RenditionsTable, RenditionsColumn),
+	)
+}
+func newSubtitlesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SubtitlesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SubtitlesTable, SubtitlesColumn),
+	)
+}
+func newAudioTracksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AudioTracksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AudioTracksTable, AudioTracksColumn),
+	)
+}
+func newChaptersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChaptersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChaptersTable, ChaptersColumn),
+	)
+}
+func newWatchProgressStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WatchProgressInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WatchProgressTable, WatchProgressColumn),
+	)
+}
@@ -55,6 +55,16 @@ func IDLTE(id int) predicate.Episode {
 	return predicate.Episode(sql.FieldLTE(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
 // EpisodeID applies equality check predicate on the "episode_id" field. It's identical to EpisodeIDEQ.
 func EpisodeID(v string) predicate.Episode {
 	return predicate.Episode(sql.FieldEQ(FieldEpisodeID, v))
@@ -115,6 +125,86 @@ func Metadata(v string) predicate.Episode {
 	return predicate.Episode(sql.FieldEQ(FieldMetadata, v))
 }
 
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
 // EpisodeIDEQ applies the EQ predicate on the "episode_id" field.
 func EpisodeIDEQ(v string) predicate.Episode {
 	return predicate.Episode(sql.FieldEQ(FieldEpisodeID, v))
@@ -780,6 +870,16 @@ func MetadataContainsFold(v string) predicate.Episode {
 	return predicate.Episode(sql.FieldContainsFold(FieldMetadata, v))
 }
 
+// MediaIsNil applies the IsNil predicate on the "media" field.
+func MediaIsNil() predicate.Episode {
+	return predicate.Episode(sql.FieldIsNull(FieldMedia))
+}
+
+// MediaNotNil applies the NotNil predicate on the "media" field.
+func MediaNotNil() predicate.Episode {
+	return predicate.Episode(sql.FieldNotNull(FieldMedia))
+}
+
 // HasSeason applies the HasEdge predicate on the "season" edge.
 func HasSeason() predicate.Episode {
 	return predicate.Episode(func(s *sql.Selector) {
@@ -803,6 +903,121 @@ func HasSeasonWith(preds ...predicate.Season) predicate.Episode {
 	})
 }
 
+// HasRenditions applies the HasEdge predicate on the "renditions" edge.
+func HasRenditions() predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RenditionsTable, RenditionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRenditionsWith applies the HasEdge predicate on the "renditions" edge with a given conditions (other predicates).
+func HasRenditionsWith(preds ...predicate.Rendition) predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := newRenditionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSubtitles applies the HasEdge predicate on the "subtitles" edge.
+func HasSubtitles() predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SubtitlesTable, SubtitlesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubtitlesWith applies the HasEdge predicate on the "subtitles" edge with a given conditions (other predicates).
+func HasSubtitlesWith(preds ...predicate.Subtitle) predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := newSubtitlesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAudioTracks applies the HasEdge predicate on the "audio_tracks" edge.
+func HasAudioTracks() predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AudioTracksTable, AudioTracksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAudioTracksWith applies the HasEdge predicate on the "audio_tracks" edge with a given conditions (other predicates).
+func HasAudioTracksWith(preds ...predicate.AudioTrack) predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := newAudioTracksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChapters applies the HasEdge predicate on the "chapters" edge.
+func HasChapters() predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChaptersTable, ChaptersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChaptersWith applies the HasEdge predicate on the "chapters" edge with a given conditions (other predicates).
+func HasChaptersWith(preds ...predicate.Chapter) predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := newChaptersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWatchProgress applies the HasEdge predicate on the "watch_progress" edge.
+func HasWatchProgress() predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WatchProgressTable, WatchProgressColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWatchProgressWith applies the HasEdge predicate on the "watch_progress" edge with a given conditions (other predicates).
+func HasWatchProgressWith(preds ...predicate.WatchProgress) predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := newWatchProgressStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Episode) predicate.Episode {
 	return predicate.Episode(sql.AndPredicates(predicates...))
@@ -10,8 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/audiotrack"
+	"github.com/clustlight/animatrix-api/ent/chapter"
 	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/rendition"
 	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/subtitle"
+	"github.com/clustlight/animatrix-api/ent/watchprogress"
 )
 
 // EpisodeCreate is the builder for creating a Episode entity.
@@ -21,6 +26,34 @@ type EpisodeCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (ec *EpisodeCreate) SetCreatedAt(t time.Time) *EpisodeCreate {
+	ec.mutation.SetCreatedAt(t)
+	return ec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ec *EpisodeCreate) SetNillableCreatedAt(t *time.Time) *EpisodeCreate {
+	if t != nil {
+		ec.SetCreatedAt(*t)
+	}
+	return ec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ec *EpisodeCreate) SetUpdatedAt(t time.Time) *EpisodeCreate {
+	ec.mutation.SetUpdatedAt(t)
+	return ec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ec *EpisodeCreate) SetNillableUpdatedAt(t *time.Time) *EpisodeCreate {
+	if t != nil {
+		ec.SetUpdatedAt(*t)
+	}
+	return ec
+}
+
 // SetEpisodeID sets the "episode_id" field.
 func (ec *EpisodeCreate) SetEpisodeID(s string) *EpisodeCreate {
 	ec.mutation.SetEpisodeID(s)
@@ -101,6 +134,12 @@ func (ec *EpisodeCreate) SetMetadata(s string) *EpisodeCreate {
 	return ec
 }
 
+// SetMedia sets the "media" field.
+func (ec *EpisodeCreate) SetMedia(m map[string]string) *EpisodeCreate {
+	ec.mutation.SetMedia(m)
+	return ec
+}
+
 // SetSeasonID sets the "season" edge to the Season entity by ID.
 func (ec *EpisodeCreate) SetSeasonID(id int) *EpisodeCreate {
 	ec.mutation.SetSeasonID(id)
@@ -112,6 +151,81 @@ func (ec *EpisodeCreate) SetSeason(s *Season) *EpisodeCreate {
 	return ec.SetSeasonID(s.ID)
 }
 
+// AddRenditionIDs adds the "renditions" edge to the Rendition entity by IDs.
+func (ec *EpisodeCreate) AddRenditionIDs(ids ...int) *EpisodeCreate {
+	ec.mutation.AddRenditionIDs(ids...)
+	return ec
+}
+
+// AddRenditions adds the "renditions" edges to the Rendition entity.
+func (ec *EpisodeCreate) AddRenditions(r ...*Rendition) *EpisodeCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ec.AddRenditionIDs(ids...)
+}
+
+// AddSubtitleIDs adds the "subtitles" edge to the Subtitle entity by IDs.
+func (ec *EpisodeCreate) AddSubtitleIDs(ids ...int) *EpisodeCreate {
+	ec.mutation.AddSubtitleIDs(ids...)
+	return ec
+}
+
+// AddSubtitles adds the "subtitles" edges to the Subtitle entity.
+func (ec *EpisodeCreate) AddSubtitles(s ...*Subtitle) *EpisodeCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ec.AddSubtitleIDs(ids...)
+}
+
+// AddAudioTrackIDs adds the "audio_tracks" edge to the AudioTrack entity by IDs.
+func (ec *EpisodeCreate) AddAudioTrackIDs(ids ...int) *EpisodeCreate {
+	ec.mutation.AddAudioTrackIDs(ids...)
+	return ec
+}
+
+// AddAudioTracks adds the "audio_tracks" edges to the AudioTrack entity.
+func (ec *EpisodeCreate) AddAudioTracks(a ...*AudioTrack) *EpisodeCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ec.AddAudioTrackIDs(ids...)
+}
+
+// AddChapterIDs adds the "chapters" edge to the Chapter entity by IDs.
+func (ec *EpisodeCreate) AddChapterIDs(ids ...int) *EpisodeCreate {
+	ec.mutation.AddChapterIDs(ids...)
+	return ec
+}
+
+// AddChapters adds the "chapters" edges to the Chapter entity.
+func (ec *EpisodeCreate) AddChapters(c ...*Chapter) *EpisodeCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ec.AddChapterIDs(ids...)
+}
+
+// AddWatchProgresIDs adds the "watch_progress" edge to the WatchProgress entity by IDs.
+func (ec *EpisodeCreate) AddWatchProgresIDs(ids ...int) *EpisodeCreate {
+	ec.mutation.AddWatchProgresIDs(ids...)
+	return ec
+}
+
+// AddWatchProgress adds the "watch_progress" edges to the WatchProgress entity.
+func (ec *EpisodeCreate) AddWatchProgress(w ...*WatchProgress) *EpisodeCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ec.AddWatchProgresIDs(ids...)
+}
+
 // Mutation returns the EpisodeMutation object of the builder.
 func (ec *EpisodeCreate) Mutation() *EpisodeMutation {
 	return ec.mutation
@@ -119,6 +233,7 @@ func (ec *EpisodeCreate) Mutation() *EpisodeMutation {
 
 // Save creates the Episode in the database.
 func (ec *EpisodeCreate) Save(ctx context.Context) (*Episode, error) {
+	ec.defaults()
 	return withHooks(ctx, ec.sqlSave, ec.mutation, ec.hooks)
 }
 
@@ -144,8 +259,26 @@ func (ec *EpisodeCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (ec *EpisodeCreate) defaults() {
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		v := episode.DefaultCreatedAt()
+		ec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ec.mutation.UpdatedAt(); !ok {
+		v := episode.DefaultUpdatedAt()
+		ec.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (ec *EpisodeCreate) check() error {
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Episode.created_at"`)}
+	}
+	if _, ok := ec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Episode.updated_at"`)}
+	}
 	if _, ok := ec.mutation.EpisodeID(); !ok {
 		return &ValidationError{Name: "episode_id", err: errors.New(`ent: missing required field "Episode.episode_id"`)}
 	}
@@ -218,6 +351,14 @@ func (ec *EpisodeCreate) createSpec() (*Episode, *sqlgraph.CreateSpec) {
 		_node = &Episode{config: ec.config}
 		_spec = sqlgraph.NewCreateSpec(episode.Table, sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt))
 	)
+	if value, ok := ec.mutation.CreatedAt(); ok {
+		_spec.SetField(episode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ec.mutation.UpdatedAt(); ok {
+		_spec.SetField(episode.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
 	if value, ok := ec.mutation.EpisodeID(); ok {
 		_spec.SetField(episode.FieldEpisodeID, field.TypeString, value)
 		_node.EpisodeID = value
@@ -266,6 +407,10 @@ func (ec *EpisodeCreate) createSpec() (*Episode, *sqlgraph.CreateSpec) {
 		_spec.SetField(episode.FieldMetadata, field.TypeString, value)
 		_node.Metadata = value
 	}
+	if value, ok := ec.mutation.Media(); ok {
+		_spec.SetField(episode.FieldMedia, field.TypeJSON, value)
+		_node.Media = value
+	}
 	if nodes := ec.mutation.SeasonIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -283,6 +428,86 @@ func (ec *EpisodeCreate) createSpec() (*Episode, *sqlgraph.CreateSpec) {
 		_node.season_episodes = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ec.mutation.RenditionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.RenditionsTable,
+			Columns: []string{episode.RenditionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rendition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.SubtitlesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.SubtitlesTable,
+			Columns: []string{episode.SubtitlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subtitle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.AudioTracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.AudioTracksTable,
+			Columns: []string{episode.AudioTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(audiotrack.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.ChaptersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.ChaptersTable,
+			Columns: []string{episode.ChaptersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chapter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.WatchProgressIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.WatchProgressTable,
+			Columns: []string{episode.WatchProgressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchprogress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -304,6 +529,7 @@ func (ecb *EpisodeCreateBulk) Save(ctx context.Context) ([]*Episode, error) {
 	for i := range ecb.builders {
 		func(i int, root context.Context) {
 			builder := ecb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*EpisodeMutation)
 				if !ok {
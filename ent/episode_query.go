@@ -4,27 +4,40 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/audiotrack"
+	"github.com/clustlight/animatrix-api/ent/chapter"
 	"github.com/clustlight/animatrix-api/ent/episode"
 	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/rendition"
 	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/subtitle"
+	"github.com/clustlight/animatrix-api/ent/watchprogress"
 )
 
 // EpisodeQuery is the builder for querying Episode entities.
 type EpisodeQuery struct {
 	config
-	ctx        *QueryContext
-	order      []episode.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Episode
-	withSeason *SeasonQuery
-	withFKs    bool
+	ctx               *QueryContext
+	order             []episode.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Episode
+	withSeason        *SeasonQuery
+	withRenditions    *RenditionQuery
+	withSubtitles     *SubtitleQuery
+	withAudioTracks   *AudioTrackQuery
+	withChapters      *ChapterQuery
+	withWatchProgress *WatchProgressQuery
+	withFKs           bool
+	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +96,116 @@ func (eq *EpisodeQuery) QuerySeason() *SeasonQuery {
 	return query
 }
 
+// QueryRenditions chains the current query on the "renditions" edge.
+func (eq *EpisodeQuery) QueryRenditions() *RenditionQuery {
+	query := (&RenditionClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, selector),
+			sqlgraph.To(rendition.Table, rendition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, episode.RenditionsTable, episode.RenditionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySubtitles chains the current query on the "subtitles" edge.
+func (eq *EpisodeQuery) QuerySubtitles() *SubtitleQuery {
+	query := (&SubtitleClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, selector),
+			sqlgraph.To(subtitle.Table, subtitle.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, episode.SubtitlesTable, episode.SubtitlesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAudioTracks chains the current query on the "audio_tracks" edge.
+func (eq *EpisodeQuery) QueryAudioTracks() *AudioTrackQuery {
+	query := (&AudioTrackClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, selector),
+			sqlgraph.To(audiotrack.Table, audiotrack.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, episode.AudioTracksTable, episode.AudioTracksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChapters chains the current query on the "chapters" edge.
+func (eq *EpisodeQuery) QueryChapters() *ChapterQuery {
+	query := (&ChapterClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, selector),
+			sqlgraph.To(chapter.Table, chapter.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, episode.ChaptersTable, episode.ChaptersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWatchProgress chains the current query on the "watch_progress" edge.
+func (eq *EpisodeQuery) QueryWatchProgress() *WatchProgressQuery {
+	query := (&WatchProgressClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, selector),
+			sqlgraph.To(watchprogress.Table, watchprogress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, episode.WatchProgressTable, episode.WatchProgressColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Episode entity from the query.
 // Returns a *NotFoundError when no Episode was found.
 func (eq *EpisodeQuery) First(ctx context.Context) (*Episode, error) {
@@ -270,12 +393,17 @@ func (eq *EpisodeQuery) Clone() *EpisodeQuery {
 		return nil
 	}
 	return &EpisodeQuery{
-		config:     eq.config,
-		ctx:        eq.ctx.Clone(),
-		order:      append([]episode.OrderOption{}, eq.order...),
-		inters:     append([]Interceptor{}, eq.inters...),
-		predicates: append([]predicate.Episode{}, eq.predicates...),
-		withSeason: eq.withSeason.Clone(),
+		config:            eq.config,
+		ctx:               eq.ctx.Clone(),
+		order:             append([]episode.OrderOption{}, eq.order...),
+		inters:            append([]Interceptor{}, eq.inters...),
+		predicates:        append([]predicate.Episode{}, eq.predicates...),
+		withSeason:        eq.withSeason.Clone(),
+		withRenditions:    eq.withRenditions.Clone(),
+		withSubtitles:     eq.withSubtitles.Clone(),
+		withAudioTracks:   eq.withAudioTracks.Clone(),
+		withChapters:      eq.withChapters.Clone(),
+		withWatchProgress: eq.withWatchProgress.Clone(),
 		// clone intermediate query.
 		sql:  eq.sql.Clone(),
 		path: eq.path,
@@ -293,18 +421,73 @@ func (eq *EpisodeQuery) WithSeason(opts ...func(*SeasonQuery)) *EpisodeQuery {
 	return eq
 }
 
+// WithRenditions tells the query-builder to eager-load the nodes that are connected to
+// the "renditions" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EpisodeQuery) WithRenditions(opts ...func(*RenditionQuery)) *EpisodeQuery {
+	query := (&RenditionClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withRenditions = query
+	return eq
+}
+
+// WithSubtitles tells the query-builder to eager-load the nodes that are connected to
+// the "subtitles" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EpisodeQuery) WithSubtitles(opts ...func(*SubtitleQuery)) *EpisodeQuery {
+	query := (&SubtitleClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withSubtitles = query
+	return eq
+}
+
+// WithAudioTracks tells the query-builder to eager-load the nodes that are connected to
+// the "audio_tracks" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EpisodeQuery) WithAudioTracks(opts ...func(*AudioTrackQuery)) *EpisodeQuery {
+	query := (&AudioTrackClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withAudioTracks = query
+	return eq
+}
+
+// WithChapters tells the query-builder to eager-load the nodes that are connected to
+// the "chapters" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EpisodeQuery) WithChapters(opts ...func(*ChapterQuery)) *EpisodeQuery {
+	query := (&ChapterClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withChapters = query
+	return eq
+}
+
+// WithWatchProgress tells the query-builder to eager-load the nodes that are connected to
+// the "watch_progress" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EpisodeQuery) WithWatchProgress(opts ...func(*WatchProgressQuery)) *EpisodeQuery {
+	query := (&WatchProgressClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withWatchProgress = query
+	return eq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		EpisodeID string `json:"episode_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Episode.Query().
-//		GroupBy(episode.FieldEpisodeID).
+//		GroupBy(episode.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (eq *EpisodeQuery) GroupBy(field string, fields ...string) *EpisodeGroupBy {
@@ -322,11 +505,11 @@ func (eq *EpisodeQuery) GroupBy(field string, fields ...string) *EpisodeGroupBy
 // Example:
 //
 //	var v []struct {
-//		EpisodeID string `json:"episode_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.Episode.Query().
-//		Select(episode.FieldEpisodeID).
+//		Select(episode.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (eq *EpisodeQuery) Select(fields ...string) *EpisodeSelect {
 	eq.ctx.Fields = append(eq.ctx.Fields, fields...)
@@ -372,8 +555,13 @@ func (eq *EpisodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Epis
 		nodes       = []*Episode{}
 		withFKs     = eq.withFKs
 		_spec       = eq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [6]bool{
 			eq.withSeason != nil,
+			eq.withRenditions != nil,
+			eq.withSubtitles != nil,
+			eq.withAudioTracks != nil,
+			eq.withChapters != nil,
+			eq.withWatchProgress != nil,
 		}
 	)
 	if eq.withSeason != nil {
@@ -391,6 +579,9 @@ func (eq *EpisodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Epis
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(eq.modifiers) > 0 {
+		_spec.Modifiers = eq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -406,6 +597,41 @@ func (eq *EpisodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Epis
 			return nil, err
 		}
 	}
+	if query := eq.withRenditions; query != nil {
+		if err := eq.loadRenditions(ctx, query, nodes,
+			func(n *Episode) { n.Edges.Renditions = []*Rendition{} },
+			func(n *Episode, e *Rendition) { n.Edges.Renditions = append(n.Edges.Renditions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withSubtitles; query != nil {
+		if err := eq.loadSubtitles(ctx, query, nodes,
+			func(n *Episode) { n.Edges.Subtitles = []*Subtitle{} },
+			func(n *Episode, e *Subtitle) { n.Edges.Subtitles = append(n.Edges.Subtitles, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withAudioTracks; query != nil {
+		if err := eq.loadAudioTracks(ctx, query, nodes,
+			func(n *Episode) { n.Edges.AudioTracks = []*AudioTrack{} },
+			func(n *Episode, e *AudioTrack) { n.Edges.AudioTracks = append(n.Edges.AudioTracks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withChapters; query != nil {
+		if err := eq.loadChapters(ctx, query, nodes,
+			func(n *Episode) { n.Edges.Chapters = []*Chapter{} },
+			func(n *Episode, e *Chapter) { n.Edges.Chapters = append(n.Edges.Chapters, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withWatchProgress; query != nil {
+		if err := eq.loadWatchProgress(ctx, query, nodes,
+			func(n *Episode) { n.Edges.WatchProgress = []*WatchProgress{} },
+			func(n *Episode, e *WatchProgress) { n.Edges.WatchProgress = append(n.Edges.WatchProgress, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -441,9 +667,167 @@ func (eq *EpisodeQuery) loadSeason(ctx context.Context, query *SeasonQuery, node
 	}
 	return nil
 }
+func (eq *EpisodeQuery) loadRenditions(ctx context.Context, query *RenditionQuery, nodes []*Episode, init func(*Episode), assign func(*Episode, *Rendition)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Episode)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Rendition(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(episode.RenditionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.episode_renditions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "episode_renditions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "episode_renditions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EpisodeQuery) loadSubtitles(ctx context.Context, query *SubtitleQuery, nodes []*Episode, init func(*Episode), assign func(*Episode, *Subtitle)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Episode)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Subtitle(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(episode.SubtitlesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.episode_subtitles
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "episode_subtitles" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "episode_subtitles" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EpisodeQuery) loadAudioTracks(ctx context.Context, query *AudioTrackQuery, nodes []*Episode, init func(*Episode), assign func(*Episode, *AudioTrack)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Episode)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AudioTrack(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(episode.AudioTracksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.episode_audio_tracks
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "episode_audio_tracks" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "episode_audio_tracks" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EpisodeQuery) loadChapters(ctx context.Context, query *ChapterQuery, nodes []*Episode, init func(*Episode), assign func(*Episode, *Chapter)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Episode)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Chapter(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(episode.ChaptersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.episode_chapters
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "episode_chapters" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "episode_chapters" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EpisodeQuery) loadWatchProgress(ctx context.Context, query *WatchProgressQuery, nodes []*Episode, init func(*Episode), assign func(*Episode, *WatchProgress)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Episode)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.WatchProgress(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(episode.WatchProgressColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.episode_watch_progress
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "episode_watch_progress" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "episode_watch_progress" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (eq *EpisodeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := eq.querySpec()
+	if len(eq.modifiers) > 0 {
+		_spec.Modifiers = eq.modifiers
+	}
 	_spec.Node.Columns = eq.ctx.Fields
 	if len(eq.ctx.Fields) > 0 {
 		_spec.Unique = eq.ctx.Unique != nil && *eq.ctx.Unique
@@ -506,6 +890,9 @@ func (eq *EpisodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if eq.ctx.Unique != nil && *eq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range eq.modifiers {
+		m(selector)
+	}
 	for _, p := range eq.predicates {
 		p(selector)
 	}
@@ -523,6 +910,32 @@ func (eq *EpisodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (eq *EpisodeQuery) ForUpdate(opts ...sql.LockOption) *EpisodeQuery {
+	if eq.driver.Dialect() == dialect.Postgres {
+		eq.Unique(false)
+	}
+	eq.modifiers = append(eq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return eq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (eq *EpisodeQuery) ForShare(opts ...sql.LockOption) *EpisodeQuery {
+	if eq.driver.Dialect() == dialect.Postgres {
+		eq.Unique(false)
+	}
+	eq.modifiers = append(eq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return eq
+}
+
 // EpisodeGroupBy is the group-by builder for Episode entities.
 type EpisodeGroupBy struct {
 	selector
@@ -11,9 +11,14 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/audiotrack"
+	"github.com/clustlight/animatrix-api/ent/chapter"
 	"github.com/clustlight/animatrix-api/ent/episode"
 	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/rendition"
 	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/subtitle"
+	"github.com/clustlight/animatrix-api/ent/watchprogress"
 )
 
 // EpisodeUpdate is the builder for updating Episode entities.
@@ -29,6 +34,12 @@ func (eu *EpisodeUpdate) Where(ps ...predicate.Episode) *EpisodeUpdate {
 	return eu
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (eu *EpisodeUpdate) SetUpdatedAt(t time.Time) *EpisodeUpdate {
+	eu.mutation.SetUpdatedAt(t)
+	return eu
+}
+
 // SetEpisodeID sets the "episode_id" field.
 func (eu *EpisodeUpdate) SetEpisodeID(s string) *EpisodeUpdate {
 	eu.mutation.SetEpisodeID(s)
@@ -231,6 +242,18 @@ func (eu *EpisodeUpdate) SetNillableMetadata(s *string) *EpisodeUpdate {
 	return eu
 }
 
+// SetMedia sets the "media" field.
+func (eu *EpisodeUpdate) SetMedia(m map[string]string) *EpisodeUpdate {
+	eu.mutation.SetMedia(m)
+	return eu
+}
+
+// ClearMedia clears the value of the "media" field.
+func (eu *EpisodeUpdate) ClearMedia() *EpisodeUpdate {
+	eu.mutation.ClearMedia()
+	return eu
+}
+
 // SetSeasonID sets the "season" edge to the Season entity by ID.
 func (eu *EpisodeUpdate) SetSeasonID(id int) *EpisodeUpdate {
 	eu.mutation.SetSeasonID(id)
@@ -242,6 +265,81 @@ func (eu *EpisodeUpdate) SetSeason(s *Season) *EpisodeUpdate {
 	return eu.SetSeasonID(s.ID)
 }
 
+// AddRenditionIDs adds the "renditions" edge to the Rendition entity by IDs.
+func (eu *EpisodeUpdate) AddRenditionIDs(ids ...int) *EpisodeUpdate {
+	eu.mutation.AddRenditionIDs(ids...)
+	return eu
+}
+
+// AddRenditions adds the "renditions" edges to the Rendition entity.
+func (eu *EpisodeUpdate) AddRenditions(r ...*Rendition) *EpisodeUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.AddRenditionIDs(ids...)
+}
+
+// AddSubtitleIDs adds the "subtitles" edge to the Subtitle entity by IDs.
+func (eu *EpisodeUpdate) AddSubtitleIDs(ids ...int) *EpisodeUpdate {
+	eu.mutation.AddSubtitleIDs(ids...)
+	return eu
+}
+
+// AddSubtitles adds the "subtitles" edges to the Subtitle entity.
+func (eu *EpisodeUpdate) AddSubtitles(s ...*Subtitle) *EpisodeUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return eu.AddSubtitleIDs(ids...)
+}
+
+// AddAudioTrackIDs adds the "audio_tracks" edge to the AudioTrack entity by IDs.
+func (eu *EpisodeUpdate) AddAudioTrackIDs(ids ...int) *EpisodeUpdate {
+	eu.mutation.AddAudioTrackIDs(ids...)
+	return eu
+}
+
+// AddAudioTracks adds the "audio_tracks" edges to the AudioTrack entity.
+func (eu *EpisodeUpdate) AddAudioTracks(a ...*AudioTrack) *EpisodeUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return eu.AddAudioTrackIDs(ids...)
+}
+
+// AddChapterIDs adds the "chapters" edge to the Chapter entity by IDs.
+func (eu *EpisodeUpdate) AddChapterIDs(ids ...int) *EpisodeUpdate {
+	eu.mutation.AddChapterIDs(ids...)
+	return eu
+}
+
+// AddChapters adds the "chapters" edges to the Chapter entity.
+func (eu *EpisodeUpdate) AddChapters(c ...*Chapter) *EpisodeUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return eu.AddChapterIDs(ids...)
+}
+
+// AddWatchProgresIDs adds the "watch_progress" edge to the WatchProgress entity by IDs.
+func (eu *EpisodeUpdate) AddWatchProgresIDs(ids ...int) *EpisodeUpdate {
+	eu.mutation.AddWatchProgresIDs(ids...)
+	return eu
+}
+
+// AddWatchProgress adds the "watch_progress" edges to the WatchProgress entity.
+func (eu *EpisodeUpdate) AddWatchProgress(w ...*WatchProgress) *EpisodeUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return eu.AddWatchProgresIDs(ids...)
+}
+
 // Mutation returns the EpisodeMutation object of the builder.
 func (eu *EpisodeUpdate) Mutation() *EpisodeMutation {
 	return eu.mutation
@@ -253,8 +351,114 @@ func (eu *EpisodeUpdate) ClearSeason() *EpisodeUpdate {
 	return eu
 }
 
+// ClearRenditions clears all "renditions" edges to the Rendition entity.
+func (eu *EpisodeUpdate) ClearRenditions() *EpisodeUpdate {
+	eu.mutation.ClearRenditions()
+	return eu
+}
+
+// RemoveRenditionIDs removes the "renditions" edge to Rendition entities by IDs.
+func (eu *EpisodeUpdate) RemoveRenditionIDs(ids ...int) *EpisodeUpdate {
+	eu.mutation.RemoveRenditionIDs(ids...)
+	return eu
+}
+
+// RemoveRenditions removes "renditions" edges to Rendition entities.
+func (eu *EpisodeUpdate) RemoveRenditions(r ...*Rendition) *EpisodeUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.RemoveRenditionIDs(ids...)
+}
+
+// ClearSubtitles clears all "subtitles" edges to the Subtitle entity.
+func (eu *EpisodeUpdate) ClearSubtitles() *EpisodeUpdate {
+	eu.mutation.ClearSubtitles()
+	return eu
+}
+
+// RemoveSubtitleIDs removes the "subtitles" edge to Subtitle entities by IDs.
+func (eu *EpisodeUpdate) RemoveSubtitleIDs(ids ...int) *EpisodeUpdate {
+	eu.mutation.RemoveSubtitleIDs(ids...)
+	return eu
+}
+
+// RemoveSubtitles removes "subtitles" edges to Subtitle entities.
+func (eu *EpisodeUpdate) RemoveSubtitles(s ...*Subtitle) *EpisodeUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return eu.RemoveSubtitleIDs(ids...)
+}
+
+// ClearAudioTracks clears all "audio_tracks" edges to the AudioTrack entity.
+func (eu *EpisodeUpdate) ClearAudioTracks() *EpisodeUpdate {
+	eu.mutation.ClearAudioTracks()
+	return eu
+}
+
+// RemoveAudioTrackIDs removes the "audio_tracks" edge to AudioTrack entities by IDs.
+func (eu *EpisodeUpdate) RemoveAudioTrackIDs(ids ...int) *EpisodeUpdate {
+	eu.mutation.RemoveAudioTrackIDs(ids...)
+	return eu
+}
+
+// RemoveAudioTracks removes "audio_tracks" edges to AudioTrack entities.
+func (eu *EpisodeUpdate) RemoveAudioTracks(a ...*AudioTrack) *EpisodeUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return eu.RemoveAudioTrackIDs(ids...)
+}
+
+// ClearChapters clears all "chapters" edges to the Chapter entity.
+func (eu *EpisodeUpdate) ClearChapters() *EpisodeUpdate {
+	eu.mutation.ClearChapters()
+	return eu
+}
+
+// RemoveChapterIDs removes the "chapters" edge to Chapter entities by IDs.
+func (eu *EpisodeUpdate) RemoveChapterIDs(ids ...int) *EpisodeUpdate {
+	eu.mutation.RemoveChapterIDs(ids...)
+	return eu
+}
+
+// RemoveChapters removes "chapters" edges to Chapter entities.
+func (eu *EpisodeUpdate) RemoveChapters(c ...*Chapter) *EpisodeUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return eu.RemoveChapterIDs(ids...)
+}
+
+// ClearWatchProgress clears all "watch_progress" edges to the WatchProgress entity.
+func (eu *EpisodeUpdate) ClearWatchProgress() *EpisodeUpdate {
+	eu.mutation.ClearWatchProgress()
+	return eu
+}
+
+// RemoveWatchProgresIDs removes the "watch_progress" edge to WatchProgress entities by IDs.
+func (eu *EpisodeUpdate) RemoveWatchProgresIDs(ids ...int) *EpisodeUpdate {
+	eu.mutation.RemoveWatchProgresIDs(ids...)
+	return eu
+}
+
+// RemoveWatchProgress removes "watch_progress" edges to WatchProgress entities.
+func (eu *EpisodeUpdate) RemoveWatchProgress(w ...*WatchProgress) *EpisodeUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return eu.RemoveWatchProgresIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (eu *EpisodeUpdate) Save(ctx context.Context) (int, error) {
+	eu.defaults()
 	return withHooks(ctx, eu.sqlSave, eu.mutation, eu.hooks)
 }
 
@@ -280,6 +484,14 @@ func (eu *EpisodeUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (eu *EpisodeUpdate) defaults() {
+	if _, ok := eu.mutation.UpdatedAt(); !ok {
+		v := episode.UpdateDefaultUpdatedAt()
+		eu.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (eu *EpisodeUpdate) check() error {
 	if v, ok := eu.mutation.EpisodeID(); ok {
@@ -310,6 +522,9 @@ func (eu *EpisodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := eu.mutation.UpdatedAt(); ok {
+		_spec.SetField(episode.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if value, ok := eu.mutation.EpisodeID(); ok {
 		_spec.SetField(episode.FieldEpisodeID, field.TypeString, value)
 	}
@@ -361,6 +576,12 @@ func (eu *EpisodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := eu.mutation.Metadata(); ok {
 		_spec.SetField(episode.FieldMetadata, field.TypeString, value)
 	}
+	if value, ok := eu.mutation.Media(); ok {
+		_spec.SetField(episode.FieldMedia, field.TypeJSON, value)
+	}
+	if eu.mutation.MediaCleared() {
+		_spec.ClearField(episode.FieldMedia, field.TypeJSON)
+	}
 	if eu.mutation.SeasonCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -390,6 +611,231 @@ func (eu *EpisodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if eu.mutation.RenditionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.RenditionsTable,
+			Columns: []string{episode.RenditionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rendition.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedRenditionsIDs(); len(nodes) > 0 && !eu.mutation.RenditionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.RenditionsTable,
+			Columns: []string{episode.RenditionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rendition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RenditionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.RenditionsTable,
+			Columns: []string{episode.RenditionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rendition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.SubtitlesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.SubtitlesTable,
+			Columns: []string{episode.SubtitlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subtitle.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedSubtitlesIDs(); len(nodes) > 0 && !eu.mutation.SubtitlesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.SubtitlesTable,
+			Columns: []string{episode.SubtitlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subtitle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.SubtitlesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.SubtitlesTable,
+			Columns: []string{episode.SubtitlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subtitle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.AudioTracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.AudioTracksTable,
+			Columns: []string{episode.AudioTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(audiotrack.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedAudioTracksIDs(); len(nodes) > 0 && !eu.mutation.AudioTracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.AudioTracksTable,
+			Columns: []string{episode.AudioTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(audiotrack.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.AudioTracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.AudioTracksTable,
+			Columns: []string{episode.AudioTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(audiotrack.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.ChaptersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.ChaptersTable,
+			Columns: []string{episode.ChaptersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chapter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedChaptersIDs(); len(nodes) > 0 && !eu.mutation.ChaptersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.ChaptersTable,
+			Columns: []string{episode.ChaptersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chapter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.ChaptersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.ChaptersTable,
+			Columns: []string{episode.ChaptersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chapter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.WatchProgressCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.WatchProgressTable,
+			Columns: []string{episode.WatchProgressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchprogress.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedWatchProgressIDs(); len(nodes) > 0 && !eu.mutation.WatchProgressCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.WatchProgressTable,
+			Columns: []string{episode.WatchProgressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchprogress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.WatchProgressIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.WatchProgressTable,
+			Columns: []string{episode.WatchProgressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchprogress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{episode.Label}
@@ -410,6 +856,12 @@ type EpisodeUpdateOne struct {
 	mutation *EpisodeMutation
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (euo *EpisodeUpdateOne) SetUpdatedAt(t time.Time) *EpisodeUpdateOne {
+	euo.mutation.SetUpdatedAt(t)
+	return euo
+}
+
 // SetEpisodeID sets the "episode_id" field.
 func (euo *EpisodeUpdateOne) SetEpisodeID(s string) *EpisodeUpdateOne {
 	euo.mutation.SetEpisodeID(s)
@@ -612,6 +1064,18 @@ func (euo *EpisodeUpdateOne) SetNillableMetadata(s *string) *EpisodeUpdateOne {
 	return euo
 }
 
+// SetMedia sets the "media" field.
+func (euo *EpisodeUpdateOne) SetMedia(m map[string]string) *EpisodeUpdateOne {
+	euo.mutation.SetMedia(m)
+	return euo
+}
+
+// ClearMedia clears the value of the "media" field.
+func (euo *EpisodeUpdateOne) ClearMedia() *EpisodeUpdateOne {
+	euo.mutation.ClearMedia()
+	return euo
+}
+
 // SetSeasonID sets the "season" edge to the Season entity by ID.
 func (euo *EpisodeUpdateOne) SetSeasonID(id int) *EpisodeUpdateOne {
 	euo.mutation.SetSeasonID(id)
@@ -623,6 +1087,81 @@ func (euo *EpisodeUpdateOne) SetSeason(s *Season) *EpisodeUpdateOne {
 	return euo.SetSeasonID(s.ID)
 }
 
+// AddRenditionIDs adds the "renditions" edge to the Rendition entity by IDs.
+func (euo *EpisodeUpdateOne) AddRenditionIDs(ids ...int) *EpisodeUpdateOne {
+	euo.mutation.AddRenditionIDs(ids...)
+	return euo
+}
+
+// AddRenditions adds the "renditions" edges to the Rendition entity.
+func (euo *EpisodeUpdateOne) AddRenditions(r ...*Rendition) *EpisodeUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.AddRenditionIDs(ids...)
+}
+
+// AddSubtitleIDs adds the "subtitles" edge to the Subtitle entity by IDs.
+func (euo *EpisodeUpdateOne) AddSubtitleIDs(ids ...int) *EpisodeUpdateOne {
+	euo.mutation.AddSubtitleIDs(ids...)
+	return euo
+}
+
+// AddSubtitles adds the "subtitles" edges to the Subtitle entity.
+func (euo *EpisodeUpdateOne) AddSubtitles(s ...*Subtitle) *EpisodeUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return euo.AddSubtitleIDs(ids...)
+}
+
+// AddAudioTrackIDs adds the "audio_tracks" edge to the AudioTrack entity by IDs.
+func (euo *EpisodeUpdateOne) AddAudioTrackIDs(ids ...int) *EpisodeUpdateOne {
+	euo.mutation.AddAudioTrackIDs(ids...)
+	return euo
+}
+
+// AddAudioTracks adds the "audio_tracks" edges to the AudioTrack entity.
+func (euo *EpisodeUpdateOne) AddAudioTracks(a ...*AudioTrack) *EpisodeUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.AddAudioTrackIDs(ids...)
+}
+
+// AddChapterIDs adds the "chapters" edge to the Chapter entity by IDs.
+func (euo *EpisodeUpdateOne) AddChapterIDs(ids ...int) *EpisodeUpdateOne {
+	euo.mutation.AddChapterIDs(ids...)
+	return euo
+}
+
+// AddChapters adds the "chapters" edges to the Chapter entity.
+func (euo *EpisodeUpdateOne) AddChapters(c ...*Chapter) *EpisodeUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return euo.AddChapterIDs(ids...)
+}
+
+// AddWatchProgresIDs adds the "watch_progress" edge to the WatchProgress entity by IDs.
+func (euo *EpisodeUpdateOne) AddWatchProgresIDs(ids ...int) *EpisodeUpdateOne {
+	euo.mutation.AddWatchProgresIDs(ids...)
+	return euo
+}
+
+// AddWatchProgress adds the "watch_progress" edges to the WatchProgress entity.
+func (euo *EpisodeUpdateOne) AddWatchProgress(w ...*WatchProgress) *EpisodeUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return euo.AddWatchProgresIDs(ids...)
+}
+
 // Mutation returns the EpisodeMutation object of the builder.
 func (euo *EpisodeUpdateOne) Mutation() *EpisodeMutation {
 	return euo.mutation
@@ -634,6 +1173,111 @@ func (euo *EpisodeUpdateOne) ClearSeason() *EpisodeUpdateOne {
 	return euo
 }
 
+// ClearRenditions clears all "renditions" edges to the Rendition entity.
+func (euo *EpisodeUpdateOne) ClearRenditions() *EpisodeUpdateOne {
+	euo.mutation.ClearRenditions()
+	return euo
+}
+
+// RemoveRenditionIDs removes the "renditions" edge to Rendition entities by IDs.
+func (euo *EpisodeUpdateOne) RemoveRenditionIDs(ids ...int) *EpisodeUpdateOne {
+	euo.mutation.RemoveRenditionIDs(ids...)
+	return euo
+}
+
+// RemoveRenditions removes "renditions" edges to Rendition entities.
+func (euo *EpisodeUpdateOne) RemoveRenditions(r ...*Rendition) *EpisodeUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.RemoveRenditionIDs(ids...)
+}
+
+// ClearSubtitles clears all "subtitles" edges to the Subtitle entity.
+func (euo *EpisodeUpdateOne) ClearSubtitles() *EpisodeUpdateOne {
+	euo.mutation.ClearSubtitles()
+	return euo
+}
+
+// RemoveSubtitleIDs removes the "subtitles" edge to Subtitle entities by IDs.
+func (euo *EpisodeUpdateOne) RemoveSubtitleIDs(ids ...int) *EpisodeUpdateOne {
+	euo.mutation.RemoveSubtitleIDs(ids...)
+	return euo
+}
+
+// RemoveSubtitles removes "subtitles" edges to Subtitle entities.
+func (euo *EpisodeUpdateOne) RemoveSubtitles(s ...*Subtitle) *EpisodeUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return euo.RemoveSubtitleIDs(ids...)
+}
+
+// ClearAudioTracks clears all "audio_tracks" edges to the AudioTrack entity.
+func (euo *EpisodeUpdateOne) ClearAudioTracks() *EpisodeUpdateOne {
+	euo.mutation.ClearAudioTracks()
+	return euo
+}
+
+// RemoveAudioTrackIDs removes the "audio_tracks" edge to AudioTrack entities by IDs.
+func (euo *EpisodeUpdateOne) RemoveAudioTrackIDs(ids ...int) *EpisodeUpdateOne {
+	euo.mutation.RemoveAudioTrackIDs(ids...)
+	return euo
+}
+
+// RemoveAudioTracks removes "audio_tracks" edges to AudioTrack entities.
+func (euo *EpisodeUpdateOne) RemoveAudioTracks(a ...*AudioTrack) *EpisodeUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.RemoveAudioTrackIDs(ids...)
+}
+
+// ClearChapters clears all "chapters" edges to the Chapter entity.
+func (euo *EpisodeUpdateOne) ClearChapters() *EpisodeUpdateOne {
+	euo.mutation.ClearChapters()
+	return euo
+}
+
+// RemoveChapterIDs removes the "chapters" edge to Chapter entities by IDs.
+func (euo *EpisodeUpdateOne) RemoveChapterIDs(ids ...int) *EpisodeUpdateOne {
+	euo.mutation.RemoveChapterIDs(ids...)
+	return euo
+}
+
+// RemoveChapters removes "chapters" edges to Chapter entities.
+func (euo *EpisodeUpdateOne) RemoveChapters(c ...*Chapter) *EpisodeUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return euo.RemoveChapterIDs(ids...)
+}
+
+// ClearWatchProgress clears all "watch_progress" edges to the WatchProgress entity.
+func (euo *EpisodeUpdateOne) ClearWatchProgress() *EpisodeUpdateOne {
+	euo.mutation.ClearWatchProgress()
+	return euo
+}
+
+// RemoveWatchProgresIDs removes the "watch_progress" edge to WatchProgress entities by IDs.
+func (euo *EpisodeUpdateOne) RemoveWatchProgresIDs(ids ...int) *EpisodeUpdateOne {
+	euo.mutation.RemoveWatchProgresIDs(ids...)
+	return euo
+}
+
+// RemoveWatchProgress removes "watch_progress" edges to WatchProgress entities.
+func (euo *EpisodeUpdateOne) RemoveWatchProgress(w ...*WatchProgress) *EpisodeUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return euo.RemoveWatchProgresIDs(ids...)
+}
+
 // Where appends a list predicates to the EpisodeUpdate builder.
 func (euo *EpisodeUpdateOne) Where(ps ...predicate.Episode) *EpisodeUpdateOne {
 	euo.mutation.Where(ps...)
@@ -649,6 +1293,7 @@ func (euo *EpisodeUpdateOne) Select(field string, fields ...string) *EpisodeUpda
 
 // Save executes the query and returns the updated Episode entity.
 func (euo *EpisodeUpdateOne) Save(ctx context.Context) (*Episode, error) {
+	euo.defaults()
 	return withHooks(ctx, euo.sqlSave, euo.mutation, euo.hooks)
 }
 
@@ -674,6 +1319,14 @@ func (euo *EpisodeUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (euo *EpisodeUpdateOne) defaults() {
+	if _, ok := euo.mutation.UpdatedAt(); !ok {
+		v := episode.UpdateDefaultUpdatedAt()
+		euo.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (euo *EpisodeUpdateOne) check() error {
 	if v, ok := euo.mutation.EpisodeID(); ok {
@@ -721,6 +1374,9 @@ func (euo *EpisodeUpdateOne) sqlSave(ctx context.Context) (_node *Episode, err e
 			}
 		}
 	}
+	if value, ok := euo.mutation.UpdatedAt(); ok {
+		_spec.SetField(episode.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if value, ok := euo.mutation.EpisodeID(); ok {
 		_spec.SetField(episode.FieldEpisodeID, field.TypeString, value)
 	}
@@ -772,6 +1428,12 @@ func (euo *EpisodeUpdateOne) sqlSave(ctx context.Context) (_node *Episode, err e
 	if value, ok := euo.mutation.Metadata(); ok {
 		_spec.SetField(episode.FieldMetadata, field.TypeString, value)
 	}
+	if value, ok := euo.mutation.Media(); ok {
+		_spec.SetField(episode.FieldMedia, field.TypeJSON, value)
+	}
+	if euo.mutation.MediaCleared() {
+		_spec.ClearField(episode.FieldMedia, field.TypeJSON)
+	}
 	if euo.mutation.SeasonCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -801,6 +1463,231 @@ func (euo *EpisodeUpdateOne) sqlSave(ctx context.Context) (_node *Episode, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if euo.mutation.RenditionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.RenditionsTable,
+			Columns: []string{episode.RenditionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rendition.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedRenditionsIDs(); len(nodes) > 0 && !euo.mutation.RenditionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.RenditionsTable,
+			Columns: []string{episode.RenditionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rendition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RenditionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.RenditionsTable,
+			Columns: []string{episode.RenditionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rendition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.SubtitlesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.SubtitlesTable,
+			Columns: []string{episode.SubtitlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subtitle.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedSubtitlesIDs(); len(nodes) > 0 && !euo.mutation.SubtitlesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.SubtitlesTable,
+			Columns: []string{episode.SubtitlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subtitle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.SubtitlesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.SubtitlesTable,
+			Columns: []string{episode.SubtitlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subtitle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.AudioTracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.AudioTracksTable,
+			Columns: []string{episode.AudioTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(audiotrack.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedAudioTracksIDs(); len(nodes) > 0 && !euo.mutation.AudioTracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.AudioTracksTable,
+			Columns: []string{episode.AudioTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(audiotrack.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.AudioTracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.AudioTracksTable,
+			Columns: []string{episode.AudioTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(audiotrack.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.ChaptersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.ChaptersTable,
+			Columns: []string{episode.ChaptersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chapter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedChaptersIDs(); len(nodes) > 0 && !euo.mutation.ChaptersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.ChaptersTable,
+			Columns: []string{episode.ChaptersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chapter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.ChaptersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.ChaptersTable,
+			Columns: []string{episode.ChaptersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chapter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.WatchProgressCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.WatchProgressTable,
+			Columns: []string{episode.WatchProgressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchprogress.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedWatchProgressIDs(); len(nodes) > 0 && !euo.mutation.WatchProgressCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.WatchProgressTable,
+			Columns: []string{episode.WatchProgressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchprogress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.WatchProgressIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   episode.WatchProgressTable,
+			Columns: []string{episode.WatchProgressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchprogress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Episode{config: euo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/clustlight/animatrix-api/ent/favorite"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/user"
+)
+
+// Favorite is the model entity for the Favorite schema.
+type Favorite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FavoriteQuery when eager-loading is set.
+	Edges            FavoriteEdges `json:"edges"`
+	season_favorites *int
+	series_favorites *int
+	user_favorites   *int
+	selectValues     sql.SelectValues
+}
+
+// FavoriteEdges holds the relations/edges for other nodes in the graph.
+type FavoriteEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Series holds the value of the series edge.
+	Series *Series `json:"series,omitempty"`
+	// Season holds the value of the season edge.
+	Season *Season `json:"season,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FavoriteEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// SeriesOrErr returns the Series value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FavoriteEdges) SeriesOrErr() (*Series, error) {
+	if e.Series != nil {
+		return e.Series, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: series.Label}
+	}
+	return nil, &NotLoadedError{edge: "series"}
+}
+
+// SeasonOrErr returns the Season value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FavoriteEdges) SeasonOrErr() (*Season, error) {
+	if e.Season != nil {
+		return e.Season, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: season.Label}
+	}
+	return nil, &NotLoadedError{edge: "season"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Favorite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case favorite.FieldID:
+			values[i] = new(sql.NullInt64)
+		case favorite.FieldCreatedAt, favorite.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case favorite.ForeignKeys[0]: // season_favorites
+			values[i] = new(sql.NullInt64)
+		case favorite.ForeignKeys[1]: // series_favorites
+			values[i] = new(sql.NullInt64)
+		case favorite.ForeignKeys[2]: // user_favorites
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Favorite fields.
+func (f *Favorite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case favorite.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			f.ID = int(value.Int64)
+		case favorite.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				f.CreatedAt = value.Time
+			}
+		case favorite.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				f.UpdatedAt = value.Time
+			}
+		case favorite.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field season_favorites", value)
+			} else if value.Valid {
+				f.season_favorites = new(int)
+				*f.season_favorites = int(value.Int64)
+			}
+		case favorite.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field series_favorites", value)
+			} else if value.Valid {
+				f.series_favorites = new(int)
+				*f.series_favorites = int(value.Int64)
+			}
+		case favorite.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_favorites", value)
+			} else if value.Valid {
+				f.user_favorites = new(int)
+				*f.user_favorites = int(value.Int64)
+			}
+		default:
+			f.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Favorite.
+// This includes values selected through modifiers, order, etc.
+func (f *Favorite) Value(name string) (ent.Value, error) {
+	return f.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Favorite entity.
+func (f *Favorite) QueryUser() *UserQuery {
+	return NewFavoriteClient(f.config).QueryUser(f)
+}
+
+// QuerySeries queries the "series" edge of the Favorite entity.
+func (f *Favorite) QuerySeries() *SeriesQuery {
+	return NewFavoriteClient(f.config).QuerySeries(f)
+}
+
+// QuerySeason queries the "season" edge of the Favorite entity.
+func (f *Favorite) QuerySeason() *SeasonQuery {
+	return NewFavoriteClient(f.config).QuerySeason(f)
+}
+
+// Update returns a builder for updating this Favorite.
+// Note that you need to call Favorite.Unwrap() before calling this method if this Favorite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (f *Favorite) Update() *FavoriteUpdateOne {
+	return NewFavoriteClient(f.config).UpdateOne(f)
+}
+
+// Unwrap unwraps the Favorite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (f *Favorite) Unwrap() *Favorite {
+	_tx, ok := f.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Favorite is not a transactional entity")
+	}
+	f.config.driver = _tx.drv
+	return f
+}
+
+// String implements the fmt.Stringer.
+func (f *Favorite) String() string {
+	var builder strings.Builder
+	builder.WriteString("Favorite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", f.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(f.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(f.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Favorites is a parsable slice of Favorite.
+type Favorites []*Favorite
@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/favorite"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/user"
+)
+
+// FavoriteCreate is the builder for creating a Favorite entity.
+type FavoriteCreate struct {
+	config
+	mutation *FavoriteMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fc *FavoriteCreate) SetCreatedAt(t time.Time) *FavoriteCreate {
+	fc.mutation.SetCreatedAt(t)
+	return fc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fc *FavoriteCreate) SetNillableCreatedAt(t *time.Time) *FavoriteCreate {
+	if t != nil {
+		fc.SetCreatedAt(*t)
+	}
+	return fc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fc *FavoriteCreate) SetUpdatedAt(t time.Time) *FavoriteCreate {
+	fc.mutation.SetUpdatedAt(t)
+	return fc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (fc *FavoriteCreate) SetNillableUpdatedAt(t *time.Time) *FavoriteCreate {
+	if t != nil {
+		fc.SetUpdatedAt(*t)
+	}
+	return fc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (fc *FavoriteCreate) SetUserID(id int) *FavoriteCreate {
+	fc.mutation.SetUserID(id)
+	return fc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fc *FavoriteCreate) SetUser(u *User) *FavoriteCreate {
+	return fc.SetUserID(u.ID)
+}
+
+// SetSeriesID sets the "series" edge to the Series entity by ID.
+func (fc *FavoriteCreate) SetSeriesID(id int) *FavoriteCreate {
+	fc.mutation.SetSeriesID(id)
+	return fc
+}
+
+// SetNillableSeriesID sets the "series" edge to the Series entity by ID if the given value is not nil.
+func (fc *FavoriteCreate) SetNillableSeriesID(id *int) *FavoriteCreate {
+	if id != nil {
+		fc = fc.SetSeriesID(*id)
+	}
+	return fc
+}
+
+// SetSeries sets the "series" edge to the Series entity.
+func (fc *FavoriteCreate) SetSeries(s *Series) *FavoriteCreate {
+	return fc.SetSeriesID(s.ID)
+}
+
+// SetSeasonID sets the "season" edge to the Season entity by ID.
+func (fc *FavoriteCreate) SetSeasonID(id int) *FavoriteCreate {
+	fc.mutation.SetSeasonID(id)
+	return fc
+}
+
+// SetNillableSeasonID sets the "season" edge to the Season entity by ID if the given value is not nil.
+func (fc *FavoriteCreate) SetNillableSeasonID(id *int) *FavoriteCreate {
+	if id != nil {
+		fc = fc.SetSeasonID(*id)
+	}
+	return fc
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (fc *FavoriteCreate) SetSeason(s *Season) *FavoriteCreate {
+	return fc.SetSeasonID(s.ID)
+}
+
+// Mutation returns the FavoriteMutation object of the builder.
+func (fc *FavoriteCreate) Mutation() *FavoriteMutation {
+	return fc.mutation
+}
+
+// Save creates the Favorite in the database.
+func (fc *FavoriteCreate) Save(ctx context.Context) (*Favorite, error) {
+	fc.defaults()
+	return withHooks(ctx, fc.sqlSave, fc.mutation, fc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fc *FavoriteCreate) SaveX(ctx context.Context) *Favorite {
+	v, err := fc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fc *FavoriteCreate) Exec(ctx context.Context) error {
+	_, err := fc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fc *FavoriteCreate) ExecX(ctx context.Context) {
+	if err := fc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fc *FavoriteCreate) defaults() {
+	if _, ok := fc.mutation.CreatedAt(); !ok {
+		v := favorite.DefaultCreatedAt()
+		fc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := fc.mutation.UpdatedAt(); !ok {
+		v := favorite.DefaultUpdatedAt()
+		fc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fc *FavoriteCreate) check() error {
+	if _, ok := fc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Favorite.created_at"`)}
+	}
+	if _, ok := fc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Favorite.updated_at"`)}
+	}
+	if len(fc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Favorite.user"`)}
+	}
+	return nil
+}
+
+func (fc *FavoriteCreate) sqlSave(ctx context.Context) (*Favorite, error) {
+	if err := fc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	fc.mutation.id = &_node.ID
+	fc.mutation.done = true
+	return _node, nil
+}
+
+func (fc *FavoriteCreate) createSpec() (*Favorite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Favorite{config: fc.config}
+		_spec = sqlgraph.NewCreateSpec(favorite.Table, sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt))
+	)
+	if value, ok := fc.mutation.CreatedAt(); ok {
+		_spec.SetField(favorite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := fc.mutation.UpdatedAt(); ok {
+		_spec.SetField(favorite.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := fc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.UserTable,
+			Columns: []string{favorite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_favorites = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.SeriesTable,
+			Columns: []string{favorite.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.series_favorites = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.SeasonTable,
+			Columns: []string{favorite.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.season_favorites = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FavoriteCreateBulk is the builder for creating many Favorite entities in bulk.
+type FavoriteCreateBulk struct {
+	config
+	err      error
+	builders []*FavoriteCreate
+}
+
+// Save creates the Favorite entities in the database.
+func (fcb *FavoriteCreateBulk) Save(ctx context.Context) ([]*Favorite, error) {
+	if fcb.err != nil {
+		return nil, fcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(fcb.builders))
+	nodes := make([]*Favorite, len(fcb.builders))
+	mutators := make([]Mutator, len(fcb.builders))
+	for i := range fcb.builders {
+		func(i int, root context.Context) {
+			builder := fcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FavoriteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fcb *FavoriteCreateBulk) SaveX(ctx context.Context) []*Favorite {
+	v, err := fcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fcb *FavoriteCreateBulk) Exec(ctx context.Context) error {
+	_, err := fcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcb *FavoriteCreateBulk) ExecX(ctx context.Context) {
+	if err := fcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
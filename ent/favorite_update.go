@@ -0,0 +1,545 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/favorite"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/user"
+)
+
+// FavoriteUpdate is the builder for updating Favorite entities.
+type FavoriteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FavoriteMutation
+}
+
+// Where appends a list predicates to the FavoriteUpdate builder.
+func (fu *FavoriteUpdate) Where(ps ...predicate.Favorite) *FavoriteUpdate {
+	fu.mutation.Where(ps...)
+	return fu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fu *FavoriteUpdate) SetUpdatedAt(t time.Time) *FavoriteUpdate {
+	fu.mutation.SetUpdatedAt(t)
+	return fu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (fu *FavoriteUpdate) SetUserID(id int) *FavoriteUpdate {
+	fu.mutation.SetUserID(id)
+	return fu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fu *FavoriteUpdate) SetUser(u *User) *FavoriteUpdate {
+	return fu.SetUserID(u.ID)
+}
+
+// SetSeriesID sets the "series" edge to the Series entity by ID.
+func (fu *FavoriteUpdate) SetSeriesID(id int) *FavoriteUpdate {
+	fu.mutation.SetSeriesID(id)
+	return fu
+}
+
+// SetNillableSeriesID sets the "series" edge to the Series entity by ID if the given value is not nil.
+func (fu *FavoriteUpdate) SetNillableSeriesID(id *int) *FavoriteUpdate {
+	if id != nil {
+		fu = fu.SetSeriesID(*id)
+	}
+	return fu
+}
+
+// SetSeries sets the "series" edge to the Series entity.
+func (fu *FavoriteUpdate) SetSeries(s *Series) *FavoriteUpdate {
+	return fu.SetSeriesID(s.ID)
+}
+
+// SetSeasonID sets the "season" edge to the Season entity by ID.
+func (fu *FavoriteUpdate) SetSeasonID(id int) *FavoriteUpdate {
+	fu.mutation.SetSeasonID(id)
+	return fu
+}
+
+// SetNillableSeasonID sets the "season" edge to the Season entity by ID if the given value is not nil.
+func (fu *FavoriteUpdate) SetNillableSeasonID(id *int) *FavoriteUpdate {
+	if id != nil {
+		fu = fu.SetSeasonID(*id)
+	}
+	return fu
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (fu *FavoriteUpdate) SetSeason(s *Season) *FavoriteUpdate {
+	return fu.SetSeasonID(s.ID)
+}
+
+// Mutation returns the FavoriteMutation object of the builder.
+func (fu *FavoriteUpdate) Mutation() *FavoriteMutation {
+	return fu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (fu *FavoriteUpdate) ClearUser() *FavoriteUpdate {
+	fu.mutation.ClearUser()
+	return fu
+}
+
+// ClearSeries clears the "series" edge to the Series entity.
+func (fu *FavoriteUpdate) ClearSeries() *FavoriteUpdate {
+	fu.mutation.ClearSeries()
+	return fu
+}
+
+// ClearSeason clears the "season" edge to the Season entity.
+func (fu *FavoriteUpdate) ClearSeason() *FavoriteUpdate {
+	fu.mutation.ClearSeason()
+	return fu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fu *FavoriteUpdate) Save(ctx context.Context) (int, error) {
+	fu.defaults()
+	return withHooks(ctx, fu.sqlSave, fu.mutation, fu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fu *FavoriteUpdate) SaveX(ctx context.Context) int {
+	affected, err := fu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fu *FavoriteUpdate) Exec(ctx context.Context) error {
+	_, err := fu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fu *FavoriteUpdate) ExecX(ctx context.Context) {
+	if err := fu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fu *FavoriteUpdate) defaults() {
+	if _, ok := fu.mutation.UpdatedAt(); !ok {
+		v := favorite.UpdateDefaultUpdatedAt()
+		fu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fu *FavoriteUpdate) check() error {
+	if fu.mutation.UserCleared() && len(fu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Favorite.user"`)
+	}
+	return nil
+}
+
+func (fu *FavoriteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(favorite.Table, favorite.Columns, sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt))
+	if ps := fu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fu.mutation.UpdatedAt(); ok {
+		_spec.SetField(favorite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if fu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.UserTable,
+			Columns: []string{favorite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.UserTable,
+			Columns: []string{favorite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.SeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.SeriesTable,
+			Columns: []string{favorite.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.SeriesTable,
+			Columns: []string{favorite.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.SeasonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.SeasonTable,
+			Columns: []string{favorite.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.SeasonTable,
+			Columns: []string{favorite.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{favorite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fu.mutation.done = true
+	return n, nil
+}
+
+// FavoriteUpdateOne is the builder for updating a single Favorite entity.
+type FavoriteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FavoriteMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fuo *FavoriteUpdateOne) SetUpdatedAt(t time.Time) *FavoriteUpdateOne {
+	fuo.mutation.SetUpdatedAt(t)
+	return fuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (fuo *FavoriteUpdateOne) SetUserID(id int) *FavoriteUpdateOne {
+	fuo.mutation.SetUserID(id)
+	return fuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fuo *FavoriteUpdateOne) SetUser(u *User) *FavoriteUpdateOne {
+	return fuo.SetUserID(u.ID)
+}
+
+// SetSeriesID sets the "series" edge to the Series entity by ID.
+func (fuo *FavoriteUpdateOne) SetSeriesID(id int) *FavoriteUpdateOne {
+	fuo.mutation.SetSeriesID(id)
+	return fuo
+}
+
+// SetNillableSeriesID sets the "series" edge to the Series entity by ID if the given value is not nil.
+func (fuo *FavoriteUpdateOne) SetNillableSeriesID(id *int) *FavoriteUpdateOne {
+	if id != nil {
+		fuo = fuo.SetSeriesID(*id)
+	}
+	return fuo
+}
+
+// SetSeries sets the "series" edge to the Series entity.
+func (fuo *FavoriteUpdateOne) SetSeries(s *Series) *FavoriteUpdateOne {
+	return fuo.SetSeriesID(s.ID)
+}
+
+// SetSeasonID sets the "season" edge to the Season entity by ID.
+func (fuo *FavoriteUpdateOne) SetSeasonID(id int) *FavoriteUpdateOne {
+	fuo.mutation.SetSeasonID(id)
+	return fuo
+}
+
+// SetNillableSeasonID sets the "season" edge to the Season entity by ID if the given value is not nil.
+func (fuo *FavoriteUpdateOne) SetNillableSeasonID(id *int) *FavoriteUpdateOne {
+	if id != nil {
+		fuo = fuo.SetSeasonID(*id)
+	}
+	return fuo
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (fuo *FavoriteUpdateOne) SetSeason(s *Season) *FavoriteUpdateOne {
+	return fuo.SetSeasonID(s.ID)
+}
+
+// Mutation returns the FavoriteMutation object of the builder.
+func (fuo *FavoriteUpdateOne) Mutation() *FavoriteMutation {
+	return fuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (fuo *FavoriteUpdateOne) ClearUser() *FavoriteUpdateOne {
+	fuo.mutation.ClearUser()
+	return fuo
+}
+
+// ClearSeries clears the "series" edge to the Series entity.
+func (fuo *FavoriteUpdateOne) ClearSeries() *FavoriteUpdateOne {
+	fuo.mutation.ClearSeries()
+	return fuo
+}
+
+// ClearSeason clears the "season" edge to the Season entity.
+func (fuo *FavoriteUpdateOne) ClearSeason() *FavoriteUpdateOne {
+	fuo.mutation.ClearSeason()
+	return fuo
+}
+
+// Where appends a list predicates to the FavoriteUpdate builder.
+func (fuo *FavoriteUpdateOne) Where(ps ...predicate.Favorite) *FavoriteUpdateOne {
+	fuo.mutation.Where(ps...)
+	return fuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fuo *FavoriteUpdateOne) Select(field string, fields ...string) *FavoriteUpdateOne {
+	fuo.fields = append([]string{field}, fields...)
+	return fuo
+}
+
+// Save executes the query and returns the updated Favorite entity.
+func (fuo *FavoriteUpdateOne) Save(ctx context.Context) (*Favorite, error) {
+	fuo.defaults()
+	return withHooks(ctx, fuo.sqlSave, fuo.mutation, fuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fuo *FavoriteUpdateOne) SaveX(ctx context.Context) *Favorite {
+	node, err := fuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fuo *FavoriteUpdateOne) Exec(ctx context.Context) error {
+	_, err := fuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fuo *FavoriteUpdateOne) ExecX(ctx context.Context) {
+	if err := fuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fuo *FavoriteUpdateOne) defaults() {
+	if _, ok := fuo.mutation.UpdatedAt(); !ok {
+		v := favorite.UpdateDefaultUpdatedAt()
+		fuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fuo *FavoriteUpdateOne) check() error {
+	if fuo.mutation.UserCleared() && len(fuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Favorite.user"`)
+	}
+	return nil
+}
+
+func (fuo *FavoriteUpdateOne) sqlSave(ctx context.Context) (_node *Favorite, err error) {
+	if err := fuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(favorite.Table, favorite.Columns, sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt))
+	id, ok := fuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Favorite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, favorite.FieldID)
+		for _, f := range fields {
+			if !favorite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != favorite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(favorite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if fuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.UserTable,
+			Columns: []string{favorite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.UserTable,
+			Columns: []string{favorite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.SeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.SeriesTable,
+			Columns: []string{favorite.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.SeriesTable,
+			Columns: []string{favorite.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.SeasonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.SeasonTable,
+			Columns: []string{favorite.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   favorite.SeasonTable,
+			Columns: []string{favorite.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Favorite{config: fuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{favorite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fuo.mutation.done = true
+	return _node, nil
+}
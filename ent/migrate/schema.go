@@ -8,9 +8,88 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "hash", Type: field.TypeString, Unique: true},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_api_keys", Type: field.TypeInt, Nullable: true},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_keys_users_api_keys",
+				Columns:    []*schema.Column{APIKeysColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// AudioTracksColumns holds the columns for the "audio_tracks" table.
+	AudioTracksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "language", Type: field.TypeString},
+		{Name: "format", Type: field.TypeString},
+		{Name: "label", Type: field.TypeString, Nullable: true},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "path", Type: field.TypeString},
+		{Name: "episode_audio_tracks", Type: field.TypeInt},
+	}
+	// AudioTracksTable holds the schema information for the "audio_tracks" table.
+	AudioTracksTable = &schema.Table{
+		Name:       "audio_tracks",
+		Columns:    AudioTracksColumns,
+		PrimaryKey: []*schema.Column{AudioTracksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audio_tracks_episodes_audio_tracks",
+				Columns:    []*schema.Column{AudioTracksColumns[8]},
+				RefColumns: []*schema.Column{EpisodesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ChaptersColumns holds the columns for the "chapters" table.
+	ChaptersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "start", Type: field.TypeFloat64},
+		{Name: "end", Type: field.TypeFloat64},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "episode_chapters", Type: field.TypeInt},
+	}
+	// ChaptersTable holds the schema information for the "chapters" table.
+	ChaptersTable = &schema.Table{
+		Name:       "chapters",
+		Columns:    ChaptersColumns,
+		PrimaryKey: []*schema.Column{ChaptersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "chapters_episodes_chapters",
+				Columns:    []*schema.Column{ChaptersColumns[7]},
+				RefColumns: []*schema.Column{EpisodesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// EpisodesColumns holds the columns for the "episodes" table.
 	EpisodesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "episode_id", Type: field.TypeString, Unique: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
@@ -23,6 +102,7 @@ var (
 		{Name: "height", Type: field.TypeInt},
 		{Name: "dynamic_range", Type: field.TypeString},
 		{Name: "metadata", Type: field.TypeString, Size: 2147483647},
+		{Name: "media", Type: field.TypeJSON, Nullable: true},
 		{Name: "season_episodes", Type: field.TypeInt},
 	}
 	// EpisodesTable holds the schema information for the "episodes" table.
@@ -33,15 +113,149 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "episodes_seasons_episodes",
-				Columns:    []*schema.Column{EpisodesColumns[13]},
+				Columns:    []*schema.Column{EpisodesColumns[16]},
 				RefColumns: []*schema.Column{SeasonsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// FavoritesColumns holds the columns for the "favorites" table.
+	FavoritesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "season_favorites", Type: field.TypeInt, Nullable: true},
+		{Name: "series_favorites", Type: field.TypeInt, Nullable: true},
+		{Name: "user_favorites", Type: field.TypeInt},
+	}
+	// FavoritesTable holds the schema information for the "favorites" table.
+	FavoritesTable = &schema.Table{
+		Name:       "favorites",
+		Columns:    FavoritesColumns,
+		PrimaryKey: []*schema.Column{FavoritesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "favorites_seasons_favorites",
+				Columns:    []*schema.Column{FavoritesColumns[3]},
+				RefColumns: []*schema.Column{SeasonsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "favorites_series_favorites",
+				Columns:    []*schema.Column{FavoritesColumns[4]},
+				RefColumns: []*schema.Column{SeriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "favorites_users_favorites",
+				Columns:    []*schema.Column{FavoritesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "favorite_user_favorites_series_favorites",
+				Unique:  true,
+				Columns: []*schema.Column{FavoritesColumns[5], FavoritesColumns[4]},
+			},
+			{
+				Name:    "favorite_user_favorites_season_favorites",
+				Unique:  true,
+				Columns: []*schema.Column{FavoritesColumns[5], FavoritesColumns[3]},
+			},
+		},
+	}
+	// RatingsColumns holds the columns for the "ratings" table.
+	RatingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "score", Type: field.TypeInt},
+		{Name: "season_ratings", Type: field.TypeInt, Nullable: true},
+		{Name: "series_ratings", Type: field.TypeInt, Nullable: true},
+		{Name: "user_ratings", Type: field.TypeInt},
+	}
+	// RatingsTable holds the schema information for the "ratings" table.
+	RatingsTable = &schema.Table{
+		Name:       "ratings",
+		Columns:    RatingsColumns,
+		PrimaryKey: []*schema.Column{RatingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ratings_seasons_ratings",
+				Columns:    []*schema.Column{RatingsColumns[4]},
+				RefColumns: []*schema.Column{SeasonsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "ratings_series_ratings",
+				Columns:    []*schema.Column{RatingsColumns[5]},
+				RefColumns: []*schema.Column{SeriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "ratings_users_ratings",
+				Columns:    []*schema.Column{RatingsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rating_user_ratings_series_ratings",
+				Unique:  true,
+				Columns: []*schema.Column{RatingsColumns[6], RatingsColumns[5]},
+			},
+			{
+				Name:    "rating_user_ratings_season_ratings",
+				Unique:  true,
+				Columns: []*schema.Column{RatingsColumns[6], RatingsColumns[4]},
+			},
+		},
+	}
+	// RenditionsColumns holds the columns for the "renditions" table.
+	RenditionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "name", Type: field.TypeString},
+		{Name: "width", Type: field.TypeInt},
+		{Name: "height", Type: field.TypeInt},
+		{Name: "codecs", Type: field.TypeString},
+		{Name: "bitrate", Type: field.TypeInt},
+		{Name: "frame_rate", Type: field.TypeFloat64, Nullable: true},
+		{Name: "dynamic_range", Type: field.TypeString},
+		{Name: "container", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString},
+		{Name: "episode_renditions", Type: field.TypeInt},
+	}
+	// RenditionsTable holds the schema information for the "renditions" table.
+	RenditionsTable = &schema.Table{
+		Name:       "renditions",
+		Columns:    RenditionsColumns,
+		PrimaryKey: []*schema.Column{RenditionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "renditions_episodes_renditions",
+				Columns:    []*schema.Column{RenditionsColumns[12]},
+				RefColumns: []*schema.Column{EpisodesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rendition_name_episode_renditions",
+				Unique:  true,
+				Columns: []*schema.Column{RenditionsColumns[3], RenditionsColumns[12]},
+			},
+		},
+	}
 	// SeasonsColumns holds the columns for the "seasons" table.
 	SeasonsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "season_id", Type: field.TypeString, Unique: true},
 		{Name: "season_title", Type: field.TypeString},
 		{Name: "season_title_yomi", Type: field.TypeString, Nullable: true},
@@ -52,6 +266,7 @@ var (
 		{Name: "first_month", Type: field.TypeInt, Nullable: true},
 		{Name: "first_end_year", Type: field.TypeInt, Nullable: true},
 		{Name: "first_end_month", Type: field.TypeInt, Nullable: true},
+		{Name: "media", Type: field.TypeJSON, Nullable: true},
 		{Name: "series_seasons", Type: field.TypeInt},
 	}
 	// SeasonsTable holds the schema information for the "seasons" table.
@@ -62,7 +277,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "seasons_series_seasons",
-				Columns:    []*schema.Column{SeasonsColumns[11]},
+				Columns:    []*schema.Column{SeasonsColumns[14]},
 				RefColumns: []*schema.Column{SeriesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -71,11 +286,14 @@ var (
 	// SeriesColumns holds the columns for the "series" table.
 	SeriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "series_id", Type: field.TypeString, Unique: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "title_yomi", Type: field.TypeString, Nullable: true},
 		{Name: "title_en", Type: field.TypeString, Nullable: true},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "media", Type: field.TypeJSON, Nullable: true},
 	}
 	// SeriesTable holds the schema information for the "series" table.
 	SeriesTable = &schema.Table{
@@ -83,15 +301,195 @@ var (
 		Columns:    SeriesColumns,
 		PrimaryKey: []*schema.Column{SeriesColumns[0]},
 	}
+	// SubtitlesColumns holds the columns for the "subtitles" table.
+	SubtitlesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "language", Type: field.TypeString},
+		{Name: "format", Type: field.TypeString},
+		{Name: "label", Type: field.TypeString, Nullable: true},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "path", Type: field.TypeString},
+		{Name: "episode_subtitles", Type: field.TypeInt},
+	}
+	// SubtitlesTable holds the schema information for the "subtitles" table.
+	SubtitlesTable = &schema.Table{
+		Name:       "subtitles",
+		Columns:    SubtitlesColumns,
+		PrimaryKey: []*schema.Column{SubtitlesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "subtitles_episodes_subtitles",
+				Columns:    []*schema.Column{SubtitlesColumns[8]},
+				RefColumns: []*schema.Column{EpisodesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 255},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+	}
+	// WatchProgressesColumns holds the columns for the "watch_progresses" table.
+	WatchProgressesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "position", Type: field.TypeFloat64},
+		{Name: "completed", Type: field.TypeBool, Default: false},
+		{Name: "episode_watch_progress", Type: field.TypeInt},
+		{Name: "user_watch_progress", Type: field.TypeInt},
+	}
+	// WatchProgressesTable holds the schema information for the "watch_progresses" table.
+	WatchProgressesTable = &schema.Table{
+		Name:       "watch_progresses",
+		Columns:    WatchProgressesColumns,
+		PrimaryKey: []*schema.Column{WatchProgressesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "watch_progresses_episodes_watch_progress",
+				Columns:    []*schema.Column{WatchProgressesColumns[5]},
+				RefColumns: []*schema.Column{EpisodesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "watch_progresses_users_watch_progress",
+				Columns:    []*schema.Column{WatchProgressesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "watchprogress_user_watch_progress_episode_watch_progress",
+				Unique:  true,
+				Columns: []*schema.Column{WatchProgressesColumns[6], WatchProgressesColumns[5]},
+			},
+		},
+	}
+	// WatchlistsColumns holds the columns for the "watchlists" table.
+	WatchlistsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "user_watchlists", Type: field.TypeInt},
+	}
+	// WatchlistsTable holds the schema information for the "watchlists" table.
+	WatchlistsTable = &schema.Table{
+		Name:       "watchlists",
+		Columns:    WatchlistsColumns,
+		PrimaryKey: []*schema.Column{WatchlistsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "watchlists_users_watchlists",
+				Columns:    []*schema.Column{WatchlistsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "watchlist_name_user_watchlists",
+				Unique:  true,
+				Columns: []*schema.Column{WatchlistsColumns[3], WatchlistsColumns[4]},
+			},
+		},
+	}
+	// WatchlistItemsColumns holds the columns for the "watchlist_items" table.
+	WatchlistItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "season_watchlist_items", Type: field.TypeInt, Nullable: true},
+		{Name: "series_watchlist_items", Type: field.TypeInt, Nullable: true},
+		{Name: "watchlist_items", Type: field.TypeInt},
+	}
+	// WatchlistItemsTable holds the schema information for the "watchlist_items" table.
+	WatchlistItemsTable = &schema.Table{
+		Name:       "watchlist_items",
+		Columns:    WatchlistItemsColumns,
+		PrimaryKey: []*schema.Column{WatchlistItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "watchlist_items_seasons_watchlist_items",
+				Columns:    []*schema.Column{WatchlistItemsColumns[3]},
+				RefColumns: []*schema.Column{SeasonsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "watchlist_items_series_watchlist_items",
+				Columns:    []*schema.Column{WatchlistItemsColumns[4]},
+				RefColumns: []*schema.Column{SeriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "watchlist_items_watchlists_items",
+				Columns:    []*schema.Column{WatchlistItemsColumns[5]},
+				RefColumns: []*schema.Column{WatchlistsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "watchlistitem_watchlist_items_series_watchlist_items",
+				Unique:  true,
+				Columns: []*schema.Column{WatchlistItemsColumns[5], WatchlistItemsColumns[4]},
+			},
+			{
+				Name:    "watchlistitem_watchlist_items_season_watchlist_items",
+				Unique:  true,
+				Columns: []*schema.Column{WatchlistItemsColumns[5], WatchlistItemsColumns[3]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		AudioTracksTable,
+		ChaptersTable,
 		EpisodesTable,
+		FavoritesTable,
+		RatingsTable,
+		RenditionsTable,
 		SeasonsTable,
 		SeriesTable,
+		SubtitlesTable,
+		UsersTable,
+		WatchProgressesTable,
+		WatchlistsTable,
+		WatchlistItemsTable,
 	}
 )
 
 func init() {
+	APIKeysTable.ForeignKeys[0].RefTable = UsersTable
+	AudioTracksTable.ForeignKeys[0].RefTable = EpisodesTable
+	ChaptersTable.ForeignKeys[0].RefTable = EpisodesTable
 	EpisodesTable.ForeignKeys[0].RefTable = SeasonsTable
+	FavoritesTable.ForeignKeys[0].RefTable = SeasonsTable
+	FavoritesTable.ForeignKeys[1].RefTable = SeriesTable
+	FavoritesTable.ForeignKeys[2].RefTable = UsersTable
+	RatingsTable.ForeignKeys[0].RefTable = SeasonsTable
+	RatingsTable.ForeignKeys[1].RefTable = SeriesTable
+	RatingsTable.ForeignKeys[2].RefTable = UsersTable
+	RenditionsTable.ForeignKeys[0].RefTable = EpisodesTable
 	SeasonsTable.ForeignKeys[0].RefTable = SeriesTable
+	SubtitlesTable.ForeignKeys[0].RefTable = EpisodesTable
+	WatchProgressesTable.ForeignKeys[0].RefTable = EpisodesTable
+	WatchProgressesTable.ForeignKeys[1].RefTable = UsersTable
+	WatchlistsTable.ForeignKeys[0].RefTable = UsersTable
+	WatchlistItemsTable.ForeignKeys[0].RefTable = SeasonsTable
+	WatchlistItemsTable.ForeignKeys[1].RefTable = SeriesTable
+	WatchlistItemsTable.ForeignKeys[2].RefTable = WatchlistsTable
 }
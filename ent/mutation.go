@@ -11,10 +11,21 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/clustlight/animatrix-api/ent/apikey"
+	"github.com/clustlight/animatrix-api/ent/audiotrack"
+	"github.com/clustlight/animatrix-api/ent/chapter"
 	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/favorite"
 	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/rating"
+	"github.com/clustlight/animatrix-api/ent/rendition"
 	"github.com/clustlight/animatrix-api/ent/season"
 	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/subtitle"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/ent/watchlist"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
+	"github.com/clustlight/animatrix-api/ent/watchprogress"
 )
 
 const (
@@ -26,52 +37,55 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeEpisode = "Episode"
-	TypeSeason  = "Season"
-	TypeSeries  = "Series"
+	TypeAPIKey        = "APIKey"
+	TypeAudioTrack    = "AudioTrack"
+	TypeChapter       = "Chapter"
+	TypeEpisode       = "Episode"
+	TypeFavorite      = "Favorite"
+	TypeRating        = "Rating"
+	TypeRendition     = "Rendition"
+	TypeSeason        = "Season"
+	TypeSeries        = "Series"
+	TypeSubtitle      = "Subtitle"
+	TypeUser          = "User"
+	TypeWatchProgress = "WatchProgress"
+	TypeWatchlist     = "Watchlist"
+	TypeWatchlistItem = "WatchlistItem"
 )
 
-// EpisodeMutation represents an operation that mutates the Episode nodes in the graph.
-type EpisodeMutation struct {
+// APIKeyMutation represents an operation that mutates the APIKey nodes in the graph.
+type APIKeyMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	episode_id        *string
-	title             *string
-	description       *string
-	episode_number    *int
-	addepisode_number *int
-	duration          *float64
-	addduration       *float64
-	duration_string   *string
-	timestamp         *time.Time
-	format_id         *string
-	width             *int
-	addwidth          *int
-	height            *int
-	addheight         *int
-	dynamic_range     *string
-	metadata          *string
-	clearedFields     map[string]struct{}
-	season            *int
-	clearedseason     bool
-	done              bool
-	oldValue          func(context.Context) (*Episode, error)
-	predicates        []predicate.Episode
-}
-
-var _ ent.Mutation = (*EpisodeMutation)(nil)
-
-// episodeOption allows management of the mutation configuration using functional options.
-type episodeOption func(*EpisodeMutation)
-
-// newEpisodeMutation creates new mutation for the Episode entity.
-func newEpisodeMutation(c config, op Op, opts ...episodeOption) *EpisodeMutation {
-	m := &EpisodeMutation{
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	name          *string
+	prefix        *string
+	hash          *string
+	scopes        *[]string
+	appendscopes  []string
+	last_used_at  *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*APIKey, error)
+	predicates    []predicate.APIKey
+}
+
+var _ ent.Mutation = (*APIKeyMutation)(nil)
+
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*APIKeyMutation)
+
+// newAPIKeyMutation creates new mutation for the APIKey entity.
+func newAPIKeyMutation(c config, op Op, opts ...apikeyOption) *APIKeyMutation {
+	m := &APIKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEpisode,
+		typ:           TypeAPIKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -80,20 +94,20 @@ func newEpisodeMutation(c config, op Op, opts ...episodeOption) *EpisodeMutation
 	return m
 }
 
-// withEpisodeID sets the ID field of the mutation.
-func withEpisodeID(id int) episodeOption {
-	return func(m *EpisodeMutation) {
+// withAPIKeyID sets the ID field of the mutation.
+func withAPIKeyID(id int) apikeyOption {
+	return func(m *APIKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Episode
+			value *APIKey
 		)
-		m.oldValue = func(ctx context.Context) (*Episode, error) {
+		m.oldValue = func(ctx context.Context) (*APIKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Episode.Get(ctx, id)
+					value, err = m.Client().APIKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -102,10 +116,10 @@ func withEpisodeID(id int) episodeOption {
 	}
 }
 
-// withEpisode sets the old Episode of the mutation.
-func withEpisode(node *Episode) episodeOption {
-	return func(m *EpisodeMutation) {
-		m.oldValue = func(context.Context) (*Episode, error) {
+// withAPIKey sets the old APIKey of the mutation.
+func withAPIKey(node *APIKey) apikeyOption {
+	return func(m *APIKeyMutation) {
+		m.oldValue = func(context.Context) (*APIKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -114,7 +128,7 @@ func withEpisode(node *Episode) episodeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EpisodeMutation) Client() *Client {
+func (m APIKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -122,7 +136,7 @@ func (m EpisodeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EpisodeMutation) Tx() (*Tx, error) {
+func (m APIKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -133,7 +147,7 @@ func (m EpisodeMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EpisodeMutation) ID() (id int, exists bool) {
+func (m *APIKeyMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -144,7 +158,7 @@ func (m *EpisodeMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EpisodeMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *APIKeyMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -153,1072 +167,10684 @@ func (m *EpisodeMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Episode.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEpisodeID sets the "episode_id" field.
-func (m *EpisodeMutation) SetEpisodeID(s string) {
-	m.episode_id = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *APIKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// EpisodeID returns the value of the "episode_id" field in the mutation.
-func (m *EpisodeMutation) EpisodeID() (r string, exists bool) {
-	v := m.episode_id
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *APIKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEpisodeID returns the old "episode_id" field's value of the Episode entity.
-// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EpisodeMutation) OldEpisodeID(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEpisodeID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEpisodeID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEpisodeID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.EpisodeID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetEpisodeID resets all changes to the "episode_id" field.
-func (m *EpisodeMutation) ResetEpisodeID() {
-	m.episode_id = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *APIKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *EpisodeMutation) SetTitle(s string) {
-	m.title = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *APIKeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *EpisodeMutation) Title() (r string, exists bool) {
-	v := m.title
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *APIKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Episode entity.
-// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EpisodeMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *EpisodeMutation) ResetTitle() {
-	m.title = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *APIKeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *EpisodeMutation) SetDescription(s string) {
-	m.description = &s
+// SetName sets the "name" field.
+func (m *APIKeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *EpisodeMutation) Description() (r string, exists bool) {
-	v := m.description
+// Name returns the value of the "name" field in the mutation.
+func (m *APIKeyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Episode entity.
-// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EpisodeMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *EpisodeMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[episode.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *EpisodeMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[episode.FieldDescription]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *EpisodeMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, episode.FieldDescription)
+// ResetName resets all changes to the "name" field.
+func (m *APIKeyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetEpisodeNumber sets the "episode_number" field.
-func (m *EpisodeMutation) SetEpisodeNumber(i int) {
-	m.episode_number = &i
-	m.addepisode_number = nil
+// SetPrefix sets the "prefix" field.
+func (m *APIKeyMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// EpisodeNumber returns the value of the "episode_number" field in the mutation.
-func (m *EpisodeMutation) EpisodeNumber() (r int, exists bool) {
-	v := m.episode_number
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *APIKeyMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEpisodeNumber returns the old "episode_number" field's value of the Episode entity.
-// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EpisodeMutation) OldEpisodeNumber(ctx context.Context) (v int, err error) {
+func (m *APIKeyMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEpisodeNumber is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEpisodeNumber requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEpisodeNumber: %w", err)
-	}
-	return oldValue.EpisodeNumber, nil
-}
-
-// AddEpisodeNumber adds i to the "episode_number" field.
-func (m *EpisodeMutation) AddEpisodeNumber(i int) {
-	if m.addepisode_number != nil {
-		*m.addepisode_number += i
-	} else {
-		m.addepisode_number = &i
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
+	return oldValue.Prefix, nil
 }
 
-// AddedEpisodeNumber returns the value that was added to the "episode_number" field in this mutation.
-func (m *EpisodeMutation) AddedEpisodeNumber() (r int, exists bool) {
-	v := m.addepisode_number
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetEpisodeNumber resets all changes to the "episode_number" field.
-func (m *EpisodeMutation) ResetEpisodeNumber() {
-	m.episode_number = nil
-	m.addepisode_number = nil
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *APIKeyMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// SetDuration sets the "duration" field.
-func (m *EpisodeMutation) SetDuration(f float64) {
-	m.duration = &f
-	m.addduration = nil
+// SetHash sets the "hash" field.
+func (m *APIKeyMutation) SetHash(s string) {
+	m.hash = &s
 }
 
-// Duration returns the value of the "duration" field in the mutation.
-func (m *EpisodeMutation) Duration() (r float64, exists bool) {
-	v := m.duration
+// Hash returns the value of the "hash" field in the mutation.
+func (m *APIKeyMutation) Hash() (r string, exists bool) {
+	v := m.hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDuration returns the old "duration" field's value of the Episode entity.
-// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// OldHash returns the old "hash" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EpisodeMutation) OldDuration(ctx context.Context) (v float64, err error) {
+func (m *APIKeyMutation) OldHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDuration requires an ID field in the mutation")
+		return v, errors.New("OldHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
-	}
-	return oldValue.Duration, nil
-}
-
-// AddDuration adds f to the "duration" field.
-func (m *EpisodeMutation) AddDuration(f float64) {
-	if m.addduration != nil {
-		*m.addduration += f
-	} else {
-		m.addduration = &f
-	}
-}
-
-// AddedDuration returns the value that was added to the "duration" field in this mutation.
-func (m *EpisodeMutation) AddedDuration() (r float64, exists bool) {
-	v := m.addduration
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
 	}
-	return *v, true
+	return oldValue.Hash, nil
 }
 
-// ResetDuration resets all changes to the "duration" field.
-func (m *EpisodeMutation) ResetDuration() {
-	m.duration = nil
-	m.addduration = nil
+// ResetHash resets all changes to the "hash" field.
+func (m *APIKeyMutation) ResetHash() {
+	m.hash = nil
 }
 
-// SetDurationString sets the "duration_string" field.
-func (m *EpisodeMutation) SetDurationString(s string) {
-	m.duration_string = &s
+// SetScopes sets the "scopes" field.
+func (m *APIKeyMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
 }
 
-// DurationString returns the value of the "duration_string" field in the mutation.
-func (m *EpisodeMutation) DurationString() (r string, exists bool) {
-	v := m.duration_string
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *APIKeyMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDurationString returns the old "duration_string" field's value of the Episode entity.
-// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EpisodeMutation) OldDurationString(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDurationString is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDurationString requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDurationString: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.DurationString, nil
+	return oldValue.Scopes, nil
 }
 
-// ResetDurationString resets all changes to the "duration_string" field.
-func (m *EpisodeMutation) ResetDurationString() {
-	m.duration_string = nil
+// AppendScopes adds s to the "scopes" field.
+func (m *APIKeyMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *EpisodeMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *APIKeyMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
 }
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *EpisodeMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
+// ResetScopes resets all changes to the "scopes" field.
+func (m *APIKeyMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *APIKeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *APIKeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the Episode entity.
-// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EpisodeMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.Timestamp, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *EpisodeMutation) ResetTimestamp() {
-	m.timestamp = nil
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *APIKeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikey.FieldLastUsedAt] = struct{}{}
 }
 
-// SetFormatID sets the "format_id" field.
-func (m *EpisodeMutation) SetFormatID(s string) {
-	m.format_id = &s
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *APIKeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedAt]
+	return ok
 }
 
-// FormatID returns the value of the "format_id" field in the mutation.
-func (m *EpisodeMutation) FormatID() (r string, exists bool) {
-	v := m.format_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *APIKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikey.FieldLastUsedAt)
 }
 
-// OldFormatID returns the old "format_id" field's value of the Episode entity.
-// If the Episode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EpisodeMutation) OldFormatID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFormatID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFormatID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFormatID: %w", err)
-	}
-	return oldValue.FormatID, nil
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *APIKeyMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// ResetFormatID resets all changes to the "format_id" field.
-func (m *EpisodeMutation) ResetFormatID() {
-	m.format_id = nil
+// ClearUser clears the "user" edge to the User entity.
+func (m *APIKeyMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// SetWidth sets the "width" field.
-func (m *EpisodeMutation) SetWidth(i int) {
-	m.width = &i
-	m.addwidth = nil
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *APIKeyMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// Width returns the value of the "width" field in the mutation.
-func (m *EpisodeMutation) Width() (r int, exists bool) {
-	v := m.width
-	if v == nil {
-		return
+// UserID returns the "user" edge ID in the mutation.
+func (m *APIKeyMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	return *v, true
+	return
 }
 
-// OldWidth returns the old "width" field's value of the Episode entity.
-// If the Episode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EpisodeMutation) OldWidth(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWidth requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *APIKeyMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Width, nil
+	return
 }
 
-// AddWidth adds i to the "width" field.
-func (m *EpisodeMutation) AddWidth(i int) {
-	if m.addwidth != nil {
-		*m.addwidth += i
-	} else {
-		m.addwidth = &i
-	}
+// ResetUser resets all changes to the "user" edge.
+func (m *APIKeyMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// AddedWidth returns the value that was added to the "width" field in this mutation.
-func (m *EpisodeMutation) AddedWidth() (r int, exists bool) {
-	v := m.addwidth
-	if v == nil {
-		return
+// Where appends a list predicates to the APIKeyMutation builder.
+func (m *APIKeyMutation) Where(ps ...predicate.APIKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the APIKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *APIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetWidth resets all changes to the "width" field.
-func (m *EpisodeMutation) ResetWidth() {
-	m.width = nil
-	m.addwidth = nil
+// Op returns the operation name.
+func (m *APIKeyMutation) Op() Op {
+	return m.op
 }
 
-// SetHeight sets the "height" field.
-func (m *EpisodeMutation) SetHeight(i int) {
-	m.height = &i
-	m.addheight = nil
+// SetOp allows setting the mutation operation.
+func (m *APIKeyMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Height returns the value of the "height" field in the mutation.
-func (m *EpisodeMutation) Height() (r int, exists bool) {
-	v := m.height
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (APIKey).
+func (m *APIKeyMutation) Type() string {
+	return m.typ
 }
 
-// OldHeight returns the old "height" field's value of the Episode entity.
-// If the Episode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EpisodeMutation) OldHeight(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *APIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, apikey.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHeight requires an ID field in the mutation")
+	if m.updated_at != nil {
+		fields = append(fields, apikey.FieldUpdatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	if m.name != nil {
+		fields = append(fields, apikey.FieldName)
 	}
-	return oldValue.Height, nil
+	if m.prefix != nil {
+		fields = append(fields, apikey.FieldPrefix)
+	}
+	if m.hash != nil {
+		fields = append(fields, apikey.FieldHash)
+	}
+	if m.scopes != nil {
+		fields = append(fields, apikey.FieldScopes)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	return fields
 }
 
-// AddHeight adds i to the "height" field.
-func (m *EpisodeMutation) AddHeight(i int) {
-	if m.addheight != nil {
-		*m.addheight += i
-	} else {
-		m.addheight = &i
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *APIKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case apikey.FieldCreatedAt:
+		return m.CreatedAt()
+	case apikey.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case apikey.FieldName:
+		return m.Name()
+	case apikey.FieldPrefix:
+		return m.Prefix()
+	case apikey.FieldHash:
+		return m.Hash()
+	case apikey.FieldScopes:
+		return m.Scopes()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
 	}
+	return nil, false
 }
 
-// AddedHeight returns the value that was added to the "height" field in this mutation.
-func (m *EpisodeMutation) AddedHeight() (r int, exists bool) {
-	v := m.addheight
-	if v == nil {
-		return
-	}
-	return *v, true
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *APIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case apikey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case apikey.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case apikey.FieldName:
+		return m.OldName(ctx)
+	case apikey.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apikey.FieldHash:
+		return m.OldHash(ctx)
+	case apikey.FieldScopes:
+		return m.OldScopes(ctx)
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown APIKey field %s", name)
 }
 
-// ResetHeight resets all changes to the "height" field.
-func (m *EpisodeMutation) ResetHeight() {
-	m.height = nil
-	m.addheight = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case apikey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case apikey.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case apikey.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case apikey.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case apikey.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case apikey.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case apikey.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
-// SetDynamicRange sets the "dynamic_range" field.
-func (m *EpisodeMutation) SetDynamicRange(s string) {
-	m.dynamic_range = &s
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *APIKeyMutation) AddedFields() []string {
+	return nil
 }
 
-// DynamicRange returns the value of the "dynamic_range" field in the mutation.
-func (m *EpisodeMutation) DynamicRange() (r string, exists bool) {
-	v := m.dynamic_range
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *APIKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// OldDynamicRange returns the old "dynamic_range" field's value of the Episode entity.
-// If the Episode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EpisodeMutation) OldDynamicRange(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDynamicRange is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDynamicRange requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDynamicRange: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APIKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.DynamicRange, nil
+	return fmt.Errorf("unknown APIKey numeric field %s", name)
 }
 
-// ResetDynamicRange resets all changes to the "dynamic_range" field.
-func (m *EpisodeMutation) ResetDynamicRange() {
-	m.dynamic_range = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *APIKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(apikey.FieldLastUsedAt) {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	return fields
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *EpisodeMutation) SetMetadata(s string) {
-	m.metadata = &s
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *APIKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *EpisodeMutation) Metadata() (r string, exists bool) {
-	v := m.metadata
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *APIKeyMutation) ClearField(name string) error {
+	switch name {
+	case apikey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown APIKey nullable field %s", name)
 }
 
-// OldMetadata returns the old "metadata" field's value of the Episode entity.
-// If the Episode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EpisodeMutation) OldMetadata(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *APIKeyMutation) ResetField(name string) error {
+	switch name {
+	case apikey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case apikey.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case apikey.FieldName:
+		m.ResetName()
+		return nil
+	case apikey.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case apikey.FieldHash:
+		m.ResetHash()
+		return nil
+	case apikey.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
 	}
-	return oldValue.Metadata, nil
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *EpisodeMutation) ResetMetadata() {
-	m.metadata = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *APIKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, apikey.EdgeUser)
+	}
+	return edges
 }
 
-// SetSeasonID sets the "season" edge to the Season entity by id.
-func (m *EpisodeMutation) SetSeasonID(id int) {
-	m.season = &id
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *APIKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case apikey.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// ClearSeason clears the "season" edge to the Season entity.
-func (m *EpisodeMutation) ClearSeason() {
-	m.clearedseason = true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *APIKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SeasonCleared reports if the "season" edge to the Season entity was cleared.
-func (m *EpisodeMutation) SeasonCleared() bool {
-	return m.clearedseason
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *APIKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SeasonID returns the "season" edge ID in the mutation.
-func (m *EpisodeMutation) SeasonID() (id int, exists bool) {
-	if m.season != nil {
-		return *m.season, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *APIKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, apikey.EdgeUser)
 	}
-	return
+	return edges
 }
 
-// SeasonIDs returns the "season" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SeasonID instead. It exists only for internal usage by the builders.
-func (m *EpisodeMutation) SeasonIDs() (ids []int) {
-	if id := m.season; id != nil {
-		ids = append(ids, *id)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *APIKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case apikey.EdgeUser:
+		return m.cleareduser
 	}
-	return
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *APIKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case apikey.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *APIKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case apikey.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey edge %s", name)
+}
+
+// AudioTrackMutation represents an operation that mutates the AudioTrack nodes in the graph.
+type AudioTrackMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	language       *string
+	format         *string
+	label          *string
+	is_default     *bool
+	_path          *string
+	clearedFields  map[string]struct{}
+	episode        *int
+	clearedepisode bool
+	done           bool
+	oldValue       func(context.Context) (*AudioTrack, error)
+	predicates     []predicate.AudioTrack
+}
+
+var _ ent.Mutation = (*AudioTrackMutation)(nil)
+
+// audiotrackOption allows management of the mutation configuration using functional options.
+type audiotrackOption func(*AudioTrackMutation)
+
+// newAudioTrackMutation creates new mutation for the AudioTrack entity.
+func newAudioTrackMutation(c config, op Op, opts ...audiotrackOption) *AudioTrackMutation {
+	m := &AudioTrackMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAudioTrack,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAudioTrackID sets the ID field of the mutation.
+func withAudioTrackID(id int) audiotrackOption {
+	return func(m *AudioTrackMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AudioTrack
+		)
+		m.oldValue = func(ctx context.Context) (*AudioTrack, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AudioTrack.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAudioTrack sets the old AudioTrack of the mutation.
+func withAudioTrack(node *AudioTrack) audiotrackOption {
+	return func(m *AudioTrackMutation) {
+		m.oldValue = func(context.Context) (*AudioTrack, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AudioTrackMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AudioTrackMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AudioTrackMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AudioTrackMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AudioTrack.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AudioTrackMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AudioTrackMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AudioTrack entity.
+// If the AudioTrack object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AudioTrackMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AudioTrackMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AudioTrackMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AudioTrackMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the AudioTrack entity.
+// If the AudioTrack object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AudioTrackMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *AudioTrackMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetLanguage sets the "language" field.
+func (m *AudioTrackMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *AudioTrackMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the AudioTrack entity.
+// If the AudioTrack object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AudioTrackMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *AudioTrackMutation) ResetLanguage() {
+	m.language = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *AudioTrackMutation) SetFormat(s string) {
+	m.format = &s
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *AudioTrackMutation) Format() (r string, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the AudioTrack entity.
+// If the AudioTrack object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AudioTrackMutation) OldFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *AudioTrackMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetLabel sets the "label" field.
+func (m *AudioTrackMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *AudioTrackMutation) Label() (r string, exists bool) {
+	v := m.label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabel returns the old "label" field's value of the AudioTrack entity.
+// If the AudioTrack object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AudioTrackMutation) OldLabel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ClearLabel clears the value of the "label" field.
+func (m *AudioTrackMutation) ClearLabel() {
+	m.label = nil
+	m.clearedFields[audiotrack.FieldLabel] = struct{}{}
+}
+
+// LabelCleared returns if the "label" field was cleared in this mutation.
+func (m *AudioTrackMutation) LabelCleared() bool {
+	_, ok := m.clearedFields[audiotrack.FieldLabel]
+	return ok
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *AudioTrackMutation) ResetLabel() {
+	m.label = nil
+	delete(m.clearedFields, audiotrack.FieldLabel)
+}
+
+// SetIsDefault sets the "is_default" field.
+func (m *AudioTrackMutation) SetIsDefault(b bool) {
+	m.is_default = &b
+}
+
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *AudioTrackMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDefault returns the old "is_default" field's value of the AudioTrack entity.
+// If the AudioTrack object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AudioTrackMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
+}
+
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *AudioTrackMutation) ResetIsDefault() {
+	m.is_default = nil
+}
+
+// SetPath sets the "path" field.
+func (m *AudioTrackMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *AudioTrackMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the AudioTrack entity.
+// If the AudioTrack object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AudioTrackMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *AudioTrackMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by id.
+func (m *AudioTrackMutation) SetEpisodeID(id int) {
+	m.episode = &id
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (m *AudioTrackMutation) ClearEpisode() {
+	m.clearedepisode = true
+}
+
+// EpisodeCleared reports if the "episode" edge to the Episode entity was cleared.
+func (m *AudioTrackMutation) EpisodeCleared() bool {
+	return m.clearedepisode
+}
+
+// EpisodeID returns the "episode" edge ID in the mutation.
+func (m *AudioTrackMutation) EpisodeID() (id int, exists bool) {
+	if m.episode != nil {
+		return *m.episode, true
+	}
+	return
+}
+
+// EpisodeIDs returns the "episode" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EpisodeID instead. It exists only for internal usage by the builders.
+func (m *AudioTrackMutation) EpisodeIDs() (ids []int) {
+	if id := m.episode; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEpisode resets all changes to the "episode" edge.
+func (m *AudioTrackMutation) ResetEpisode() {
+	m.episode = nil
+	m.clearedepisode = false
+}
+
+// Where appends a list predicates to the AudioTrackMutation builder.
+func (m *AudioTrackMutation) Where(ps ...predicate.AudioTrack) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AudioTrackMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AudioTrackMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AudioTrack, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AudioTrackMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AudioTrackMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AudioTrack).
+func (m *AudioTrackMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AudioTrackMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, audiotrack.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, audiotrack.FieldUpdatedAt)
+	}
+	if m.language != nil {
+		fields = append(fields, audiotrack.FieldLanguage)
+	}
+	if m.format != nil {
+		fields = append(fields, audiotrack.FieldFormat)
+	}
+	if m.label != nil {
+		fields = append(fields, audiotrack.FieldLabel)
+	}
+	if m.is_default != nil {
+		fields = append(fields, audiotrack.FieldIsDefault)
+	}
+	if m._path != nil {
+		fields = append(fields, audiotrack.FieldPath)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AudioTrackMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case audiotrack.FieldCreatedAt:
+		return m.CreatedAt()
+	case audiotrack.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case audiotrack.FieldLanguage:
+		return m.Language()
+	case audiotrack.FieldFormat:
+		return m.Format()
+	case audiotrack.FieldLabel:
+		return m.Label()
+	case audiotrack.FieldIsDefault:
+		return m.IsDefault()
+	case audiotrack.FieldPath:
+		return m.Path()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AudioTrackMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case audiotrack.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case audiotrack.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case audiotrack.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case audiotrack.FieldFormat:
+		return m.OldFormat(ctx)
+	case audiotrack.FieldLabel:
+		return m.OldLabel(ctx)
+	case audiotrack.FieldIsDefault:
+		return m.OldIsDefault(ctx)
+	case audiotrack.FieldPath:
+		return m.OldPath(ctx)
+	}
+	return nil, fmt.Errorf("unknown AudioTrack field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AudioTrackMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case audiotrack.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case audiotrack.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case audiotrack.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case audiotrack.FieldFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case audiotrack.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
+	case audiotrack.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
+		return nil
+	case audiotrack.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AudioTrack field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AudioTrackMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AudioTrackMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AudioTrackMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AudioTrack numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AudioTrackMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(audiotrack.FieldLabel) {
+		fields = append(fields, audiotrack.FieldLabel)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AudioTrackMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AudioTrackMutation) ClearField(name string) error {
+	switch name {
+	case audiotrack.FieldLabel:
+		m.ClearLabel()
+		return nil
+	}
+	return fmt.Errorf("unknown AudioTrack nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AudioTrackMutation) ResetField(name string) error {
+	switch name {
+	case audiotrack.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case audiotrack.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case audiotrack.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case audiotrack.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case audiotrack.FieldLabel:
+		m.ResetLabel()
+		return nil
+	case audiotrack.FieldIsDefault:
+		m.ResetIsDefault()
+		return nil
+	case audiotrack.FieldPath:
+		m.ResetPath()
+		return nil
+	}
+	return fmt.Errorf("unknown AudioTrack field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AudioTrackMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.episode != nil {
+		edges = append(edges, audiotrack.EdgeEpisode)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AudioTrackMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case audiotrack.EdgeEpisode:
+		if id := m.episode; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AudioTrackMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AudioTrackMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AudioTrackMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedepisode {
+		edges = append(edges, audiotrack.EdgeEpisode)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AudioTrackMutation) EdgeCleared(name string) bool {
+	switch name {
+	case audiotrack.EdgeEpisode:
+		return m.clearedepisode
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AudioTrackMutation) ClearEdge(name string) error {
+	switch name {
+	case audiotrack.EdgeEpisode:
+		m.ClearEpisode()
+		return nil
+	}
+	return fmt.Errorf("unknown AudioTrack unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AudioTrackMutation) ResetEdge(name string) error {
+	switch name {
+	case audiotrack.EdgeEpisode:
+		m.ResetEpisode()
+		return nil
+	}
+	return fmt.Errorf("unknown AudioTrack edge %s", name)
+}
+
+// ChapterMutation represents an operation that mutates the Chapter nodes in the graph.
+type ChapterMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	start          *float64
+	addstart       *float64
+	end            *float64
+	addend         *float64
+	kind           *string
+	title          *string
+	clearedFields  map[string]struct{}
+	episode        *int
+	clearedepisode bool
+	done           bool
+	oldValue       func(context.Context) (*Chapter, error)
+	predicates     []predicate.Chapter
+}
+
+var _ ent.Mutation = (*ChapterMutation)(nil)
+
+// chapterOption allows management of the mutation configuration using functional options.
+type chapterOption func(*ChapterMutation)
+
+// newChapterMutation creates new mutation for the Chapter entity.
+func newChapterMutation(c config, op Op, opts ...chapterOption) *ChapterMutation {
+	m := &ChapterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChapter,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChapterID sets the ID field of the mutation.
+func withChapterID(id int) chapterOption {
+	return func(m *ChapterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Chapter
+		)
+		m.oldValue = func(ctx context.Context) (*Chapter, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Chapter.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChapter sets the old Chapter of the mutation.
+func withChapter(node *Chapter) chapterOption {
+	return func(m *ChapterMutation) {
+		m.oldValue = func(context.Context) (*Chapter, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChapterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChapterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChapterMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChapterMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Chapter.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ChapterMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChapterMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Chapter entity.
+// If the Chapter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChapterMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChapterMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ChapterMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ChapterMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Chapter entity.
+// If the Chapter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChapterMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ChapterMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetStart sets the "start" field.
+func (m *ChapterMutation) SetStart(f float64) {
+	m.start = &f
+	m.addstart = nil
+}
+
+// Start returns the value of the "start" field in the mutation.
+func (m *ChapterMutation) Start() (r float64, exists bool) {
+	v := m.start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStart returns the old "start" field's value of the Chapter entity.
+// If the Chapter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChapterMutation) OldStart(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStart: %w", err)
+	}
+	return oldValue.Start, nil
+}
+
+// AddStart adds f to the "start" field.
+func (m *ChapterMutation) AddStart(f float64) {
+	if m.addstart != nil {
+		*m.addstart += f
+	} else {
+		m.addstart = &f
+	}
+}
+
+// AddedStart returns the value that was added to the "start" field in this mutation.
+func (m *ChapterMutation) AddedStart() (r float64, exists bool) {
+	v := m.addstart
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStart resets all changes to the "start" field.
+func (m *ChapterMutation) ResetStart() {
+	m.start = nil
+	m.addstart = nil
+}
+
+// SetEnd sets the "end" field.
+func (m *ChapterMutation) SetEnd(f float64) {
+	m.end = &f
+	m.addend = nil
+}
+
+// End returns the value of the "end" field in the mutation.
+func (m *ChapterMutation) End() (r float64, exists bool) {
+	v := m.end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnd returns the old "end" field's value of the Chapter entity.
+// If the Chapter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChapterMutation) OldEnd(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnd: %w", err)
+	}
+	return oldValue.End, nil
+}
+
+// AddEnd adds f to the "end" field.
+func (m *ChapterMutation) AddEnd(f float64) {
+	if m.addend != nil {
+		*m.addend += f
+	} else {
+		m.addend = &f
+	}
+}
+
+// AddedEnd returns the value that was added to the "end" field in this mutation.
+func (m *ChapterMutation) AddedEnd() (r float64, exists bool) {
+	v := m.addend
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEnd resets all changes to the "end" field.
+func (m *ChapterMutation) ResetEnd() {
+	m.end = nil
+	m.addend = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *ChapterMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ChapterMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Chapter entity.
+// If the Chapter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChapterMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *ChapterMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *ChapterMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ChapterMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Chapter entity.
+// If the Chapter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChapterMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *ChapterMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[chapter.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *ChapterMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[chapter.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ChapterMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, chapter.FieldTitle)
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by id.
+func (m *ChapterMutation) SetEpisodeID(id int) {
+	m.episode = &id
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (m *ChapterMutation) ClearEpisode() {
+	m.clearedepisode = true
+}
+
+// EpisodeCleared reports if the "episode" edge to the Episode entity was cleared.
+func (m *ChapterMutation) EpisodeCleared() bool {
+	return m.clearedepisode
+}
+
+// EpisodeID returns the "episode" edge ID in the mutation.
+func (m *ChapterMutation) EpisodeID() (id int, exists bool) {
+	if m.episode != nil {
+		return *m.episode, true
+	}
+	return
+}
+
+// EpisodeIDs returns the "episode" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EpisodeID instead. It exists only for internal usage by the builders.
+func (m *ChapterMutation) EpisodeIDs() (ids []int) {
+	if id := m.episode; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEpisode resets all changes to the "episode" edge.
+func (m *ChapterMutation) ResetEpisode() {
+	m.episode = nil
+	m.clearedepisode = false
+}
+
+// Where appends a list predicates to the ChapterMutation builder.
+func (m *ChapterMutation) Where(ps ...predicate.Chapter) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChapterMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChapterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Chapter, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChapterMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChapterMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Chapter).
+func (m *ChapterMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChapterMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, chapter.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, chapter.FieldUpdatedAt)
+	}
+	if m.start != nil {
+		fields = append(fields, chapter.FieldStart)
+	}
+	if m.end != nil {
+		fields = append(fields, chapter.FieldEnd)
+	}
+	if m.kind != nil {
+		fields = append(fields, chapter.FieldKind)
+	}
+	if m.title != nil {
+		fields = append(fields, chapter.FieldTitle)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChapterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case chapter.FieldCreatedAt:
+		return m.CreatedAt()
+	case chapter.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case chapter.FieldStart:
+		return m.Start()
+	case chapter.FieldEnd:
+		return m.End()
+	case chapter.FieldKind:
+		return m.Kind()
+	case chapter.FieldTitle:
+		return m.Title()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChapterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case chapter.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case chapter.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case chapter.FieldStart:
+		return m.OldStart(ctx)
+	case chapter.FieldEnd:
+		return m.OldEnd(ctx)
+	case chapter.FieldKind:
+		return m.OldKind(ctx)
+	case chapter.FieldTitle:
+		return m.OldTitle(ctx)
+	}
+	return nil, fmt.Errorf("unknown Chapter field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChapterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case chapter.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case chapter.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case chapter.FieldStart:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStart(v)
+		return nil
+	case chapter.FieldEnd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnd(v)
+		return nil
+	case chapter.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case chapter.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Chapter field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChapterMutation) AddedFields() []string {
+	var fields []string
+	if m.addstart != nil {
+		fields = append(fields, chapter.FieldStart)
+	}
+	if m.addend != nil {
+		fields = append(fields, chapter.FieldEnd)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChapterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chapter.FieldStart:
+		return m.AddedStart()
+	case chapter.FieldEnd:
+		return m.AddedEnd()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChapterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case chapter.FieldStart:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStart(v)
+		return nil
+	case chapter.FieldEnd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEnd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Chapter numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChapterMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(chapter.FieldTitle) {
+		fields = append(fields, chapter.FieldTitle)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChapterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChapterMutation) ClearField(name string) error {
+	switch name {
+	case chapter.FieldTitle:
+		m.ClearTitle()
+		return nil
+	}
+	return fmt.Errorf("unknown Chapter nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChapterMutation) ResetField(name string) error {
+	switch name {
+	case chapter.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case chapter.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case chapter.FieldStart:
+		m.ResetStart()
+		return nil
+	case chapter.FieldEnd:
+		m.ResetEnd()
+		return nil
+	case chapter.FieldKind:
+		m.ResetKind()
+		return nil
+	case chapter.FieldTitle:
+		m.ResetTitle()
+		return nil
+	}
+	return fmt.Errorf("unknown Chapter field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChapterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.episode != nil {
+		edges = append(edges, chapter.EdgeEpisode)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChapterMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case chapter.EdgeEpisode:
+		if id := m.episode; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChapterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChapterMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChapterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedepisode {
+		edges = append(edges, chapter.EdgeEpisode)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChapterMutation) EdgeCleared(name string) bool {
+	switch name {
+	case chapter.EdgeEpisode:
+		return m.clearedepisode
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChapterMutation) ClearEdge(name string) error {
+	switch name {
+	case chapter.EdgeEpisode:
+		m.ClearEpisode()
+		return nil
+	}
+	return fmt.Errorf("unknown Chapter unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChapterMutation) ResetEdge(name string) error {
+	switch name {
+	case chapter.EdgeEpisode:
+		m.ResetEpisode()
+		return nil
+	}
+	return fmt.Errorf("unknown Chapter edge %s", name)
+}
+
+// EpisodeMutation represents an operation that mutates the Episode nodes in the graph.
+type EpisodeMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	created_at            *time.Time
+	updated_at            *time.Time
+	episode_id            *string
+	title                 *string
+	description           *string
+	episode_number        *int
+	addepisode_number     *int
+	duration              *float64
+	addduration           *float64
+	duration_string       *string
+	timestamp             *time.Time
+	format_id             *string
+	width                 *int
+	addwidth              *int
+	height                *int
+	addheight             *int
+	dynamic_range         *string
+	metadata              *string
+	media                 *map[string]string
+	clearedFields         map[string]struct{}
+	season                *int
+	clearedseason         bool
+	renditions            map[int]struct{}
+	removedrenditions     map[int]struct{}
+	clearedrenditions     bool
+	subtitles             map[int]struct{}
+	removedsubtitles      map[int]struct{}
+	clearedsubtitles      bool
+	audio_tracks          map[int]struct{}
+	removedaudio_tracks   map[int]struct{}
+	clearedaudio_tracks   bool
+	chapters              map[int]struct{}
+	removedchapters       map[int]struct{}
+	clearedchapters       bool
+	watch_progress        map[int]struct{}
+	removedwatch_progress map[int]struct{}
+	clearedwatch_progress bool
+	done                  bool
+	oldValue              func(context.Context) (*Episode, error)
+	predicates            []predicate.Episode
+}
+
+var _ ent.Mutation = (*EpisodeMutation)(nil)
+
+// episodeOption allows management of the mutation configuration using functional options.
+type episodeOption func(*EpisodeMutation)
+
+// newEpisodeMutation creates new mutation for the Episode entity.
+func newEpisodeMutation(c config, op Op, opts ...episodeOption) *EpisodeMutation {
+	m := &EpisodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEpisode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEpisodeID sets the ID field of the mutation.
+func withEpisodeID(id int) episodeOption {
+	return func(m *EpisodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Episode
+		)
+		m.oldValue = func(ctx context.Context) (*Episode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Episode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEpisode sets the old Episode of the mutation.
+func withEpisode(node *Episode) episodeOption {
+	return func(m *EpisodeMutation) {
+		m.oldValue = func(context.Context) (*Episode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EpisodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EpisodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EpisodeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EpisodeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Episode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EpisodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EpisodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EpisodeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EpisodeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EpisodeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EpisodeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (m *EpisodeMutation) SetEpisodeID(s string) {
+	m.episode_id = &s
+}
+
+// EpisodeID returns the value of the "episode_id" field in the mutation.
+func (m *EpisodeMutation) EpisodeID() (r string, exists bool) {
+	v := m.episode_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEpisodeID returns the old "episode_id" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldEpisodeID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEpisodeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEpisodeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEpisodeID: %w", err)
+	}
+	return oldValue.EpisodeID, nil
+}
+
+// ResetEpisodeID resets all changes to the "episode_id" field.
+func (m *EpisodeMutation) ResetEpisodeID() {
+	m.episode_id = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *EpisodeMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *EpisodeMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *EpisodeMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *EpisodeMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *EpisodeMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *EpisodeMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[episode.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *EpisodeMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[episode.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *EpisodeMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, episode.FieldDescription)
+}
+
+// SetEpisodeNumber sets the "episode_number" field.
+func (m *EpisodeMutation) SetEpisodeNumber(i int) {
+	m.episode_number = &i
+	m.addepisode_number = nil
+}
+
+// EpisodeNumber returns the value of the "episode_number" field in the mutation.
+func (m *EpisodeMutation) EpisodeNumber() (r int, exists bool) {
+	v := m.episode_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEpisodeNumber returns the old "episode_number" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldEpisodeNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEpisodeNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEpisodeNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEpisodeNumber: %w", err)
+	}
+	return oldValue.EpisodeNumber, nil
+}
+
+// AddEpisodeNumber adds i to the "episode_number" field.
+func (m *EpisodeMutation) AddEpisodeNumber(i int) {
+	if m.addepisode_number != nil {
+		*m.addepisode_number += i
+	} else {
+		m.addepisode_number = &i
+	}
+}
+
+// AddedEpisodeNumber returns the value that was added to the "episode_number" field in this mutation.
+func (m *EpisodeMutation) AddedEpisodeNumber() (r int, exists bool) {
+	v := m.addepisode_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEpisodeNumber resets all changes to the "episode_number" field.
+func (m *EpisodeMutation) ResetEpisodeNumber() {
+	m.episode_number = nil
+	m.addepisode_number = nil
+}
+
+// SetDuration sets the "duration" field.
+func (m *EpisodeMutation) SetDuration(f float64) {
+	m.duration = &f
+	m.addduration = nil
+}
+
+// Duration returns the value of the "duration" field in the mutation.
+func (m *EpisodeMutation) Duration() (r float64, exists bool) {
+	v := m.duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDuration returns the old "duration" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldDuration(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	}
+	return oldValue.Duration, nil
+}
+
+// AddDuration adds f to the "duration" field.
+func (m *EpisodeMutation) AddDuration(f float64) {
+	if m.addduration != nil {
+		*m.addduration += f
+	} else {
+		m.addduration = &f
+	}
+}
+
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *EpisodeMutation) AddedDuration() (r float64, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDuration resets all changes to the "duration" field.
+func (m *EpisodeMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
+}
+
+// SetDurationString sets the "duration_string" field.
+func (m *EpisodeMutation) SetDurationString(s string) {
+	m.duration_string = &s
+}
+
+// DurationString returns the value of the "duration_string" field in the mutation.
+func (m *EpisodeMutation) DurationString() (r string, exists bool) {
+	v := m.duration_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationString returns the old "duration_string" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldDurationString(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationString is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationString requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationString: %w", err)
+	}
+	return oldValue.DurationString, nil
+}
+
+// ResetDurationString resets all changes to the "duration_string" field.
+func (m *EpisodeMutation) ResetDurationString() {
+	m.duration_string = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *EpisodeMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *EpisodeMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *EpisodeMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetFormatID sets the "format_id" field.
+func (m *EpisodeMutation) SetFormatID(s string) {
+	m.format_id = &s
+}
+
+// FormatID returns the value of the "format_id" field in the mutation.
+func (m *EpisodeMutation) FormatID() (r string, exists bool) {
+	v := m.format_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormatID returns the old "format_id" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldFormatID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormatID: %w", err)
+	}
+	return oldValue.FormatID, nil
+}
+
+// ResetFormatID resets all changes to the "format_id" field.
+func (m *EpisodeMutation) ResetFormatID() {
+	m.format_id = nil
+}
+
+// SetWidth sets the "width" field.
+func (m *EpisodeMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *EpisodeMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWidth returns the old "width" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldWidth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
+}
+
+// AddWidth adds i to the "width" field.
+func (m *EpisodeMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
+}
+
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *EpisodeMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *EpisodeMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+}
+
+// SetHeight sets the "height" field.
+func (m *EpisodeMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *EpisodeMutation) Height() (r int, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
+}
+
+// AddHeight adds i to the "height" field.
+func (m *EpisodeMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *EpisodeMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *EpisodeMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+}
+
+// SetDynamicRange sets the "dynamic_range" field.
+func (m *EpisodeMutation) SetDynamicRange(s string) {
+	m.dynamic_range = &s
+}
+
+// DynamicRange returns the value of the "dynamic_range" field in the mutation.
+func (m *EpisodeMutation) DynamicRange() (r string, exists bool) {
+	v := m.dynamic_range
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDynamicRange returns the old "dynamic_range" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldDynamicRange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDynamicRange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDynamicRange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDynamicRange: %w", err)
+	}
+	return oldValue.DynamicRange, nil
+}
+
+// ResetDynamicRange resets all changes to the "dynamic_range" field.
+func (m *EpisodeMutation) ResetDynamicRange() {
+	m.dynamic_range = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *EpisodeMutation) SetMetadata(s string) {
+	m.metadata = &s
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *EpisodeMutation) Metadata() (r string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldMetadata(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *EpisodeMutation) ResetMetadata() {
+	m.metadata = nil
+}
+
+// SetMedia sets the "media" field.
+func (m *EpisodeMutation) SetMedia(value map[string]string) {
+	m.media = &value
+}
+
+// Media returns the value of the "media" field in the mutation.
+func (m *EpisodeMutation) Media() (r map[string]string, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMedia returns the old "media" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldMedia(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMedia is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMedia requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMedia: %w", err)
+	}
+	return oldValue.Media, nil
+}
+
+// ClearMedia clears the value of the "media" field.
+func (m *EpisodeMutation) ClearMedia() {
+	m.media = nil
+	m.clearedFields[episode.FieldMedia] = struct{}{}
+}
+
+// MediaCleared returns if the "media" field was cleared in this mutation.
+func (m *EpisodeMutation) MediaCleared() bool {
+	_, ok := m.clearedFields[episode.FieldMedia]
+	return ok
+}
+
+// ResetMedia resets all changes to the "media" field.
+func (m *EpisodeMutation) ResetMedia() {
+	m.media = nil
+	delete(m.clearedFields, episode.FieldMedia)
+}
+
+// SetSeasonID sets the "season" edge to the Season entity by id.
+func (m *EpisodeMutation) SetSeasonID(id int) {
+	m.season = &id
+}
+
+// ClearSeason clears the "season" edge to the Season entity.
+func (m *EpisodeMutation) ClearSeason() {
+	m.clearedseason = true
+}
+
+// SeasonCleared reports if the "season" edge to the Season entity was cleared.
+func (m *EpisodeMutation) SeasonCleared() bool {
+	return m.clearedseason
+}
+
+// SeasonID returns the "season" edge ID in the mutation.
+func (m *EpisodeMutation) SeasonID() (id int, exists bool) {
+	if m.season != nil {
+		return *m.season, true
+	}
+	return
+}
+
+// SeasonIDs returns the "season" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SeasonID instead. It exists only for internal usage by the builders.
+func (m *EpisodeMutation) SeasonIDs() (ids []int) {
+	if id := m.season; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSeason resets all changes to the "season" edge.
+func (m *EpisodeMutation) ResetSeason() {
+	m.season = nil
+	m.clearedseason = false
+}
+
+// AddRenditionIDs adds the "renditions" edge to the Rendition entity by ids.
+func (m *EpisodeMutation) AddRenditionIDs(ids ...int) {
+	if m.renditions == nil {
+		m.renditions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.renditions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRenditions clears the "renditions" edge to the Rendition entity.
+func (m *EpisodeMutation) ClearRenditions() {
+	m.clearedrenditions = true
+}
+
+// RenditionsCleared reports if the "renditions" edge to the Rendition entity was cleared.
+func (m *EpisodeMutation) RenditionsCleared() bool {
+	return m.clearedrenditions
+}
+
+// RemoveRenditionIDs removes the "renditions" edge to the Rendition entity by IDs.
+func (m *EpisodeMutation) RemoveRenditionIDs(ids ...int) {
+	if m.removedrenditions == nil {
+		m.removedrenditions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.renditions, ids[i])
+		m.removedrenditions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRenditions returns the removed IDs of the "renditions" edge to the Rendition entity.
+func (m *EpisodeMutation) RemovedRenditionsIDs() (ids []int) {
+	for id := range m.removedrenditions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RenditionsIDs returns the "renditions" edge IDs in the mutation.
+func (m *EpisodeMutation) RenditionsIDs() (ids []int) {
+	for id := range m.renditions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRenditions resets all changes to the "renditions" edge.
+func (m *EpisodeMutation) ResetRenditions() {
+	m.renditions = nil
+	m.clearedrenditions = false
+	m.removedrenditions = nil
+}
+
+// AddSubtitleIDs adds the "subtitles" edge to the Subtitle entity by ids.
+func (m *EpisodeMutation) AddSubtitleIDs(ids ...int) {
+	if m.subtitles == nil {
+		m.subtitles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.subtitles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSubtitles clears the "subtitles" edge to the Subtitle entity.
+func (m *EpisodeMutation) ClearSubtitles() {
+	m.clearedsubtitles = true
+}
+
+// SubtitlesCleared reports if the "subtitles" edge to the Subtitle entity was cleared.
+func (m *EpisodeMutation) SubtitlesCleared() bool {
+	return m.clearedsubtitles
+}
+
+// RemoveSubtitleIDs removes the "subtitles" edge to the Subtitle entity by IDs.
+func (m *EpisodeMutation) RemoveSubtitleIDs(ids ...int) {
+	if m.removedsubtitles == nil {
+		m.removedsubtitles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.subtitles, ids[i])
+		m.removedsubtitles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSubtitles returns the removed IDs of the "subtitles" edge to the Subtitle entity.
+func (m *EpisodeMutation) RemovedSubtitlesIDs() (ids []int) {
+	for id := range m.removedsubtitles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SubtitlesIDs returns the "subtitles" edge IDs in the mutation.
+func (m *EpisodeMutation) SubtitlesIDs() (ids []int) {
+	for id := range m.subtitles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSubtitles resets all changes to the "subtitles" edge.
+func (m *EpisodeMutation) ResetSubtitles() {
+	m.subtitles = nil
+	m.clearedsubtitles = false
+	m.removedsubtitles = nil
+}
+
+// AddAudioTrackIDs adds the "audio_tracks" edge to the AudioTrack entity by ids.
+func (m *EpisodeMutation) AddAudioTrackIDs(ids ...int) {
+	if m.audio_tracks == nil {
+		m.audio_tracks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.audio_tracks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAudioTracks clears the "audio_tracks" edge to the AudioTrack entity.
+func (m *EpisodeMutation) ClearAudioTracks() {
+	m.clearedaudio_tracks = true
+}
+
+// AudioTracksCleared reports if the "audio_tracks" edge to the AudioTrack entity was cleared.
+func (m *EpisodeMutation) AudioTracksCleared() bool {
+	return m.clearedaudio_tracks
+}
+
+// RemoveAudioTrackIDs removes the "audio_tracks" edge to the AudioTrack entity by IDs.
+func (m *EpisodeMutation) RemoveAudioTrackIDs(ids ...int) {
+	if m.removedaudio_tracks == nil {
+		m.removedaudio_tracks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.audio_tracks, ids[i])
+		m.removedaudio_tracks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAudioTracks returns the removed IDs of the "audio_tracks" edge to the AudioTrack entity.
+func (m *EpisodeMutation) RemovedAudioTracksIDs() (ids []int) {
+	for id := range m.removedaudio_tracks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AudioTracksIDs returns the "audio_tracks" edge IDs in the mutation.
+func (m *EpisodeMutation) AudioTracksIDs() (ids []int) {
+	for id := range m.audio_tracks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAudioTracks resets all changes to the "audio_tracks" edge.
+func (m *EpisodeMutation) ResetAudioTracks() {
+	m.audio_tracks = nil
+	m.clearedaudio_tracks = false
+	m.removedaudio_tracks = nil
+}
+
+// AddChapterIDs adds the "chapters" edge to the Chapter entity by ids.
+func (m *EpisodeMutation) AddChapterIDs(ids ...int) {
+	if m.chapters == nil {
+		m.chapters = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.chapters[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChapters clears the "chapters" edge to the Chapter entity.
+func (m *EpisodeMutation) ClearChapters() {
+	m.clearedchapters = true
+}
+
+// ChaptersCleared reports if the "chapters" edge to the Chapter entity was cleared.
+func (m *EpisodeMutation) ChaptersCleared() bool {
+	return m.clearedchapters
+}
+
+// RemoveChapterIDs removes the "chapters" edge to the Chapter entity by IDs.
+func (m *EpisodeMutation) RemoveChapterIDs(ids ...int) {
+	if m.removedchapters == nil {
+		m.removedchapters = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.chapters, ids[i])
+		m.removedchapters[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChapters returns the removed IDs of the "chapters" edge to the Chapter entity.
+func (m *EpisodeMutation) RemovedChaptersIDs() (ids []int) {
+	for id := range m.removedchapters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChaptersIDs returns the "chapters" edge IDs in the mutation.
+func (m *EpisodeMutation) ChaptersIDs() (ids []int) {
+	for id := range m.chapters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChapters resets all changes to the "chapters" edge.
+func (m *EpisodeMutation) ResetChapters() {
+	m.chapters = nil
+	m.clearedchapters = false
+	m.removedchapters = nil
+}
+
+// AddWatchProgresIDs adds the "watch_progress" edge to the WatchProgress entity by ids.
+func (m *EpisodeMutation) AddWatchProgresIDs(ids ...int) {
+	if m.watch_progress == nil {
+		m.watch_progress = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.watch_progress[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWatchProgress clears the "watch_progress" edge to the WatchProgress entity.
+func (m *EpisodeMutation) ClearWatchProgress() {
+	m.clearedwatch_progress = true
+}
+
+// WatchProgressCleared reports if the "watch_progress" edge to the WatchProgress entity was cleared.
+func (m *EpisodeMutation) WatchProgressCleared() bool {
+	return m.clearedwatch_progress
+}
+
+// RemoveWatchProgresIDs removes the "watch_progress" edge to the WatchProgress entity by IDs.
+func (m *EpisodeMutation) RemoveWatchProgresIDs(ids ...int) {
+	if m.removedwatch_progress == nil {
+		m.removedwatch_progress = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.watch_progress, ids[i])
+		m.removedwatch_progress[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWatchProgress returns the removed IDs of the "watch_progress" edge to the WatchProgress entity.
+func (m *EpisodeMutation) RemovedWatchProgressIDs() (ids []int) {
+	for id := range m.removedwatch_progress {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WatchProgressIDs returns the "watch_progress" edge IDs in the mutation.
+func (m *EpisodeMutation) WatchProgressIDs() (ids []int) {
+	for id := range m.watch_progress {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWatchProgress resets all changes to the "watch_progress" edge.
+func (m *EpisodeMutation) ResetWatchProgress() {
+	m.watch_progress = nil
+	m.clearedwatch_progress = false
+	m.removedwatch_progress = nil
+}
+
+// Where appends a list predicates to the EpisodeMutation builder.
+func (m *EpisodeMutation) Where(ps ...predicate.Episode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EpisodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EpisodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Episode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EpisodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EpisodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Episode).
+func (m *EpisodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EpisodeMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.created_at != nil {
+		fields = append(fields, episode.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, episode.FieldUpdatedAt)
+	}
+	if m.episode_id != nil {
+		fields = append(fields, episode.FieldEpisodeID)
+	}
+	if m.title != nil {
+		fields = append(fields, episode.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, episode.FieldDescription)
+	}
+	if m.episode_number != nil {
+		fields = append(fields, episode.FieldEpisodeNumber)
+	}
+	if m.duration != nil {
+		fields = append(fields, episode.FieldDuration)
+	}
+	if m.duration_string != nil {
+		fields = append(fields, episode.FieldDurationString)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, episode.FieldTimestamp)
+	}
+	if m.format_id != nil {
+		fields = append(fields, episode.FieldFormatID)
+	}
+	if m.width != nil {
+		fields = append(fields, episode.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, episode.FieldHeight)
+	}
+	if m.dynamic_range != nil {
+		fields = append(fields, episode.FieldDynamicRange)
+	}
+	if m.metadata != nil {
+		fields = append(fields, episode.FieldMetadata)
+	}
+	if m.media != nil {
+		fields = append(fields, episode.FieldMedia)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EpisodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case episode.FieldCreatedAt:
+		return m.CreatedAt()
+	case episode.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case episode.FieldEpisodeID:
+		return m.EpisodeID()
+	case episode.FieldTitle:
+		return m.Title()
+	case episode.FieldDescription:
+		return m.Description()
+	case episode.FieldEpisodeNumber:
+		return m.EpisodeNumber()
+	case episode.FieldDuration:
+		return m.Duration()
+	case episode.FieldDurationString:
+		return m.DurationString()
+	case episode.FieldTimestamp:
+		return m.Timestamp()
+	case episode.FieldFormatID:
+		return m.FormatID()
+	case episode.FieldWidth:
+		return m.Width()
+	case episode.FieldHeight:
+		return m.Height()
+	case episode.FieldDynamicRange:
+		return m.DynamicRange()
+	case episode.FieldMetadata:
+		return m.Metadata()
+	case episode.FieldMedia:
+		return m.Media()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EpisodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case episode.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case episode.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case episode.FieldEpisodeID:
+		return m.OldEpisodeID(ctx)
+	case episode.FieldTitle:
+		return m.OldTitle(ctx)
+	case episode.FieldDescription:
+		return m.OldDescription(ctx)
+	case episode.FieldEpisodeNumber:
+		return m.OldEpisodeNumber(ctx)
+	case episode.FieldDuration:
+		return m.OldDuration(ctx)
+	case episode.FieldDurationString:
+		return m.OldDurationString(ctx)
+	case episode.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case episode.FieldFormatID:
+		return m.OldFormatID(ctx)
+	case episode.FieldWidth:
+		return m.OldWidth(ctx)
+	case episode.FieldHeight:
+		return m.OldHeight(ctx)
+	case episode.FieldDynamicRange:
+		return m.OldDynamicRange(ctx)
+	case episode.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case episode.FieldMedia:
+		return m.OldMedia(ctx)
+	}
+	return nil, fmt.Errorf("unknown Episode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EpisodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case episode.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case episode.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case episode.FieldEpisodeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEpisodeID(v)
+		return nil
+	case episode.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case episode.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case episode.FieldEpisodeNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEpisodeNumber(v)
+		return nil
+	case episode.FieldDuration:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
+	case episode.FieldDurationString:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationString(v)
+		return nil
+	case episode.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case episode.FieldFormatID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormatID(v)
+		return nil
+	case episode.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case episode.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case episode.FieldDynamicRange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDynamicRange(v)
+		return nil
+	case episode.FieldMetadata:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case episode.FieldMedia:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMedia(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Episode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EpisodeMutation) AddedFields() []string {
+	var fields []string
+	if m.addepisode_number != nil {
+		fields = append(fields, episode.FieldEpisodeNumber)
+	}
+	if m.addduration != nil {
+		fields = append(fields, episode.FieldDuration)
+	}
+	if m.addwidth != nil {
+		fields = append(fields, episode.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, episode.FieldHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EpisodeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case episode.FieldEpisodeNumber:
+		return m.AddedEpisodeNumber()
+	case episode.FieldDuration:
+		return m.AddedDuration()
+	case episode.FieldWidth:
+		return m.AddedWidth()
+	case episode.FieldHeight:
+		return m.AddedHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EpisodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case episode.FieldEpisodeNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEpisodeNumber(v)
+		return nil
+	case episode.FieldDuration:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuration(v)
+		return nil
+	case episode.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case episode.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Episode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EpisodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(episode.FieldDescription) {
+		fields = append(fields, episode.FieldDescription)
+	}
+	if m.FieldCleared(episode.FieldMedia) {
+		fields = append(fields, episode.FieldMedia)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EpisodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EpisodeMutation) ClearField(name string) error {
+	switch name {
+	case episode.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case episode.FieldMedia:
+		m.ClearMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown Episode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EpisodeMutation) ResetField(name string) error {
+	switch name {
+	case episode.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case episode.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case episode.FieldEpisodeID:
+		m.ResetEpisodeID()
+		return nil
+	case episode.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case episode.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case episode.FieldEpisodeNumber:
+		m.ResetEpisodeNumber()
+		return nil
+	case episode.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case episode.FieldDurationString:
+		m.ResetDurationString()
+		return nil
+	case episode.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case episode.FieldFormatID:
+		m.ResetFormatID()
+		return nil
+	case episode.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case episode.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case episode.FieldDynamicRange:
+		m.ResetDynamicRange()
+		return nil
+	case episode.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case episode.FieldMedia:
+		m.ResetMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown Episode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EpisodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.season != nil {
+		edges = append(edges, episode.EdgeSeason)
+	}
+	if m.renditions != nil {
+		edges = append(edges, episode.EdgeRenditions)
+	}
+	if m.subtitles != nil {
+		edges = append(edges, episode.EdgeSubtitles)
+	}
+	if m.audio_tracks != nil {
+		edges = append(edges, episode.EdgeAudioTracks)
+	}
+	if m.chapters != nil {
+		edges = append(edges, episode.EdgeChapters)
+	}
+	if m.watch_progress != nil {
+		edges = append(edges, episode.EdgeWatchProgress)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EpisodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case episode.EdgeSeason:
+		if id := m.season; id != nil {
+			return []ent.Value{*id}
+		}
+	case episode.EdgeRenditions:
+		ids := make([]ent.Value, 0, len(m.renditions))
+		for id := range m.renditions {
+			ids = append(ids, id)
+		}
+		return ids
+	case episode.EdgeSubtitles:
+		ids := make([]ent.Value, 0, len(m.subtitles))
+		for id := range m.subtitles {
+			ids = append(ids, id)
+		}
+		return ids
+	case episode.EdgeAudioTracks:
+		ids := make([]ent.Value, 0, len(m.audio_tracks))
+		for id := range m.audio_tracks {
+			ids = append(ids, id)
+		}
+		return ids
+	case episode.EdgeChapters:
+		ids := make([]ent.Value, 0, len(m.chapters))
+		for id := range m.chapters {
+			ids = append(ids, id)
+		}
+		return ids
+	case episode.EdgeWatchProgress:
+		ids := make([]ent.Value, 0, len(m.watch_progress))
+		for id := range m.watch_progress {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EpisodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedrenditions != nil {
+		edges = append(edges, episode.EdgeRenditions)
+	}
+	if m.removedsubtitles != nil {
+		edges = append(edges, episode.EdgeSubtitles)
+	}
+	if m.removedaudio_tracks != nil {
+		edges = append(edges, episode.EdgeAudioTracks)
+	}
+	if m.removedchapters != nil {
+		edges = append(edges, episode.EdgeChapters)
+	}
+	if m.removedwatch_progress != nil {
+		edges = append(edges, episode.EdgeWatchProgress)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EpisodeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case episode.EdgeRenditions:
+		ids := make([]ent.Value, 0, len(m.removedrenditions))
+		for id := range m.removedrenditions {
+			ids = append(ids, id)
+		}
+		return ids
+	case episode.EdgeSubtitles:
+		ids := make([]ent.Value, 0, len(m.removedsubtitles))
+		for id := range m.removedsubtitles {
+			ids = append(ids, id)
+		}
+		return ids
+	case episode.EdgeAudioTracks:
+		ids := make([]ent.Value, 0, len(m.removedaudio_tracks))
+		for id := range m.removedaudio_tracks {
+			ids = append(ids, id)
+		}
+		return ids
+	case episode.EdgeChapters:
+		ids := make([]ent.Value, 0, len(m.removedchapters))
+		for id := range m.removedchapters {
+			ids = append(ids, id)
+		}
+		return ids
+	case episode.EdgeWatchProgress:
+		ids := make([]ent.Value, 0, len(m.removedwatch_progress))
+		for id := range m.removedwatch_progress {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EpisodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedseason {
+		edges = append(edges, episode.EdgeSeason)
+	}
+	if m.clearedrenditions {
+		edges = append(edges, episode.EdgeRenditions)
+	}
+	if m.clearedsubtitles {
+		edges = append(edges, episode.EdgeSubtitles)
+	}
+	if m.clearedaudio_tracks {
+		edges = append(edges, episode.EdgeAudioTracks)
+	}
+	if m.clearedchapters {
+		edges = append(edges, episode.EdgeChapters)
+	}
+	if m.clearedwatch_progress {
+		edges = append(edges, episode.EdgeWatchProgress)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EpisodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case episode.EdgeSeason:
+		return m.clearedseason
+	case episode.EdgeRenditions:
+		return m.clearedrenditions
+	case episode.EdgeSubtitles:
+		return m.clearedsubtitles
+	case episode.EdgeAudioTracks:
+		return m.clearedaudio_tracks
+	case episode.EdgeChapters:
+		return m.clearedchapters
+	case episode.EdgeWatchProgress:
+		return m.clearedwatch_progress
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EpisodeMutation) ClearEdge(name string) error {
+	switch name {
+	case episode.EdgeSeason:
+		m.ClearSeason()
+		return nil
+	}
+	return fmt.Errorf("unknown Episode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EpisodeMutation) ResetEdge(name string) error {
+	switch name {
+	case episode.EdgeSeason:
+		m.ResetSeason()
+		return nil
+	case episode.EdgeRenditions:
+		m.ResetRenditions()
+		return nil
+	case episode.EdgeSubtitles:
+		m.ResetSubtitles()
+		return nil
+	case episode.EdgeAudioTracks:
+		m.ResetAudioTracks()
+		return nil
+	case episode.EdgeChapters:
+		m.ResetChapters()
+		return nil
+	case episode.EdgeWatchProgress:
+		m.ResetWatchProgress()
+		return nil
+	}
+	return fmt.Errorf("unknown Episode edge %s", name)
+}
+
+// FavoriteMutation represents an operation that mutates the Favorite nodes in the graph.
+type FavoriteMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	series        *int
+	clearedseries bool
+	season        *int
+	clearedseason bool
+	done          bool
+	oldValue      func(context.Context) (*Favorite, error)
+	predicates    []predicate.Favorite
+}
+
+var _ ent.Mutation = (*FavoriteMutation)(nil)
+
+// favoriteOption allows management of the mutation configuration using functional options.
+type favoriteOption func(*FavoriteMutation)
+
+// newFavoriteMutation creates new mutation for the Favorite entity.
+func newFavoriteMutation(c config, op Op, opts ...favoriteOption) *FavoriteMutation {
+	m := &FavoriteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFavorite,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFavoriteID sets the ID field of the mutation.
+func withFavoriteID(id int) favoriteOption {
+	return func(m *FavoriteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Favorite
+		)
+		m.oldValue = func(ctx context.Context) (*Favorite, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Favorite.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFavorite sets the old Favorite of the mutation.
+func withFavorite(node *Favorite) favoriteOption {
+	return func(m *FavoriteMutation) {
+		m.oldValue = func(context.Context) (*Favorite, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FavoriteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FavoriteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FavoriteMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FavoriteMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Favorite.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FavoriteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FavoriteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Favorite entity.
+// If the Favorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FavoriteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FavoriteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FavoriteMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FavoriteMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Favorite entity.
+// If the Favorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FavoriteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FavoriteMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *FavoriteMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *FavoriteMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *FavoriteMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *FavoriteMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *FavoriteMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *FavoriteMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetSeriesID sets the "series" edge to the Series entity by id.
+func (m *FavoriteMutation) SetSeriesID(id int) {
+	m.series = &id
+}
+
+// ClearSeries clears the "series" edge to the Series entity.
+func (m *FavoriteMutation) ClearSeries() {
+	m.clearedseries = true
+}
+
+// SeriesCleared reports if the "series" edge to the Series entity was cleared.
+func (m *FavoriteMutation) SeriesCleared() bool {
+	return m.clearedseries
+}
+
+// SeriesID returns the "series" edge ID in the mutation.
+func (m *FavoriteMutation) SeriesID() (id int, exists bool) {
+	if m.series != nil {
+		return *m.series, true
+	}
+	return
+}
+
+// SeriesIDs returns the "series" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SeriesID instead. It exists only for internal usage by the builders.
+func (m *FavoriteMutation) SeriesIDs() (ids []int) {
+	if id := m.series; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSeries resets all changes to the "series" edge.
+func (m *FavoriteMutation) ResetSeries() {
+	m.series = nil
+	m.clearedseries = false
+}
+
+// SetSeasonID sets the "season" edge to the Season entity by id.
+func (m *FavoriteMutation) SetSeasonID(id int) {
+	m.season = &id
+}
+
+// ClearSeason clears the "season" edge to the Season entity.
+func (m *FavoriteMutation) ClearSeason() {
+	m.clearedseason = true
+}
+
+// SeasonCleared reports if the "season" edge to the Season entity was cleared.
+func (m *FavoriteMutation) SeasonCleared() bool {
+	return m.clearedseason
+}
+
+// SeasonID returns the "season" edge ID in the mutation.
+func (m *FavoriteMutation) SeasonID() (id int, exists bool) {
+	if m.season != nil {
+		return *m.season, true
+	}
+	return
+}
+
+// SeasonIDs returns the "season" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SeasonID instead. It exists only for internal usage by the builders.
+func (m *FavoriteMutation) SeasonIDs() (ids []int) {
+	if id := m.season; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSeason resets all changes to the "season" edge.
+func (m *FavoriteMutation) ResetSeason() {
+	m.season = nil
+	m.clearedseason = false
+}
+
+// Where appends a list predicates to the FavoriteMutation builder.
+func (m *FavoriteMutation) Where(ps ...predicate.Favorite) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FavoriteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FavoriteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Favorite, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FavoriteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FavoriteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Favorite).
+func (m *FavoriteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FavoriteMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.created_at != nil {
+		fields = append(fields, favorite.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, favorite.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FavoriteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case favorite.FieldCreatedAt:
+		return m.CreatedAt()
+	case favorite.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FavoriteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case favorite.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case favorite.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Favorite field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FavoriteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case favorite.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case favorite.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Favorite field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FavoriteMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FavoriteMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FavoriteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Favorite numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FavoriteMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FavoriteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FavoriteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Favorite nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FavoriteMutation) ResetField(name string) error {
+	switch name {
+	case favorite.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case favorite.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Favorite field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FavoriteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.user != nil {
+		edges = append(edges, favorite.EdgeUser)
+	}
+	if m.series != nil {
+		edges = append(edges, favorite.EdgeSeries)
+	}
+	if m.season != nil {
+		edges = append(edges, favorite.EdgeSeason)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FavoriteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case favorite.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case favorite.EdgeSeries:
+		if id := m.series; id != nil {
+			return []ent.Value{*id}
+		}
+	case favorite.EdgeSeason:
+		if id := m.season; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FavoriteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FavoriteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FavoriteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareduser {
+		edges = append(edges, favorite.EdgeUser)
+	}
+	if m.clearedseries {
+		edges = append(edges, favorite.EdgeSeries)
+	}
+	if m.clearedseason {
+		edges = append(edges, favorite.EdgeSeason)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FavoriteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case favorite.EdgeUser:
+		return m.cleareduser
+	case favorite.EdgeSeries:
+		return m.clearedseries
+	case favorite.EdgeSeason:
+		return m.clearedseason
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FavoriteMutation) ClearEdge(name string) error {
+	switch name {
+	case favorite.EdgeUser:
+		m.ClearUser()
+		return nil
+	case favorite.EdgeSeries:
+		m.ClearSeries()
+		return nil
+	case favorite.EdgeSeason:
+		m.ClearSeason()
+		return nil
+	}
+	return fmt.Errorf("unknown Favorite unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FavoriteMutation) ResetEdge(name string) error {
+	switch name {
+	case favorite.EdgeUser:
+		m.ResetUser()
+		return nil
+	case favorite.EdgeSeries:
+		m.ResetSeries()
+		return nil
+	case favorite.EdgeSeason:
+		m.ResetSeason()
+		return nil
+	}
+	return fmt.Errorf("unknown Favorite edge %s", name)
+}
+
+// RatingMutation represents an operation that mutates the Rating nodes in the graph.
+type RatingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	score         *int
+	addscore      *int
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	series        *int
+	clearedseries bool
+	season        *int
+	clearedseason bool
+	done          bool
+	oldValue      func(context.Context) (*Rating, error)
+	predicates    []predicate.Rating
+}
+
+var _ ent.Mutation = (*RatingMutation)(nil)
+
+// ratingOption allows management of the mutation configuration using functional options.
+type ratingOption func(*RatingMutation)
+
+// newRatingMutation creates new mutation for the Rating entity.
+func newRatingMutation(c config, op Op, opts ...ratingOption) *RatingMutation {
+	m := &RatingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRating,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRatingID sets the ID field of the mutation.
+func withRatingID(id int) ratingOption {
+	return func(m *RatingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Rating
+		)
+		m.oldValue = func(ctx context.Context) (*Rating, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Rating.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRating sets the old Rating of the mutation.
+func withRating(node *Rating) ratingOption {
+	return func(m *RatingMutation) {
+		m.oldValue = func(context.Context) (*Rating, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RatingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RatingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RatingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RatingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Rating.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RatingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RatingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Rating entity.
+// If the Rating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RatingMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RatingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RatingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Rating entity.
+// If the Rating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RatingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetScore sets the "score" field.
+func (m *RatingMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *RatingMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the Rating entity.
+// If the Rating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingMutation) OldScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *RatingMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *RatingMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *RatingMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *RatingMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RatingMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RatingMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *RatingMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RatingMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RatingMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetSeriesID sets the "series" edge to the Series entity by id.
+func (m *RatingMutation) SetSeriesID(id int) {
+	m.series = &id
+}
+
+// ClearSeries clears the "series" edge to the Series entity.
+func (m *RatingMutation) ClearSeries() {
+	m.clearedseries = true
+}
+
+// SeriesCleared reports if the "series" edge to the Series entity was cleared.
+func (m *RatingMutation) SeriesCleared() bool {
+	return m.clearedseries
+}
+
+// SeriesID returns the "series" edge ID in the mutation.
+func (m *RatingMutation) SeriesID() (id int, exists bool) {
+	if m.series != nil {
+		return *m.series, true
+	}
+	return
+}
+
+// SeriesIDs returns the "series" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SeriesID instead. It exists only for internal usage by the builders.
+func (m *RatingMutation) SeriesIDs() (ids []int) {
+	if id := m.series; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSeries resets all changes to the "series" edge.
+func (m *RatingMutation) ResetSeries() {
+	m.series = nil
+	m.clearedseries = false
+}
+
+// SetSeasonID sets the "season" edge to the Season entity by id.
+func (m *RatingMutation) SetSeasonID(id int) {
+	m.season = &id
+}
+
+// ClearSeason clears the "season" edge to the Season entity.
+func (m *RatingMutation) ClearSeason() {
+	m.clearedseason = true
+}
+
+// SeasonCleared reports if the "season" edge to the Season entity was cleared.
+func (m *RatingMutation) SeasonCleared() bool {
+	return m.clearedseason
+}
+
+// SeasonID returns the "season" edge ID in the mutation.
+func (m *RatingMutation) SeasonID() (id int, exists bool) {
+	if m.season != nil {
+		return *m.season, true
+	}
+	return
+}
+
+// SeasonIDs returns the "season" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SeasonID instead. It exists only for internal usage by the builders.
+func (m *RatingMutation) SeasonIDs() (ids []int) {
+	if id := m.season; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
 // ResetSeason resets all changes to the "season" edge.
-func (m *EpisodeMutation) ResetSeason() {
+func (m *RatingMutation) ResetSeason() {
 	m.season = nil
 	m.clearedseason = false
 }
 
-// Where appends a list predicates to the EpisodeMutation builder.
-func (m *EpisodeMutation) Where(ps ...predicate.Episode) {
-	m.predicates = append(m.predicates, ps...)
+// Where appends a list predicates to the RatingMutation builder.
+func (m *RatingMutation) Where(ps ...predicate.Rating) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RatingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RatingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Rating, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RatingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RatingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Rating).
+func (m *RatingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RatingMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.created_at != nil {
+		fields = append(fields, rating.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, rating.FieldUpdatedAt)
+	}
+	if m.score != nil {
+		fields = append(fields, rating.FieldScore)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RatingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rating.FieldCreatedAt:
+		return m.CreatedAt()
+	case rating.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case rating.FieldScore:
+		return m.Score()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RatingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rating.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case rating.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case rating.FieldScore:
+		return m.OldScore(ctx)
+	}
+	return nil, fmt.Errorf("unknown Rating field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RatingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rating.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case rating.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case rating.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rating field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RatingMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, rating.FieldScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RatingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rating.FieldScore:
+		return m.AddedScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RatingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rating.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rating numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RatingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RatingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RatingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Rating nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RatingMutation) ResetField(name string) error {
+	switch name {
+	case rating.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case rating.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case rating.FieldScore:
+		m.ResetScore()
+		return nil
+	}
+	return fmt.Errorf("unknown Rating field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RatingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.user != nil {
+		edges = append(edges, rating.EdgeUser)
+	}
+	if m.series != nil {
+		edges = append(edges, rating.EdgeSeries)
+	}
+	if m.season != nil {
+		edges = append(edges, rating.EdgeSeason)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RatingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rating.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case rating.EdgeSeries:
+		if id := m.series; id != nil {
+			return []ent.Value{*id}
+		}
+	case rating.EdgeSeason:
+		if id := m.season; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RatingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RatingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RatingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareduser {
+		edges = append(edges, rating.EdgeUser)
+	}
+	if m.clearedseries {
+		edges = append(edges, rating.EdgeSeries)
+	}
+	if m.clearedseason {
+		edges = append(edges, rating.EdgeSeason)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RatingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rating.EdgeUser:
+		return m.cleareduser
+	case rating.EdgeSeries:
+		return m.clearedseries
+	case rating.EdgeSeason:
+		return m.clearedseason
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RatingMutation) ClearEdge(name string) error {
+	switch name {
+	case rating.EdgeUser:
+		m.ClearUser()
+		return nil
+	case rating.EdgeSeries:
+		m.ClearSeries()
+		return nil
+	case rating.EdgeSeason:
+		m.ClearSeason()
+		return nil
+	}
+	return fmt.Errorf("unknown Rating unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RatingMutation) ResetEdge(name string) error {
+	switch name {
+	case rating.EdgeUser:
+		m.ResetUser()
+		return nil
+	case rating.EdgeSeries:
+		m.ResetSeries()
+		return nil
+	case rating.EdgeSeason:
+		m.ResetSeason()
+		return nil
+	}
+	return fmt.Errorf("unknown Rating edge %s", name)
+}
+
+// RenditionMutation represents an operation that mutates the Rendition nodes in the graph.
+type RenditionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	name           *string
+	width          *int
+	addwidth       *int
+	height         *int
+	addheight      *int
+	codecs         *string
+	bitrate        *int
+	addbitrate     *int
+	frame_rate     *float64
+	addframe_rate  *float64
+	dynamic_range  *string
+	container      *string
+	_path          *string
+	clearedFields  map[string]struct{}
+	episode        *int
+	clearedepisode bool
+	done           bool
+	oldValue       func(context.Context) (*Rendition, error)
+	predicates     []predicate.Rendition
+}
+
+var _ ent.Mutation = (*RenditionMutation)(nil)
+
+// renditionOption allows management of the mutation configuration using functional options.
+type renditionOption func(*RenditionMutation)
+
+// newRenditionMutation creates new mutation for the Rendition entity.
+func newRenditionMutation(c config, op Op, opts ...renditionOption) *RenditionMutation {
+	m := &RenditionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRendition,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRenditionID sets the ID field of the mutation.
+func withRenditionID(id int) renditionOption {
+	return func(m *RenditionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Rendition
+		)
+		m.oldValue = func(ctx context.Context) (*Rendition, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Rendition.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRendition sets the old Rendition of the mutation.
+func withRendition(node *Rendition) renditionOption {
+	return func(m *RenditionMutation) {
+		m.oldValue = func(context.Context) (*Rendition, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RenditionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RenditionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RenditionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RenditionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Rendition.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RenditionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RenditionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RenditionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RenditionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RenditionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RenditionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *RenditionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RenditionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RenditionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetWidth sets the "width" field.
+func (m *RenditionMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *RenditionMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWidth returns the old "width" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldWidth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
+}
+
+// AddWidth adds i to the "width" field.
+func (m *RenditionMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
+}
+
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *RenditionMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *RenditionMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+}
+
+// SetHeight sets the "height" field.
+func (m *RenditionMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *RenditionMutation) Height() (r int, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
+}
+
+// AddHeight adds i to the "height" field.
+func (m *RenditionMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *RenditionMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *RenditionMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+}
+
+// SetCodecs sets the "codecs" field.
+func (m *RenditionMutation) SetCodecs(s string) {
+	m.codecs = &s
+}
+
+// Codecs returns the value of the "codecs" field in the mutation.
+func (m *RenditionMutation) Codecs() (r string, exists bool) {
+	v := m.codecs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodecs returns the old "codecs" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldCodecs(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodecs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodecs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodecs: %w", err)
+	}
+	return oldValue.Codecs, nil
+}
+
+// ResetCodecs resets all changes to the "codecs" field.
+func (m *RenditionMutation) ResetCodecs() {
+	m.codecs = nil
+}
+
+// SetBitrate sets the "bitrate" field.
+func (m *RenditionMutation) SetBitrate(i int) {
+	m.bitrate = &i
+	m.addbitrate = nil
+}
+
+// Bitrate returns the value of the "bitrate" field in the mutation.
+func (m *RenditionMutation) Bitrate() (r int, exists bool) {
+	v := m.bitrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBitrate returns the old "bitrate" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldBitrate(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBitrate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBitrate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBitrate: %w", err)
+	}
+	return oldValue.Bitrate, nil
+}
+
+// AddBitrate adds i to the "bitrate" field.
+func (m *RenditionMutation) AddBitrate(i int) {
+	if m.addbitrate != nil {
+		*m.addbitrate += i
+	} else {
+		m.addbitrate = &i
+	}
+}
+
+// AddedBitrate returns the value that was added to the "bitrate" field in this mutation.
+func (m *RenditionMutation) AddedBitrate() (r int, exists bool) {
+	v := m.addbitrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBitrate resets all changes to the "bitrate" field.
+func (m *RenditionMutation) ResetBitrate() {
+	m.bitrate = nil
+	m.addbitrate = nil
+}
+
+// SetFrameRate sets the "frame_rate" field.
+func (m *RenditionMutation) SetFrameRate(f float64) {
+	m.frame_rate = &f
+	m.addframe_rate = nil
+}
+
+// FrameRate returns the value of the "frame_rate" field in the mutation.
+func (m *RenditionMutation) FrameRate() (r float64, exists bool) {
+	v := m.frame_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrameRate returns the old "frame_rate" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldFrameRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrameRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrameRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrameRate: %w", err)
+	}
+	return oldValue.FrameRate, nil
+}
+
+// AddFrameRate adds f to the "frame_rate" field.
+func (m *RenditionMutation) AddFrameRate(f float64) {
+	if m.addframe_rate != nil {
+		*m.addframe_rate += f
+	} else {
+		m.addframe_rate = &f
+	}
+}
+
+// AddedFrameRate returns the value that was added to the "frame_rate" field in this mutation.
+func (m *RenditionMutation) AddedFrameRate() (r float64, exists bool) {
+	v := m.addframe_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFrameRate clears the value of the "frame_rate" field.
+func (m *RenditionMutation) ClearFrameRate() {
+	m.frame_rate = nil
+	m.addframe_rate = nil
+	m.clearedFields[rendition.FieldFrameRate] = struct{}{}
+}
+
+// FrameRateCleared returns if the "frame_rate" field was cleared in this mutation.
+func (m *RenditionMutation) FrameRateCleared() bool {
+	_, ok := m.clearedFields[rendition.FieldFrameRate]
+	return ok
+}
+
+// ResetFrameRate resets all changes to the "frame_rate" field.
+func (m *RenditionMutation) ResetFrameRate() {
+	m.frame_rate = nil
+	m.addframe_rate = nil
+	delete(m.clearedFields, rendition.FieldFrameRate)
+}
+
+// SetDynamicRange sets the "dynamic_range" field.
+func (m *RenditionMutation) SetDynamicRange(s string) {
+	m.dynamic_range = &s
+}
+
+// DynamicRange returns the value of the "dynamic_range" field in the mutation.
+func (m *RenditionMutation) DynamicRange() (r string, exists bool) {
+	v := m.dynamic_range
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDynamicRange returns the old "dynamic_range" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldDynamicRange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDynamicRange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDynamicRange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDynamicRange: %w", err)
+	}
+	return oldValue.DynamicRange, nil
+}
+
+// ResetDynamicRange resets all changes to the "dynamic_range" field.
+func (m *RenditionMutation) ResetDynamicRange() {
+	m.dynamic_range = nil
+}
+
+// SetContainer sets the "container" field.
+func (m *RenditionMutation) SetContainer(s string) {
+	m.container = &s
+}
+
+// Container returns the value of the "container" field in the mutation.
+func (m *RenditionMutation) Container() (r string, exists bool) {
+	v := m.container
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContainer returns the old "container" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldContainer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContainer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContainer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContainer: %w", err)
+	}
+	return oldValue.Container, nil
+}
+
+// ResetContainer resets all changes to the "container" field.
+func (m *RenditionMutation) ResetContainer() {
+	m.container = nil
+}
+
+// SetPath sets the "path" field.
+func (m *RenditionMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *RenditionMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *RenditionMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by id.
+func (m *RenditionMutation) SetEpisodeID(id int) {
+	m.episode = &id
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (m *RenditionMutation) ClearEpisode() {
+	m.clearedepisode = true
+}
+
+// EpisodeCleared reports if the "episode" edge to the Episode entity was cleared.
+func (m *RenditionMutation) EpisodeCleared() bool {
+	return m.clearedepisode
+}
+
+// EpisodeID returns the "episode" edge ID in the mutation.
+func (m *RenditionMutation) EpisodeID() (id int, exists bool) {
+	if m.episode != nil {
+		return *m.episode, true
+	}
+	return
+}
+
+// EpisodeIDs returns the "episode" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EpisodeID instead. It exists only for internal usage by the builders.
+func (m *RenditionMutation) EpisodeIDs() (ids []int) {
+	if id := m.episode; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEpisode resets all changes to the "episode" edge.
+func (m *RenditionMutation) ResetEpisode() {
+	m.episode = nil
+	m.clearedepisode = false
+}
+
+// Where appends a list predicates to the RenditionMutation builder.
+func (m *RenditionMutation) Where(ps ...predicate.Rendition) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RenditionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RenditionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Rendition, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RenditionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RenditionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Rendition).
+func (m *RenditionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RenditionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, rendition.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, rendition.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, rendition.FieldName)
+	}
+	if m.width != nil {
+		fields = append(fields, rendition.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, rendition.FieldHeight)
+	}
+	if m.codecs != nil {
+		fields = append(fields, rendition.FieldCodecs)
+	}
+	if m.bitrate != nil {
+		fields = append(fields, rendition.FieldBitrate)
+	}
+	if m.frame_rate != nil {
+		fields = append(fields, rendition.FieldFrameRate)
+	}
+	if m.dynamic_range != nil {
+		fields = append(fields, rendition.FieldDynamicRange)
+	}
+	if m.container != nil {
+		fields = append(fields, rendition.FieldContainer)
+	}
+	if m._path != nil {
+		fields = append(fields, rendition.FieldPath)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RenditionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rendition.FieldCreatedAt:
+		return m.CreatedAt()
+	case rendition.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case rendition.FieldName:
+		return m.Name()
+	case rendition.FieldWidth:
+		return m.Width()
+	case rendition.FieldHeight:
+		return m.Height()
+	case rendition.FieldCodecs:
+		return m.Codecs()
+	case rendition.FieldBitrate:
+		return m.Bitrate()
+	case rendition.FieldFrameRate:
+		return m.FrameRate()
+	case rendition.FieldDynamicRange:
+		return m.DynamicRange()
+	case rendition.FieldContainer:
+		return m.Container()
+	case rendition.FieldPath:
+		return m.Path()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RenditionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rendition.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case rendition.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case rendition.FieldName:
+		return m.OldName(ctx)
+	case rendition.FieldWidth:
+		return m.OldWidth(ctx)
+	case rendition.FieldHeight:
+		return m.OldHeight(ctx)
+	case rendition.FieldCodecs:
+		return m.OldCodecs(ctx)
+	case rendition.FieldBitrate:
+		return m.OldBitrate(ctx)
+	case rendition.FieldFrameRate:
+		return m.OldFrameRate(ctx)
+	case rendition.FieldDynamicRange:
+		return m.OldDynamicRange(ctx)
+	case rendition.FieldContainer:
+		return m.OldContainer(ctx)
+	case rendition.FieldPath:
+		return m.OldPath(ctx)
+	}
+	return nil, fmt.Errorf("unknown Rendition field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RenditionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rendition.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case rendition.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case rendition.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case rendition.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case rendition.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case rendition.FieldCodecs:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodecs(v)
+		return nil
+	case rendition.FieldBitrate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBitrate(v)
+		return nil
+	case rendition.FieldFrameRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrameRate(v)
+		return nil
+	case rendition.FieldDynamicRange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDynamicRange(v)
+		return nil
+	case rendition.FieldContainer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContainer(v)
+		return nil
+	case rendition.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rendition field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RenditionMutation) AddedFields() []string {
+	var fields []string
+	if m.addwidth != nil {
+		fields = append(fields, rendition.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, rendition.FieldHeight)
+	}
+	if m.addbitrate != nil {
+		fields = append(fields, rendition.FieldBitrate)
+	}
+	if m.addframe_rate != nil {
+		fields = append(fields, rendition.FieldFrameRate)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RenditionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rendition.FieldWidth:
+		return m.AddedWidth()
+	case rendition.FieldHeight:
+		return m.AddedHeight()
+	case rendition.FieldBitrate:
+		return m.AddedBitrate()
+	case rendition.FieldFrameRate:
+		return m.AddedFrameRate()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RenditionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rendition.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case rendition.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case rendition.FieldBitrate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBitrate(v)
+		return nil
+	case rendition.FieldFrameRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFrameRate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rendition numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RenditionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rendition.FieldFrameRate) {
+		fields = append(fields, rendition.FieldFrameRate)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RenditionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RenditionMutation) ClearField(name string) error {
+	switch name {
+	case rendition.FieldFrameRate:
+		m.ClearFrameRate()
+		return nil
+	}
+	return fmt.Errorf("unknown Rendition nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RenditionMutation) ResetField(name string) error {
+	switch name {
+	case rendition.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case rendition.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case rendition.FieldName:
+		m.ResetName()
+		return nil
+	case rendition.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case rendition.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case rendition.FieldCodecs:
+		m.ResetCodecs()
+		return nil
+	case rendition.FieldBitrate:
+		m.ResetBitrate()
+		return nil
+	case rendition.FieldFrameRate:
+		m.ResetFrameRate()
+		return nil
+	case rendition.FieldDynamicRange:
+		m.ResetDynamicRange()
+		return nil
+	case rendition.FieldContainer:
+		m.ResetContainer()
+		return nil
+	case rendition.FieldPath:
+		m.ResetPath()
+		return nil
+	}
+	return fmt.Errorf("unknown Rendition field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RenditionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.episode != nil {
+		edges = append(edges, rendition.EdgeEpisode)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RenditionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rendition.EdgeEpisode:
+		if id := m.episode; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RenditionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RenditionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RenditionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedepisode {
+		edges = append(edges, rendition.EdgeEpisode)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RenditionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rendition.EdgeEpisode:
+		return m.clearedepisode
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RenditionMutation) ClearEdge(name string) error {
+	switch name {
+	case rendition.EdgeEpisode:
+		m.ClearEpisode()
+		return nil
+	}
+	return fmt.Errorf("unknown Rendition unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RenditionMutation) ResetEdge(name string) error {
+	switch name {
+	case rendition.EdgeEpisode:
+		m.ResetEpisode()
+		return nil
+	}
+	return fmt.Errorf("unknown Rendition edge %s", name)
+}
+
+// SeasonMutation represents an operation that mutates the Season nodes in the graph.
+type SeasonMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	created_at             *time.Time
+	updated_at             *time.Time
+	season_id              *string
+	season_title           *string
+	season_title_yomi      *string
+	season_number          *int
+	addseason_number       *int
+	shoboi_tid             *int
+	addshoboi_tid          *int
+	description            *string
+	first_year             *int
+	addfirst_year          *int
+	first_month            *int
+	addfirst_month         *int
+	first_end_year         *int
+	addfirst_end_year      *int
+	first_end_month        *int
+	addfirst_end_month     *int
+	media                  *map[string]string
+	clearedFields          map[string]struct{}
+	series                 *int
+	clearedseries          bool
+	episodes               map[int]struct{}
+	removedepisodes        map[int]struct{}
+	clearedepisodes        bool
+	favorites              map[int]struct{}
+	removedfavorites       map[int]struct{}
+	clearedfavorites       bool
+	ratings                map[int]struct{}
+	removedratings         map[int]struct{}
+	clearedratings         bool
+	watchlist_items        map[int]struct{}
+	removedwatchlist_items map[int]struct{}
+	clearedwatchlist_items bool
+	done                   bool
+	oldValue               func(context.Context) (*Season, error)
+	predicates             []predicate.Season
+}
+
+var _ ent.Mutation = (*SeasonMutation)(nil)
+
+// seasonOption allows management of the mutation configuration using functional options.
+type seasonOption func(*SeasonMutation)
+
+// newSeasonMutation creates new mutation for the Season entity.
+func newSeasonMutation(c config, op Op, opts ...seasonOption) *SeasonMutation {
+	m := &SeasonMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSeason,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSeasonID sets the ID field of the mutation.
+func withSeasonID(id int) seasonOption {
+	return func(m *SeasonMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Season
+		)
+		m.oldValue = func(ctx context.Context) (*Season, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Season.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSeason sets the old Season of the mutation.
+func withSeason(node *Season) seasonOption {
+	return func(m *SeasonMutation) {
+		m.oldValue = func(context.Context) (*Season, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SeasonMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SeasonMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SeasonMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SeasonMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Season.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SeasonMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SeasonMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SeasonMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SeasonMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SeasonMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SeasonMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetSeasonID sets the "season_id" field.
+func (m *SeasonMutation) SetSeasonID(s string) {
+	m.season_id = &s
+}
+
+// SeasonID returns the value of the "season_id" field in the mutation.
+func (m *SeasonMutation) SeasonID() (r string, exists bool) {
+	v := m.season_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeasonID returns the old "season_id" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldSeasonID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeasonID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeasonID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeasonID: %w", err)
+	}
+	return oldValue.SeasonID, nil
+}
+
+// ResetSeasonID resets all changes to the "season_id" field.
+func (m *SeasonMutation) ResetSeasonID() {
+	m.season_id = nil
+}
+
+// SetSeasonTitle sets the "season_title" field.
+func (m *SeasonMutation) SetSeasonTitle(s string) {
+	m.season_title = &s
+}
+
+// SeasonTitle returns the value of the "season_title" field in the mutation.
+func (m *SeasonMutation) SeasonTitle() (r string, exists bool) {
+	v := m.season_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeasonTitle returns the old "season_title" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldSeasonTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeasonTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeasonTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeasonTitle: %w", err)
+	}
+	return oldValue.SeasonTitle, nil
+}
+
+// ResetSeasonTitle resets all changes to the "season_title" field.
+func (m *SeasonMutation) ResetSeasonTitle() {
+	m.season_title = nil
+}
+
+// SetSeasonTitleYomi sets the "season_title_yomi" field.
+func (m *SeasonMutation) SetSeasonTitleYomi(s string) {
+	m.season_title_yomi = &s
+}
+
+// SeasonTitleYomi returns the value of the "season_title_yomi" field in the mutation.
+func (m *SeasonMutation) SeasonTitleYomi() (r string, exists bool) {
+	v := m.season_title_yomi
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeasonTitleYomi returns the old "season_title_yomi" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldSeasonTitleYomi(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeasonTitleYomi is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeasonTitleYomi requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeasonTitleYomi: %w", err)
+	}
+	return oldValue.SeasonTitleYomi, nil
+}
+
+// ClearSeasonTitleYomi clears the value of the "season_title_yomi" field.
+func (m *SeasonMutation) ClearSeasonTitleYomi() {
+	m.season_title_yomi = nil
+	m.clearedFields[season.FieldSeasonTitleYomi] = struct{}{}
+}
+
+// SeasonTitleYomiCleared returns if the "season_title_yomi" field was cleared in this mutation.
+func (m *SeasonMutation) SeasonTitleYomiCleared() bool {
+	_, ok := m.clearedFields[season.FieldSeasonTitleYomi]
+	return ok
+}
+
+// ResetSeasonTitleYomi resets all changes to the "season_title_yomi" field.
+func (m *SeasonMutation) ResetSeasonTitleYomi() {
+	m.season_title_yomi = nil
+	delete(m.clearedFields, season.FieldSeasonTitleYomi)
+}
+
+// SetSeasonNumber sets the "season_number" field.
+func (m *SeasonMutation) SetSeasonNumber(i int) {
+	m.season_number = &i
+	m.addseason_number = nil
+}
+
+// SeasonNumber returns the value of the "season_number" field in the mutation.
+func (m *SeasonMutation) SeasonNumber() (r int, exists bool) {
+	v := m.season_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeasonNumber returns the old "season_number" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldSeasonNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeasonNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeasonNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeasonNumber: %w", err)
+	}
+	return oldValue.SeasonNumber, nil
+}
+
+// AddSeasonNumber adds i to the "season_number" field.
+func (m *SeasonMutation) AddSeasonNumber(i int) {
+	if m.addseason_number != nil {
+		*m.addseason_number += i
+	} else {
+		m.addseason_number = &i
+	}
+}
+
+// AddedSeasonNumber returns the value that was added to the "season_number" field in this mutation.
+func (m *SeasonMutation) AddedSeasonNumber() (r int, exists bool) {
+	v := m.addseason_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeasonNumber resets all changes to the "season_number" field.
+func (m *SeasonMutation) ResetSeasonNumber() {
+	m.season_number = nil
+	m.addseason_number = nil
+}
+
+// SetShoboiTid sets the "shoboi_tid" field.
+func (m *SeasonMutation) SetShoboiTid(i int) {
+	m.shoboi_tid = &i
+	m.addshoboi_tid = nil
+}
+
+// ShoboiTid returns the value of the "shoboi_tid" field in the mutation.
+func (m *SeasonMutation) ShoboiTid() (r int, exists bool) {
+	v := m.shoboi_tid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShoboiTid returns the old "shoboi_tid" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldShoboiTid(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShoboiTid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShoboiTid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShoboiTid: %w", err)
+	}
+	return oldValue.ShoboiTid, nil
+}
+
+// AddShoboiTid adds i to the "shoboi_tid" field.
+func (m *SeasonMutation) AddShoboiTid(i int) {
+	if m.addshoboi_tid != nil {
+		*m.addshoboi_tid += i
+	} else {
+		m.addshoboi_tid = &i
+	}
+}
+
+// AddedShoboiTid returns the value that was added to the "shoboi_tid" field in this mutation.
+func (m *SeasonMutation) AddedShoboiTid() (r int, exists bool) {
+	v := m.addshoboi_tid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearShoboiTid clears the value of the "shoboi_tid" field.
+func (m *SeasonMutation) ClearShoboiTid() {
+	m.shoboi_tid = nil
+	m.addshoboi_tid = nil
+	m.clearedFields[season.FieldShoboiTid] = struct{}{}
+}
+
+// ShoboiTidCleared returns if the "shoboi_tid" field was cleared in this mutation.
+func (m *SeasonMutation) ShoboiTidCleared() bool {
+	_, ok := m.clearedFields[season.FieldShoboiTid]
+	return ok
+}
+
+// ResetShoboiTid resets all changes to the "shoboi_tid" field.
+func (m *SeasonMutation) ResetShoboiTid() {
+	m.shoboi_tid = nil
+	m.addshoboi_tid = nil
+	delete(m.clearedFields, season.FieldShoboiTid)
+}
+
+// SetDescription sets the "description" field.
+func (m *SeasonMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *SeasonMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *SeasonMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[season.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *SeasonMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[season.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *SeasonMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, season.FieldDescription)
+}
+
+// SetFirstYear sets the "first_year" field.
+func (m *SeasonMutation) SetFirstYear(i int) {
+	m.first_year = &i
+	m.addfirst_year = nil
+}
+
+// FirstYear returns the value of the "first_year" field in the mutation.
+func (m *SeasonMutation) FirstYear() (r int, exists bool) {
+	v := m.first_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstYear returns the old "first_year" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldFirstYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstYear: %w", err)
+	}
+	return oldValue.FirstYear, nil
+}
+
+// AddFirstYear adds i to the "first_year" field.
+func (m *SeasonMutation) AddFirstYear(i int) {
+	if m.addfirst_year != nil {
+		*m.addfirst_year += i
+	} else {
+		m.addfirst_year = &i
+	}
+}
+
+// AddedFirstYear returns the value that was added to the "first_year" field in this mutation.
+func (m *SeasonMutation) AddedFirstYear() (r int, exists bool) {
+	v := m.addfirst_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFirstYear clears the value of the "first_year" field.
+func (m *SeasonMutation) ClearFirstYear() {
+	m.first_year = nil
+	m.addfirst_year = nil
+	m.clearedFields[season.FieldFirstYear] = struct{}{}
+}
+
+// FirstYearCleared returns if the "first_year" field was cleared in this mutation.
+func (m *SeasonMutation) FirstYearCleared() bool {
+	_, ok := m.clearedFields[season.FieldFirstYear]
+	return ok
+}
+
+// ResetFirstYear resets all changes to the "first_year" field.
+func (m *SeasonMutation) ResetFirstYear() {
+	m.first_year = nil
+	m.addfirst_year = nil
+	delete(m.clearedFields, season.FieldFirstYear)
+}
+
+// SetFirstMonth sets the "first_month" field.
+func (m *SeasonMutation) SetFirstMonth(i int) {
+	m.first_month = &i
+	m.addfirst_month = nil
+}
+
+// FirstMonth returns the value of the "first_month" field in the mutation.
+func (m *SeasonMutation) FirstMonth() (r int, exists bool) {
+	v := m.first_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstMonth returns the old "first_month" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldFirstMonth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstMonth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstMonth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstMonth: %w", err)
+	}
+	return oldValue.FirstMonth, nil
+}
+
+// AddFirstMonth adds i to the "first_month" field.
+func (m *SeasonMutation) AddFirstMonth(i int) {
+	if m.addfirst_month != nil {
+		*m.addfirst_month += i
+	} else {
+		m.addfirst_month = &i
+	}
+}
+
+// AddedFirstMonth returns the value that was added to the "first_month" field in this mutation.
+func (m *SeasonMutation) AddedFirstMonth() (r int, exists bool) {
+	v := m.addfirst_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFirstMonth clears the value of the "first_month" field.
+func (m *SeasonMutation) ClearFirstMonth() {
+	m.first_month = nil
+	m.addfirst_month = nil
+	m.clearedFields[season.FieldFirstMonth] = struct{}{}
+}
+
+// FirstMonthCleared returns if the "first_month" field was cleared in this mutation.
+func (m *SeasonMutation) FirstMonthCleared() bool {
+	_, ok := m.clearedFields[season.FieldFirstMonth]
+	return ok
+}
+
+// ResetFirstMonth resets all changes to the "first_month" field.
+func (m *SeasonMutation) ResetFirstMonth() {
+	m.first_month = nil
+	m.addfirst_month = nil
+	delete(m.clearedFields, season.FieldFirstMonth)
+}
+
+// SetFirstEndYear sets the "first_end_year" field.
+func (m *SeasonMutation) SetFirstEndYear(i int) {
+	m.first_end_year = &i
+	m.addfirst_end_year = nil
+}
+
+// FirstEndYear returns the value of the "first_end_year" field in the mutation.
+func (m *SeasonMutation) FirstEndYear() (r int, exists bool) {
+	v := m.first_end_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstEndYear returns the old "first_end_year" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldFirstEndYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstEndYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstEndYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstEndYear: %w", err)
+	}
+	return oldValue.FirstEndYear, nil
+}
+
+// AddFirstEndYear adds i to the "first_end_year" field.
+func (m *SeasonMutation) AddFirstEndYear(i int) {
+	if m.addfirst_end_year != nil {
+		*m.addfirst_end_year += i
+	} else {
+		m.addfirst_end_year = &i
+	}
+}
+
+// AddedFirstEndYear returns the value that was added to the "first_end_year" field in this mutation.
+func (m *SeasonMutation) AddedFirstEndYear() (r int, exists bool) {
+	v := m.addfirst_end_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFirstEndYear clears the value of the "first_end_year" field.
+func (m *SeasonMutation) ClearFirstEndYear() {
+	m.first_end_year = nil
+	m.addfirst_end_year = nil
+	m.clearedFields[season.FieldFirstEndYear] = struct{}{}
+}
+
+// FirstEndYearCleared returns if the "first_end_year" field was cleared in this mutation.
+func (m *SeasonMutation) FirstEndYearCleared() bool {
+	_, ok := m.clearedFields[season.FieldFirstEndYear]
+	return ok
+}
+
+// ResetFirstEndYear resets all changes to the "first_end_year" field.
+func (m *SeasonMutation) ResetFirstEndYear() {
+	m.first_end_year = nil
+	m.addfirst_end_year = nil
+	delete(m.clearedFields, season.FieldFirstEndYear)
+}
+
+// SetFirstEndMonth sets the "first_end_month" field.
+func (m *SeasonMutation) SetFirstEndMonth(i int) {
+	m.first_end_month = &i
+	m.addfirst_end_month = nil
+}
+
+// FirstEndMonth returns the value of the "first_end_month" field in the mutation.
+func (m *SeasonMutation) FirstEndMonth() (r int, exists bool) {
+	v := m.first_end_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstEndMonth returns the old "first_end_month" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldFirstEndMonth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstEndMonth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstEndMonth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstEndMonth: %w", err)
+	}
+	return oldValue.FirstEndMonth, nil
+}
+
+// AddFirstEndMonth adds i to the "first_end_month" field.
+func (m *SeasonMutation) AddFirstEndMonth(i int) {
+	if m.addfirst_end_month != nil {
+		*m.addfirst_end_month += i
+	} else {
+		m.addfirst_end_month = &i
+	}
+}
+
+// AddedFirstEndMonth returns the value that was added to the "first_end_month" field in this mutation.
+func (m *SeasonMutation) AddedFirstEndMonth() (r int, exists bool) {
+	v := m.addfirst_end_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFirstEndMonth clears the value of the "first_end_month" field.
+func (m *SeasonMutation) ClearFirstEndMonth() {
+	m.first_end_month = nil
+	m.addfirst_end_month = nil
+	m.clearedFields[season.FieldFirstEndMonth] = struct{}{}
+}
+
+// FirstEndMonthCleared returns if the "first_end_month" field was cleared in this mutation.
+func (m *SeasonMutation) FirstEndMonthCleared() bool {
+	_, ok := m.clearedFields[season.FieldFirstEndMonth]
+	return ok
+}
+
+// ResetFirstEndMonth resets all changes to the "first_end_month" field.
+func (m *SeasonMutation) ResetFirstEndMonth() {
+	m.first_end_month = nil
+	m.addfirst_end_month = nil
+	delete(m.clearedFields, season.FieldFirstEndMonth)
+}
+
+// SetMedia sets the "media" field.
+func (m *SeasonMutation) SetMedia(value map[string]string) {
+	m.media = &value
+}
+
+// Media returns the value of the "media" field in the mutation.
+func (m *SeasonMutation) Media() (r map[string]string, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMedia returns the old "media" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldMedia(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMedia is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMedia requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMedia: %w", err)
+	}
+	return oldValue.Media, nil
+}
+
+// ClearMedia clears the value of the "media" field.
+func (m *SeasonMutation) ClearMedia() {
+	m.media = nil
+	m.clearedFields[season.FieldMedia] = struct{}{}
+}
+
+// MediaCleared returns if the "media" field was cleared in this mutation.
+func (m *SeasonMutation) MediaCleared() bool {
+	_, ok := m.clearedFields[season.FieldMedia]
+	return ok
+}
+
+// ResetMedia resets all changes to the "media" field.
+func (m *SeasonMutation) ResetMedia() {
+	m.media = nil
+	delete(m.clearedFields, season.FieldMedia)
+}
+
+// SetSeriesID sets the "series" edge to the Series entity by id.
+func (m *SeasonMutation) SetSeriesID(id int) {
+	m.series = &id
+}
+
+// ClearSeries clears the "series" edge to the Series entity.
+func (m *SeasonMutation) ClearSeries() {
+	m.clearedseries = true
+}
+
+// SeriesCleared reports if the "series" edge to the Series entity was cleared.
+func (m *SeasonMutation) SeriesCleared() bool {
+	return m.clearedseries
+}
+
+// SeriesID returns the "series" edge ID in the mutation.
+func (m *SeasonMutation) SeriesID() (id int, exists bool) {
+	if m.series != nil {
+		return *m.series, true
+	}
+	return
+}
+
+// SeriesIDs returns the "series" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SeriesID instead. It exists only for internal usage by the builders.
+func (m *SeasonMutation) SeriesIDs() (ids []int) {
+	if id := m.series; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSeries resets all changes to the "series" edge.
+func (m *SeasonMutation) ResetSeries() {
+	m.series = nil
+	m.clearedseries = false
+}
+
+// AddEpisodeIDs adds the "episodes" edge to the Episode entity by ids.
+func (m *SeasonMutation) AddEpisodeIDs(ids ...int) {
+	if m.episodes == nil {
+		m.episodes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.episodes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEpisodes clears the "episodes" edge to the Episode entity.
+func (m *SeasonMutation) ClearEpisodes() {
+	m.clearedepisodes = true
+}
+
+// EpisodesCleared reports if the "episodes" edge to the Episode entity was cleared.
+func (m *SeasonMutation) EpisodesCleared() bool {
+	return m.clearedepisodes
+}
+
+// RemoveEpisodeIDs removes the "episodes" edge to the Episode entity by IDs.
+func (m *SeasonMutation) RemoveEpisodeIDs(ids ...int) {
+	if m.removedepisodes == nil {
+		m.removedepisodes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.episodes, ids[i])
+		m.removedepisodes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEpisodes returns the removed IDs of the "episodes" edge to the Episode entity.
+func (m *SeasonMutation) RemovedEpisodesIDs() (ids []int) {
+	for id := range m.removedepisodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EpisodesIDs returns the "episodes" edge IDs in the mutation.
+func (m *SeasonMutation) EpisodesIDs() (ids []int) {
+	for id := range m.episodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEpisodes resets all changes to the "episodes" edge.
+func (m *SeasonMutation) ResetEpisodes() {
+	m.episodes = nil
+	m.clearedepisodes = false
+	m.removedepisodes = nil
+}
+
+// AddFavoriteIDs adds the "favorites" edge to the Favorite entity by ids.
+func (m *SeasonMutation) AddFavoriteIDs(ids ...int) {
+	if m.favorites == nil {
+		m.favorites = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.favorites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFavorites clears the "favorites" edge to the Favorite entity.
+func (m *SeasonMutation) ClearFavorites() {
+	m.clearedfavorites = true
+}
+
+// FavoritesCleared reports if the "favorites" edge to the Favorite entity was cleared.
+func (m *SeasonMutation) FavoritesCleared() bool {
+	return m.clearedfavorites
+}
+
+// RemoveFavoriteIDs removes the "favorites" edge to the Favorite entity by IDs.
+func (m *SeasonMutation) RemoveFavoriteIDs(ids ...int) {
+	if m.removedfavorites == nil {
+		m.removedfavorites = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.favorites, ids[i])
+		m.removedfavorites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFavorites returns the removed IDs of the "favorites" edge to the Favorite entity.
+func (m *SeasonMutation) RemovedFavoritesIDs() (ids []int) {
+	for id := range m.removedfavorites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FavoritesIDs returns the "favorites" edge IDs in the mutation.
+func (m *SeasonMutation) FavoritesIDs() (ids []int) {
+	for id := range m.favorites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFavorites resets all changes to the "favorites" edge.
+func (m *SeasonMutation) ResetFavorites() {
+	m.favorites = nil
+	m.clearedfavorites = false
+	m.removedfavorites = nil
+}
+
+// AddRatingIDs adds the "ratings" edge to the Rating entity by ids.
+func (m *SeasonMutation) AddRatingIDs(ids ...int) {
+	if m.ratings == nil {
+		m.ratings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.ratings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRatings clears the "ratings" edge to the Rating entity.
+func (m *SeasonMutation) ClearRatings() {
+	m.clearedratings = true
+}
+
+// RatingsCleared reports if the "ratings" edge to the Rating entity was cleared.
+func (m *SeasonMutation) RatingsCleared() bool {
+	return m.clearedratings
+}
+
+// RemoveRatingIDs removes the "ratings" edge to the Rating entity by IDs.
+func (m *SeasonMutation) RemoveRatingIDs(ids ...int) {
+	if m.removedratings == nil {
+		m.removedratings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.ratings, ids[i])
+		m.removedratings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRatings returns the removed IDs of the "ratings" edge to the Rating entity.
+func (m *SeasonMutation) RemovedRatingsIDs() (ids []int) {
+	for id := range m.removedratings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RatingsIDs returns the "ratings" edge IDs in the mutation.
+func (m *SeasonMutation) RatingsIDs() (ids []int) {
+	for id := range m.ratings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRatings resets all changes to the "ratings" edge.
+func (m *SeasonMutation) ResetRatings() {
+	m.ratings = nil
+	m.clearedratings = false
+	m.removedratings = nil
+}
+
+// AddWatchlistItemIDs adds the "watchlist_items" edge to the WatchlistItem entity by ids.
+func (m *SeasonMutation) AddWatchlistItemIDs(ids ...int) {
+	if m.watchlist_items == nil {
+		m.watchlist_items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.watchlist_items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWatchlistItems clears the "watchlist_items" edge to the WatchlistItem entity.
+func (m *SeasonMutation) ClearWatchlistItems() {
+	m.clearedwatchlist_items = true
+}
+
+// WatchlistItemsCleared reports if the "watchlist_items" edge to the WatchlistItem entity was cleared.
+func (m *SeasonMutation) WatchlistItemsCleared() bool {
+	return m.clearedwatchlist_items
+}
+
+// RemoveWatchlistItemIDs removes the "watchlist_items" edge to the WatchlistItem entity by IDs.
+func (m *SeasonMutation) RemoveWatchlistItemIDs(ids ...int) {
+	if m.removedwatchlist_items == nil {
+		m.removedwatchlist_items = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.watchlist_items, ids[i])
+		m.removedwatchlist_items[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWatchlistItems returns the removed IDs of the "watchlist_items" edge to the WatchlistItem entity.
+func (m *SeasonMutation) RemovedWatchlistItemsIDs() (ids []int) {
+	for id := range m.removedwatchlist_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WatchlistItemsIDs returns the "watchlist_items" edge IDs in the mutation.
+func (m *SeasonMutation) WatchlistItemsIDs() (ids []int) {
+	for id := range m.watchlist_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWatchlistItems resets all changes to the "watchlist_items" edge.
+func (m *SeasonMutation) ResetWatchlistItems() {
+	m.watchlist_items = nil
+	m.clearedwatchlist_items = false
+	m.removedwatchlist_items = nil
+}
+
+// Where appends a list predicates to the SeasonMutation builder.
+func (m *SeasonMutation) Where(ps ...predicate.Season) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SeasonMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SeasonMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Season, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SeasonMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SeasonMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Season).
+func (m *SeasonMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SeasonMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.created_at != nil {
+		fields = append(fields, season.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, season.FieldUpdatedAt)
+	}
+	if m.season_id != nil {
+		fields = append(fields, season.FieldSeasonID)
+	}
+	if m.season_title != nil {
+		fields = append(fields, season.FieldSeasonTitle)
+	}
+	if m.season_title_yomi != nil {
+		fields = append(fields, season.FieldSeasonTitleYomi)
+	}
+	if m.season_number != nil {
+		fields = append(fields, season.FieldSeasonNumber)
+	}
+	if m.shoboi_tid != nil {
+		fields = append(fields, season.FieldShoboiTid)
+	}
+	if m.description != nil {
+		fields = append(fields, season.FieldDescription)
+	}
+	if m.first_year != nil {
+		fields = append(fields, season.FieldFirstYear)
+	}
+	if m.first_month != nil {
+		fields = append(fields, season.FieldFirstMonth)
+	}
+	if m.first_end_year != nil {
+		fields = append(fields, season.FieldFirstEndYear)
+	}
+	if m.first_end_month != nil {
+		fields = append(fields, season.FieldFirstEndMonth)
+	}
+	if m.media != nil {
+		fields = append(fields, season.FieldMedia)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SeasonMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case season.FieldCreatedAt:
+		return m.CreatedAt()
+	case season.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case season.FieldSeasonID:
+		return m.SeasonID()
+	case season.FieldSeasonTitle:
+		return m.SeasonTitle()
+	case season.FieldSeasonTitleYomi:
+		return m.SeasonTitleYomi()
+	case season.FieldSeasonNumber:
+		return m.SeasonNumber()
+	case season.FieldShoboiTid:
+		return m.ShoboiTid()
+	case season.FieldDescription:
+		return m.Description()
+	case season.FieldFirstYear:
+		return m.FirstYear()
+	case season.FieldFirstMonth:
+		return m.FirstMonth()
+	case season.FieldFirstEndYear:
+		return m.FirstEndYear()
+	case season.FieldFirstEndMonth:
+		return m.FirstEndMonth()
+	case season.FieldMedia:
+		return m.Media()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SeasonMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case season.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case season.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case season.FieldSeasonID:
+		return m.OldSeasonID(ctx)
+	case season.FieldSeasonTitle:
+		return m.OldSeasonTitle(ctx)
+	case season.FieldSeasonTitleYomi:
+		return m.OldSeasonTitleYomi(ctx)
+	case season.FieldSeasonNumber:
+		return m.OldSeasonNumber(ctx)
+	case season.FieldShoboiTid:
+		return m.OldShoboiTid(ctx)
+	case season.FieldDescription:
+		return m.OldDescription(ctx)
+	case season.FieldFirstYear:
+		return m.OldFirstYear(ctx)
+	case season.FieldFirstMonth:
+		return m.OldFirstMonth(ctx)
+	case season.FieldFirstEndYear:
+		return m.OldFirstEndYear(ctx)
+	case season.FieldFirstEndMonth:
+		return m.OldFirstEndMonth(ctx)
+	case season.FieldMedia:
+		return m.OldMedia(ctx)
+	}
+	return nil, fmt.Errorf("unknown Season field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SeasonMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case season.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case season.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case season.FieldSeasonID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeasonID(v)
+		return nil
+	case season.FieldSeasonTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeasonTitle(v)
+		return nil
+	case season.FieldSeasonTitleYomi:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeasonTitleYomi(v)
+		return nil
+	case season.FieldSeasonNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeasonNumber(v)
+		return nil
+	case season.FieldShoboiTid:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShoboiTid(v)
+		return nil
+	case season.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case season.FieldFirstYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstYear(v)
+		return nil
+	case season.FieldFirstMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstMonth(v)
+		return nil
+	case season.FieldFirstEndYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstEndYear(v)
+		return nil
+	case season.FieldFirstEndMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstEndMonth(v)
+		return nil
+	case season.FieldMedia:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMedia(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Season field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SeasonMutation) AddedFields() []string {
+	var fields []string
+	if m.addseason_number != nil {
+		fields = append(fields, season.FieldSeasonNumber)
+	}
+	if m.addshoboi_tid != nil {
+		fields = append(fields, season.FieldShoboiTid)
+	}
+	if m.addfirst_year != nil {
+		fields = append(fields, season.FieldFirstYear)
+	}
+	if m.addfirst_month != nil {
+		fields = append(fields, season.FieldFirstMonth)
+	}
+	if m.addfirst_end_year != nil {
+		fields = append(fields, season.FieldFirstEndYear)
+	}
+	if m.addfirst_end_month != nil {
+		fields = append(fields, season.FieldFirstEndMonth)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SeasonMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case season.FieldSeasonNumber:
+		return m.AddedSeasonNumber()
+	case season.FieldShoboiTid:
+		return m.AddedShoboiTid()
+	case season.FieldFirstYear:
+		return m.AddedFirstYear()
+	case season.FieldFirstMonth:
+		return m.AddedFirstMonth()
+	case season.FieldFirstEndYear:
+		return m.AddedFirstEndYear()
+	case season.FieldFirstEndMonth:
+		return m.AddedFirstEndMonth()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SeasonMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case season.FieldSeasonNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeasonNumber(v)
+		return nil
+	case season.FieldShoboiTid:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddShoboiTid(v)
+		return nil
+	case season.FieldFirstYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFirstYear(v)
+		return nil
+	case season.FieldFirstMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFirstMonth(v)
+		return nil
+	case season.FieldFirstEndYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFirstEndYear(v)
+		return nil
+	case season.FieldFirstEndMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFirstEndMonth(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Season numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SeasonMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(season.FieldSeasonTitleYomi) {
+		fields = append(fields, season.FieldSeasonTitleYomi)
+	}
+	if m.FieldCleared(season.FieldShoboiTid) {
+		fields = append(fields, season.FieldShoboiTid)
+	}
+	if m.FieldCleared(season.FieldDescription) {
+		fields = append(fields, season.FieldDescription)
+	}
+	if m.FieldCleared(season.FieldFirstYear) {
+		fields = append(fields, season.FieldFirstYear)
+	}
+	if m.FieldCleared(season.FieldFirstMonth) {
+		fields = append(fields, season.FieldFirstMonth)
+	}
+	if m.FieldCleared(season.FieldFirstEndYear) {
+		fields = append(fields, season.FieldFirstEndYear)
+	}
+	if m.FieldCleared(season.FieldFirstEndMonth) {
+		fields = append(fields, season.FieldFirstEndMonth)
+	}
+	if m.FieldCleared(season.FieldMedia) {
+		fields = append(fields, season.FieldMedia)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SeasonMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SeasonMutation) ClearField(name string) error {
+	switch name {
+	case season.FieldSeasonTitleYomi:
+		m.ClearSeasonTitleYomi()
+		return nil
+	case season.FieldShoboiTid:
+		m.ClearShoboiTid()
+		return nil
+	case season.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case season.FieldFirstYear:
+		m.ClearFirstYear()
+		return nil
+	case season.FieldFirstMonth:
+		m.ClearFirstMonth()
+		return nil
+	case season.FieldFirstEndYear:
+		m.ClearFirstEndYear()
+		return nil
+	case season.FieldFirstEndMonth:
+		m.ClearFirstEndMonth()
+		return nil
+	case season.FieldMedia:
+		m.ClearMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown Season nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SeasonMutation) ResetField(name string) error {
+	switch name {
+	case season.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case season.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case season.FieldSeasonID:
+		m.ResetSeasonID()
+		return nil
+	case season.FieldSeasonTitle:
+		m.ResetSeasonTitle()
+		return nil
+	case season.FieldSeasonTitleYomi:
+		m.ResetSeasonTitleYomi()
+		return nil
+	case season.FieldSeasonNumber:
+		m.ResetSeasonNumber()
+		return nil
+	case season.FieldShoboiTid:
+		m.ResetShoboiTid()
+		return nil
+	case season.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case season.FieldFirstYear:
+		m.ResetFirstYear()
+		return nil
+	case season.FieldFirstMonth:
+		m.ResetFirstMonth()
+		return nil
+	case season.FieldFirstEndYear:
+		m.ResetFirstEndYear()
+		return nil
+	case season.FieldFirstEndMonth:
+		m.ResetFirstEndMonth()
+		return nil
+	case season.FieldMedia:
+		m.ResetMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown Season field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SeasonMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.series != nil {
+		edges = append(edges, season.EdgeSeries)
+	}
+	if m.episodes != nil {
+		edges = append(edges, season.EdgeEpisodes)
+	}
+	if m.favorites != nil {
+		edges = append(edges, season.EdgeFavorites)
+	}
+	if m.ratings != nil {
+		edges = append(edges, season.EdgeRatings)
+	}
+	if m.watchlist_items != nil {
+		edges = append(edges, season.EdgeWatchlistItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SeasonMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case season.EdgeSeries:
+		if id := m.series; id != nil {
+			return []ent.Value{*id}
+		}
+	case season.EdgeEpisodes:
+		ids := make([]ent.Value, 0, len(m.episodes))
+		for id := range m.episodes {
+			ids = append(ids, id)
+		}
+		return ids
+	case season.EdgeFavorites:
+		ids := make([]ent.Value, 0, len(m.favorites))
+		for id := range m.favorites {
+			ids = append(ids, id)
+		}
+		return ids
+	case season.EdgeRatings:
+		ids := make([]ent.Value, 0, len(m.ratings))
+		for id := range m.ratings {
+			ids = append(ids, id)
+		}
+		return ids
+	case season.EdgeWatchlistItems:
+		ids := make([]ent.Value, 0, len(m.watchlist_items))
+		for id := range m.watchlist_items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SeasonMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedepisodes != nil {
+		edges = append(edges, season.EdgeEpisodes)
+	}
+	if m.removedfavorites != nil {
+		edges = append(edges, season.EdgeFavorites)
+	}
+	if m.removedratings != nil {
+		edges = append(edges, season.EdgeRatings)
+	}
+	if m.removedwatchlist_items != nil {
+		edges = append(edges, season.EdgeWatchlistItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SeasonMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case season.EdgeEpisodes:
+		ids := make([]ent.Value, 0, len(m.removedepisodes))
+		for id := range m.removedepisodes {
+			ids = append(ids, id)
+		}
+		return ids
+	case season.EdgeFavorites:
+		ids := make([]ent.Value, 0, len(m.removedfavorites))
+		for id := range m.removedfavorites {
+			ids = append(ids, id)
+		}
+		return ids
+	case season.EdgeRatings:
+		ids := make([]ent.Value, 0, len(m.removedratings))
+		for id := range m.removedratings {
+			ids = append(ids, id)
+		}
+		return ids
+	case season.EdgeWatchlistItems:
+		ids := make([]ent.Value, 0, len(m.removedwatchlist_items))
+		for id := range m.removedwatchlist_items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SeasonMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedseries {
+		edges = append(edges, season.EdgeSeries)
+	}
+	if m.clearedepisodes {
+		edges = append(edges, season.EdgeEpisodes)
+	}
+	if m.clearedfavorites {
+		edges = append(edges, season.EdgeFavorites)
+	}
+	if m.clearedratings {
+		edges = append(edges, season.EdgeRatings)
+	}
+	if m.clearedwatchlist_items {
+		edges = append(edges, season.EdgeWatchlistItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SeasonMutation) EdgeCleared(name string) bool {
+	switch name {
+	case season.EdgeSeries:
+		return m.clearedseries
+	case season.EdgeEpisodes:
+		return m.clearedepisodes
+	case season.EdgeFavorites:
+		return m.clearedfavorites
+	case season.EdgeRatings:
+		return m.clearedratings
+	case season.EdgeWatchlistItems:
+		return m.clearedwatchlist_items
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SeasonMutation) ClearEdge(name string) error {
+	switch name {
+	case season.EdgeSeries:
+		m.ClearSeries()
+		return nil
+	}
+	return fmt.Errorf("unknown Season unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SeasonMutation) ResetEdge(name string) error {
+	switch name {
+	case season.EdgeSeries:
+		m.ResetSeries()
+		return nil
+	case season.EdgeEpisodes:
+		m.ResetEpisodes()
+		return nil
+	case season.EdgeFavorites:
+		m.ResetFavorites()
+		return nil
+	case season.EdgeRatings:
+		m.ResetRatings()
+		return nil
+	case season.EdgeWatchlistItems:
+		m.ResetWatchlistItems()
+		return nil
+	}
+	return fmt.Errorf("unknown Season edge %s", name)
+}
+
+// SeriesMutation represents an operation that mutates the Series nodes in the graph.
+type SeriesMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	created_at             *time.Time
+	updated_at             *time.Time
+	series_id              *string
+	title                  *string
+	title_yomi             *string
+	title_en               *string
+	description            *string
+	media                  *map[string]string
+	clearedFields          map[string]struct{}
+	seasons                map[int]struct{}
+	removedseasons         map[int]struct{}
+	clearedseasons         bool
+	favorites              map[int]struct{}
+	removedfavorites       map[int]struct{}
+	clearedfavorites       bool
+	ratings                map[int]struct{}
+	removedratings         map[int]struct{}
+	clearedratings         bool
+	watchlist_items        map[int]struct{}
+	removedwatchlist_items map[int]struct{}
+	clearedwatchlist_items bool
+	done                   bool
+	oldValue               func(context.Context) (*Series, error)
+	predicates             []predicate.Series
+}
+
+var _ ent.Mutation = (*SeriesMutation)(nil)
+
+// seriesOption allows management of the mutation configuration using functional options.
+type seriesOption func(*SeriesMutation)
+
+// newSeriesMutation creates new mutation for the Series entity.
+func newSeriesMutation(c config, op Op, opts ...seriesOption) *SeriesMutation {
+	m := &SeriesMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSeries,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSeriesID sets the ID field of the mutation.
+func withSeriesID(id int) seriesOption {
+	return func(m *SeriesMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Series
+		)
+		m.oldValue = func(ctx context.Context) (*Series, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Series.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSeries sets the old Series of the mutation.
+func withSeries(node *Series) seriesOption {
+	return func(m *SeriesMutation) {
+		m.oldValue = func(context.Context) (*Series, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SeriesMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SeriesMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SeriesMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SeriesMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Series.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SeriesMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SeriesMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SeriesMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SeriesMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SeriesMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SeriesMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetSeriesID sets the "series_id" field.
+func (m *SeriesMutation) SetSeriesID(s string) {
+	m.series_id = &s
+}
+
+// SeriesID returns the value of the "series_id" field in the mutation.
+func (m *SeriesMutation) SeriesID() (r string, exists bool) {
+	v := m.series_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeriesID returns the old "series_id" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldSeriesID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeriesID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeriesID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeriesID: %w", err)
+	}
+	return oldValue.SeriesID, nil
+}
+
+// ResetSeriesID resets all changes to the "series_id" field.
+func (m *SeriesMutation) ResetSeriesID() {
+	m.series_id = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *SeriesMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *SeriesMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *SeriesMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetTitleYomi sets the "title_yomi" field.
+func (m *SeriesMutation) SetTitleYomi(s string) {
+	m.title_yomi = &s
+}
+
+// TitleYomi returns the value of the "title_yomi" field in the mutation.
+func (m *SeriesMutation) TitleYomi() (r string, exists bool) {
+	v := m.title_yomi
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitleYomi returns the old "title_yomi" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldTitleYomi(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitleYomi is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitleYomi requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitleYomi: %w", err)
+	}
+	return oldValue.TitleYomi, nil
+}
+
+// ClearTitleYomi clears the value of the "title_yomi" field.
+func (m *SeriesMutation) ClearTitleYomi() {
+	m.title_yomi = nil
+	m.clearedFields[series.FieldTitleYomi] = struct{}{}
+}
+
+// TitleYomiCleared returns if the "title_yomi" field was cleared in this mutation.
+func (m *SeriesMutation) TitleYomiCleared() bool {
+	_, ok := m.clearedFields[series.FieldTitleYomi]
+	return ok
+}
+
+// ResetTitleYomi resets all changes to the "title_yomi" field.
+func (m *SeriesMutation) ResetTitleYomi() {
+	m.title_yomi = nil
+	delete(m.clearedFields, series.FieldTitleYomi)
+}
+
+// SetTitleEn sets the "title_en" field.
+func (m *SeriesMutation) SetTitleEn(s string) {
+	m.title_en = &s
+}
+
+// TitleEn returns the value of the "title_en" field in the mutation.
+func (m *SeriesMutation) TitleEn() (r string, exists bool) {
+	v := m.title_en
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitleEn returns the old "title_en" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldTitleEn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitleEn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitleEn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitleEn: %w", err)
+	}
+	return oldValue.TitleEn, nil
+}
+
+// ClearTitleEn clears the value of the "title_en" field.
+func (m *SeriesMutation) ClearTitleEn() {
+	m.title_en = nil
+	m.clearedFields[series.FieldTitleEn] = struct{}{}
+}
+
+// TitleEnCleared returns if the "title_en" field was cleared in this mutation.
+func (m *SeriesMutation) TitleEnCleared() bool {
+	_, ok := m.clearedFields[series.FieldTitleEn]
+	return ok
+}
+
+// ResetTitleEn resets all changes to the "title_en" field.
+func (m *SeriesMutation) ResetTitleEn() {
+	m.title_en = nil
+	delete(m.clearedFields, series.FieldTitleEn)
+}
+
+// SetDescription sets the "description" field.
+func (m *SeriesMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *SeriesMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *SeriesMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[series.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *SeriesMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[series.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *SeriesMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, series.FieldDescription)
+}
+
+// SetMedia sets the "media" field.
+func (m *SeriesMutation) SetMedia(value map[string]string) {
+	m.media = &value
+}
+
+// Media returns the value of the "media" field in the mutation.
+func (m *SeriesMutation) Media() (r map[string]string, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMedia returns the old "media" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldMedia(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMedia is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMedia requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMedia: %w", err)
+	}
+	return oldValue.Media, nil
+}
+
+// ClearMedia clears the value of the "media" field.
+func (m *SeriesMutation) ClearMedia() {
+	m.media = nil
+	m.clearedFields[series.FieldMedia] = struct{}{}
+}
+
+// MediaCleared returns if the "media" field was cleared in this mutation.
+func (m *SeriesMutation) MediaCleared() bool {
+	_, ok := m.clearedFields[series.FieldMedia]
+	return ok
+}
+
+// ResetMedia resets all changes to the "media" field.
+func (m *SeriesMutation) ResetMedia() {
+	m.media = nil
+	delete(m.clearedFields, series.FieldMedia)
+}
+
+// AddSeasonIDs adds the "seasons" edge to the Season entity by ids.
+func (m *SeriesMutation) AddSeasonIDs(ids ...int) {
+	if m.seasons == nil {
+		m.seasons = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.seasons[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSeasons clears the "seasons" edge to the Season entity.
+func (m *SeriesMutation) ClearSeasons() {
+	m.clearedseasons = true
+}
+
+// SeasonsCleared reports if the "seasons" edge to the Season entity was cleared.
+func (m *SeriesMutation) SeasonsCleared() bool {
+	return m.clearedseasons
+}
+
+// RemoveSeasonIDs removes the "seasons" edge to the Season entity by IDs.
+func (m *SeriesMutation) RemoveSeasonIDs(ids ...int) {
+	if m.removedseasons == nil {
+		m.removedseasons = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.seasons, ids[i])
+		m.removedseasons[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSeasons returns the removed IDs of the "seasons" edge to the Season entity.
+func (m *SeriesMutation) RemovedSeasonsIDs() (ids []int) {
+	for id := range m.removedseasons {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SeasonsIDs returns the "seasons" edge IDs in the mutation.
+func (m *SeriesMutation) SeasonsIDs() (ids []int) {
+	for id := range m.seasons {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSeasons resets all changes to the "seasons" edge.
+func (m *SeriesMutation) ResetSeasons() {
+	m.seasons = nil
+	m.clearedseasons = false
+	m.removedseasons = nil
+}
+
+// AddFavoriteIDs adds the "favorites" edge to the Favorite entity by ids.
+func (m *SeriesMutation) AddFavoriteIDs(ids ...int) {
+	if m.favorites == nil {
+		m.favorites = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.favorites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFavorites clears the "favorites" edge to the Favorite entity.
+func (m *SeriesMutation) ClearFavorites() {
+	m.clearedfavorites = true
+}
+
+// FavoritesCleared reports if the "favorites" edge to the Favorite entity was cleared.
+func (m *SeriesMutation) FavoritesCleared() bool {
+	return m.clearedfavorites
+}
+
+// RemoveFavoriteIDs removes the "favorites" edge to the Favorite entity by IDs.
+func (m *SeriesMutation) RemoveFavoriteIDs(ids ...int) {
+	if m.removedfavorites == nil {
+		m.removedfavorites = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.favorites, ids[i])
+		m.removedfavorites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFavorites returns the removed IDs of the "favorites" edge to the Favorite entity.
+func (m *SeriesMutation) RemovedFavoritesIDs() (ids []int) {
+	for id := range m.removedfavorites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FavoritesIDs returns the "favorites" edge IDs in the mutation.
+func (m *SeriesMutation) FavoritesIDs() (ids []int) {
+	for id := range m.favorites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFavorites resets all changes to the "favorites" edge.
+func (m *SeriesMutation) ResetFavorites() {
+	m.favorites = nil
+	m.clearedfavorites = false
+	m.removedfavorites = nil
+}
+
+// AddRatingIDs adds the "ratings" edge to the Rating entity by ids.
+func (m *SeriesMutation) AddRatingIDs(ids ...int) {
+	if m.ratings == nil {
+		m.ratings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.ratings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRatings clears the "ratings" edge to the Rating entity.
+func (m *SeriesMutation) ClearRatings() {
+	m.clearedratings = true
+}
+
+// RatingsCleared reports if the "ratings" edge to the Rating entity was cleared.
+func (m *SeriesMutation) RatingsCleared() bool {
+	return m.clearedratings
+}
+
+// RemoveRatingIDs removes the "ratings" edge to the Rating entity by IDs.
+func (m *SeriesMutation) RemoveRatingIDs(ids ...int) {
+	if m.removedratings == nil {
+		m.removedratings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.ratings, ids[i])
+		m.removedratings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRatings returns the removed IDs of the "ratings" edge to the Rating entity.
+func (m *SeriesMutation) RemovedRatingsIDs() (ids []int) {
+	for id := range m.removedratings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RatingsIDs returns the "ratings" edge IDs in the mutation.
+func (m *SeriesMutation) RatingsIDs() (ids []int) {
+	for id := range m.ratings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRatings resets all changes to the "ratings" edge.
+func (m *SeriesMutation) ResetRatings() {
+	m.ratings = nil
+	m.clearedratings = false
+	m.removedratings = nil
+}
+
+// AddWatchlistItemIDs adds the "watchlist_items" edge to the WatchlistItem entity by ids.
+func (m *SeriesMutation) AddWatchlistItemIDs(ids ...int) {
+	if m.watchlist_items == nil {
+		m.watchlist_items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.watchlist_items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWatchlistItems clears the "watchlist_items" edge to the WatchlistItem entity.
+func (m *SeriesMutation) ClearWatchlistItems() {
+	m.clearedwatchlist_items = true
+}
+
+// WatchlistItemsCleared reports if the "watchlist_items" edge to the WatchlistItem entity was cleared.
+func (m *SeriesMutation) WatchlistItemsCleared() bool {
+	return m.clearedwatchlist_items
+}
+
+// RemoveWatchlistItemIDs removes the "watchlist_items" edge to the WatchlistItem entity by IDs.
+func (m *SeriesMutation) RemoveWatchlistItemIDs(ids ...int) {
+	if m.removedwatchlist_items == nil {
+		m.removedwatchlist_items = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.watchlist_items, ids[i])
+		m.removedwatchlist_items[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWatchlistItems returns the removed IDs of the "watchlist_items" edge to the WatchlistItem entity.
+func (m *SeriesMutation) RemovedWatchlistItemsIDs() (ids []int) {
+	for id := range m.removedwatchlist_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WatchlistItemsIDs returns the "watchlist_items" edge IDs in the mutation.
+func (m *SeriesMutation) WatchlistItemsIDs() (ids []int) {
+	for id := range m.watchlist_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWatchlistItems resets all changes to the "watchlist_items" edge.
+func (m *SeriesMutation) ResetWatchlistItems() {
+	m.watchlist_items = nil
+	m.clearedwatchlist_items = false
+	m.removedwatchlist_items = nil
+}
+
+// Where appends a list predicates to the SeriesMutation builder.
+func (m *SeriesMutation) Where(ps ...predicate.Series) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SeriesMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SeriesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Series, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SeriesMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SeriesMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Series).
+func (m *SeriesMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SeriesMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, series.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, series.FieldUpdatedAt)
+	}
+	if m.series_id != nil {
+		fields = append(fields, series.FieldSeriesID)
+	}
+	if m.title != nil {
+		fields = append(fields, series.FieldTitle)
+	}
+	if m.title_yomi != nil {
+		fields = append(fields, series.FieldTitleYomi)
+	}
+	if m.title_en != nil {
+		fields = append(fields, series.FieldTitleEn)
+	}
+	if m.description != nil {
+		fields = append(fields, series.FieldDescription)
+	}
+	if m.media != nil {
+		fields = append(fields, series.FieldMedia)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SeriesMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case series.FieldCreatedAt:
+		return m.CreatedAt()
+	case series.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case series.FieldSeriesID:
+		return m.SeriesID()
+	case series.FieldTitle:
+		return m.Title()
+	case series.FieldTitleYomi:
+		return m.TitleYomi()
+	case series.FieldTitleEn:
+		return m.TitleEn()
+	case series.FieldDescription:
+		return m.Description()
+	case series.FieldMedia:
+		return m.Media()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SeriesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case series.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case series.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case series.FieldSeriesID:
+		return m.OldSeriesID(ctx)
+	case series.FieldTitle:
+		return m.OldTitle(ctx)
+	case series.FieldTitleYomi:
+		return m.OldTitleYomi(ctx)
+	case series.FieldTitleEn:
+		return m.OldTitleEn(ctx)
+	case series.FieldDescription:
+		return m.OldDescription(ctx)
+	case series.FieldMedia:
+		return m.OldMedia(ctx)
+	}
+	return nil, fmt.Errorf("unknown Series field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SeriesMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case series.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case series.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case series.FieldSeriesID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeriesID(v)
+		return nil
+	case series.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case series.FieldTitleYomi:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitleYomi(v)
+		return nil
+	case series.FieldTitleEn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitleEn(v)
+		return nil
+	case series.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case series.FieldMedia:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMedia(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Series field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SeriesMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SeriesMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SeriesMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Series numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SeriesMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(series.FieldTitleYomi) {
+		fields = append(fields, series.FieldTitleYomi)
+	}
+	if m.FieldCleared(series.FieldTitleEn) {
+		fields = append(fields, series.FieldTitleEn)
+	}
+	if m.FieldCleared(series.FieldDescription) {
+		fields = append(fields, series.FieldDescription)
+	}
+	if m.FieldCleared(series.FieldMedia) {
+		fields = append(fields, series.FieldMedia)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SeriesMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SeriesMutation) ClearField(name string) error {
+	switch name {
+	case series.FieldTitleYomi:
+		m.ClearTitleYomi()
+		return nil
+	case series.FieldTitleEn:
+		m.ClearTitleEn()
+		return nil
+	case series.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case series.FieldMedia:
+		m.ClearMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown Series nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SeriesMutation) ResetField(name string) error {
+	switch name {
+	case series.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case series.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case series.FieldSeriesID:
+		m.ResetSeriesID()
+		return nil
+	case series.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case series.FieldTitleYomi:
+		m.ResetTitleYomi()
+		return nil
+	case series.FieldTitleEn:
+		m.ResetTitleEn()
+		return nil
+	case series.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case series.FieldMedia:
+		m.ResetMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown Series field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SeriesMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.seasons != nil {
+		edges = append(edges, series.EdgeSeasons)
+	}
+	if m.favorites != nil {
+		edges = append(edges, series.EdgeFavorites)
+	}
+	if m.ratings != nil {
+		edges = append(edges, series.EdgeRatings)
+	}
+	if m.watchlist_items != nil {
+		edges = append(edges, series.EdgeWatchlistItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SeriesMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case series.EdgeSeasons:
+		ids := make([]ent.Value, 0, len(m.seasons))
+		for id := range m.seasons {
+			ids = append(ids, id)
+		}
+		return ids
+	case series.EdgeFavorites:
+		ids := make([]ent.Value, 0, len(m.favorites))
+		for id := range m.favorites {
+			ids = append(ids, id)
+		}
+		return ids
+	case series.EdgeRatings:
+		ids := make([]ent.Value, 0, len(m.ratings))
+		for id := range m.ratings {
+			ids = append(ids, id)
+		}
+		return ids
+	case series.EdgeWatchlistItems:
+		ids := make([]ent.Value, 0, len(m.watchlist_items))
+		for id := range m.watchlist_items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SeriesMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedseasons != nil {
+		edges = append(edges, series.EdgeSeasons)
+	}
+	if m.removedfavorites != nil {
+		edges = append(edges, series.EdgeFavorites)
+	}
+	if m.removedratings != nil {
+		edges = append(edges, series.EdgeRatings)
+	}
+	if m.removedwatchlist_items != nil {
+		edges = append(edges, series.EdgeWatchlistItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SeriesMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case series.EdgeSeasons:
+		ids := make([]ent.Value, 0, len(m.removedseasons))
+		for id := range m.removedseasons {
+			ids = append(ids, id)
+		}
+		return ids
+	case series.EdgeFavorites:
+		ids := make([]ent.Value, 0, len(m.removedfavorites))
+		for id := range m.removedfavorites {
+			ids = append(ids, id)
+		}
+		return ids
+	case series.EdgeRatings:
+		ids := make([]ent.Value, 0, len(m.removedratings))
+		for id := range m.removedratings {
+			ids = append(ids, id)
+		}
+		return ids
+	case series.EdgeWatchlistItems:
+		ids := make([]ent.Value, 0, len(m.removedwatchlist_items))
+		for id := range m.removedwatchlist_items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SeriesMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedseasons {
+		edges = append(edges, series.EdgeSeasons)
+	}
+	if m.clearedfavorites {
+		edges = append(edges, series.EdgeFavorites)
+	}
+	if m.clearedratings {
+		edges = append(edges, series.EdgeRatings)
+	}
+	if m.clearedwatchlist_items {
+		edges = append(edges, series.EdgeWatchlistItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SeriesMutation) EdgeCleared(name string) bool {
+	switch name {
+	case series.EdgeSeasons:
+		return m.clearedseasons
+	case series.EdgeFavorites:
+		return m.clearedfavorites
+	case series.EdgeRatings:
+		return m.clearedratings
+	case series.EdgeWatchlistItems:
+		return m.clearedwatchlist_items
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SeriesMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Series unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SeriesMutation) ResetEdge(name string) error {
+	switch name {
+	case series.EdgeSeasons:
+		m.ResetSeasons()
+		return nil
+	case series.EdgeFavorites:
+		m.ResetFavorites()
+		return nil
+	case series.EdgeRatings:
+		m.ResetRatings()
+		return nil
+	case series.EdgeWatchlistItems:
+		m.ResetWatchlistItems()
+		return nil
+	}
+	return fmt.Errorf("unknown Series edge %s", name)
+}
+
+// SubtitleMutation represents an operation that mutates the Subtitle nodes in the graph.
+type SubtitleMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	language       *string
+	format         *string
+	label          *string
+	is_default     *bool
+	_path          *string
+	clearedFields  map[string]struct{}
+	episode        *int
+	clearedepisode bool
+	done           bool
+	oldValue       func(context.Context) (*Subtitle, error)
+	predicates     []predicate.Subtitle
+}
+
+var _ ent.Mutation = (*SubtitleMutation)(nil)
+
+// subtitleOption allows management of the mutation configuration using functional options.
+type subtitleOption func(*SubtitleMutation)
+
+// newSubtitleMutation creates new mutation for the Subtitle entity.
+func newSubtitleMutation(c config, op Op, opts ...subtitleOption) *SubtitleMutation {
+	m := &SubtitleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSubtitle,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSubtitleID sets the ID field of the mutation.
+func withSubtitleID(id int) subtitleOption {
+	return func(m *SubtitleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Subtitle
+		)
+		m.oldValue = func(ctx context.Context) (*Subtitle, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Subtitle.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSubtitle sets the old Subtitle of the mutation.
+func withSubtitle(node *Subtitle) subtitleOption {
+	return func(m *SubtitleMutation) {
+		m.oldValue = func(context.Context) (*Subtitle, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SubtitleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SubtitleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SubtitleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SubtitleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Subtitle.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SubtitleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SubtitleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Subtitle entity.
+// If the Subtitle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubtitleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SubtitleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SubtitleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SubtitleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Subtitle entity.
+// If the Subtitle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubtitleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SubtitleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetLanguage sets the "language" field.
+func (m *SubtitleMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *SubtitleMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the Subtitle entity.
+// If the Subtitle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubtitleMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *SubtitleMutation) ResetLanguage() {
+	m.language = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *SubtitleMutation) SetFormat(s string) {
+	m.format = &s
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *SubtitleMutation) Format() (r string, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the Subtitle entity.
+// If the Subtitle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubtitleMutation) OldFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *SubtitleMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetLabel sets the "label" field.
+func (m *SubtitleMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *SubtitleMutation) Label() (r string, exists bool) {
+	v := m.label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabel returns the old "label" field's value of the Subtitle entity.
+// If the Subtitle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubtitleMutation) OldLabel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ClearLabel clears the value of the "label" field.
+func (m *SubtitleMutation) ClearLabel() {
+	m.label = nil
+	m.clearedFields[subtitle.FieldLabel] = struct{}{}
+}
+
+// LabelCleared returns if the "label" field was cleared in this mutation.
+func (m *SubtitleMutation) LabelCleared() bool {
+	_, ok := m.clearedFields[subtitle.FieldLabel]
+	return ok
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *SubtitleMutation) ResetLabel() {
+	m.label = nil
+	delete(m.clearedFields, subtitle.FieldLabel)
+}
+
+// SetIsDefault sets the "is_default" field.
+func (m *SubtitleMutation) SetIsDefault(b bool) {
+	m.is_default = &b
+}
+
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *SubtitleMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDefault returns the old "is_default" field's value of the Subtitle entity.
+// If the Subtitle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubtitleMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
+}
+
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *SubtitleMutation) ResetIsDefault() {
+	m.is_default = nil
+}
+
+// SetPath sets the "path" field.
+func (m *SubtitleMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *SubtitleMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the Subtitle entity.
+// If the Subtitle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubtitleMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *SubtitleMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by id.
+func (m *SubtitleMutation) SetEpisodeID(id int) {
+	m.episode = &id
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (m *SubtitleMutation) ClearEpisode() {
+	m.clearedepisode = true
+}
+
+// EpisodeCleared reports if the "episode" edge to the Episode entity was cleared.
+func (m *SubtitleMutation) EpisodeCleared() bool {
+	return m.clearedepisode
+}
+
+// EpisodeID returns the "episode" edge ID in the mutation.
+func (m *SubtitleMutation) EpisodeID() (id int, exists bool) {
+	if m.episode != nil {
+		return *m.episode, true
+	}
+	return
+}
+
+// EpisodeIDs returns the "episode" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EpisodeID instead. It exists only for internal usage by the builders.
+func (m *SubtitleMutation) EpisodeIDs() (ids []int) {
+	if id := m.episode; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEpisode resets all changes to the "episode" edge.
+func (m *SubtitleMutation) ResetEpisode() {
+	m.episode = nil
+	m.clearedepisode = false
+}
+
+// Where appends a list predicates to the SubtitleMutation builder.
+func (m *SubtitleMutation) Where(ps ...predicate.Subtitle) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SubtitleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SubtitleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Subtitle, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SubtitleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SubtitleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Subtitle).
+func (m *SubtitleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SubtitleMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, subtitle.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, subtitle.FieldUpdatedAt)
+	}
+	if m.language != nil {
+		fields = append(fields, subtitle.FieldLanguage)
+	}
+	if m.format != nil {
+		fields = append(fields, subtitle.FieldFormat)
+	}
+	if m.label != nil {
+		fields = append(fields, subtitle.FieldLabel)
+	}
+	if m.is_default != nil {
+		fields = append(fields, subtitle.FieldIsDefault)
+	}
+	if m._path != nil {
+		fields = append(fields, subtitle.FieldPath)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SubtitleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case subtitle.FieldCreatedAt:
+		return m.CreatedAt()
+	case subtitle.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case subtitle.FieldLanguage:
+		return m.Language()
+	case subtitle.FieldFormat:
+		return m.Format()
+	case subtitle.FieldLabel:
+		return m.Label()
+	case subtitle.FieldIsDefault:
+		return m.IsDefault()
+	case subtitle.FieldPath:
+		return m.Path()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SubtitleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case subtitle.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case subtitle.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case subtitle.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case subtitle.FieldFormat:
+		return m.OldFormat(ctx)
+	case subtitle.FieldLabel:
+		return m.OldLabel(ctx)
+	case subtitle.FieldIsDefault:
+		return m.OldIsDefault(ctx)
+	case subtitle.FieldPath:
+		return m.OldPath(ctx)
+	}
+	return nil, fmt.Errorf("unknown Subtitle field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SubtitleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case subtitle.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case subtitle.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case subtitle.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case subtitle.FieldFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case subtitle.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
+	case subtitle.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
+		return nil
+	case subtitle.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Subtitle field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SubtitleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SubtitleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SubtitleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Subtitle numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SubtitleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(subtitle.FieldLabel) {
+		fields = append(fields, subtitle.FieldLabel)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SubtitleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SubtitleMutation) ClearField(name string) error {
+	switch name {
+	case subtitle.FieldLabel:
+		m.ClearLabel()
+		return nil
+	}
+	return fmt.Errorf("unknown Subtitle nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SubtitleMutation) ResetField(name string) error {
+	switch name {
+	case subtitle.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case subtitle.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case subtitle.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case subtitle.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case subtitle.FieldLabel:
+		m.ResetLabel()
+		return nil
+	case subtitle.FieldIsDefault:
+		m.ResetIsDefault()
+		return nil
+	case subtitle.FieldPath:
+		m.ResetPath()
+		return nil
+	}
+	return fmt.Errorf("unknown Subtitle field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SubtitleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.episode != nil {
+		edges = append(edges, subtitle.EdgeEpisode)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SubtitleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case subtitle.EdgeEpisode:
+		if id := m.episode; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SubtitleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SubtitleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SubtitleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedepisode {
+		edges = append(edges, subtitle.EdgeEpisode)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SubtitleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case subtitle.EdgeEpisode:
+		return m.clearedepisode
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SubtitleMutation) ClearEdge(name string) error {
+	switch name {
+	case subtitle.EdgeEpisode:
+		m.ClearEpisode()
+		return nil
+	}
+	return fmt.Errorf("unknown Subtitle unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SubtitleMutation) ResetEdge(name string) error {
+	switch name {
+	case subtitle.EdgeEpisode:
+		m.ResetEpisode()
+		return nil
+	}
+	return fmt.Errorf("unknown Subtitle edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	created_at            *time.Time
+	updated_at            *time.Time
+	name                  *string
+	clearedFields         map[string]struct{}
+	watch_progress        map[int]struct{}
+	removedwatch_progress map[int]struct{}
+	clearedwatch_progress bool
+	favorites             map[int]struct{}
+	removedfavorites      map[int]struct{}
+	clearedfavorites      bool
+	ratings               map[int]struct{}
+	removedratings        map[int]struct{}
+	clearedratings        bool
+	watchlists            map[int]struct{}
+	removedwatchlists     map[int]struct{}
+	clearedwatchlists     bool
+	api_keys              map[int]struct{}
+	removedapi_keys       map[int]struct{}
+	clearedapi_keys       bool
+	done                  bool
+	oldValue              func(context.Context) (*User, error)
+	predicates            []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// WhereP appends storage-level predicates to the EpisodeMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EpisodeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Episode, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *EpisodeMutation) Op() Op {
-	return m.op
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *EpisodeMutation) SetOp(op Op) {
-	m.op = op
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
 }
 
-// Type returns the node type of this mutation (Episode).
-func (m *EpisodeMutation) Type() string {
-	return m.typ
+// AddWatchProgresIDs adds the "watch_progress" edge to the WatchProgress entity by ids.
+func (m *UserMutation) AddWatchProgresIDs(ids ...int) {
+	if m.watch_progress == nil {
+		m.watch_progress = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.watch_progress[ids[i]] = struct{}{}
+	}
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *EpisodeMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.episode_id != nil {
-		fields = append(fields, episode.FieldEpisodeID)
+// ClearWatchProgress clears the "watch_progress" edge to the WatchProgress entity.
+func (m *UserMutation) ClearWatchProgress() {
+	m.clearedwatch_progress = true
+}
+
+// WatchProgressCleared reports if the "watch_progress" edge to the WatchProgress entity was cleared.
+func (m *UserMutation) WatchProgressCleared() bool {
+	return m.clearedwatch_progress
+}
+
+// RemoveWatchProgresIDs removes the "watch_progress" edge to the WatchProgress entity by IDs.
+func (m *UserMutation) RemoveWatchProgresIDs(ids ...int) {
+	if m.removedwatch_progress == nil {
+		m.removedwatch_progress = make(map[int]struct{})
 	}
-	if m.title != nil {
-		fields = append(fields, episode.FieldTitle)
+	for i := range ids {
+		delete(m.watch_progress, ids[i])
+		m.removedwatch_progress[ids[i]] = struct{}{}
 	}
-	if m.description != nil {
-		fields = append(fields, episode.FieldDescription)
+}
+
+// RemovedWatchProgress returns the removed IDs of the "watch_progress" edge to the WatchProgress entity.
+func (m *UserMutation) RemovedWatchProgressIDs() (ids []int) {
+	for id := range m.removedwatch_progress {
+		ids = append(ids, id)
 	}
-	if m.episode_number != nil {
-		fields = append(fields, episode.FieldEpisodeNumber)
+	return
+}
+
+// WatchProgressIDs returns the "watch_progress" edge IDs in the mutation.
+func (m *UserMutation) WatchProgressIDs() (ids []int) {
+	for id := range m.watch_progress {
+		ids = append(ids, id)
 	}
-	if m.duration != nil {
-		fields = append(fields, episode.FieldDuration)
+	return
+}
+
+// ResetWatchProgress resets all changes to the "watch_progress" edge.
+func (m *UserMutation) ResetWatchProgress() {
+	m.watch_progress = nil
+	m.clearedwatch_progress = false
+	m.removedwatch_progress = nil
+}
+
+// AddFavoriteIDs adds the "favorites" edge to the Favorite entity by ids.
+func (m *UserMutation) AddFavoriteIDs(ids ...int) {
+	if m.favorites == nil {
+		m.favorites = make(map[int]struct{})
 	}
-	if m.duration_string != nil {
-		fields = append(fields, episode.FieldDurationString)
+	for i := range ids {
+		m.favorites[ids[i]] = struct{}{}
 	}
-	if m.timestamp != nil {
-		fields = append(fields, episode.FieldTimestamp)
+}
+
+// ClearFavorites clears the "favorites" edge to the Favorite entity.
+func (m *UserMutation) ClearFavorites() {
+	m.clearedfavorites = true
+}
+
+// FavoritesCleared reports if the "favorites" edge to the Favorite entity was cleared.
+func (m *UserMutation) FavoritesCleared() bool {
+	return m.clearedfavorites
+}
+
+// RemoveFavoriteIDs removes the "favorites" edge to the Favorite entity by IDs.
+func (m *UserMutation) RemoveFavoriteIDs(ids ...int) {
+	if m.removedfavorites == nil {
+		m.removedfavorites = make(map[int]struct{})
 	}
-	if m.format_id != nil {
-		fields = append(fields, episode.FieldFormatID)
+	for i := range ids {
+		delete(m.favorites, ids[i])
+		m.removedfavorites[ids[i]] = struct{}{}
 	}
-	if m.width != nil {
-		fields = append(fields, episode.FieldWidth)
+}
+
+// RemovedFavorites returns the removed IDs of the "favorites" edge to the Favorite entity.
+func (m *UserMutation) RemovedFavoritesIDs() (ids []int) {
+	for id := range m.removedfavorites {
+		ids = append(ids, id)
 	}
-	if m.height != nil {
-		fields = append(fields, episode.FieldHeight)
+	return
+}
+
+// FavoritesIDs returns the "favorites" edge IDs in the mutation.
+func (m *UserMutation) FavoritesIDs() (ids []int) {
+	for id := range m.favorites {
+		ids = append(ids, id)
 	}
-	if m.dynamic_range != nil {
-		fields = append(fields, episode.FieldDynamicRange)
+	return
+}
+
+// ResetFavorites resets all changes to the "favorites" edge.
+func (m *UserMutation) ResetFavorites() {
+	m.favorites = nil
+	m.clearedfavorites = false
+	m.removedfavorites = nil
+}
+
+// AddRatingIDs adds the "ratings" edge to the Rating entity by ids.
+func (m *UserMutation) AddRatingIDs(ids ...int) {
+	if m.ratings == nil {
+		m.ratings = make(map[int]struct{})
 	}
-	if m.metadata != nil {
-		fields = append(fields, episode.FieldMetadata)
+	for i := range ids {
+		m.ratings[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *EpisodeMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case episode.FieldEpisodeID:
-		return m.EpisodeID()
-	case episode.FieldTitle:
-		return m.Title()
-	case episode.FieldDescription:
-		return m.Description()
-	case episode.FieldEpisodeNumber:
-		return m.EpisodeNumber()
-	case episode.FieldDuration:
-		return m.Duration()
-	case episode.FieldDurationString:
-		return m.DurationString()
-	case episode.FieldTimestamp:
-		return m.Timestamp()
-	case episode.FieldFormatID:
-		return m.FormatID()
-	case episode.FieldWidth:
-		return m.Width()
-	case episode.FieldHeight:
-		return m.Height()
-	case episode.FieldDynamicRange:
-		return m.DynamicRange()
-	case episode.FieldMetadata:
-		return m.Metadata()
+// ClearRatings clears the "ratings" edge to the Rating entity.
+func (m *UserMutation) ClearRatings() {
+	m.clearedratings = true
+}
+
+// RatingsCleared reports if the "ratings" edge to the Rating entity was cleared.
+func (m *UserMutation) RatingsCleared() bool {
+	return m.clearedratings
+}
+
+// RemoveRatingIDs removes the "ratings" edge to the Rating entity by IDs.
+func (m *UserMutation) RemoveRatingIDs(ids ...int) {
+	if m.removedratings == nil {
+		m.removedratings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.ratings, ids[i])
+		m.removedratings[ids[i]] = struct{}{}
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *EpisodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case episode.FieldEpisodeID:
-		return m.OldEpisodeID(ctx)
-	case episode.FieldTitle:
-		return m.OldTitle(ctx)
-	case episode.FieldDescription:
-		return m.OldDescription(ctx)
-	case episode.FieldEpisodeNumber:
-		return m.OldEpisodeNumber(ctx)
-	case episode.FieldDuration:
-		return m.OldDuration(ctx)
-	case episode.FieldDurationString:
-		return m.OldDurationString(ctx)
-	case episode.FieldTimestamp:
-		return m.OldTimestamp(ctx)
-	case episode.FieldFormatID:
-		return m.OldFormatID(ctx)
-	case episode.FieldWidth:
-		return m.OldWidth(ctx)
-	case episode.FieldHeight:
-		return m.OldHeight(ctx)
-	case episode.FieldDynamicRange:
-		return m.OldDynamicRange(ctx)
-	case episode.FieldMetadata:
-		return m.OldMetadata(ctx)
+// RemovedRatings returns the removed IDs of the "ratings" edge to the Rating entity.
+func (m *UserMutation) RemovedRatingsIDs() (ids []int) {
+	for id := range m.removedratings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RatingsIDs returns the "ratings" edge IDs in the mutation.
+func (m *UserMutation) RatingsIDs() (ids []int) {
+	for id := range m.ratings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRatings resets all changes to the "ratings" edge.
+func (m *UserMutation) ResetRatings() {
+	m.ratings = nil
+	m.clearedratings = false
+	m.removedratings = nil
+}
+
+// AddWatchlistIDs adds the "watchlists" edge to the Watchlist entity by ids.
+func (m *UserMutation) AddWatchlistIDs(ids ...int) {
+	if m.watchlists == nil {
+		m.watchlists = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.watchlists[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWatchlists clears the "watchlists" edge to the Watchlist entity.
+func (m *UserMutation) ClearWatchlists() {
+	m.clearedwatchlists = true
+}
+
+// WatchlistsCleared reports if the "watchlists" edge to the Watchlist entity was cleared.
+func (m *UserMutation) WatchlistsCleared() bool {
+	return m.clearedwatchlists
+}
+
+// RemoveWatchlistIDs removes the "watchlists" edge to the Watchlist entity by IDs.
+func (m *UserMutation) RemoveWatchlistIDs(ids ...int) {
+	if m.removedwatchlists == nil {
+		m.removedwatchlists = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.watchlists, ids[i])
+		m.removedwatchlists[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWatchlists returns the removed IDs of the "watchlists" edge to the Watchlist entity.
+func (m *UserMutation) RemovedWatchlistsIDs() (ids []int) {
+	for id := range m.removedwatchlists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WatchlistsIDs returns the "watchlists" edge IDs in the mutation.
+func (m *UserMutation) WatchlistsIDs() (ids []int) {
+	for id := range m.watchlists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWatchlists resets all changes to the "watchlists" edge.
+func (m *UserMutation) ResetWatchlists() {
+	m.watchlists = nil
+	m.clearedwatchlists = false
+	m.removedwatchlists = nil
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by ids.
+func (m *UserMutation) AddAPIKeyIDs(ids ...int) {
+	if m.api_keys == nil {
+		m.api_keys = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.api_keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAPIKeys clears the "api_keys" edge to the APIKey entity.
+func (m *UserMutation) ClearAPIKeys() {
+	m.clearedapi_keys = true
+}
+
+// APIKeysCleared reports if the "api_keys" edge to the APIKey entity was cleared.
+func (m *UserMutation) APIKeysCleared() bool {
+	return m.clearedapi_keys
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to the APIKey entity by IDs.
+func (m *UserMutation) RemoveAPIKeyIDs(ids ...int) {
+	if m.removedapi_keys == nil {
+		m.removedapi_keys = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.api_keys, ids[i])
+		m.removedapi_keys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAPIKeys returns the removed IDs of the "api_keys" edge to the APIKey entity.
+func (m *UserMutation) RemovedAPIKeysIDs() (ids []int) {
+	for id := range m.removedapi_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// APIKeysIDs returns the "api_keys" edge IDs in the mutation.
+func (m *UserMutation) APIKeysIDs() (ids []int) {
+	for id := range m.api_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAPIKeys resets all changes to the "api_keys" edge.
+func (m *UserMutation) ResetAPIKeys() {
+	m.api_keys = nil
+	m.clearedapi_keys = false
+	m.removedapi_keys = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
 	}
-	return nil, fmt.Errorf("unknown Episode field %s", name)
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case user.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case user.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EpisodeMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case episode.FieldEpisodeID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEpisodeID(v)
-		return nil
-	case episode.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case episode.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case episode.FieldEpisodeNumber:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEpisodeNumber(v)
-		return nil
-	case episode.FieldDuration:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDuration(v)
-		return nil
-	case episode.FieldDurationString:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDurationString(v)
-		return nil
-	case episode.FieldTimestamp:
+	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimestamp(v)
-		return nil
-	case episode.FieldFormatID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFormatID(v)
-		return nil
-	case episode.FieldWidth:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWidth(v)
-		return nil
-	case episode.FieldHeight:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHeight(v)
+		m.SetCreatedAt(v)
 		return nil
-	case episode.FieldDynamicRange:
-		v, ok := value.(string)
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDynamicRange(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case episode.FieldMetadata:
+	case user.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Episode field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EpisodeMutation) AddedFields() []string {
-	var fields []string
-	if m.addepisode_number != nil {
-		fields = append(fields, episode.FieldEpisodeNumber)
-	}
-	if m.addduration != nil {
-		fields = append(fields, episode.FieldDuration)
-	}
-	if m.addwidth != nil {
-		fields = append(fields, episode.FieldWidth)
-	}
-	if m.addheight != nil {
-		fields = append(fields, episode.FieldHeight)
-	}
-	return fields
+func (m *UserMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EpisodeMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case episode.FieldEpisodeNumber:
-		return m.AddedEpisodeNumber()
-	case episode.FieldDuration:
-		return m.AddedDuration()
-	case episode.FieldWidth:
-		return m.AddedWidth()
-	case episode.FieldHeight:
-		return m.AddedHeight()
-	}
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EpisodeMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case episode.FieldEpisodeNumber:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddEpisodeNumber(v)
-		return nil
-	case episode.FieldDuration:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDuration(v)
-		return nil
-	case episode.FieldWidth:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddWidth(v)
-		return nil
-	case episode.FieldHeight:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddHeight(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Episode numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EpisodeMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(episode.FieldDescription) {
-		fields = append(fields, episode.FieldDescription)
-	}
-	return fields
+func (m *UserMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EpisodeMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EpisodeMutation) ClearField(name string) error {
-	switch name {
-	case episode.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown Episode nullable field %s", name)
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EpisodeMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case episode.FieldEpisodeID:
-		m.ResetEpisodeID()
-		return nil
-	case episode.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case episode.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case episode.FieldEpisodeNumber:
-		m.ResetEpisodeNumber()
-		return nil
-	case episode.FieldDuration:
-		m.ResetDuration()
-		return nil
-	case episode.FieldDurationString:
-		m.ResetDurationString()
-		return nil
-	case episode.FieldTimestamp:
-		m.ResetTimestamp()
-		return nil
-	case episode.FieldFormatID:
-		m.ResetFormatID()
-		return nil
-	case episode.FieldWidth:
-		m.ResetWidth()
-		return nil
-	case episode.FieldHeight:
-		m.ResetHeight()
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case episode.FieldDynamicRange:
-		m.ResetDynamicRange()
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case episode.FieldMetadata:
-		m.ResetMetadata()
+	case user.FieldName:
+		m.ResetName()
 		return nil
 	}
-	return fmt.Errorf("unknown Episode field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EpisodeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.season != nil {
-		edges = append(edges, episode.EdgeSeason)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.watch_progress != nil {
+		edges = append(edges, user.EdgeWatchProgress)
+	}
+	if m.favorites != nil {
+		edges = append(edges, user.EdgeFavorites)
+	}
+	if m.ratings != nil {
+		edges = append(edges, user.EdgeRatings)
+	}
+	if m.watchlists != nil {
+		edges = append(edges, user.EdgeWatchlists)
+	}
+	if m.api_keys != nil {
+		edges = append(edges, user.EdgeAPIKeys)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EpisodeMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case episode.EdgeSeason:
-		if id := m.season; id != nil {
-			return []ent.Value{*id}
+	case user.EdgeWatchProgress:
+		ids := make([]ent.Value, 0, len(m.watch_progress))
+		for id := range m.watch_progress {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFavorites:
+		ids := make([]ent.Value, 0, len(m.favorites))
+		for id := range m.favorites {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRatings:
+		ids := make([]ent.Value, 0, len(m.ratings))
+		for id := range m.ratings {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWatchlists:
+		ids := make([]ent.Value, 0, len(m.watchlists))
+		for id := range m.watchlists {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.api_keys))
+		for id := range m.api_keys {
+			ids = append(ids, id)
 		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedwatch_progress != nil {
+		edges = append(edges, user.EdgeWatchProgress)
+	}
+	if m.removedfavorites != nil {
+		edges = append(edges, user.EdgeFavorites)
+	}
+	if m.removedratings != nil {
+		edges = append(edges, user.EdgeRatings)
+	}
+	if m.removedwatchlists != nil {
+		edges = append(edges, user.EdgeWatchlists)
+	}
+	if m.removedapi_keys != nil {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeWatchProgress:
+		ids := make([]ent.Value, 0, len(m.removedwatch_progress))
+		for id := range m.removedwatch_progress {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFavorites:
+		ids := make([]ent.Value, 0, len(m.removedfavorites))
+		for id := range m.removedfavorites {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRatings:
+		ids := make([]ent.Value, 0, len(m.removedratings))
+		for id := range m.removedratings {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWatchlists:
+		ids := make([]ent.Value, 0, len(m.removedwatchlists))
+		for id := range m.removedwatchlists {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedapi_keys))
+		for id := range m.removedapi_keys {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EpisodeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *EpisodeMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EpisodeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedseason {
-		edges = append(edges, episode.EdgeSeason)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedwatch_progress {
+		edges = append(edges, user.EdgeWatchProgress)
+	}
+	if m.clearedfavorites {
+		edges = append(edges, user.EdgeFavorites)
+	}
+	if m.clearedratings {
+		edges = append(edges, user.EdgeRatings)
+	}
+	if m.clearedwatchlists {
+		edges = append(edges, user.EdgeWatchlists)
+	}
+	if m.clearedapi_keys {
+		edges = append(edges, user.EdgeAPIKeys)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EpisodeMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case episode.EdgeSeason:
-		return m.clearedseason
+	case user.EdgeWatchProgress:
+		return m.clearedwatch_progress
+	case user.EdgeFavorites:
+		return m.clearedfavorites
+	case user.EdgeRatings:
+		return m.clearedratings
+	case user.EdgeWatchlists:
+		return m.clearedwatchlists
+	case user.EdgeAPIKeys:
+		return m.clearedapi_keys
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EpisodeMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
-	case episode.EdgeSeason:
-		m.ClearSeason()
-		return nil
 	}
-	return fmt.Errorf("unknown Episode unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EpisodeMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case episode.EdgeSeason:
-		m.ResetSeason()
+	case user.EdgeWatchProgress:
+		m.ResetWatchProgress()
+		return nil
+	case user.EdgeFavorites:
+		m.ResetFavorites()
+		return nil
+	case user.EdgeRatings:
+		m.ResetRatings()
+		return nil
+	case user.EdgeWatchlists:
+		m.ResetWatchlists()
+		return nil
+	case user.EdgeAPIKeys:
+		m.ResetAPIKeys()
 		return nil
 	}
-	return fmt.Errorf("unknown Episode edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// SeasonMutation represents an operation that mutates the Season nodes in the graph.
-type SeasonMutation struct {
+// WatchProgressMutation represents an operation that mutates the WatchProgress nodes in the graph.
+type WatchProgressMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	season_id          *string
-	season_title       *string
-	season_title_yomi  *string
-	season_number      *int
-	addseason_number   *int
-	shoboi_tid         *int
-	addshoboi_tid      *int
-	description        *string
-	first_year         *int
-	addfirst_year      *int
-	first_month        *int
-	addfirst_month     *int
-	first_end_year     *int
-	addfirst_end_year  *int
-	first_end_month    *int
-	addfirst_end_month *int
-	clearedFields      map[string]struct{}
-	series             *int
-	clearedseries      bool
-	episodes           map[int]struct{}
-	removedepisodes    map[int]struct{}
-	clearedepisodes    bool
-	done               bool
-	oldValue           func(context.Context) (*Season, error)
-	predicates         []predicate.Season
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	position       *float64
+	addposition    *float64
+	completed      *bool
+	clearedFields  map[string]struct{}
+	user           *int
+	cleareduser    bool
+	episode        *int
+	clearedepisode bool
+	done           bool
+	oldValue       func(context.Context) (*WatchProgress, error)
+	predicates     []predicate.WatchProgress
 }
 
-var _ ent.Mutation = (*SeasonMutation)(nil)
+var _ ent.Mutation = (*WatchProgressMutation)(nil)
 
-// seasonOption allows management of the mutation configuration using functional options.
-type seasonOption func(*SeasonMutation)
+// watchprogressOption allows management of the mutation configuration using functional options.
+type watchprogressOption func(*WatchProgressMutation)
 
-// newSeasonMutation creates new mutation for the Season entity.
-func newSeasonMutation(c config, op Op, opts ...seasonOption) *SeasonMutation {
-	m := &SeasonMutation{
+// newWatchProgressMutation creates new mutation for the WatchProgress entity.
+func newWatchProgressMutation(c config, op Op, opts ...watchprogressOption) *WatchProgressMutation {
+	m := &WatchProgressMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSeason,
+		typ:           TypeWatchProgress,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1227,20 +10853,20 @@ func newSeasonMutation(c config, op Op, opts ...seasonOption) *SeasonMutation {
 	return m
 }
 
-// withSeasonID sets the ID field of the mutation.
-func withSeasonID(id int) seasonOption {
-	return func(m *SeasonMutation) {
+// withWatchProgressID sets the ID field of the mutation.
+func withWatchProgressID(id int) watchprogressOption {
+	return func(m *WatchProgressMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Season
+			value *WatchProgress
 		)
-		m.oldValue = func(ctx context.Context) (*Season, error) {
+		m.oldValue = func(ctx context.Context) (*WatchProgress, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Season.Get(ctx, id)
+					value, err = m.Client().WatchProgress.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1249,10 +10875,10 @@ func withSeasonID(id int) seasonOption {
 	}
 }
 
-// withSeason sets the old Season of the mutation.
-func withSeason(node *Season) seasonOption {
-	return func(m *SeasonMutation) {
-		m.oldValue = func(context.Context) (*Season, error) {
+// withWatchProgress sets the old WatchProgress of the mutation.
+func withWatchProgress(node *WatchProgress) watchprogressOption {
+	return func(m *WatchProgressMutation) {
+		m.oldValue = func(context.Context) (*WatchProgress, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1261,7 +10887,7 @@ func withSeason(node *Season) seasonOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SeasonMutation) Client() *Client {
+func (m WatchProgressMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1269,7 +10895,7 @@ func (m SeasonMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SeasonMutation) Tx() (*Tx, error) {
+func (m WatchProgressMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1280,7 +10906,7 @@ func (m SeasonMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SeasonMutation) ID() (id int, exists bool) {
+func (m *WatchProgressMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1291,7 +10917,7 @@ func (m *SeasonMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SeasonMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WatchProgressMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1300,690 +10926,871 @@ func (m *SeasonMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Season.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WatchProgress.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSeasonID sets the "season_id" field.
-func (m *SeasonMutation) SetSeasonID(s string) {
-	m.season_id = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *WatchProgressMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// SeasonID returns the value of the "season_id" field in the mutation.
-func (m *SeasonMutation) SeasonID() (r string, exists bool) {
-	v := m.season_id
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WatchProgressMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSeasonID returns the old "season_id" field's value of the Season entity.
-// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WatchProgress entity.
+// If the WatchProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeasonMutation) OldSeasonID(ctx context.Context) (v string, err error) {
+func (m *WatchProgressMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeasonID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeasonID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeasonID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.SeasonID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetSeasonID resets all changes to the "season_id" field.
-func (m *SeasonMutation) ResetSeasonID() {
-	m.season_id = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WatchProgressMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetSeasonTitle sets the "season_title" field.
-func (m *SeasonMutation) SetSeasonTitle(s string) {
-	m.season_title = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WatchProgressMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// SeasonTitle returns the value of the "season_title" field in the mutation.
-func (m *SeasonMutation) SeasonTitle() (r string, exists bool) {
-	v := m.season_title
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WatchProgressMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSeasonTitle returns the old "season_title" field's value of the Season entity.
-// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the WatchProgress entity.
+// If the WatchProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeasonMutation) OldSeasonTitle(ctx context.Context) (v string, err error) {
+func (m *WatchProgressMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeasonTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeasonTitle requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeasonTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.SeasonTitle, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetSeasonTitle resets all changes to the "season_title" field.
-func (m *SeasonMutation) ResetSeasonTitle() {
-	m.season_title = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WatchProgressMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetSeasonTitleYomi sets the "season_title_yomi" field.
-func (m *SeasonMutation) SetSeasonTitleYomi(s string) {
-	m.season_title_yomi = &s
+// SetPosition sets the "position" field.
+func (m *WatchProgressMutation) SetPosition(f float64) {
+	m.position = &f
+	m.addposition = nil
 }
 
-// SeasonTitleYomi returns the value of the "season_title_yomi" field in the mutation.
-func (m *SeasonMutation) SeasonTitleYomi() (r string, exists bool) {
-	v := m.season_title_yomi
+// Position returns the value of the "position" field in the mutation.
+func (m *WatchProgressMutation) Position() (r float64, exists bool) {
+	v := m.position
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSeasonTitleYomi returns the old "season_title_yomi" field's value of the Season entity.
-// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// OldPosition returns the old "position" field's value of the WatchProgress entity.
+// If the WatchProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeasonMutation) OldSeasonTitleYomi(ctx context.Context) (v string, err error) {
+func (m *WatchProgressMutation) OldPosition(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeasonTitleYomi is only allowed on UpdateOne operations")
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeasonTitleYomi requires an ID field in the mutation")
+		return v, errors.New("OldPosition requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeasonTitleYomi: %w", err)
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
 	}
-	return oldValue.SeasonTitleYomi, nil
+	return oldValue.Position, nil
 }
 
-// ClearSeasonTitleYomi clears the value of the "season_title_yomi" field.
-func (m *SeasonMutation) ClearSeasonTitleYomi() {
-	m.season_title_yomi = nil
-	m.clearedFields[season.FieldSeasonTitleYomi] = struct{}{}
+// AddPosition adds f to the "position" field.
+func (m *WatchProgressMutation) AddPosition(f float64) {
+	if m.addposition != nil {
+		*m.addposition += f
+	} else {
+		m.addposition = &f
+	}
 }
 
-// SeasonTitleYomiCleared returns if the "season_title_yomi" field was cleared in this mutation.
-func (m *SeasonMutation) SeasonTitleYomiCleared() bool {
-	_, ok := m.clearedFields[season.FieldSeasonTitleYomi]
-	return ok
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *WatchProgressMutation) AddedPosition() (r float64, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetSeasonTitleYomi resets all changes to the "season_title_yomi" field.
-func (m *SeasonMutation) ResetSeasonTitleYomi() {
-	m.season_title_yomi = nil
-	delete(m.clearedFields, season.FieldSeasonTitleYomi)
+// ResetPosition resets all changes to the "position" field.
+func (m *WatchProgressMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
 }
 
-// SetSeasonNumber sets the "season_number" field.
-func (m *SeasonMutation) SetSeasonNumber(i int) {
-	m.season_number = &i
-	m.addseason_number = nil
+// SetCompleted sets the "completed" field.
+func (m *WatchProgressMutation) SetCompleted(b bool) {
+	m.completed = &b
 }
 
-// SeasonNumber returns the value of the "season_number" field in the mutation.
-func (m *SeasonMutation) SeasonNumber() (r int, exists bool) {
-	v := m.season_number
+// Completed returns the value of the "completed" field in the mutation.
+func (m *WatchProgressMutation) Completed() (r bool, exists bool) {
+	v := m.completed
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSeasonNumber returns the old "season_number" field's value of the Season entity.
-// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// OldCompleted returns the old "completed" field's value of the WatchProgress entity.
+// If the WatchProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeasonMutation) OldSeasonNumber(ctx context.Context) (v int, err error) {
+func (m *WatchProgressMutation) OldCompleted(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeasonNumber is only allowed on UpdateOne operations")
+		return v, errors.New("OldCompleted is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeasonNumber requires an ID field in the mutation")
+		return v, errors.New("OldCompleted requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeasonNumber: %w", err)
+		return v, fmt.Errorf("querying old value for OldCompleted: %w", err)
 	}
-	return oldValue.SeasonNumber, nil
+	return oldValue.Completed, nil
 }
 
-// AddSeasonNumber adds i to the "season_number" field.
-func (m *SeasonMutation) AddSeasonNumber(i int) {
-	if m.addseason_number != nil {
-		*m.addseason_number += i
-	} else {
-		m.addseason_number = &i
-	}
+// ResetCompleted resets all changes to the "completed" field.
+func (m *WatchProgressMutation) ResetCompleted() {
+	m.completed = nil
 }
 
-// AddedSeasonNumber returns the value that was added to the "season_number" field in this mutation.
-func (m *SeasonMutation) AddedSeasonNumber() (r int, exists bool) {
-	v := m.addseason_number
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *WatchProgressMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// ResetSeasonNumber resets all changes to the "season_number" field.
-func (m *SeasonMutation) ResetSeasonNumber() {
-	m.season_number = nil
-	m.addseason_number = nil
+// ClearUser clears the "user" edge to the User entity.
+func (m *WatchProgressMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// SetShoboiTid sets the "shoboi_tid" field.
-func (m *SeasonMutation) SetShoboiTid(i int) {
-	m.shoboi_tid = &i
-	m.addshoboi_tid = nil
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *WatchProgressMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// ShoboiTid returns the value of the "shoboi_tid" field in the mutation.
-func (m *SeasonMutation) ShoboiTid() (r int, exists bool) {
-	v := m.shoboi_tid
-	if v == nil {
-		return
+// UserID returns the "user" edge ID in the mutation.
+func (m *WatchProgressMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	return *v, true
+	return
 }
 
-// OldShoboiTid returns the old "shoboi_tid" field's value of the Season entity.
-// If the Season object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeasonMutation) OldShoboiTid(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldShoboiTid is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldShoboiTid requires an ID field in the mutation")
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *WatchProgressMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldShoboiTid: %w", err)
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *WatchProgressMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by id.
+func (m *WatchProgressMutation) SetEpisodeID(id int) {
+	m.episode = &id
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (m *WatchProgressMutation) ClearEpisode() {
+	m.clearedepisode = true
+}
+
+// EpisodeCleared reports if the "episode" edge to the Episode entity was cleared.
+func (m *WatchProgressMutation) EpisodeCleared() bool {
+	return m.clearedepisode
+}
+
+// EpisodeID returns the "episode" edge ID in the mutation.
+func (m *WatchProgressMutation) EpisodeID() (id int, exists bool) {
+	if m.episode != nil {
+		return *m.episode, true
 	}
-	return oldValue.ShoboiTid, nil
+	return
 }
 
-// AddShoboiTid adds i to the "shoboi_tid" field.
-func (m *SeasonMutation) AddShoboiTid(i int) {
-	if m.addshoboi_tid != nil {
-		*m.addshoboi_tid += i
-	} else {
-		m.addshoboi_tid = &i
+// EpisodeIDs returns the "episode" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EpisodeID instead. It exists only for internal usage by the builders.
+func (m *WatchProgressMutation) EpisodeIDs() (ids []int) {
+	if id := m.episode; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// AddedShoboiTid returns the value that was added to the "shoboi_tid" field in this mutation.
-func (m *SeasonMutation) AddedShoboiTid() (r int, exists bool) {
-	v := m.addshoboi_tid
-	if v == nil {
-		return
+// ResetEpisode resets all changes to the "episode" edge.
+func (m *WatchProgressMutation) ResetEpisode() {
+	m.episode = nil
+	m.clearedepisode = false
+}
+
+// Where appends a list predicates to the WatchProgressMutation builder.
+func (m *WatchProgressMutation) Where(ps ...predicate.WatchProgress) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WatchProgressMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WatchProgressMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WatchProgress, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ClearShoboiTid clears the value of the "shoboi_tid" field.
-func (m *SeasonMutation) ClearShoboiTid() {
-	m.shoboi_tid = nil
-	m.addshoboi_tid = nil
-	m.clearedFields[season.FieldShoboiTid] = struct{}{}
+// Op returns the operation name.
+func (m *WatchProgressMutation) Op() Op {
+	return m.op
 }
 
-// ShoboiTidCleared returns if the "shoboi_tid" field was cleared in this mutation.
-func (m *SeasonMutation) ShoboiTidCleared() bool {
-	_, ok := m.clearedFields[season.FieldShoboiTid]
-	return ok
+// SetOp allows setting the mutation operation.
+func (m *WatchProgressMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WatchProgress).
+func (m *WatchProgressMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WatchProgressMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.created_at != nil {
+		fields = append(fields, watchprogress.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, watchprogress.FieldUpdatedAt)
+	}
+	if m.position != nil {
+		fields = append(fields, watchprogress.FieldPosition)
+	}
+	if m.completed != nil {
+		fields = append(fields, watchprogress.FieldCompleted)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WatchProgressMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case watchprogress.FieldCreatedAt:
+		return m.CreatedAt()
+	case watchprogress.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case watchprogress.FieldPosition:
+		return m.Position()
+	case watchprogress.FieldCompleted:
+		return m.Completed()
+	}
+	return nil, false
 }
 
-// ResetShoboiTid resets all changes to the "shoboi_tid" field.
-func (m *SeasonMutation) ResetShoboiTid() {
-	m.shoboi_tid = nil
-	m.addshoboi_tid = nil
-	delete(m.clearedFields, season.FieldShoboiTid)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WatchProgressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case watchprogress.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case watchprogress.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case watchprogress.FieldPosition:
+		return m.OldPosition(ctx)
+	case watchprogress.FieldCompleted:
+		return m.OldCompleted(ctx)
+	}
+	return nil, fmt.Errorf("unknown WatchProgress field %s", name)
 }
 
-// SetDescription sets the "description" field.
-func (m *SeasonMutation) SetDescription(s string) {
-	m.description = &s
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WatchProgressMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case watchprogress.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case watchprogress.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case watchprogress.FieldPosition:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case watchprogress.FieldCompleted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompleted(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WatchProgress field %s", name)
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *SeasonMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WatchProgressMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, watchprogress.FieldPosition)
 	}
-	return *v, true
+	return fields
 }
 
-// OldDescription returns the old "description" field's value of the Season entity.
-// If the Season object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeasonMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WatchProgressMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case watchprogress.FieldPosition:
+		return m.AddedPosition()
 	}
-	return oldValue.Description, nil
+	return nil, false
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *SeasonMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[season.FieldDescription] = struct{}{}
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WatchProgressMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case watchprogress.FieldPosition:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WatchProgress numeric field %s", name)
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *SeasonMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[season.FieldDescription]
-	return ok
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WatchProgressMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *SeasonMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, season.FieldDescription)
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WatchProgressMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetFirstYear sets the "first_year" field.
-func (m *SeasonMutation) SetFirstYear(i int) {
-	m.first_year = &i
-	m.addfirst_year = nil
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WatchProgressMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WatchProgress nullable field %s", name)
 }
 
-// FirstYear returns the value of the "first_year" field in the mutation.
-func (m *SeasonMutation) FirstYear() (r int, exists bool) {
-	v := m.first_year
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WatchProgressMutation) ResetField(name string) error {
+	switch name {
+	case watchprogress.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case watchprogress.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case watchprogress.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case watchprogress.FieldCompleted:
+		m.ResetCompleted()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown WatchProgress field %s", name)
 }
 
-// OldFirstYear returns the old "first_year" field's value of the Season entity.
-// If the Season object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeasonMutation) OldFirstYear(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstYear is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstYear requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WatchProgressMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, watchprogress.EdgeUser)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstYear: %w", err)
+	if m.episode != nil {
+		edges = append(edges, watchprogress.EdgeEpisode)
 	}
-	return oldValue.FirstYear, nil
+	return edges
 }
 
-// AddFirstYear adds i to the "first_year" field.
-func (m *SeasonMutation) AddFirstYear(i int) {
-	if m.addfirst_year != nil {
-		*m.addfirst_year += i
-	} else {
-		m.addfirst_year = &i
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WatchProgressMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case watchprogress.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case watchprogress.EdgeEpisode:
+		if id := m.episode; id != nil {
+			return []ent.Value{*id}
+		}
 	}
+	return nil
 }
 
-// AddedFirstYear returns the value that was added to the "first_year" field in this mutation.
-func (m *SeasonMutation) AddedFirstYear() (r int, exists bool) {
-	v := m.addfirst_year
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WatchProgressMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
 }
 
-// ClearFirstYear clears the value of the "first_year" field.
-func (m *SeasonMutation) ClearFirstYear() {
-	m.first_year = nil
-	m.addfirst_year = nil
-	m.clearedFields[season.FieldFirstYear] = struct{}{}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WatchProgressMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// FirstYearCleared returns if the "first_year" field was cleared in this mutation.
-func (m *SeasonMutation) FirstYearCleared() bool {
-	_, ok := m.clearedFields[season.FieldFirstYear]
-	return ok
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WatchProgressMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, watchprogress.EdgeUser)
+	}
+	if m.clearedepisode {
+		edges = append(edges, watchprogress.EdgeEpisode)
+	}
+	return edges
 }
 
-// ResetFirstYear resets all changes to the "first_year" field.
-func (m *SeasonMutation) ResetFirstYear() {
-	m.first_year = nil
-	m.addfirst_year = nil
-	delete(m.clearedFields, season.FieldFirstYear)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WatchProgressMutation) EdgeCleared(name string) bool {
+	switch name {
+	case watchprogress.EdgeUser:
+		return m.cleareduser
+	case watchprogress.EdgeEpisode:
+		return m.clearedepisode
+	}
+	return false
 }
 
-// SetFirstMonth sets the "first_month" field.
-func (m *SeasonMutation) SetFirstMonth(i int) {
-	m.first_month = &i
-	m.addfirst_month = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WatchProgressMutation) ClearEdge(name string) error {
+	switch name {
+	case watchprogress.EdgeUser:
+		m.ClearUser()
+		return nil
+	case watchprogress.EdgeEpisode:
+		m.ClearEpisode()
+		return nil
+	}
+	return fmt.Errorf("unknown WatchProgress unique edge %s", name)
 }
 
-// FirstMonth returns the value of the "first_month" field in the mutation.
-func (m *SeasonMutation) FirstMonth() (r int, exists bool) {
-	v := m.first_month
-	if v == nil {
-		return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WatchProgressMutation) ResetEdge(name string) error {
+	switch name {
+	case watchprogress.EdgeUser:
+		m.ResetUser()
+		return nil
+	case watchprogress.EdgeEpisode:
+		m.ResetEpisode()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown WatchProgress edge %s", name)
 }
 
-// OldFirstMonth returns the old "first_month" field's value of the Season entity.
-// If the Season object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeasonMutation) OldFirstMonth(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstMonth is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstMonth requires an ID field in the mutation")
+// WatchlistMutation represents an operation that mutates the Watchlist nodes in the graph.
+type WatchlistMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	name          *string
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	items         map[int]struct{}
+	removeditems  map[int]struct{}
+	cleareditems  bool
+	done          bool
+	oldValue      func(context.Context) (*Watchlist, error)
+	predicates    []predicate.Watchlist
+}
+
+var _ ent.Mutation = (*WatchlistMutation)(nil)
+
+// watchlistOption allows management of the mutation configuration using functional options.
+type watchlistOption func(*WatchlistMutation)
+
+// newWatchlistMutation creates new mutation for the Watchlist entity.
+func newWatchlistMutation(c config, op Op, opts ...watchlistOption) *WatchlistMutation {
+	m := &WatchlistMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWatchlist,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstMonth: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.FirstMonth, nil
+	return m
 }
 
-// AddFirstMonth adds i to the "first_month" field.
-func (m *SeasonMutation) AddFirstMonth(i int) {
-	if m.addfirst_month != nil {
-		*m.addfirst_month += i
-	} else {
-		m.addfirst_month = &i
+// withWatchlistID sets the ID field of the mutation.
+func withWatchlistID(id int) watchlistOption {
+	return func(m *WatchlistMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Watchlist
+		)
+		m.oldValue = func(ctx context.Context) (*Watchlist, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Watchlist.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// AddedFirstMonth returns the value that was added to the "first_month" field in this mutation.
-func (m *SeasonMutation) AddedFirstMonth() (r int, exists bool) {
-	v := m.addfirst_month
-	if v == nil {
-		return
+// withWatchlist sets the old Watchlist of the mutation.
+func withWatchlist(node *Watchlist) watchlistOption {
+	return func(m *WatchlistMutation) {
+		m.oldValue = func(context.Context) (*Watchlist, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// ClearFirstMonth clears the value of the "first_month" field.
-func (m *SeasonMutation) ClearFirstMonth() {
-	m.first_month = nil
-	m.addfirst_month = nil
-	m.clearedFields[season.FieldFirstMonth] = struct{}{}
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WatchlistMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WatchlistMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// FirstMonthCleared returns if the "first_month" field was cleared in this mutation.
-func (m *SeasonMutation) FirstMonthCleared() bool {
-	_, ok := m.clearedFields[season.FieldFirstMonth]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WatchlistMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetFirstMonth resets all changes to the "first_month" field.
-func (m *SeasonMutation) ResetFirstMonth() {
-	m.first_month = nil
-	m.addfirst_month = nil
-	delete(m.clearedFields, season.FieldFirstMonth)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WatchlistMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Watchlist.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetFirstEndYear sets the "first_end_year" field.
-func (m *SeasonMutation) SetFirstEndYear(i int) {
-	m.first_end_year = &i
-	m.addfirst_end_year = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *WatchlistMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// FirstEndYear returns the value of the "first_end_year" field in the mutation.
-func (m *SeasonMutation) FirstEndYear() (r int, exists bool) {
-	v := m.first_end_year
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WatchlistMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFirstEndYear returns the old "first_end_year" field's value of the Season entity.
-// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Watchlist entity.
+// If the Watchlist object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeasonMutation) OldFirstEndYear(ctx context.Context) (v int, err error) {
+func (m *WatchlistMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstEndYear is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstEndYear requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstEndYear: %w", err)
-	}
-	return oldValue.FirstEndYear, nil
-}
-
-// AddFirstEndYear adds i to the "first_end_year" field.
-func (m *SeasonMutation) AddFirstEndYear(i int) {
-	if m.addfirst_end_year != nil {
-		*m.addfirst_end_year += i
-	} else {
-		m.addfirst_end_year = &i
-	}
-}
-
-// AddedFirstEndYear returns the value that was added to the "first_end_year" field in this mutation.
-func (m *SeasonMutation) AddedFirstEndYear() (r int, exists bool) {
-	v := m.addfirst_end_year
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
-}
-
-// ClearFirstEndYear clears the value of the "first_end_year" field.
-func (m *SeasonMutation) ClearFirstEndYear() {
-	m.first_end_year = nil
-	m.addfirst_end_year = nil
-	m.clearedFields[season.FieldFirstEndYear] = struct{}{}
-}
-
-// FirstEndYearCleared returns if the "first_end_year" field was cleared in this mutation.
-func (m *SeasonMutation) FirstEndYearCleared() bool {
-	_, ok := m.clearedFields[season.FieldFirstEndYear]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetFirstEndYear resets all changes to the "first_end_year" field.
-func (m *SeasonMutation) ResetFirstEndYear() {
-	m.first_end_year = nil
-	m.addfirst_end_year = nil
-	delete(m.clearedFields, season.FieldFirstEndYear)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WatchlistMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetFirstEndMonth sets the "first_end_month" field.
-func (m *SeasonMutation) SetFirstEndMonth(i int) {
-	m.first_end_month = &i
-	m.addfirst_end_month = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WatchlistMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// FirstEndMonth returns the value of the "first_end_month" field in the mutation.
-func (m *SeasonMutation) FirstEndMonth() (r int, exists bool) {
-	v := m.first_end_month
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WatchlistMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFirstEndMonth returns the old "first_end_month" field's value of the Season entity.
-// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Watchlist entity.
+// If the Watchlist object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeasonMutation) OldFirstEndMonth(ctx context.Context) (v int, err error) {
+func (m *WatchlistMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstEndMonth is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstEndMonth requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstEndMonth: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.FirstEndMonth, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// AddFirstEndMonth adds i to the "first_end_month" field.
-func (m *SeasonMutation) AddFirstEndMonth(i int) {
-	if m.addfirst_end_month != nil {
-		*m.addfirst_end_month += i
-	} else {
-		m.addfirst_end_month = &i
-	}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WatchlistMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddedFirstEndMonth returns the value that was added to the "first_end_month" field in this mutation.
-func (m *SeasonMutation) AddedFirstEndMonth() (r int, exists bool) {
-	v := m.addfirst_end_month
+// SetName sets the "name" field.
+func (m *WatchlistMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WatchlistMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearFirstEndMonth clears the value of the "first_end_month" field.
-func (m *SeasonMutation) ClearFirstEndMonth() {
-	m.first_end_month = nil
-	m.addfirst_end_month = nil
-	m.clearedFields[season.FieldFirstEndMonth] = struct{}{}
-}
-
-// FirstEndMonthCleared returns if the "first_end_month" field was cleared in this mutation.
-func (m *SeasonMutation) FirstEndMonthCleared() bool {
-	_, ok := m.clearedFields[season.FieldFirstEndMonth]
-	return ok
+// OldName returns the old "name" field's value of the Watchlist entity.
+// If the Watchlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WatchlistMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// ResetFirstEndMonth resets all changes to the "first_end_month" field.
-func (m *SeasonMutation) ResetFirstEndMonth() {
-	m.first_end_month = nil
-	m.addfirst_end_month = nil
-	delete(m.clearedFields, season.FieldFirstEndMonth)
+// ResetName resets all changes to the "name" field.
+func (m *WatchlistMutation) ResetName() {
+	m.name = nil
 }
 
-// SetSeriesID sets the "series" edge to the Series entity by id.
-func (m *SeasonMutation) SetSeriesID(id int) {
-	m.series = &id
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *WatchlistMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// ClearSeries clears the "series" edge to the Series entity.
-func (m *SeasonMutation) ClearSeries() {
-	m.clearedseries = true
+// ClearUser clears the "user" edge to the User entity.
+func (m *WatchlistMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// SeriesCleared reports if the "series" edge to the Series entity was cleared.
-func (m *SeasonMutation) SeriesCleared() bool {
-	return m.clearedseries
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *WatchlistMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// SeriesID returns the "series" edge ID in the mutation.
-func (m *SeasonMutation) SeriesID() (id int, exists bool) {
-	if m.series != nil {
-		return *m.series, true
+// UserID returns the "user" edge ID in the mutation.
+func (m *WatchlistMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// SeriesIDs returns the "series" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SeriesID instead. It exists only for internal usage by the builders.
-func (m *SeasonMutation) SeriesIDs() (ids []int) {
-	if id := m.series; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *WatchlistMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSeries resets all changes to the "series" edge.
-func (m *SeasonMutation) ResetSeries() {
-	m.series = nil
-	m.clearedseries = false
+// ResetUser resets all changes to the "user" edge.
+func (m *WatchlistMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// AddEpisodeIDs adds the "episodes" edge to the Episode entity by ids.
-func (m *SeasonMutation) AddEpisodeIDs(ids ...int) {
-	if m.episodes == nil {
-		m.episodes = make(map[int]struct{})
+// AddItemIDs adds the "items" edge to the WatchlistItem entity by ids.
+func (m *WatchlistMutation) AddItemIDs(ids ...int) {
+	if m.items == nil {
+		m.items = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.episodes[ids[i]] = struct{}{}
+		m.items[ids[i]] = struct{}{}
 	}
 }
 
-// ClearEpisodes clears the "episodes" edge to the Episode entity.
-func (m *SeasonMutation) ClearEpisodes() {
-	m.clearedepisodes = true
+// ClearItems clears the "items" edge to the WatchlistItem entity.
+func (m *WatchlistMutation) ClearItems() {
+	m.cleareditems = true
 }
 
-// EpisodesCleared reports if the "episodes" edge to the Episode entity was cleared.
-func (m *SeasonMutation) EpisodesCleared() bool {
-	return m.clearedepisodes
+// ItemsCleared reports if the "items" edge to the WatchlistItem entity was cleared.
+func (m *WatchlistMutation) ItemsCleared() bool {
+	return m.cleareditems
 }
 
-// RemoveEpisodeIDs removes the "episodes" edge to the Episode entity by IDs.
-func (m *SeasonMutation) RemoveEpisodeIDs(ids ...int) {
-	if m.removedepisodes == nil {
-		m.removedepisodes = make(map[int]struct{})
+// RemoveItemIDs removes the "items" edge to the WatchlistItem entity by IDs.
+func (m *WatchlistMutation) RemoveItemIDs(ids ...int) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.episodes, ids[i])
-		m.removedepisodes[ids[i]] = struct{}{}
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedEpisodes returns the removed IDs of the "episodes" edge to the Episode entity.
-func (m *SeasonMutation) RemovedEpisodesIDs() (ids []int) {
-	for id := range m.removedepisodes {
+// RemovedItems returns the removed IDs of the "items" edge to the WatchlistItem entity.
+func (m *WatchlistMutation) RemovedItemsIDs() (ids []int) {
+	for id := range m.removeditems {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// EpisodesIDs returns the "episodes" edge IDs in the mutation.
-func (m *SeasonMutation) EpisodesIDs() (ids []int) {
-	for id := range m.episodes {
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *WatchlistMutation) ItemsIDs() (ids []int) {
+	for id := range m.items {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetEpisodes resets all changes to the "episodes" edge.
-func (m *SeasonMutation) ResetEpisodes() {
-	m.episodes = nil
-	m.clearedepisodes = false
-	m.removedepisodes = nil
+// ResetItems resets all changes to the "items" edge.
+func (m *WatchlistMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
 }
 
-// Where appends a list predicates to the SeasonMutation builder.
-func (m *SeasonMutation) Where(ps ...predicate.Season) {
+// Where appends a list predicates to the WatchlistMutation builder.
+func (m *WatchlistMutation) Where(ps ...predicate.Watchlist) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SeasonMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WatchlistMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SeasonMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Season, len(ps))
+func (m *WatchlistMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Watchlist, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1991,83 +11798,48 @@ func (m *SeasonMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SeasonMutation) Op() Op {
+func (m *WatchlistMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SeasonMutation) SetOp(op Op) {
+func (m *WatchlistMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Season).
-func (m *SeasonMutation) Type() string {
+// Type returns the node type of this mutation (Watchlist).
+func (m *WatchlistMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SeasonMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.season_id != nil {
-		fields = append(fields, season.FieldSeasonID)
-	}
-	if m.season_title != nil {
-		fields = append(fields, season.FieldSeasonTitle)
-	}
-	if m.season_title_yomi != nil {
-		fields = append(fields, season.FieldSeasonTitleYomi)
-	}
-	if m.season_number != nil {
-		fields = append(fields, season.FieldSeasonNumber)
-	}
-	if m.shoboi_tid != nil {
-		fields = append(fields, season.FieldShoboiTid)
-	}
-	if m.description != nil {
-		fields = append(fields, season.FieldDescription)
-	}
-	if m.first_year != nil {
-		fields = append(fields, season.FieldFirstYear)
-	}
-	if m.first_month != nil {
-		fields = append(fields, season.FieldFirstMonth)
+func (m *WatchlistMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.created_at != nil {
+		fields = append(fields, watchlist.FieldCreatedAt)
 	}
-	if m.first_end_year != nil {
-		fields = append(fields, season.FieldFirstEndYear)
+	if m.updated_at != nil {
+		fields = append(fields, watchlist.FieldUpdatedAt)
 	}
-	if m.first_end_month != nil {
-		fields = append(fields, season.FieldFirstEndMonth)
+	if m.name != nil {
+		fields = append(fields, watchlist.FieldName)
 	}
 	return fields
 }
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *SeasonMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case season.FieldSeasonID:
-		return m.SeasonID()
-	case season.FieldSeasonTitle:
-		return m.SeasonTitle()
-	case season.FieldSeasonTitleYomi:
-		return m.SeasonTitleYomi()
-	case season.FieldSeasonNumber:
-		return m.SeasonNumber()
-	case season.FieldShoboiTid:
-		return m.ShoboiTid()
-	case season.FieldDescription:
-		return m.Description()
-	case season.FieldFirstYear:
-		return m.FirstYear()
-	case season.FieldFirstMonth:
-		return m.FirstMonth()
-	case season.FieldFirstEndYear:
-		return m.FirstEndYear()
-	case season.FieldFirstEndMonth:
-		return m.FirstEndMonth()
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WatchlistMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case watchlist.FieldCreatedAt:
+		return m.CreatedAt()
+	case watchlist.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case watchlist.FieldName:
+		return m.Name()
 	}
 	return nil, false
 }
@@ -2075,333 +11847,129 @@ func (m *SeasonMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SeasonMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WatchlistMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case season.FieldSeasonID:
-		return m.OldSeasonID(ctx)
-	case season.FieldSeasonTitle:
-		return m.OldSeasonTitle(ctx)
-	case season.FieldSeasonTitleYomi:
-		return m.OldSeasonTitleYomi(ctx)
-	case season.FieldSeasonNumber:
-		return m.OldSeasonNumber(ctx)
-	case season.FieldShoboiTid:
-		return m.OldShoboiTid(ctx)
-	case season.FieldDescription:
-		return m.OldDescription(ctx)
-	case season.FieldFirstYear:
-		return m.OldFirstYear(ctx)
-	case season.FieldFirstMonth:
-		return m.OldFirstMonth(ctx)
-	case season.FieldFirstEndYear:
-		return m.OldFirstEndYear(ctx)
-	case season.FieldFirstEndMonth:
-		return m.OldFirstEndMonth(ctx)
+	case watchlist.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case watchlist.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case watchlist.FieldName:
+		return m.OldName(ctx)
 	}
-	return nil, fmt.Errorf("unknown Season field %s", name)
+	return nil, fmt.Errorf("unknown Watchlist field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SeasonMutation) SetField(name string, value ent.Value) error {
+func (m *WatchlistMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case season.FieldSeasonID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSeasonID(v)
-		return nil
-	case season.FieldSeasonTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSeasonTitle(v)
-		return nil
-	case season.FieldSeasonTitleYomi:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSeasonTitleYomi(v)
-		return nil
-	case season.FieldSeasonNumber:
-		v, ok := value.(int)
+	case watchlist.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSeasonNumber(v)
+		m.SetCreatedAt(v)
 		return nil
-	case season.FieldShoboiTid:
-		v, ok := value.(int)
+	case watchlist.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetShoboiTid(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case season.FieldDescription:
+	case watchlist.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
-		return nil
-	case season.FieldFirstYear:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFirstYear(v)
-		return nil
-	case season.FieldFirstMonth:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFirstMonth(v)
-		return nil
-	case season.FieldFirstEndYear:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFirstEndYear(v)
-		return nil
-	case season.FieldFirstEndMonth:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFirstEndMonth(v)
+		m.SetName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Season field %s", name)
+	return fmt.Errorf("unknown Watchlist field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SeasonMutation) AddedFields() []string {
-	var fields []string
-	if m.addseason_number != nil {
-		fields = append(fields, season.FieldSeasonNumber)
-	}
-	if m.addshoboi_tid != nil {
-		fields = append(fields, season.FieldShoboiTid)
-	}
-	if m.addfirst_year != nil {
-		fields = append(fields, season.FieldFirstYear)
-	}
-	if m.addfirst_month != nil {
-		fields = append(fields, season.FieldFirstMonth)
-	}
-	if m.addfirst_end_year != nil {
-		fields = append(fields, season.FieldFirstEndYear)
-	}
-	if m.addfirst_end_month != nil {
-		fields = append(fields, season.FieldFirstEndMonth)
-	}
-	return fields
+func (m *WatchlistMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SeasonMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case season.FieldSeasonNumber:
-		return m.AddedSeasonNumber()
-	case season.FieldShoboiTid:
-		return m.AddedShoboiTid()
-	case season.FieldFirstYear:
-		return m.AddedFirstYear()
-	case season.FieldFirstMonth:
-		return m.AddedFirstMonth()
-	case season.FieldFirstEndYear:
-		return m.AddedFirstEndYear()
-	case season.FieldFirstEndMonth:
-		return m.AddedFirstEndMonth()
-	}
+func (m *WatchlistMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SeasonMutation) AddField(name string, value ent.Value) error {
+func (m *WatchlistMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case season.FieldSeasonNumber:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSeasonNumber(v)
-		return nil
-	case season.FieldShoboiTid:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddShoboiTid(v)
-		return nil
-	case season.FieldFirstYear:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFirstYear(v)
-		return nil
-	case season.FieldFirstMonth:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFirstMonth(v)
-		return nil
-	case season.FieldFirstEndYear:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFirstEndYear(v)
-		return nil
-	case season.FieldFirstEndMonth:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFirstEndMonth(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Season numeric field %s", name)
+	return fmt.Errorf("unknown Watchlist numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SeasonMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(season.FieldSeasonTitleYomi) {
-		fields = append(fields, season.FieldSeasonTitleYomi)
-	}
-	if m.FieldCleared(season.FieldShoboiTid) {
-		fields = append(fields, season.FieldShoboiTid)
-	}
-	if m.FieldCleared(season.FieldDescription) {
-		fields = append(fields, season.FieldDescription)
-	}
-	if m.FieldCleared(season.FieldFirstYear) {
-		fields = append(fields, season.FieldFirstYear)
-	}
-	if m.FieldCleared(season.FieldFirstMonth) {
-		fields = append(fields, season.FieldFirstMonth)
-	}
-	if m.FieldCleared(season.FieldFirstEndYear) {
-		fields = append(fields, season.FieldFirstEndYear)
-	}
-	if m.FieldCleared(season.FieldFirstEndMonth) {
-		fields = append(fields, season.FieldFirstEndMonth)
-	}
-	return fields
+func (m *WatchlistMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *SeasonMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
-}
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *SeasonMutation) ClearField(name string) error {
-	switch name {
-	case season.FieldSeasonTitleYomi:
-		m.ClearSeasonTitleYomi()
-		return nil
-	case season.FieldShoboiTid:
-		m.ClearShoboiTid()
-		return nil
-	case season.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case season.FieldFirstYear:
-		m.ClearFirstYear()
-		return nil
-	case season.FieldFirstMonth:
-		m.ClearFirstMonth()
-		return nil
-	case season.FieldFirstEndYear:
-		m.ClearFirstEndYear()
-		return nil
-	case season.FieldFirstEndMonth:
-		m.ClearFirstEndMonth()
-		return nil
-	}
-	return fmt.Errorf("unknown Season nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *SeasonMutation) ResetField(name string) error {
-	switch name {
-	case season.FieldSeasonID:
-		m.ResetSeasonID()
-		return nil
-	case season.FieldSeasonTitle:
-		m.ResetSeasonTitle()
-		return nil
-	case season.FieldSeasonTitleYomi:
-		m.ResetSeasonTitleYomi()
-		return nil
-	case season.FieldSeasonNumber:
-		m.ResetSeasonNumber()
-		return nil
-	case season.FieldShoboiTid:
-		m.ResetShoboiTid()
-		return nil
-	case season.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case season.FieldFirstYear:
-		m.ResetFirstYear()
-		return nil
-	case season.FieldFirstMonth:
-		m.ResetFirstMonth()
+// cleared in this mutation.
+func (m *WatchlistMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WatchlistMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Watchlist nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WatchlistMutation) ResetField(name string) error {
+	switch name {
+	case watchlist.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case season.FieldFirstEndYear:
-		m.ResetFirstEndYear()
+	case watchlist.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case season.FieldFirstEndMonth:
-		m.ResetFirstEndMonth()
+	case watchlist.FieldName:
+		m.ResetName()
 		return nil
 	}
-	return fmt.Errorf("unknown Season field %s", name)
+	return fmt.Errorf("unknown Watchlist field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SeasonMutation) AddedEdges() []string {
+func (m *WatchlistMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.series != nil {
-		edges = append(edges, season.EdgeSeries)
+	if m.user != nil {
+		edges = append(edges, watchlist.EdgeUser)
 	}
-	if m.episodes != nil {
-		edges = append(edges, season.EdgeEpisodes)
+	if m.items != nil {
+		edges = append(edges, watchlist.EdgeItems)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SeasonMutation) AddedIDs(name string) []ent.Value {
+func (m *WatchlistMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case season.EdgeSeries:
-		if id := m.series; id != nil {
+	case watchlist.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case season.EdgeEpisodes:
-		ids := make([]ent.Value, 0, len(m.episodes))
-		for id := range m.episodes {
+	case watchlist.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2410,21 +11978,21 @@ func (m *SeasonMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SeasonMutation) RemovedEdges() []string {
+func (m *WatchlistMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedepisodes != nil {
-		edges = append(edges, season.EdgeEpisodes)
+	if m.removeditems != nil {
+		edges = append(edges, watchlist.EdgeItems)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SeasonMutation) RemovedIDs(name string) []ent.Value {
+func (m *WatchlistMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case season.EdgeEpisodes:
-		ids := make([]ent.Value, 0, len(m.removedepisodes))
-		for id := range m.removedepisodes {
+	case watchlist.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2433,85 +12001,85 @@ func (m *SeasonMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SeasonMutation) ClearedEdges() []string {
+func (m *WatchlistMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedseries {
-		edges = append(edges, season.EdgeSeries)
+	if m.cleareduser {
+		edges = append(edges, watchlist.EdgeUser)
 	}
-	if m.clearedepisodes {
-		edges = append(edges, season.EdgeEpisodes)
+	if m.cleareditems {
+		edges = append(edges, watchlist.EdgeItems)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SeasonMutation) EdgeCleared(name string) bool {
+func (m *WatchlistMutation) EdgeCleared(name string) bool {
 	switch name {
-	case season.EdgeSeries:
-		return m.clearedseries
-	case season.EdgeEpisodes:
-		return m.clearedepisodes
+	case watchlist.EdgeUser:
+		return m.cleareduser
+	case watchlist.EdgeItems:
+		return m.cleareditems
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SeasonMutation) ClearEdge(name string) error {
+func (m *WatchlistMutation) ClearEdge(name string) error {
 	switch name {
-	case season.EdgeSeries:
-		m.ClearSeries()
+	case watchlist.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Season unique edge %s", name)
+	return fmt.Errorf("unknown Watchlist unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SeasonMutation) ResetEdge(name string) error {
+func (m *WatchlistMutation) ResetEdge(name string) error {
 	switch name {
-	case season.EdgeSeries:
-		m.ResetSeries()
+	case watchlist.EdgeUser:
+		m.ResetUser()
 		return nil
-	case season.EdgeEpisodes:
-		m.ResetEpisodes()
+	case watchlist.EdgeItems:
+		m.ResetItems()
 		return nil
 	}
-	return fmt.Errorf("unknown Season edge %s", name)
+	return fmt.Errorf("unknown Watchlist edge %s", name)
 }
 
-// SeriesMutation represents an operation that mutates the Series nodes in the graph.
-type SeriesMutation struct {
+// WatchlistItemMutation represents an operation that mutates the WatchlistItem nodes in the graph.
+type WatchlistItemMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	series_id      *string
-	title          *string
-	title_yomi     *string
-	title_en       *string
-	description    *string
-	clearedFields  map[string]struct{}
-	seasons        map[int]struct{}
-	removedseasons map[int]struct{}
-	clearedseasons bool
-	done           bool
-	oldValue       func(context.Context) (*Series, error)
-	predicates     []predicate.Series
-}
-
-var _ ent.Mutation = (*SeriesMutation)(nil)
-
-// seriesOption allows management of the mutation configuration using functional options.
-type seriesOption func(*SeriesMutation)
-
-// newSeriesMutation creates new mutation for the Series entity.
-func newSeriesMutation(c config, op Op, opts ...seriesOption) *SeriesMutation {
-	m := &SeriesMutation{
+	op               Op
+	typ              string
+	id               *int
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	watchlist        *int
+	clearedwatchlist bool
+	series           *int
+	clearedseries    bool
+	season           *int
+	clearedseason    bool
+	done             bool
+	oldValue         func(context.Context) (*WatchlistItem, error)
+	predicates       []predicate.WatchlistItem
+}
+
+var _ ent.Mutation = (*WatchlistItemMutation)(nil)
+
+// watchlistitemOption allows management of the mutation configuration using functional options.
+type watchlistitemOption func(*WatchlistItemMutation)
+
+// newWatchlistItemMutation creates new mutation for the WatchlistItem entity.
+func newWatchlistItemMutation(c config, op Op, opts ...watchlistitemOption) *WatchlistItemMutation {
+	m := &WatchlistItemMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSeries,
+		typ:           TypeWatchlistItem,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2520,20 +12088,20 @@ func newSeriesMutation(c config, op Op, opts ...seriesOption) *SeriesMutation {
 	return m
 }
 
-// withSeriesID sets the ID field of the mutation.
-func withSeriesID(id int) seriesOption {
-	return func(m *SeriesMutation) {
+// withWatchlistItemID sets the ID field of the mutation.
+func withWatchlistItemID(id int) watchlistitemOption {
+	return func(m *WatchlistItemMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Series
+			value *WatchlistItem
 		)
-		m.oldValue = func(ctx context.Context) (*Series, error) {
+		m.oldValue = func(ctx context.Context) (*WatchlistItem, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Series.Get(ctx, id)
+					value, err = m.Client().WatchlistItem.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2542,10 +12110,10 @@ func withSeriesID(id int) seriesOption {
 	}
 }
 
-// withSeries sets the old Series of the mutation.
-func withSeries(node *Series) seriesOption {
-	return func(m *SeriesMutation) {
-		m.oldValue = func(context.Context) (*Series, error) {
+// withWatchlistItem sets the old WatchlistItem of the mutation.
+func withWatchlistItem(node *WatchlistItem) watchlistitemOption {
+	return func(m *WatchlistItemMutation) {
+		m.oldValue = func(context.Context) (*WatchlistItem, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2554,7 +12122,7 @@ func withSeries(node *Series) seriesOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SeriesMutation) Client() *Client {
+func (m WatchlistItemMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2562,7 +12130,7 @@ func (m SeriesMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SeriesMutation) Tx() (*Tx, error) {
+func (m WatchlistItemMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2573,7 +12141,7 @@ func (m SeriesMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SeriesMutation) ID() (id int, exists bool) {
+func (m *WatchlistItemMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2584,7 +12152,7 @@ func (m *SeriesMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SeriesMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WatchlistItemMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2593,294 +12161,210 @@ func (m *SeriesMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Series.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WatchlistItem.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSeriesID sets the "series_id" field.
-func (m *SeriesMutation) SetSeriesID(s string) {
-	m.series_id = &s
-}
-
-// SeriesID returns the value of the "series_id" field in the mutation.
-func (m *SeriesMutation) SeriesID() (r string, exists bool) {
-	v := m.series_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSeriesID returns the old "series_id" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldSeriesID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeriesID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeriesID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeriesID: %w", err)
-	}
-	return oldValue.SeriesID, nil
-}
-
-// ResetSeriesID resets all changes to the "series_id" field.
-func (m *SeriesMutation) ResetSeriesID() {
-	m.series_id = nil
-}
-
-// SetTitle sets the "title" field.
-func (m *SeriesMutation) SetTitle(s string) {
-	m.title = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *WatchlistItemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *SeriesMutation) Title() (r string, exists bool) {
-	v := m.title
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WatchlistItemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *WatchlistItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *SeriesMutation) ResetTitle() {
-	m.title = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WatchlistItemMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetTitleYomi sets the "title_yomi" field.
-func (m *SeriesMutation) SetTitleYomi(s string) {
-	m.title_yomi = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WatchlistItemMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// TitleYomi returns the value of the "title_yomi" field in the mutation.
-func (m *SeriesMutation) TitleYomi() (r string, exists bool) {
-	v := m.title_yomi
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WatchlistItemMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitleYomi returns the old "title_yomi" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldTitleYomi(ctx context.Context) (v string, err error) {
+func (m *WatchlistItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitleYomi is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitleYomi requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitleYomi: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.TitleYomi, nil
-}
-
-// ClearTitleYomi clears the value of the "title_yomi" field.
-func (m *SeriesMutation) ClearTitleYomi() {
-	m.title_yomi = nil
-	m.clearedFields[series.FieldTitleYomi] = struct{}{}
+	return oldValue.UpdatedAt, nil
 }
 
-// TitleYomiCleared returns if the "title_yomi" field was cleared in this mutation.
-func (m *SeriesMutation) TitleYomiCleared() bool {
-	_, ok := m.clearedFields[series.FieldTitleYomi]
-	return ok
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WatchlistItemMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ResetTitleYomi resets all changes to the "title_yomi" field.
-func (m *SeriesMutation) ResetTitleYomi() {
-	m.title_yomi = nil
-	delete(m.clearedFields, series.FieldTitleYomi)
+// SetWatchlistID sets the "watchlist" edge to the Watchlist entity by id.
+func (m *WatchlistItemMutation) SetWatchlistID(id int) {
+	m.watchlist = &id
 }
 
-// SetTitleEn sets the "title_en" field.
-func (m *SeriesMutation) SetTitleEn(s string) {
-	m.title_en = &s
+// ClearWatchlist clears the "watchlist" edge to the Watchlist entity.
+func (m *WatchlistItemMutation) ClearWatchlist() {
+	m.clearedwatchlist = true
 }
 
-// TitleEn returns the value of the "title_en" field in the mutation.
-func (m *SeriesMutation) TitleEn() (r string, exists bool) {
-	v := m.title_en
-	if v == nil {
-		return
-	}
-	return *v, true
+// WatchlistCleared reports if the "watchlist" edge to the Watchlist entity was cleared.
+func (m *WatchlistItemMutation) WatchlistCleared() bool {
+	return m.clearedwatchlist
 }
 
-// OldTitleEn returns the old "title_en" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldTitleEn(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitleEn is only allowed on UpdateOne operations")
+// WatchlistID returns the "watchlist" edge ID in the mutation.
+func (m *WatchlistItemMutation) WatchlistID() (id int, exists bool) {
+	if m.watchlist != nil {
+		return *m.watchlist, true
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitleEn requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitleEn: %w", err)
-	}
-	return oldValue.TitleEn, nil
-}
-
-// ClearTitleEn clears the value of the "title_en" field.
-func (m *SeriesMutation) ClearTitleEn() {
-	m.title_en = nil
-	m.clearedFields[series.FieldTitleEn] = struct{}{}
-}
-
-// TitleEnCleared returns if the "title_en" field was cleared in this mutation.
-func (m *SeriesMutation) TitleEnCleared() bool {
-	_, ok := m.clearedFields[series.FieldTitleEn]
-	return ok
-}
-
-// ResetTitleEn resets all changes to the "title_en" field.
-func (m *SeriesMutation) ResetTitleEn() {
-	m.title_en = nil
-	delete(m.clearedFields, series.FieldTitleEn)
-}
-
-// SetDescription sets the "description" field.
-func (m *SeriesMutation) SetDescription(s string) {
-	m.description = &s
+	return
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *SeriesMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
+// WatchlistIDs returns the "watchlist" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WatchlistID instead. It exists only for internal usage by the builders.
+func (m *WatchlistItemMutation) WatchlistIDs() (ids []int) {
+	if id := m.watchlist; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// OldDescription returns the old "description" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
-	}
-	return oldValue.Description, nil
+// ResetWatchlist resets all changes to the "watchlist" edge.
+func (m *WatchlistItemMutation) ResetWatchlist() {
+	m.watchlist = nil
+	m.clearedwatchlist = false
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *SeriesMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[series.FieldDescription] = struct{}{}
+// SetSeriesID sets the "series" edge to the Series entity by id.
+func (m *WatchlistItemMutation) SetSeriesID(id int) {
+	m.series = &id
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *SeriesMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[series.FieldDescription]
-	return ok
+// ClearSeries clears the "series" edge to the Series entity.
+func (m *WatchlistItemMutation) ClearSeries() {
+	m.clearedseries = true
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *SeriesMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, series.FieldDescription)
+// SeriesCleared reports if the "series" edge to the Series entity was cleared.
+func (m *WatchlistItemMutation) SeriesCleared() bool {
+	return m.clearedseries
 }
 
-// AddSeasonIDs adds the "seasons" edge to the Season entity by ids.
-func (m *SeriesMutation) AddSeasonIDs(ids ...int) {
-	if m.seasons == nil {
-		m.seasons = make(map[int]struct{})
+// SeriesID returns the "series" edge ID in the mutation.
+func (m *WatchlistItemMutation) SeriesID() (id int, exists bool) {
+	if m.series != nil {
+		return *m.series, true
 	}
-	for i := range ids {
-		m.seasons[ids[i]] = struct{}{}
+	return
+}
+
+// SeriesIDs returns the "series" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SeriesID instead. It exists only for internal usage by the builders.
+func (m *WatchlistItemMutation) SeriesIDs() (ids []int) {
+	if id := m.series; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// ClearSeasons clears the "seasons" edge to the Season entity.
-func (m *SeriesMutation) ClearSeasons() {
-	m.clearedseasons = true
+// ResetSeries resets all changes to the "series" edge.
+func (m *WatchlistItemMutation) ResetSeries() {
+	m.series = nil
+	m.clearedseries = false
 }
 
-// SeasonsCleared reports if the "seasons" edge to the Season entity was cleared.
-func (m *SeriesMutation) SeasonsCleared() bool {
-	return m.clearedseasons
+// SetSeasonID sets the "season" edge to the Season entity by id.
+func (m *WatchlistItemMutation) SetSeasonID(id int) {
+	m.season = &id
 }
 
-// RemoveSeasonIDs removes the "seasons" edge to the Season entity by IDs.
-func (m *SeriesMutation) RemoveSeasonIDs(ids ...int) {
-	if m.removedseasons == nil {
-		m.removedseasons = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.seasons, ids[i])
-		m.removedseasons[ids[i]] = struct{}{}
-	}
+// ClearSeason clears the "season" edge to the Season entity.
+func (m *WatchlistItemMutation) ClearSeason() {
+	m.clearedseason = true
 }
 
-// RemovedSeasons returns the removed IDs of the "seasons" edge to the Season entity.
-func (m *SeriesMutation) RemovedSeasonsIDs() (ids []int) {
-	for id := range m.removedseasons {
-		ids = append(ids, id)
+// SeasonCleared reports if the "season" edge to the Season entity was cleared.
+func (m *WatchlistItemMutation) SeasonCleared() bool {
+	return m.clearedseason
+}
+
+// SeasonID returns the "season" edge ID in the mutation.
+func (m *WatchlistItemMutation) SeasonID() (id int, exists bool) {
+	if m.season != nil {
+		return *m.season, true
 	}
 	return
 }
 
-// SeasonsIDs returns the "seasons" edge IDs in the mutation.
-func (m *SeriesMutation) SeasonsIDs() (ids []int) {
-	for id := range m.seasons {
-		ids = append(ids, id)
+// SeasonIDs returns the "season" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SeasonID instead. It exists only for internal usage by the builders.
+func (m *WatchlistItemMutation) SeasonIDs() (ids []int) {
+	if id := m.season; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSeasons resets all changes to the "seasons" edge.
-func (m *SeriesMutation) ResetSeasons() {
-	m.seasons = nil
-	m.clearedseasons = false
-	m.removedseasons = nil
+// ResetSeason resets all changes to the "season" edge.
+func (m *WatchlistItemMutation) ResetSeason() {
+	m.season = nil
+	m.clearedseason = false
 }
 
-// Where appends a list predicates to the SeriesMutation builder.
-func (m *SeriesMutation) Where(ps ...predicate.Series) {
+// Where appends a list predicates to the WatchlistItemMutation builder.
+func (m *WatchlistItemMutation) Where(ps ...predicate.WatchlistItem) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SeriesMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WatchlistItemMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SeriesMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Series, len(ps))
+func (m *WatchlistItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WatchlistItem, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2888,39 +12372,30 @@ func (m *SeriesMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SeriesMutation) Op() Op {
+func (m *WatchlistItemMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SeriesMutation) SetOp(op Op) {
+func (m *WatchlistItemMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Series).
-func (m *SeriesMutation) Type() string {
+// Type returns the node type of this mutation (WatchlistItem).
+func (m *WatchlistItemMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SeriesMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.series_id != nil {
-		fields = append(fields, series.FieldSeriesID)
-	}
-	if m.title != nil {
-		fields = append(fields, series.FieldTitle)
-	}
-	if m.title_yomi != nil {
-		fields = append(fields, series.FieldTitleYomi)
+func (m *WatchlistItemMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.created_at != nil {
+		fields = append(fields, watchlistitem.FieldCreatedAt)
 	}
-	if m.title_en != nil {
-		fields = append(fields, series.FieldTitleEn)
-	}
-	if m.description != nil {
-		fields = append(fields, series.FieldDescription)
+	if m.updated_at != nil {
+		fields = append(fields, watchlistitem.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2928,18 +12403,12 @@ func (m *SeriesMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SeriesMutation) Field(name string) (ent.Value, bool) {
+func (m *WatchlistItemMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case series.FieldSeriesID:
-		return m.SeriesID()
-	case series.FieldTitle:
-		return m.Title()
-	case series.FieldTitleYomi:
-		return m.TitleYomi()
-	case series.FieldTitleEn:
-		return m.TitleEn()
-	case series.FieldDescription:
-		return m.Description()
+	case watchlistitem.FieldCreatedAt:
+		return m.CreatedAt()
+	case watchlistitem.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2947,231 +12416,200 @@ func (m *SeriesMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SeriesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WatchlistItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case series.FieldSeriesID:
-		return m.OldSeriesID(ctx)
-	case series.FieldTitle:
-		return m.OldTitle(ctx)
-	case series.FieldTitleYomi:
-		return m.OldTitleYomi(ctx)
-	case series.FieldTitleEn:
-		return m.OldTitleEn(ctx)
-	case series.FieldDescription:
-		return m.OldDescription(ctx)
+	case watchlistitem.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case watchlistitem.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Series field %s", name)
+	return nil, fmt.Errorf("unknown WatchlistItem field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SeriesMutation) SetField(name string, value ent.Value) error {
+func (m *WatchlistItemMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case series.FieldSeriesID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSeriesID(v)
-		return nil
-	case series.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case series.FieldTitleYomi:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitleYomi(v)
-		return nil
-	case series.FieldTitleEn:
-		v, ok := value.(string)
+	case watchlistitem.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitleEn(v)
+		m.SetCreatedAt(v)
 		return nil
-	case series.FieldDescription:
-		v, ok := value.(string)
+	case watchlistitem.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Series field %s", name)
+	return fmt.Errorf("unknown WatchlistItem field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SeriesMutation) AddedFields() []string {
+func (m *WatchlistItemMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SeriesMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WatchlistItemMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SeriesMutation) AddField(name string, value ent.Value) error {
+func (m *WatchlistItemMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Series numeric field %s", name)
+	return fmt.Errorf("unknown WatchlistItem numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SeriesMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(series.FieldTitleYomi) {
-		fields = append(fields, series.FieldTitleYomi)
-	}
-	if m.FieldCleared(series.FieldTitleEn) {
-		fields = append(fields, series.FieldTitleEn)
-	}
-	if m.FieldCleared(series.FieldDescription) {
-		fields = append(fields, series.FieldDescription)
-	}
-	return fields
+func (m *WatchlistItemMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SeriesMutation) FieldCleared(name string) bool {
+func (m *WatchlistItemMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SeriesMutation) ClearField(name string) error {
-	switch name {
-	case series.FieldTitleYomi:
-		m.ClearTitleYomi()
-		return nil
-	case series.FieldTitleEn:
-		m.ClearTitleEn()
-		return nil
-	case series.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown Series nullable field %s", name)
+func (m *WatchlistItemMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WatchlistItem nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SeriesMutation) ResetField(name string) error {
+func (m *WatchlistItemMutation) ResetField(name string) error {
 	switch name {
-	case series.FieldSeriesID:
-		m.ResetSeriesID()
-		return nil
-	case series.FieldTitle:
-		m.ResetTitle()
+	case watchlistitem.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case series.FieldTitleYomi:
-		m.ResetTitleYomi()
-		return nil
-	case series.FieldTitleEn:
-		m.ResetTitleEn()
-		return nil
-	case series.FieldDescription:
-		m.ResetDescription()
+	case watchlistitem.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Series field %s", name)
+	return fmt.Errorf("unknown WatchlistItem field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SeriesMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.seasons != nil {
-		edges = append(edges, series.EdgeSeasons)
+func (m *WatchlistItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.watchlist != nil {
+		edges = append(edges, watchlistitem.EdgeWatchlist)
+	}
+	if m.series != nil {
+		edges = append(edges, watchlistitem.EdgeSeries)
+	}
+	if m.season != nil {
+		edges = append(edges, watchlistitem.EdgeSeason)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SeriesMutation) AddedIDs(name string) []ent.Value {
+func (m *WatchlistItemMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case series.EdgeSeasons:
-		ids := make([]ent.Value, 0, len(m.seasons))
-		for id := range m.seasons {
-			ids = append(ids, id)
+	case watchlistitem.EdgeWatchlist:
+		if id := m.watchlist; id != nil {
+			return []ent.Value{*id}
+		}
+	case watchlistitem.EdgeSeries:
+		if id := m.series; id != nil {
+			return []ent.Value{*id}
+		}
+	case watchlistitem.EdgeSeason:
+		if id := m.season; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SeriesMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedseasons != nil {
-		edges = append(edges, series.EdgeSeasons)
-	}
+func (m *WatchlistItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SeriesMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case series.EdgeSeasons:
-		ids := make([]ent.Value, 0, len(m.removedseasons))
-		for id := range m.removedseasons {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WatchlistItemMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SeriesMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedseasons {
-		edges = append(edges, series.EdgeSeasons)
+func (m *WatchlistItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedwatchlist {
+		edges = append(edges, watchlistitem.EdgeWatchlist)
+	}
+	if m.clearedseries {
+		edges = append(edges, watchlistitem.EdgeSeries)
+	}
+	if m.clearedseason {
+		edges = append(edges, watchlistitem.EdgeSeason)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SeriesMutation) EdgeCleared(name string) bool {
+func (m *WatchlistItemMutation) EdgeCleared(name string) bool {
 	switch name {
-	case series.EdgeSeasons:
-		return m.clearedseasons
+	case watchlistitem.EdgeWatchlist:
+		return m.clearedwatchlist
+	case watchlistitem.EdgeSeries:
+		return m.clearedseries
+	case watchlistitem.EdgeSeason:
+		return m.clearedseason
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SeriesMutation) ClearEdge(name string) error {
+func (m *WatchlistItemMutation) ClearEdge(name string) error {
 	switch name {
+	case watchlistitem.EdgeWatchlist:
+		m.ClearWatchlist()
+		return nil
+	case watchlistitem.EdgeSeries:
+		m.ClearSeries()
+		return nil
+	case watchlistitem.EdgeSeason:
+		m.ClearSeason()
+		return nil
 	}
-	return fmt.Errorf("unknown Series unique edge %s", name)
+	return fmt.Errorf("unknown WatchlistItem unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SeriesMutation) ResetEdge(name string) error {
+func (m *WatchlistItemMutation) ResetEdge(name string) error {
 	switch name {
-	case series.EdgeSeasons:
-		m.ResetSeasons()
+	case watchlistitem.EdgeWatchlist:
+		m.ResetWatchlist()
+		return nil
+	case watchlistitem.EdgeSeries:
+		m.ResetSeries()
+		return nil
+	case watchlistitem.EdgeSeason:
+		m.ResetSeason()
 		return nil
 	}
-	return fmt.Errorf("unknown Series edge %s", name)
+	return fmt.Errorf("unknown WatchlistItem edge %s", name)
 }
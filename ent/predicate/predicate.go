@@ -6,11 +6,44 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
+// AudioTrack is the predicate function for audiotrack builders.
+type AudioTrack func(*sql.Selector)
+
+// Chapter is the predicate function for chapter builders.
+type Chapter func(*sql.Selector)
+
 // Episode is the predicate function for episode builders.
 type Episode func(*sql.Selector)
 
+// Favorite is the predicate function for favorite builders.
+type Favorite func(*sql.Selector)
+
+// Rating is the predicate function for rating builders.
+type Rating func(*sql.Selector)
+
+// Rendition is the predicate function for rendition builders.
+type Rendition func(*sql.Selector)
+
 // Season is the predicate function for season builders.
 type Season func(*sql.Selector)
 
 // Series is the predicate function for series builders.
 type Series func(*sql.Selector)
+
+// Subtitle is the predicate function for subtitle builders.
+type Subtitle func(*sql.Selector)
+
+// User is the predicate function for user builders.
+type User func(*sql.Selector)
+
+// WatchProgress is the predicate function for watchprogress builders.
+type WatchProgress func(*sql.Selector)
+
+// Watchlist is the predicate function for watchlist builders.
+type Watchlist func(*sql.Selector)
+
+// WatchlistItem is the predicate function for watchlistitem builders.
+type WatchlistItem func(*sql.Selector)
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/clustlight/animatrix-api/ent/rating"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/user"
+)
+
+// Rating is the model entity for the Rating schema.
+type Rating struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Score holds the value of the "score" field.
+	Score int `json:"score,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RatingQuery when eager-loading is set.
+	Edges          RatingEdges `json:"edges"`
+	season_ratings *int
+	series_ratings *int
+	user_ratings   *int
+	selectValues   sql.SelectValues
+}
+
+// RatingEdges holds the relations/edges for other nodes in the graph.
+type RatingEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Series holds the value of the series edge.
+	Series *Series `json:"series,omitempty"`
+	// Season holds the value of the season edge.
+	Season *Season `json:"season,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RatingEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// SeriesOrErr returns the Series value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RatingEdges) SeriesOrErr() (*Series, error) {
+	if e.Series != nil {
+		return e.Series, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: series.Label}
+	}
+	return nil, &NotLoadedError{edge: "series"}
+}
+
+// SeasonOrErr returns the Season value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RatingEdges) SeasonOrErr() (*Season, error) {
+	if e.Season != nil {
+		return e.Season, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: season.Label}
+	}
+	return nil, &NotLoadedError{edge: "season"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Rating) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rating.FieldID, rating.FieldScore:
+			values[i] = new(sql.NullInt64)
+		case rating.FieldCreatedAt, rating.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case rating.ForeignKeys[0]: // season_ratings
+			values[i] = new(sql.NullInt64)
+		case rating.ForeignKeys[1]: // series_ratings
+			values[i] = new(sql.NullInt64)
+		case rating.ForeignKeys[2]: // user_ratings
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Rating fields.
+func (r *Rating) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rating.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case rating.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case rating.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				r.UpdatedAt = value.Time
+			}
+		case rating.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				r.Score = int(value.Int64)
+			}
+		case rating.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field season_ratings", value)
+			} else if value.Valid {
+				r.season_ratings = new(int)
+				*r.season_ratings = int(value.Int64)
+			}
+		case rating.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field series_ratings", value)
+			} else if value.Valid {
+				r.series_ratings = new(int)
+				*r.series_ratings = int(value.Int64)
+			}
+		case rating.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_ratings", value)
+			} else if value.Valid {
+				r.user_ratings = new(int)
+				*r.user_ratings = int(value.Int64)
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Rating.
+// This includes values selected through modifiers, order, etc.
+func (r *Rating) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Rating entity.
+func (r *Rating) QueryUser() *UserQuery {
+	return NewRatingClient(r.config).QueryUser(r)
+}
+
+// QuerySeries queries the "series" edge of the Rating entity.
+func (r *Rating) QuerySeries() *SeriesQuery {
+	return NewRatingClient(r.config).QuerySeries(r)
+}
+
+// QuerySeason queries the "season" edge of the Rating entity.
+func (r *Rating) QuerySeason() *SeasonQuery {
+	return NewRatingClient(r.config).QuerySeason(r)
+}
+
+// Update returns a builder for updating this Rating.
+// Note that you need to call Rating.Unwrap() before calling this method if this Rating
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Rating) Update() *RatingUpdateOne {
+	return NewRatingClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Rating entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Rating) Unwrap() *Rating {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Rating is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Rating) String() string {
+	var builder strings.Builder
+	builder.WriteString("Rating(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(r.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", r.Score))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Ratings is a parsable slice of Rating.
+type Ratings []*Rating
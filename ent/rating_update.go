@@ -0,0 +1,609 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/rating"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/user"
+)
+
+// RatingUpdate is the builder for updating Rating entities.
+type RatingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RatingMutation
+}
+
+// Where appends a list predicates to the RatingUpdate builder.
+func (ru *RatingUpdate) Where(ps ...predicate.Rating) *RatingUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *RatingUpdate) SetUpdatedAt(t time.Time) *RatingUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// SetScore sets the "score" field.
+func (ru *RatingUpdate) SetScore(i int) *RatingUpdate {
+	ru.mutation.ResetScore()
+	ru.mutation.SetScore(i)
+	return ru
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (ru *RatingUpdate) SetNillableScore(i *int) *RatingUpdate {
+	if i != nil {
+		ru.SetScore(*i)
+	}
+	return ru
+}
+
+// AddScore adds i to the "score" field.
+func (ru *RatingUpdate) AddScore(i int) *RatingUpdate {
+	ru.mutation.AddScore(i)
+	return ru
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ru *RatingUpdate) SetUserID(id int) *RatingUpdate {
+	ru.mutation.SetUserID(id)
+	return ru
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ru *RatingUpdate) SetUser(u *User) *RatingUpdate {
+	return ru.SetUserID(u.ID)
+}
+
+// SetSeriesID sets the "series" edge to the Series entity by ID.
+func (ru *RatingUpdate) SetSeriesID(id int) *RatingUpdate {
+	ru.mutation.SetSeriesID(id)
+	return ru
+}
+
+// SetNillableSeriesID sets the "series" edge to the Series entity by ID if the given value is not nil.
+func (ru *RatingUpdate) SetNillableSeriesID(id *int) *RatingUpdate {
+	if id != nil {
+		ru = ru.SetSeriesID(*id)
+	}
+	return ru
+}
+
+// SetSeries sets the "series" edge to the Series entity.
+func (ru *RatingUpdate) SetSeries(s *Series) *RatingUpdate {
+	return ru.SetSeriesID(s.ID)
+}
+
+// SetSeasonID sets the "season" edge to the Season entity by ID.
+func (ru *RatingUpdate) SetSeasonID(id int) *RatingUpdate {
+	ru.mutation.SetSeasonID(id)
+	return ru
+}
+
+// SetNillableSeasonID sets the "season" edge to the Season entity by ID if the given value is not nil.
+func (ru *RatingUpdate) SetNillableSeasonID(id *int) *RatingUpdate {
+	if id != nil {
+		ru = ru.SetSeasonID(*id)
+	}
+	return ru
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (ru *RatingUpdate) SetSeason(s *Season) *RatingUpdate {
+	return ru.SetSeasonID(s.ID)
+}
+
+// Mutation returns the RatingMutation object of the builder.
+func (ru *RatingUpdate) Mutation() *RatingMutation {
+	return ru.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ru *RatingUpdate) ClearUser() *RatingUpdate {
+	ru.mutation.ClearUser()
+	return ru
+}
+
+// ClearSeries clears the "series" edge to the Series entity.
+func (ru *RatingUpdate) ClearSeries() *RatingUpdate {
+	ru.mutation.ClearSeries()
+	return ru
+}
+
+// ClearSeason clears the "season" edge to the Season entity.
+func (ru *RatingUpdate) ClearSeason() *RatingUpdate {
+	ru.mutation.ClearSeason()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *RatingUpdate) Save(ctx context.Context) (int, error) {
+	ru.defaults()
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *RatingUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *RatingUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *RatingUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ru *RatingUpdate) defaults() {
+	if _, ok := ru.mutation.UpdatedAt(); !ok {
+		v := rating.UpdateDefaultUpdatedAt()
+		ru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *RatingUpdate) check() error {
+	if v, ok := ru.mutation.Score(); ok {
+		if err := rating.ScoreValidator(v); err != nil {
+			return &ValidationError{Name: "score", err: fmt.Errorf(`ent: validator failed for field "Rating.score": %w`, err)}
+		}
+	}
+	if ru.mutation.UserCleared() && len(ru.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Rating.user"`)
+	}
+	return nil
+}
+
+func (ru *RatingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rating.Table, rating.Columns, sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.SetField(rating.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.Score(); ok {
+		_spec.SetField(rating.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedScore(); ok {
+		_spec.AddField(rating.FieldScore, field.TypeInt, value)
+	}
+	if ru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.UserTable,
+			Columns: []string{rating.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.UserTable,
+			Columns: []string{rating.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.SeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.SeriesTable,
+			Columns: []string{rating.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.SeriesTable,
+			Columns: []string{rating.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.SeasonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.SeasonTable,
+			Columns: []string{rating.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.SeasonTable,
+			Columns: []string{rating.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rating.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// RatingUpdateOne is the builder for updating a single Rating entity.
+type RatingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RatingMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *RatingUpdateOne) SetUpdatedAt(t time.Time) *RatingUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// SetScore sets the "score" field.
+func (ruo *RatingUpdateOne) SetScore(i int) *RatingUpdateOne {
+	ruo.mutation.ResetScore()
+	ruo.mutation.SetScore(i)
+	return ruo
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (ruo *RatingUpdateOne) SetNillableScore(i *int) *RatingUpdateOne {
+	if i != nil {
+		ruo.SetScore(*i)
+	}
+	return ruo
+}
+
+// AddScore adds i to the "score" field.
+func (ruo *RatingUpdateOne) AddScore(i int) *RatingUpdateOne {
+	ruo.mutation.AddScore(i)
+	return ruo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ruo *RatingUpdateOne) SetUserID(id int) *RatingUpdateOne {
+	ruo.mutation.SetUserID(id)
+	return ruo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ruo *RatingUpdateOne) SetUser(u *User) *RatingUpdateOne {
+	return ruo.SetUserID(u.ID)
+}
+
+// SetSeriesID sets the "series" edge to the Series entity by ID.
+func (ruo *RatingUpdateOne) SetSeriesID(id int) *RatingUpdateOne {
+	ruo.mutation.SetSeriesID(id)
+	return ruo
+}
+
+// SetNillableSeriesID sets the "series" edge to the Series entity by ID if the given value is not nil.
+func (ruo *RatingUpdateOne) SetNillableSeriesID(id *int) *RatingUpdateOne {
+	if id != nil {
+		ruo = ruo.SetSeriesID(*id)
+	}
+	return ruo
+}
+
+// SetSeries sets the "series" edge to the Series entity.
+func (ruo *RatingUpdateOne) SetSeries(s *Series) *RatingUpdateOne {
+	return ruo.SetSeriesID(s.ID)
+}
+
+// SetSeasonID sets the "season" edge to the Season entity by ID.
+func (ruo *RatingUpdateOne) SetSeasonID(id int) *RatingUpdateOne {
+	ruo.mutation.SetSeasonID(id)
+	return ruo
+}
+
+// SetNillableSeasonID sets the "season" edge to the Season entity by ID if the given value is not nil.
+func (ruo *RatingUpdateOne) SetNillableSeasonID(id *int) *RatingUpdateOne {
+	if id != nil {
+		ruo = ruo.SetSeasonID(*id)
+	}
+	return ruo
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (ruo *RatingUpdateOne) SetSeason(s *Season) *RatingUpdateOne {
+	return ruo.SetSeasonID(s.ID)
+}
+
+// Mutation returns the RatingMutation object of the builder.
+func (ruo *RatingUpdateOne) Mutation() *RatingMutation {
+	return ruo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ruo *RatingUpdateOne) ClearUser() *RatingUpdateOne {
+	ruo.mutation.ClearUser()
+	return ruo
+}
+
+// ClearSeries clears the "series" edge to the Series entity.
+func (ruo *RatingUpdateOne) ClearSeries() *RatingUpdateOne {
+	ruo.mutation.ClearSeries()
+	return ruo
+}
+
+// ClearSeason clears the "season" edge to the Season entity.
+func (ruo *RatingUpdateOne) ClearSeason() *RatingUpdateOne {
+	ruo.mutation.ClearSeason()
+	return ruo
+}
+
+// Where appends a list predicates to the RatingUpdate builder.
+func (ruo *RatingUpdateOne) Where(ps ...predicate.Rating) *RatingUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *RatingUpdateOne) Select(field string, fields ...string) *RatingUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Rating entity.
+func (ruo *RatingUpdateOne) Save(ctx context.Context) (*Rating, error) {
+	ruo.defaults()
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *RatingUpdateOne) SaveX(ctx context.Context) *Rating {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *RatingUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *RatingUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruo *RatingUpdateOne) defaults() {
+	if _, ok := ruo.mutation.UpdatedAt(); !ok {
+		v := rating.UpdateDefaultUpdatedAt()
+		ruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *RatingUpdateOne) check() error {
+	if v, ok := ruo.mutation.Score(); ok {
+		if err := rating.ScoreValidator(v); err != nil {
+			return &ValidationError{Name: "score", err: fmt.Errorf(`ent: validator failed for field "Rating.score": %w`, err)}
+		}
+	}
+	if ruo.mutation.UserCleared() && len(ruo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Rating.user"`)
+	}
+	return nil
+}
+
+func (ruo *RatingUpdateOne) sqlSave(ctx context.Context) (_node *Rating, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rating.Table, rating.Columns, sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Rating.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rating.FieldID)
+		for _, f := range fields {
+			if !rating.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rating.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(rating.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.Score(); ok {
+		_spec.SetField(rating.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedScore(); ok {
+		_spec.AddField(rating.FieldScore, field.TypeInt, value)
+	}
+	if ruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.UserTable,
+			Columns: []string{rating.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.UserTable,
+			Columns: []string{rating.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.SeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.SeriesTable,
+			Columns: []string{rating.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.SeriesTable,
+			Columns: []string{rating.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.SeasonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.SeasonTable,
+			Columns: []string{rating.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.SeasonTable,
+			Columns: []string{rating.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Rating{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rating.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
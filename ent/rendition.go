@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/rendition"
+)
+
+// Rendition is the model entity for the Rendition schema.
+type Rendition struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Width holds the value of the "width" field.
+	Width int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height int `json:"height,omitempty"`
+	// Codecs holds the value of the "codecs" field.
+	Codecs string `json:"codecs,omitempty"`
+	// Bitrate holds the value of the "bitrate" field.
+	Bitrate int `json:"bitrate,omitempty"`
+	// FrameRate holds the value of the "frame_rate" field.
+	FrameRate float64 `json:"frame_rate,omitempty"`
+	// DynamicRange holds the value of the "dynamic_range" field.
+	DynamicRange string `json:"dynamic_range,omitempty"`
+	// Container holds the value of the "container" field.
+	Container string `json:"container,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RenditionQuery when eager-loading is set.
+	Edges              RenditionEdges `json:"edges"`
+	episode_renditions *int
+	selectValues       sql.SelectValues
+}
+
+// RenditionEdges holds the relations/edges for other nodes in the graph.
+type RenditionEdges struct {
+	// Episode holds the value of the episode edge.
+	Episode *Episode `json:"episode,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EpisodeOrErr returns the Episode value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RenditionEdges) EpisodeOrErr() (*Episode, error) {
+	if e.Episode != nil {
+		return e.Episode, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: episode.Label}
+	}
+	return nil, &NotLoadedError{edge: "episode"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Rendition) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rendition.FieldFrameRate:
+			values[i] = new(sql.NullFloat64)
+		case rendition.FieldID, rendition.FieldWidth, rendition.FieldHeight, rendition.FieldBitrate:
+			values[i] = new(sql.NullInt64)
+		case rendition.FieldName, rendition.FieldCodecs, rendition.FieldDynamicRange, rendition.FieldContainer, rendition.FieldPath:
+			values[i] = new(sql.NullString)
+		case rendition.FieldCreatedAt, rendition.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case rendition.ForeignKeys[0]: // episode_renditions
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Rendition fields.
+func (r *Rendition) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rendition.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case rendition.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case rendition.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				r.UpdatedAt = value.Time
+			}
+		case rendition.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				r.Name = value.String
+			}
+		case rendition.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				r.Width = int(value.Int64)
+			}
+		case rendition.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				r.Height = int(value.Int64)
+			}
+		case rendition.FieldCodecs:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field codecs", values[i])
+			} else if value.Valid {
+				r.Codecs = value.String
+			}
+		case rendition.FieldBitrate:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bitrate", values[i])
+			} else if value.Valid {
+				r.Bitrate = int(value.Int64)
+			}
+		case rendition.FieldFrameRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field frame_rate", values[i])
+			} else if value.Valid {
+				r.FrameRate = value.Float64
+			}
+		case rendition.FieldDynamicRange:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dynamic_range", values[i])
+			} else if value.Valid {
+				r.DynamicRange = value.String
+			}
+		case rendition.FieldContainer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field container", values[i])
+			} else if value.Valid {
+				r.Container = value.String
+			}
+		case rendition.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				r.Path = value.String
+			}
+		case rendition.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field episode_renditions", value)
+			} else if value.Valid {
+				r.episode_renditions = new(int)
+				*r.episode_renditions = int(value.Int64)
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Rendition.
+// This includes values selected through modifiers, order, etc.
+func (r *Rendition) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryEpisode queries the "episode" edge of the Rendition entity.
+func (r *Rendition) QueryEpisode() *EpisodeQuery {
+	return NewRenditionClient(r.config).QueryEpisode(r)
+}
+
+// Update returns a builder for updating this Rendition.
+// Note that you need to call Rendition.Unwrap() before calling this method if this Rendition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Rendition) Update() *RenditionUpdateOne {
+	return NewRenditionClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Rendition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Rendition) Unwrap() *Rendition {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Rendition is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Rendition) String() string {
+	var builder strings.Builder
+	builder.WriteString("Rendition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(r.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(r.Name)
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", r.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", r.Height))
+	builder.WriteString(", ")
+	builder.WriteString("codecs=")
+	builder.WriteString(r.Codecs)
+	builder.WriteString(", ")
+	builder.WriteString("bitrate=")
+	builder.WriteString(fmt.Sprintf("%v", r.Bitrate))
+	builder.WriteString(", ")
+	builder.WriteString("frame_rate=")
+	builder.WriteString(fmt.Sprintf("%v", r.FrameRate))
+	builder.WriteString(", ")
+	builder.WriteString("dynamic_range=")
+	builder.WriteString(r.DynamicRange)
+	builder.WriteString(", ")
+	builder.WriteString("container=")
+	builder.WriteString(r.Container)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(r.Path)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Renditions is a parsable slice of Rendition.
+type Renditions []*Rendition
@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rendition
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the rendition type in the database.
+	Label = "rendition"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldCodecs holds the string denoting the codecs field in the database.
+	FieldCodecs = "codecs"
+	// FieldBitrate holds the string denoting the bitrate field in the database.
+	FieldBitrate = "bitrate"
+	// FieldFrameRate holds the string denoting the frame_rate field in the database.
+	FieldFrameRate = "frame_rate"
+	// FieldDynamicRange holds the string denoting the dynamic_range field in the database.
+	FieldDynamicRange = "dynamic_range"
+	// FieldContainer holds the string denoting the container field in the database.
+	FieldContainer = "container"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// EdgeEpisode holds the string denoting the episode edge name in mutations.
+	EdgeEpisode = "episode"
+	// Table holds the table name of the rendition in the database.
+	Table = "renditions"
+	// EpisodeTable is the table that holds the episode relation/edge.
+	EpisodeTable = "renditions"
+	// EpisodeInverseTable is the table name for the Episode entity.
+	// It exists in this package in order to avoid circular dependency with the "episode" package.
+	EpisodeInverseTable = "episodes"
+	// EpisodeColumn is the table column denoting the episode relation/edge.
+	EpisodeColumn = "episode_renditions"
+)
+
+// Columns holds all SQL columns for rendition fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldName,
+	FieldWidth,
+	FieldHeight,
+	FieldCodecs,
+	FieldBitrate,
+	FieldFrameRate,
+	FieldDynamicRange,
+	FieldContainer,
+	FieldPath,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "renditions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"episode_renditions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// PathValidator is a validator for the "path" field. It is called by the builders before save.
+	PathValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Rendition queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByWidth orders the results by the width field.
+func ByWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWidth, opts...).ToFunc()
+}
+
+// ByHeight orders the results by the height field.
+func ByHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeight, opts...).ToFunc()
+}
+
+// ByCodecs orders the results by the codecs field.
+func ByCodecs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCodecs, opts...).ToFunc()
+}
+
+// ByBitrate orders the results by the bitrate field.
+func ByBitrate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBitrate, opts...).ToFunc()
+}
+
+// ByFrameRate orders the results by the frame_rate field.
+func ByFrameRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFrameRate, opts...).ToFunc()
+}
+
+// ByDynamicRange orders the results by the dynamic_range field.
+func ByDynamicRange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDynamicRange, opts...).ToFunc()
+}
+
+// ByContainer orders the results by the container field.
+func ByContainer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContainer, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByEpisodeField orders the results by episode field.
+func ByEpisodeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEpisodeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newEpisodeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EpisodeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EpisodeTable, EpisodeColumn),
+	)
+}
@@ -0,0 +1,724 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rendition
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldName, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldHeight, v))
+}
+
+// Codecs applies equality check predicate on the "codecs" field. It's identical to CodecsEQ.
+func Codecs(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldCodecs, v))
+}
+
+// Bitrate applies equality check predicate on the "bitrate" field. It's identical to BitrateEQ.
+func Bitrate(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldBitrate, v))
+}
+
+// FrameRate applies equality check predicate on the "frame_rate" field. It's identical to FrameRateEQ.
+func FrameRate(v float64) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldFrameRate, v))
+}
+
+// DynamicRange applies equality check predicate on the "dynamic_range" field. It's identical to DynamicRangeEQ.
+func DynamicRange(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldDynamicRange, v))
+}
+
+// Container applies equality check predicate on the "container" field. It's identical to ContainerEQ.
+func Container(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldContainer, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldPath, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldContainsFold(FieldName, v))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLTE(FieldWidth, v))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLTE(FieldHeight, v))
+}
+
+// CodecsEQ applies the EQ predicate on the "codecs" field.
+func CodecsEQ(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldCodecs, v))
+}
+
+// CodecsNEQ applies the NEQ predicate on the "codecs" field.
+func CodecsNEQ(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNEQ(FieldCodecs, v))
+}
+
+// CodecsIn applies the In predicate on the "codecs" field.
+func CodecsIn(vs ...string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldIn(FieldCodecs, vs...))
+}
+
+// CodecsNotIn applies the NotIn predicate on the "codecs" field.
+func CodecsNotIn(vs ...string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNotIn(FieldCodecs, vs...))
+}
+
+// CodecsGT applies the GT predicate on the "codecs" field.
+func CodecsGT(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGT(FieldCodecs, v))
+}
+
+// CodecsGTE applies the GTE predicate on the "codecs" field.
+func CodecsGTE(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGTE(FieldCodecs, v))
+}
+
+// CodecsLT applies the LT predicate on the "codecs" field.
+func CodecsLT(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLT(FieldCodecs, v))
+}
+
+// CodecsLTE applies the LTE predicate on the "codecs" field.
+func CodecsLTE(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLTE(FieldCodecs, v))
+}
+
+// CodecsContains applies the Contains predicate on the "codecs" field.
+func CodecsContains(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldContains(FieldCodecs, v))
+}
+
+// CodecsHasPrefix applies the HasPrefix predicate on the "codecs" field.
+func CodecsHasPrefix(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldHasPrefix(FieldCodecs, v))
+}
+
+// CodecsHasSuffix applies the HasSuffix predicate on the "codecs" field.
+func CodecsHasSuffix(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldHasSuffix(FieldCodecs, v))
+}
+
+// CodecsEqualFold applies the EqualFold predicate on the "codecs" field.
+func CodecsEqualFold(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEqualFold(FieldCodecs, v))
+}
+
+// CodecsContainsFold applies the ContainsFold predicate on the "codecs" field.
+func CodecsContainsFold(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldContainsFold(FieldCodecs, v))
+}
+
+// BitrateEQ applies the EQ predicate on the "bitrate" field.
+func BitrateEQ(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldBitrate, v))
+}
+
+// BitrateNEQ applies the NEQ predicate on the "bitrate" field.
+func BitrateNEQ(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNEQ(FieldBitrate, v))
+}
+
+// BitrateIn applies the In predicate on the "bitrate" field.
+func BitrateIn(vs ...int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldIn(FieldBitrate, vs...))
+}
+
+// BitrateNotIn applies the NotIn predicate on the "bitrate" field.
+func BitrateNotIn(vs ...int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNotIn(FieldBitrate, vs...))
+}
+
+// BitrateGT applies the GT predicate on the "bitrate" field.
+func BitrateGT(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGT(FieldBitrate, v))
+}
+
+// BitrateGTE applies the GTE predicate on the "bitrate" field.
+func BitrateGTE(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGTE(FieldBitrate, v))
+}
+
+// BitrateLT applies the LT predicate on the "bitrate" field.
+func BitrateLT(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLT(FieldBitrate, v))
+}
+
+// BitrateLTE applies the LTE predicate on the "bitrate" field.
+func BitrateLTE(v int) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLTE(FieldBitrate, v))
+}
+
+// FrameRateEQ applies the EQ predicate on the "frame_rate" field.
+func FrameRateEQ(v float64) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldFrameRate, v))
+}
+
+// FrameRateNEQ applies the NEQ predicate on the "frame_rate" field.
+func FrameRateNEQ(v float64) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNEQ(FieldFrameRate, v))
+}
+
+// FrameRateIn applies the In predicate on the "frame_rate" field.
+func FrameRateIn(vs ...float64) predicate.Rendition {
+	return predicate.Rendition(sql.FieldIn(FieldFrameRate, vs...))
+}
+
+// FrameRateNotIn applies the NotIn predicate on the "frame_rate" field.
+func FrameRateNotIn(vs ...float64) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNotIn(FieldFrameRate, vs...))
+}
+
+// FrameRateGT applies the GT predicate on the "frame_rate" field.
+func FrameRateGT(v float64) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGT(FieldFrameRate, v))
+}
+
+// FrameRateGTE applies the GTE predicate on the "frame_rate" field.
+func FrameRateGTE(v float64) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGTE(FieldFrameRate, v))
+}
+
+// FrameRateLT applies the LT predicate on the "frame_rate" field.
+func FrameRateLT(v float64) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLT(FieldFrameRate, v))
+}
+
+// FrameRateLTE applies the LTE predicate on the "frame_rate" field.
+func FrameRateLTE(v float64) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLTE(FieldFrameRate, v))
+}
+
+// FrameRateIsNil applies the IsNil predicate on the "frame_rate" field.
+func FrameRateIsNil() predicate.Rendition {
+	return predicate.Rendition(sql.FieldIsNull(FieldFrameRate))
+}
+
+// FrameRateNotNil applies the NotNil predicate on the "frame_rate" field.
+func FrameRateNotNil() predicate.Rendition {
+	return predicate.Rendition(sql.FieldNotNull(FieldFrameRate))
+}
+
+// DynamicRangeEQ applies the EQ predicate on the "dynamic_range" field.
+func DynamicRangeEQ(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldDynamicRange, v))
+}
+
+// DynamicRangeNEQ applies the NEQ predicate on the "dynamic_range" field.
+func DynamicRangeNEQ(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNEQ(FieldDynamicRange, v))
+}
+
+// DynamicRangeIn applies the In predicate on the "dynamic_range" field.
+func DynamicRangeIn(vs ...string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldIn(FieldDynamicRange, vs...))
+}
+
+// DynamicRangeNotIn applies the NotIn predicate on the "dynamic_range" field.
+func DynamicRangeNotIn(vs ...string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNotIn(FieldDynamicRange, vs...))
+}
+
+// DynamicRangeGT applies the GT predicate on the "dynamic_range" field.
+func DynamicRangeGT(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGT(FieldDynamicRange, v))
+}
+
+// DynamicRangeGTE applies the GTE predicate on the "dynamic_range" field.
+func DynamicRangeGTE(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGTE(FieldDynamicRange, v))
+}
+
+// DynamicRangeLT applies the LT predicate on the "dynamic_range" field.
+func DynamicRangeLT(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLT(FieldDynamicRange, v))
+}
+
+// DynamicRangeLTE applies the LTE predicate on the "dynamic_range" field.
+func DynamicRangeLTE(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLTE(FieldDynamicRange, v))
+}
+
+// DynamicRangeContains applies the Contains predicate on the "dynamic_range" field.
+func DynamicRangeContains(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldContains(FieldDynamicRange, v))
+}
+
+// DynamicRangeHasPrefix applies the HasPrefix predicate on the "dynamic_range" field.
+func DynamicRangeHasPrefix(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldHasPrefix(FieldDynamicRange, v))
+}
+
+// DynamicRangeHasSuffix applies the HasSuffix predicate on the "dynamic_range" field.
+func DynamicRangeHasSuffix(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldHasSuffix(FieldDynamicRange, v))
+}
+
+// DynamicRangeEqualFold applies the EqualFold predicate on the "dynamic_range" field.
+func DynamicRangeEqualFold(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEqualFold(FieldDynamicRange, v))
+}
+
+// DynamicRangeContainsFold applies the ContainsFold predicate on the "dynamic_range" field.
+func DynamicRangeContainsFold(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldContainsFold(FieldDynamicRange, v))
+}
+
+// ContainerEQ applies the EQ predicate on the "container" field.
+func ContainerEQ(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldContainer, v))
+}
+
+// ContainerNEQ applies the NEQ predicate on the "container" field.
+func ContainerNEQ(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNEQ(FieldContainer, v))
+}
+
+// ContainerIn applies the In predicate on the "container" field.
+func ContainerIn(vs ...string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldIn(FieldContainer, vs...))
+}
+
+// ContainerNotIn applies the NotIn predicate on the "container" field.
+func ContainerNotIn(vs ...string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNotIn(FieldContainer, vs...))
+}
+
+// ContainerGT applies the GT predicate on the "container" field.
+func ContainerGT(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGT(FieldContainer, v))
+}
+
+// ContainerGTE applies the GTE predicate on the "container" field.
+func ContainerGTE(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGTE(FieldContainer, v))
+}
+
+// ContainerLT applies the LT predicate on the "container" field.
+func ContainerLT(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLT(FieldContainer, v))
+}
+
+// ContainerLTE applies the LTE predicate on the "container" field.
+func ContainerLTE(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLTE(FieldContainer, v))
+}
+
+// ContainerContains applies the Contains predicate on the "container" field.
+func ContainerContains(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldContains(FieldContainer, v))
+}
+
+// ContainerHasPrefix applies the HasPrefix predicate on the "container" field.
+func ContainerHasPrefix(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldHasPrefix(FieldContainer, v))
+}
+
+// ContainerHasSuffix applies the HasSuffix predicate on the "container" field.
+func ContainerHasSuffix(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldHasSuffix(FieldContainer, v))
+}
+
+// ContainerEqualFold applies the EqualFold predicate on the "container" field.
+func ContainerEqualFold(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEqualFold(FieldContainer, v))
+}
+
+// ContainerContainsFold applies the ContainsFold predicate on the "container" field.
+func ContainerContainsFold(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldContainsFold(FieldContainer, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.Rendition {
+	return predicate.Rendition(sql.FieldContainsFold(FieldPath, v))
+}
+
+// HasEpisode applies the HasEdge predicate on the "episode" edge.
+func HasEpisode() predicate.Rendition {
+	return predicate.Rendition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EpisodeTable, EpisodeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEpisodeWith applies the HasEdge predicate on the "episode" edge with a given conditions (other predicates).
+func HasEpisodeWith(preds ...predicate.Episode) predicate.Rendition {
+	return predicate.Rendition(func(s *sql.Selector) {
+		step := newEpisodeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Rendition) predicate.Rendition {
+	return predicate.Rendition(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Rendition) predicate.Rendition {
+	return predicate.Rendition(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Rendition) predicate.Rendition {
+	return predicate.Rendition(sql.NotPredicates(p))
+}
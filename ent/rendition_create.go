@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/rendition"
+)
+
+// RenditionCreate is the builder for creating a Rendition entity.
+type RenditionCreate struct {
+	config
+	mutation *RenditionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *RenditionCreate) SetCreatedAt(t time.Time) *RenditionCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *RenditionCreate) SetNillableCreatedAt(t *time.Time) *RenditionCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rc *RenditionCreate) SetUpdatedAt(t time.Time) *RenditionCreate {
+	rc.mutation.SetUpdatedAt(t)
+	return rc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rc *RenditionCreate) SetNillableUpdatedAt(t *time.Time) *RenditionCreate {
+	if t != nil {
+		rc.SetUpdatedAt(*t)
+	}
+	return rc
+}
+
+// SetName sets the "name" field.
+func (rc *RenditionCreate) SetName(s string) *RenditionCreate {
+	rc.mutation.SetName(s)
+	return rc
+}
+
+// SetWidth sets the "width" field.
+func (rc *RenditionCreate) SetWidth(i int) *RenditionCreate {
+	rc.mutation.SetWidth(i)
+	return rc
+}
+
+// SetHeight sets the "height" field.
+func (rc *RenditionCreate) SetHeight(i int) *RenditionCreate {
+	rc.mutation.SetHeight(i)
+	return rc
+}
+
+// SetCodecs sets the "codecs" field.
+func (rc *RenditionCreate) SetCodecs(s string) *RenditionCreate {
+	rc.mutation.SetCodecs(s)
+	return rc
+}
+
+// SetBitrate sets the "bitrate" field.
+func (rc *RenditionCreate) SetBitrate(i int) *RenditionCreate {
+	rc.mutation.SetBitrate(i)
+	return rc
+}
+
+// SetFrameRate sets the "frame_rate" field.
+func (rc *RenditionCreate) SetFrameRate(f float64) *RenditionCreate {
+	rc.mutation.SetFrameRate(f)
+	return rc
+}
+
+// SetNillableFrameRate sets the "frame_rate" field if the given value is not nil.
+func (rc *RenditionCreate) SetNillableFrameRate(f *float64) *RenditionCreate {
+	if f != nil {
+		rc.SetFrameRate(*f)
+	}
+	return rc
+}
+
+// SetDynamicRange sets the "dynamic_range" field.
+func (rc *RenditionCreate) SetDynamicRange(s string) *RenditionCreate {
+	rc.mutation.SetDynamicRange(s)
+	return rc
+}
+
+// SetContainer sets the "container" field.
+func (rc *RenditionCreate) SetContainer(s string) *RenditionCreate {
+	rc.mutation.SetContainer(s)
+	return rc
+}
+
+// SetPath sets the "path" field.
+func (rc *RenditionCreate) SetPath(s string) *RenditionCreate {
+	rc.mutation.SetPath(s)
+	return rc
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (rc *RenditionCreate) SetEpisodeID(id int) *RenditionCreate {
+	rc.mutation.SetEpisodeID(id)
+	return rc
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (rc *RenditionCreate) SetEpisode(e *Episode) *RenditionCreate {
+	return rc.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the RenditionMutation object of the builder.
+func (rc *RenditionCreate) Mutation() *RenditionMutation {
+	return rc.mutation
+}
+
+// Save creates the Rendition in the database.
+func (rc *RenditionCreate) Save(ctx context.Context) (*Rendition, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *RenditionCreate) SaveX(ctx context.Context) *Rendition {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *RenditionCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *RenditionCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *RenditionCreate) defaults() {
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := rendition.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		v := rendition.DefaultUpdatedAt()
+		rc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *RenditionCreate) check() error {
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Rendition.created_at"`)}
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Rendition.updated_at"`)}
+	}
+	if _, ok := rc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Rendition.name"`)}
+	}
+	if v, ok := rc.mutation.Name(); ok {
+		if err := rendition.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Rendition.name": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "Rendition.width"`)}
+	}
+	if _, ok := rc.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "Rendition.height"`)}
+	}
+	if _, ok := rc.mutation.Codecs(); !ok {
+		return &ValidationError{Name: "codecs", err: errors.New(`ent: missing required field "Rendition.codecs"`)}
+	}
+	if _, ok := rc.mutation.Bitrate(); !ok {
+		return &ValidationError{Name: "bitrate", err: errors.New(`ent: missing required field "Rendition.bitrate"`)}
+	}
+	if _, ok := rc.mutation.DynamicRange(); !ok {
+		return &ValidationError{Name: "dynamic_range", err: errors.New(`ent: missing required field "Rendition.dynamic_range"`)}
+	}
+	if _, ok := rc.mutation.Container(); !ok {
+		return &ValidationError{Name: "container", err: errors.New(`ent: missing required field "Rendition.container"`)}
+	}
+	if _, ok := rc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "Rendition.path"`)}
+	}
+	if v, ok := rc.mutation.Path(); ok {
+		if err := rendition.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Rendition.path": %w`, err)}
+		}
+	}
+	if len(rc.mutation.EpisodeIDs()) == 0 {
+		return &ValidationError{Name: "episode", err: errors.New(`ent: missing required edge "Rendition.episode"`)}
+	}
+	return nil
+}
+
+func (rc *RenditionCreate) sqlSave(ctx context.Context) (*Rendition, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *RenditionCreate) createSpec() (*Rendition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Rendition{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(rendition.Table, sqlgraph.NewFieldSpec(rendition.FieldID, field.TypeInt))
+	)
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(rendition.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.UpdatedAt(); ok {
+		_spec.SetField(rendition.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rc.mutation.Name(); ok {
+		_spec.SetField(rendition.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := rc.mutation.Width(); ok {
+		_spec.SetField(rendition.FieldWidth, field.TypeInt, value)
+		_node.Width = value
+	}
+	if value, ok := rc.mutation.Height(); ok {
+		_spec.SetField(rendition.FieldHeight, field.TypeInt, value)
+		_node.Height = value
+	}
+	if value, ok := rc.mutation.Codecs(); ok {
+		_spec.SetField(rendition.FieldCodecs, field.TypeString, value)
+		_node.Codecs = value
+	}
+	if value, ok := rc.mutation.Bitrate(); ok {
+		_spec.SetField(rendition.FieldBitrate, field.TypeInt, value)
+		_node.Bitrate = value
+	}
+	if value, ok := rc.mutation.FrameRate(); ok {
+		_spec.SetField(rendition.FieldFrameRate, field.TypeFloat64, value)
+		_node.FrameRate = value
+	}
+	if value, ok := rc.mutation.DynamicRange(); ok {
+		_spec.SetField(rendition.FieldDynamicRange, field.TypeString, value)
+		_node.DynamicRange = value
+	}
+	if value, ok := rc.mutation.Container(); ok {
+		_spec.SetField(rendition.FieldContainer, field.TypeString, value)
+		_node.Container = value
+	}
+	if value, ok := rc.mutation.Path(); ok {
+		_spec.SetField(rendition.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if nodes := rc.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rendition.EpisodeTable,
+			Columns: []string{rendition.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.episode_renditions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RenditionCreateBulk is the builder for creating many Rendition entities in bulk.
+type RenditionCreateBulk struct {
+	config
+	err      error
+	builders []*RenditionCreate
+}
+
+// Save creates the Rendition entities in the database.
+func (rcb *RenditionCreateBulk) Save(ctx context.Context) ([]*Rendition, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Rendition, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RenditionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *RenditionCreateBulk) SaveX(ctx context.Context) []*Rendition {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *RenditionCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *RenditionCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
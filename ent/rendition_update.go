@@ -0,0 +1,751 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/rendition"
+)
+
+// RenditionUpdate is the builder for updating Rendition entities.
+type RenditionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RenditionMutation
+}
+
+// Where appends a list predicates to the RenditionUpdate builder.
+func (ru *RenditionUpdate) Where(ps ...predicate.Rendition) *RenditionUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *RenditionUpdate) SetUpdatedAt(t time.Time) *RenditionUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// SetName sets the "name" field.
+func (ru *RenditionUpdate) SetName(s string) *RenditionUpdate {
+	ru.mutation.SetName(s)
+	return ru
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillableName(s *string) *RenditionUpdate {
+	if s != nil {
+		ru.SetName(*s)
+	}
+	return ru
+}
+
+// SetWidth sets the "width" field.
+func (ru *RenditionUpdate) SetWidth(i int) *RenditionUpdate {
+	ru.mutation.ResetWidth()
+	ru.mutation.SetWidth(i)
+	return ru
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillableWidth(i *int) *RenditionUpdate {
+	if i != nil {
+		ru.SetWidth(*i)
+	}
+	return ru
+}
+
+// AddWidth adds i to the "width" field.
+func (ru *RenditionUpdate) AddWidth(i int) *RenditionUpdate {
+	ru.mutation.AddWidth(i)
+	return ru
+}
+
+// SetHeight sets the "height" field.
+func (ru *RenditionUpdate) SetHeight(i int) *RenditionUpdate {
+	ru.mutation.ResetHeight()
+	ru.mutation.SetHeight(i)
+	return ru
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillableHeight(i *int) *RenditionUpdate {
+	if i != nil {
+		ru.SetHeight(*i)
+	}
+	return ru
+}
+
+// AddHeight adds i to the "height" field.
+func (ru *RenditionUpdate) AddHeight(i int) *RenditionUpdate {
+	ru.mutation.AddHeight(i)
+	return ru
+}
+
+// SetCodecs sets the "codecs" field.
+func (ru *RenditionUpdate) SetCodecs(s string) *RenditionUpdate {
+	ru.mutation.SetCodecs(s)
+	return ru
+}
+
+// SetNillableCodecs sets the "codecs" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillableCodecs(s *string) *RenditionUpdate {
+	if s != nil {
+		ru.SetCodecs(*s)
+	}
+	return ru
+}
+
+// SetBitrate sets the "bitrate" field.
+func (ru *RenditionUpdate) SetBitrate(i int) *RenditionUpdate {
+	ru.mutation.ResetBitrate()
+	ru.mutation.SetBitrate(i)
+	return ru
+}
+
+// SetNillableBitrate sets the "bitrate" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillableBitrate(i *int) *RenditionUpdate {
+	if i != nil {
+		ru.SetBitrate(*i)
+	}
+	return ru
+}
+
+// AddBitrate adds i to the "bitrate" field.
+func (ru *RenditionUpdate) AddBitrate(i int) *RenditionUpdate {
+	ru.mutation.AddBitrate(i)
+	return ru
+}
+
+// SetFrameRate sets the "frame_rate" field.
+func (ru *RenditionUpdate) SetFrameRate(f float64) *RenditionUpdate {
+	ru.mutation.ResetFrameRate()
+	ru.mutation.SetFrameRate(f)
+	return ru
+}
+
+// SetNillableFrameRate sets the "frame_rate" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillableFrameRate(f *float64) *RenditionUpdate {
+	if f != nil {
+		ru.SetFrameRate(*f)
+	}
+	return ru
+}
+
+// AddFrameRate adds f to the "frame_rate" field.
+func (ru *RenditionUpdate) AddFrameRate(f float64) *RenditionUpdate {
+	ru.mutation.AddFrameRate(f)
+	return ru
+}
+
+// ClearFrameRate clears the value of the "frame_rate" field.
+func (ru *RenditionUpdate) ClearFrameRate() *RenditionUpdate {
+	ru.mutation.ClearFrameRate()
+	return ru
+}
+
+// SetDynamicRange sets the "dynamic_range" field.
+func (ru *RenditionUpdate) SetDynamicRange(s string) *RenditionUpdate {
+	ru.mutation.SetDynamicRange(s)
+	return ru
+}
+
+// SetNillableDynamicRange sets the "dynamic_range" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillableDynamicRange(s *string) *RenditionUpdate {
+	if s != nil {
+		ru.SetDynamicRange(*s)
+	}
+	return ru
+}
+
+// SetContainer sets the "container" field.
+func (ru *RenditionUpdate) SetContainer(s string) *RenditionUpdate {
+	ru.mutation.SetContainer(s)
+	return ru
+}
+
+// SetNillableContainer sets the "container" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillableContainer(s *string) *RenditionUpdate {
+	if s != nil {
+		ru.SetContainer(*s)
+	}
+	return ru
+}
+
+// SetPath sets the "path" field.
+func (ru *RenditionUpdate) SetPath(s string) *RenditionUpdate {
+	ru.mutation.SetPath(s)
+	return ru
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillablePath(s *string) *RenditionUpdate {
+	if s != nil {
+		ru.SetPath(*s)
+	}
+	return ru
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (ru *RenditionUpdate) SetEpisodeID(id int) *RenditionUpdate {
+	ru.mutation.SetEpisodeID(id)
+	return ru
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (ru *RenditionUpdate) SetEpisode(e *Episode) *RenditionUpdate {
+	return ru.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the RenditionMutation object of the builder.
+func (ru *RenditionUpdate) Mutation() *RenditionMutation {
+	return ru.mutation
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (ru *RenditionUpdate) ClearEpisode() *RenditionUpdate {
+	ru.mutation.ClearEpisode()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *RenditionUpdate) Save(ctx context.Context) (int, error) {
+	ru.defaults()
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *RenditionUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *RenditionUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *RenditionUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ru *RenditionUpdate) defaults() {
+	if _, ok := ru.mutation.UpdatedAt(); !ok {
+		v := rendition.UpdateDefaultUpdatedAt()
+		ru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *RenditionUpdate) check() error {
+	if v, ok := ru.mutation.Name(); ok {
+		if err := rendition.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Rendition.name": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Path(); ok {
+		if err := rendition.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Rendition.path": %w`, err)}
+		}
+	}
+	if ru.mutation.EpisodeCleared() && len(ru.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Rendition.episode"`)
+	}
+	return nil
+}
+
+func (ru *RenditionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rendition.Table, rendition.Columns, sqlgraph.NewFieldSpec(rendition.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.SetField(rendition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.Name(); ok {
+		_spec.SetField(rendition.FieldName, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Width(); ok {
+		_spec.SetField(rendition.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedWidth(); ok {
+		_spec.AddField(rendition.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.Height(); ok {
+		_spec.SetField(rendition.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedHeight(); ok {
+		_spec.AddField(rendition.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.Codecs(); ok {
+		_spec.SetField(rendition.FieldCodecs, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Bitrate(); ok {
+		_spec.SetField(rendition.FieldBitrate, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedBitrate(); ok {
+		_spec.AddField(rendition.FieldBitrate, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.FrameRate(); ok {
+		_spec.SetField(rendition.FieldFrameRate, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.AddedFrameRate(); ok {
+		_spec.AddField(rendition.FieldFrameRate, field.TypeFloat64, value)
+	}
+	if ru.mutation.FrameRateCleared() {
+		_spec.ClearField(rendition.FieldFrameRate, field.TypeFloat64)
+	}
+	if value, ok := ru.mutation.DynamicRange(); ok {
+		_spec.SetField(rendition.FieldDynamicRange, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Container(); ok {
+		_spec.SetField(rendition.FieldContainer, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Path(); ok {
+		_spec.SetField(rendition.FieldPath, field.TypeString, value)
+	}
+	if ru.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rendition.EpisodeTable,
+			Columns: []string{rendition.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rendition.EpisodeTable,
+			Columns: []string{rendition.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rendition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// RenditionUpdateOne is the builder for updating a single Rendition entity.
+type RenditionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RenditionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *RenditionUpdateOne) SetUpdatedAt(t time.Time) *RenditionUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// SetName sets the "name" field.
+func (ruo *RenditionUpdateOne) SetName(s string) *RenditionUpdateOne {
+	ruo.mutation.SetName(s)
+	return ruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillableName(s *string) *RenditionUpdateOne {
+	if s != nil {
+		ruo.SetName(*s)
+	}
+	return ruo
+}
+
+// SetWidth sets the "width" field.
+func (ruo *RenditionUpdateOne) SetWidth(i int) *RenditionUpdateOne {
+	ruo.mutation.ResetWidth()
+	ruo.mutation.SetWidth(i)
+	return ruo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillableWidth(i *int) *RenditionUpdateOne {
+	if i != nil {
+		ruo.SetWidth(*i)
+	}
+	return ruo
+}
+
+// AddWidth adds i to the "width" field.
+func (ruo *RenditionUpdateOne) AddWidth(i int) *RenditionUpdateOne {
+	ruo.mutation.AddWidth(i)
+	return ruo
+}
+
+// SetHeight sets the "height" field.
+func (ruo *RenditionUpdateOne) SetHeight(i int) *RenditionUpdateOne {
+	ruo.mutation.ResetHeight()
+	ruo.mutation.SetHeight(i)
+	return ruo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillableHeight(i *int) *RenditionUpdateOne {
+	if i != nil {
+		ruo.SetHeight(*i)
+	}
+	return ruo
+}
+
+// AddHeight adds i to the "height" field.
+func (ruo *RenditionUpdateOne) AddHeight(i int) *RenditionUpdateOne {
+	ruo.mutation.AddHeight(i)
+	return ruo
+}
+
+// SetCodecs sets the "codecs" field.
+func (ruo *RenditionUpdateOne) SetCodecs(s string) *RenditionUpdateOne {
+	ruo.mutation.SetCodecs(s)
+	return ruo
+}
+
+// SetNillableCodecs sets the "codecs" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillableCodecs(s *string) *RenditionUpdateOne {
+	if s != nil {
+		ruo.SetCodecs(*s)
+	}
+	return ruo
+}
+
+// SetBitrate sets the "bitrate" field.
+func (ruo *RenditionUpdateOne) SetBitrate(i int) *RenditionUpdateOne {
+	ruo.mutation.ResetBitrate()
+	ruo.mutation.SetBitrate(i)
+	return ruo
+}
+
+// SetNillableBitrate sets the "bitrate" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillableBitrate(i *int) *RenditionUpdateOne {
+	if i != nil {
+		ruo.SetBitrate(*i)
+	}
+	return ruo
+}
+
+// AddBitrate adds i to the "bitrate" field.
+func (ruo *RenditionUpdateOne) AddBitrate(i int) *RenditionUpdateOne {
+	ruo.mutation.AddBitrate(i)
+	return ruo
+}
+
+// SetFrameRate sets the "frame_rate" field.
+func (ruo *RenditionUpdateOne) SetFrameRate(f float64) *RenditionUpdateOne {
+	ruo.mutation.ResetFrameRate()
+	ruo.mutation.SetFrameRate(f)
+	return ruo
+}
+
+// SetNillableFrameRate sets the "frame_rate" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillableFrameRate(f *float64) *RenditionUpdateOne {
+	if f != nil {
+		ruo.SetFrameRate(*f)
+	}
+	return ruo
+}
+
+// AddFrameRate adds f to the "frame_rate" field.
+func (ruo *RenditionUpdateOne) AddFrameRate(f float64) *RenditionUpdateOne {
+	ruo.mutation.AddFrameRate(f)
+	return ruo
+}
+
+// ClearFrameRate clears the value of the "frame_rate" field.
+func (ruo *RenditionUpdateOne) ClearFrameRate() *RenditionUpdateOne {
+	ruo.mutation.ClearFrameRate()
+	return ruo
+}
+
+// SetDynamicRange sets the "dynamic_range" field.
+func (ruo *RenditionUpdateOne) SetDynamicRange(s string) *RenditionUpdateOne {
+	ruo.mutation.SetDynamicRange(s)
+	return ruo
+}
+
+// SetNillableDynamicRange sets the "dynamic_range" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillableDynamicRange(s *string) *RenditionUpdateOne {
+	if s != nil {
+		ruo.SetDynamicRange(*s)
+	}
+	return ruo
+}
+
+// SetContainer sets the "container" field.
+func (ruo *RenditionUpdateOne) SetContainer(s string) *RenditionUpdateOne {
+	ruo.mutation.SetContainer(s)
+	return ruo
+}
+
+// SetNillableContainer sets the "container" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillableContainer(s *string) *RenditionUpdateOne {
+	if s != nil {
+		ruo.SetContainer(*s)
+	}
+	return ruo
+}
+
+// SetPath sets the "path" field.
+func (ruo *RenditionUpdateOne) SetPath(s string) *RenditionUpdateOne {
+	ruo.mutation.SetPath(s)
+	return ruo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillablePath(s *string) *RenditionUpdateOne {
+	if s != nil {
+		ruo.SetPath(*s)
+	}
+	return ruo
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (ruo *RenditionUpdateOne) SetEpisodeID(id int) *RenditionUpdateOne {
+	ruo.mutation.SetEpisodeID(id)
+	return ruo
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (ruo *RenditionUpdateOne) SetEpisode(e *Episode) *RenditionUpdateOne {
+	return ruo.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the RenditionMutation object of the builder.
+func (ruo *RenditionUpdateOne) Mutation() *RenditionMutation {
+	return ruo.mutation
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (ruo *RenditionUpdateOne) ClearEpisode() *RenditionUpdateOne {
+	ruo.mutation.ClearEpisode()
+	return ruo
+}
+
+// Where appends a list predicates to the RenditionUpdate builder.
+func (ruo *RenditionUpdateOne) Where(ps ...predicate.Rendition) *RenditionUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *RenditionUpdateOne) Select(field string, fields ...string) *RenditionUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Rendition entity.
+func (ruo *RenditionUpdateOne) Save(ctx context.Context) (*Rendition, error) {
+	ruo.defaults()
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *RenditionUpdateOne) SaveX(ctx context.Context) *Rendition {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *RenditionUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *RenditionUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruo *RenditionUpdateOne) defaults() {
+	if _, ok := ruo.mutation.UpdatedAt(); !ok {
+		v := rendition.UpdateDefaultUpdatedAt()
+		ruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *RenditionUpdateOne) check() error {
+	if v, ok := ruo.mutation.Name(); ok {
+		if err := rendition.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Rendition.name": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Path(); ok {
+		if err := rendition.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Rendition.path": %w`, err)}
+		}
+	}
+	if ruo.mutation.EpisodeCleared() && len(ruo.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Rendition.episode"`)
+	}
+	return nil
+}
+
+func (ruo *RenditionUpdateOne) sqlSave(ctx context.Context) (_node *Rendition, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rendition.Table, rendition.Columns, sqlgraph.NewFieldSpec(rendition.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Rendition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rendition.FieldID)
+		for _, f := range fields {
+			if !rendition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rendition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(rendition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.Name(); ok {
+		_spec.SetField(rendition.FieldName, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Width(); ok {
+		_spec.SetField(rendition.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedWidth(); ok {
+		_spec.AddField(rendition.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.Height(); ok {
+		_spec.SetField(rendition.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedHeight(); ok {
+		_spec.AddField(rendition.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.Codecs(); ok {
+		_spec.SetField(rendition.FieldCodecs, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Bitrate(); ok {
+		_spec.SetField(rendition.FieldBitrate, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedBitrate(); ok {
+		_spec.AddField(rendition.FieldBitrate, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.FrameRate(); ok {
+		_spec.SetField(rendition.FieldFrameRate, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.AddedFrameRate(); ok {
+		_spec.AddField(rendition.FieldFrameRate, field.TypeFloat64, value)
+	}
+	if ruo.mutation.FrameRateCleared() {
+		_spec.ClearField(rendition.FieldFrameRate, field.TypeFloat64)
+	}
+	if value, ok := ruo.mutation.DynamicRange(); ok {
+		_spec.SetField(rendition.FieldDynamicRange, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Container(); ok {
+		_spec.SetField(rendition.FieldContainer, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Path(); ok {
+		_spec.SetField(rendition.FieldPath, field.TypeString, value)
+	}
+	if ruo.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rendition.EpisodeTable,
+			Columns: []string{rendition.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rendition.EpisodeTable,
+			Columns: []string{rendition.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Rendition{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rendition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -3,18 +3,127 @@
 package ent
 
 import (
+	"time"
+
+	"github.com/clustlight/animatrix-api/ent/apikey"
+	"github.com/clustlight/animatrix-api/ent/audiotrack"
+	"github.com/clustlight/animatrix-api/ent/chapter"
 	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/favorite"
+	"github.com/clustlight/animatrix-api/ent/rating"
+	"github.com/clustlight/animatrix-api/ent/rendition"
 	"github.com/clustlight/animatrix-api/ent/schema"
 	"github.com/clustlight/animatrix-api/ent/season"
 	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/subtitle"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/ent/watchlist"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
+	"github.com/clustlight/animatrix-api/ent/watchprogress"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyMixin := schema.APIKey{}.Mixin()
+	apikeyMixinFields0 := apikeyMixin[0].Fields()
+	_ = apikeyMixinFields0
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyMixinFields0[0].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescUpdatedAt is the schema descriptor for updated_at field.
+	apikeyDescUpdatedAt := apikeyMixinFields0[1].Descriptor()
+	// apikey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apikey.DefaultUpdatedAt = apikeyDescUpdatedAt.Default.(func() time.Time)
+	// apikey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apikey.UpdateDefaultUpdatedAt = apikeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[0].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = func() func(string) error {
+		validators := apikeyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[1].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = apikeyDescPrefix.Validators[0].(func(string) error)
+	// apikeyDescHash is the schema descriptor for hash field.
+	apikeyDescHash := apikeyFields[2].Descriptor()
+	// apikey.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	apikey.HashValidator = apikeyDescHash.Validators[0].(func(string) error)
+	audiotrackMixin := schema.AudioTrack{}.Mixin()
+	audiotrackMixinFields0 := audiotrackMixin[0].Fields()
+	_ = audiotrackMixinFields0
+	audiotrackFields := schema.AudioTrack{}.Fields()
+	_ = audiotrackFields
+	// audiotrackDescCreatedAt is the schema descriptor for created_at field.
+	audiotrackDescCreatedAt := audiotrackMixinFields0[0].Descriptor()
+	// audiotrack.DefaultCreatedAt holds the default value on creation for the created_at field.
+	audiotrack.DefaultCreatedAt = audiotrackDescCreatedAt.Default.(func() time.Time)
+	// audiotrackDescUpdatedAt is the schema descriptor for updated_at field.
+	audiotrackDescUpdatedAt := audiotrackMixinFields0[1].Descriptor()
+	// audiotrack.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	audiotrack.DefaultUpdatedAt = audiotrackDescUpdatedAt.Default.(func() time.Time)
+	// audiotrack.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	audiotrack.UpdateDefaultUpdatedAt = audiotrackDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// audiotrackDescLanguage is the schema descriptor for language field.
+	audiotrackDescLanguage := audiotrackFields[0].Descriptor()
+	// audiotrack.LanguageValidator is a validator for the "language" field. It is called by the builders before save.
+	audiotrack.LanguageValidator = audiotrackDescLanguage.Validators[0].(func(string) error)
+	// audiotrackDescIsDefault is the schema descriptor for is_default field.
+	audiotrackDescIsDefault := audiotrackFields[3].Descriptor()
+	// audiotrack.DefaultIsDefault holds the default value on creation for the is_default field.
+	audiotrack.DefaultIsDefault = audiotrackDescIsDefault.Default.(bool)
+	// audiotrackDescPath is the schema descriptor for path field.
+	audiotrackDescPath := audiotrackFields[4].Descriptor()
+	// audiotrack.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	audiotrack.PathValidator = audiotrackDescPath.Validators[0].(func(string) error)
+	chapterMixin := schema.Chapter{}.Mixin()
+	chapterMixinFields0 := chapterMixin[0].Fields()
+	_ = chapterMixinFields0
+	chapterFields := schema.Chapter{}.Fields()
+	_ = chapterFields
+	// chapterDescCreatedAt is the schema descriptor for created_at field.
+	chapterDescCreatedAt := chapterMixinFields0[0].Descriptor()
+	// chapter.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chapter.DefaultCreatedAt = chapterDescCreatedAt.Default.(func() time.Time)
+	// chapterDescUpdatedAt is the schema descriptor for updated_at field.
+	chapterDescUpdatedAt := chapterMixinFields0[1].Descriptor()
+	// chapter.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	chapter.DefaultUpdatedAt = chapterDescUpdatedAt.Default.(func() time.Time)
+	// chapter.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	chapter.UpdateDefaultUpdatedAt = chapterDescUpdatedAt.UpdateDefault.(func() time.Time)
+	episodeMixin := schema.Episode{}.Mixin()
+	episodeMixinFields0 := episodeMixin[0].Fields()
+	_ = episodeMixinFields0
 	episodeFields := schema.Episode{}.Fields()
 	_ = episodeFields
+	// episodeDescCreatedAt is the schema descriptor for created_at field.
+	episodeDescCreatedAt := episodeMixinFields0[0].Descriptor()
+	// episode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	episode.DefaultCreatedAt = episodeDescCreatedAt.Default.(func() time.Time)
+	// episodeDescUpdatedAt is the schema descriptor for updated_at field.
+	episodeDescUpdatedAt := episodeMixinFields0[1].Descriptor()
+	// episode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	episode.DefaultUpdatedAt = episodeDescUpdatedAt.Default.(func() time.Time)
+	// episode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	episode.UpdateDefaultUpdatedAt = episodeDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// episodeDescEpisodeID is the schema descriptor for episode_id field.
 	episodeDescEpisodeID := episodeFields[0].Descriptor()
 	// episode.EpisodeIDValidator is a validator for the "episode_id" field. It is called by the builders before save.
@@ -23,8 +132,78 @@ func init() {
 	episodeDescTitle := episodeFields[1].Descriptor()
 	// episode.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	episode.TitleValidator = episodeDescTitle.Validators[0].(func(string) error)
+	favoriteMixin := schema.Favorite{}.Mixin()
+	favoriteMixinFields0 := favoriteMixin[0].Fields()
+	_ = favoriteMixinFields0
+	favoriteFields := schema.Favorite{}.Fields()
+	_ = favoriteFields
+	// favoriteDescCreatedAt is the schema descriptor for created_at field.
+	favoriteDescCreatedAt := favoriteMixinFields0[0].Descriptor()
+	// favorite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	favorite.DefaultCreatedAt = favoriteDescCreatedAt.Default.(func() time.Time)
+	// favoriteDescUpdatedAt is the schema descriptor for updated_at field.
+	favoriteDescUpdatedAt := favoriteMixinFields0[1].Descriptor()
+	// favorite.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	favorite.DefaultUpdatedAt = favoriteDescUpdatedAt.Default.(func() time.Time)
+	// favorite.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	favorite.UpdateDefaultUpdatedAt = favoriteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	ratingMixin := schema.Rating{}.Mixin()
+	ratingMixinFields0 := ratingMixin[0].Fields()
+	_ = ratingMixinFields0
+	ratingFields := schema.Rating{}.Fields()
+	_ = ratingFields
+	// ratingDescCreatedAt is the schema descriptor for created_at field.
+	ratingDescCreatedAt := ratingMixinFields0[0].Descriptor()
+	// rating.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rating.DefaultCreatedAt = ratingDescCreatedAt.Default.(func() time.Time)
+	// ratingDescUpdatedAt is the schema descriptor for updated_at field.
+	ratingDescUpdatedAt := ratingMixinFields0[1].Descriptor()
+	// rating.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rating.DefaultUpdatedAt = ratingDescUpdatedAt.Default.(func() time.Time)
+	// rating.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rating.UpdateDefaultUpdatedAt = ratingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ratingDescScore is the schema descriptor for score field.
+	ratingDescScore := ratingFields[0].Descriptor()
+	// rating.ScoreValidator is a validator for the "score" field. It is called by the builders before save.
+	rating.ScoreValidator = ratingDescScore.Validators[0].(func(int) error)
+	renditionMixin := schema.Rendition{}.Mixin()
+	renditionMixinFields0 := renditionMixin[0].Fields()
+	_ = renditionMixinFields0
+	renditionFields := schema.Rendition{}.Fields()
+	_ = renditionFields
+	// renditionDescCreatedAt is the schema descriptor for created_at field.
+	renditionDescCreatedAt := renditionMixinFields0[0].Descriptor()
+	// rendition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rendition.DefaultCreatedAt = renditionDescCreatedAt.Default.(func() time.Time)
+	// renditionDescUpdatedAt is the schema descriptor for updated_at field.
+	renditionDescUpdatedAt := renditionMixinFields0[1].Descriptor()
+	// rendition.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rendition.DefaultUpdatedAt = renditionDescUpdatedAt.Default.(func() time.Time)
+	// rendition.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rendition.UpdateDefaultUpdatedAt = renditionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// renditionDescName is the schema descriptor for name field.
+	renditionDescName := renditionFields[0].Descriptor()
+	// rendition.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	rendition.NameValidator = renditionDescName.Validators[0].(func(string) error)
+	// renditionDescPath is the schema descriptor for path field.
+	renditionDescPath := renditionFields[8].Descriptor()
+	// rendition.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	rendition.PathValidator = renditionDescPath.Validators[0].(func(string) error)
+	seasonMixin := schema.Season{}.Mixin()
+	seasonMixinFields0 := seasonMixin[0].Fields()
+	_ = seasonMixinFields0
 	seasonFields := schema.Season{}.Fields()
 	_ = seasonFields
+	// seasonDescCreatedAt is the schema descriptor for created_at field.
+	seasonDescCreatedAt := seasonMixinFields0[0].Descriptor()
+	// season.DefaultCreatedAt holds the default value on creation for the created_at field.
+	season.DefaultCreatedAt = seasonDescCreatedAt.Default.(func() time.Time)
+	// seasonDescUpdatedAt is the schema descriptor for updated_at field.
+	seasonDescUpdatedAt := seasonMixinFields0[1].Descriptor()
+	// season.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	season.DefaultUpdatedAt = seasonDescUpdatedAt.Default.(func() time.Time)
+	// season.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	season.UpdateDefaultUpdatedAt = seasonDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// seasonDescSeasonID is the schema descriptor for season_id field.
 	seasonDescSeasonID := seasonFields[0].Descriptor()
 	// season.SeasonIDValidator is a validator for the "season_id" field. It is called by the builders before save.
@@ -33,8 +212,21 @@ func init() {
 	seasonDescSeasonTitle := seasonFields[1].Descriptor()
 	// season.SeasonTitleValidator is a validator for the "season_title" field. It is called by the builders before save.
 	season.SeasonTitleValidator = seasonDescSeasonTitle.Validators[0].(func(string) error)
+	seriesMixin := schema.Series{}.Mixin()
+	seriesMixinFields0 := seriesMixin[0].Fields()
+	_ = seriesMixinFields0
 	seriesFields := schema.Series{}.Fields()
 	_ = seriesFields
+	// seriesDescCreatedAt is the schema descriptor for created_at field.
+	seriesDescCreatedAt := seriesMixinFields0[0].Descriptor()
+	// series.DefaultCreatedAt holds the default value on creation for the created_at field.
+	series.DefaultCreatedAt = seriesDescCreatedAt.Default.(func() time.Time)
+	// seriesDescUpdatedAt is the schema descriptor for updated_at field.
+	seriesDescUpdatedAt := seriesMixinFields0[1].Descriptor()
+	// series.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	series.DefaultUpdatedAt = seriesDescUpdatedAt.Default.(func() time.Time)
+	// series.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	series.UpdateDefaultUpdatedAt = seriesDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// seriesDescSeriesID is the schema descriptor for series_id field.
 	seriesDescSeriesID := seriesFields[0].Descriptor()
 	// series.SeriesIDValidator is a validator for the "series_id" field. It is called by the builders before save.
@@ -43,4 +235,131 @@ func init() {
 	seriesDescTitle := seriesFields[1].Descriptor()
 	// series.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	series.TitleValidator = seriesDescTitle.Validators[0].(func(string) error)
+	subtitleMixin := schema.Subtitle{}.Mixin()
+	subtitleMixinFields0 := subtitleMixin[0].Fields()
+	_ = subtitleMixinFields0
+	subtitleFields := schema.Subtitle{}.Fields()
+	_ = subtitleFields
+	// subtitleDescCreatedAt is the schema descriptor for created_at field.
+	subtitleDescCreatedAt := subtitleMixinFields0[0].Descriptor()
+	// subtitle.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subtitle.DefaultCreatedAt = subtitleDescCreatedAt.Default.(func() time.Time)
+	// subtitleDescUpdatedAt is the schema descriptor for updated_at field.
+	subtitleDescUpdatedAt := subtitleMixinFields0[1].Descriptor()
+	// subtitle.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subtitle.DefaultUpdatedAt = subtitleDescUpdatedAt.Default.(func() time.Time)
+	// subtitle.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subtitle.UpdateDefaultUpdatedAt = subtitleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subtitleDescLanguage is the schema descriptor for language field.
+	subtitleDescLanguage := subtitleFields[0].Descriptor()
+	// subtitle.LanguageValidator is a validator for the "language" field. It is called by the builders before save.
+	subtitle.LanguageValidator = subtitleDescLanguage.Validators[0].(func(string) error)
+	// subtitleDescIsDefault is the schema descriptor for is_default field.
+	subtitleDescIsDefault := subtitleFields[3].Descriptor()
+	// subtitle.DefaultIsDefault holds the default value on creation for the is_default field.
+	subtitle.DefaultIsDefault = subtitleDescIsDefault.Default.(bool)
+	// subtitleDescPath is the schema descriptor for path field.
+	subtitleDescPath := subtitleFields[4].Descriptor()
+	// subtitle.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	subtitle.PathValidator = subtitleDescPath.Validators[0].(func(string) error)
+	userMixin := schema.User{}.Mixin()
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userMixinFields0[0].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userMixinFields0[1].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[0].Descriptor()
+	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	user.NameValidator = func() func(string) error {
+		validators := userDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	watchprogressMixin := schema.WatchProgress{}.Mixin()
+	watchprogressMixinFields0 := watchprogressMixin[0].Fields()
+	_ = watchprogressMixinFields0
+	watchprogressFields := schema.WatchProgress{}.Fields()
+	_ = watchprogressFields
+	// watchprogressDescCreatedAt is the schema descriptor for created_at field.
+	watchprogressDescCreatedAt := watchprogressMixinFields0[0].Descriptor()
+	// watchprogress.DefaultCreatedAt holds the default value on creation for the created_at field.
+	watchprogress.DefaultCreatedAt = watchprogressDescCreatedAt.Default.(func() time.Time)
+	// watchprogressDescUpdatedAt is the schema descriptor for updated_at field.
+	watchprogressDescUpdatedAt := watchprogressMixinFields0[1].Descriptor()
+	// watchprogress.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	watchprogress.DefaultUpdatedAt = watchprogressDescUpdatedAt.Default.(func() time.Time)
+	// watchprogress.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	watchprogress.UpdateDefaultUpdatedAt = watchprogressDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// watchprogressDescCompleted is the schema descriptor for completed field.
+	watchprogressDescCompleted := watchprogressFields[1].Descriptor()
+	// watchprogress.DefaultCompleted holds the default value on creation for the completed field.
+	watchprogress.DefaultCompleted = watchprogressDescCompleted.Default.(bool)
+	watchlistMixin := schema.Watchlist{}.Mixin()
+	watchlistMixinFields0 := watchlistMixin[0].Fields()
+	_ = watchlistMixinFields0
+	watchlistFields := schema.Watchlist{}.Fields()
+	_ = watchlistFields
+	// watchlistDescCreatedAt is the schema descriptor for created_at field.
+	watchlistDescCreatedAt := watchlistMixinFields0[0].Descriptor()
+	// watchlist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	watchlist.DefaultCreatedAt = watchlistDescCreatedAt.Default.(func() time.Time)
+	// watchlistDescUpdatedAt is the schema descriptor for updated_at field.
+	watchlistDescUpdatedAt := watchlistMixinFields0[1].Descriptor()
+	// watchlist.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	watchlist.DefaultUpdatedAt = watchlistDescUpdatedAt.Default.(func() time.Time)
+	// watchlist.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	watchlist.UpdateDefaultUpdatedAt = watchlistDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// watchlistDescName is the schema descriptor for name field.
+	watchlistDescName := watchlistFields[0].Descriptor()
+	// watchlist.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	watchlist.NameValidator = func() func(string) error {
+		validators := watchlistDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	watchlistitemMixin := schema.WatchlistItem{}.Mixin()
+	watchlistitemMixinFields0 := watchlistitemMixin[0].Fields()
+	_ = watchlistitemMixinFields0
+	watchlistitemFields := schema.WatchlistItem{}.Fields()
+	_ = watchlistitemFields
+	// watchlistitemDescCreatedAt is the schema descriptor for created_at field.
+	watchlistitemDescCreatedAt := watchlistitemMixinFields0[0].Descriptor()
+	// watchlistitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	watchlistitem.DefaultCreatedAt = watchlistitemDescCreatedAt.Default.(func() time.Time)
+	// watchlistitemDescUpdatedAt is the schema descriptor for updated_at field.
+	watchlistitemDescUpdatedAt := watchlistitemMixinFields0[1].Descriptor()
+	// watchlistitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	watchlistitem.DefaultUpdatedAt = watchlistitemDescUpdatedAt.Default.(func() time.Time)
+	// watchlistitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	watchlistitem.UpdateDefaultUpdatedAt = watchlistitemDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
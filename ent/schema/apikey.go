@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKey holds the schema definition for the APIKey entity, a secret
+// granting scopes of access to the API. Only a hash of the key is kept.
+type APIKey struct {
+	ent.Schema
+}
+
+// Mixin of the APIKey.
+func (APIKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty().MaxLen(100),
+		// prefix is the start of the key, to tell keys apart.
+		field.String("prefix").NotEmpty(),
+		// hash is the hex SHA-256 of the key.
+		field.String("hash").Unique().NotEmpty().Sensitive(),
+		field.Strings("scopes"),
+		field.Time("last_used_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		// user is the account requests with the key act for.
+		edge.From("user", User.Type).Ref("api_keys").Unique(),
+	}
+}
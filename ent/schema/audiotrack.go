@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// AudioTrack holds the schema definition for the AudioTrack entity, an
+// alternate audio track of an episode.
+type AudioTrack struct {
+	ent.Schema
+}
+
+// Mixin of the AudioTrack.
+func (AudioTrack) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the AudioTrack.
+func (AudioTrack) Fields() []ent.Field {
+	return []ent.Field{
+		// language is a BCP 47 tag, e.g. "ja" or "pt-BR".
+		field.String("language").NotEmpty(),
+		// format is the codec, e.g. aac or flac.
+		field.String("format"),
+		field.String("label").Optional(),
+		// is_default marks the track players select; at most one per episode.
+		field.Bool("is_default").Default(false),
+		// path is the object key of the file; it may also be an absolute URL.
+		field.String("path").NotEmpty(),
+	}
+}
+
+// Edges of the AudioTrack.
+func (AudioTrack) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("episode", Episode.Type).Ref("audio_tracks").Unique().Required(),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Chapter holds the schema definition for the Chapter entity, a marked
+// section of an episode such as its opening.
+type Chapter struct {
+	ent.Schema
+}
+
+// Mixin of the Chapter.
+func (Chapter) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Chapter.
+func (Chapter) Fields() []ent.Field {
+	return []ent.Field{
+		// start and end are offsets in seconds, like Episode.duration.
+		field.Float("start"),
+		field.Float("end"),
+		// kind is opening, ending, recap, preview or main.
+		field.String("kind"),
+		field.String("title").Optional(),
+	}
+}
+
+// Edges of the Chapter.
+func (Chapter) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("episode", Episode.Type).Ref("chapters").Unique().Required(),
+	}
+}
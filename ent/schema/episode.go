@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -11,6 +12,13 @@ type Episode struct {
 	ent.Schema
 }
 
+// Mixin of the Episode.
+func (Episode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
 // Fields of the Episode.
 func (Episode) Fields() []ent.Field {
 	return []ent.Field{
@@ -27,6 +35,8 @@ func (Episode) Fields() []ent.Field {
 		field.Int("height"),
 		field.String("dynamic_range"),
 		field.Text("metadata"),
+		// media maps asset names to object keys overriding the configured layout.
+		field.JSON("media", map[string]string{}).Optional(),
 	}
 }
 
@@ -34,5 +44,15 @@ func (Episode) Fields() []ent.Field {
 func (Episode) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("season", Season.Type).Ref("episodes").Unique().Required(),
+		edge.To("renditions", Rendition.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("subtitles", Subtitle.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("audio_tracks", AudioTrack.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("chapters", Chapter.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("watch_progress", WatchProgress.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
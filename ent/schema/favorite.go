@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/index"
+)
+
+// Favorite holds the schema definition for the Favorite entity, a series
+// or season a user marked as favorite. Exactly one of the series and
+// season edges is set.
+type Favorite struct {
+	ent.Schema
+}
+
+// Mixin of the Favorite.
+func (Favorite) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Edges of the Favorite.
+func (Favorite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("favorites").Unique().Required(),
+		edge.From("series", Series.Type).Ref("favorites").Unique(),
+		edge.From("season", Season.Type).Ref("favorites").Unique(),
+	}
+}
+
+// Indexes of the Favorite.
+func (Favorite) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("user", "series").Unique(),
+		index.Edges("user", "season").Unique(),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Rating holds the schema definition for the Rating entity, the score a
+// user gave a series or season. Exactly one of the series and season
+// edges is set.
+type Rating struct {
+	ent.Schema
+}
+
+// Mixin of the Rating.
+func (Rating) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Rating.
+func (Rating) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("score").Range(1, 10),
+	}
+}
+
+// Edges of the Rating.
+func (Rating) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("ratings").Unique().Required(),
+		edge.From("series", Series.Type).Ref("ratings").Unique(),
+		edge.From("season", Season.Type).Ref("ratings").Unique(),
+	}
+}
+
+// Indexes of the Rating.
+func (Rating) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("user", "series").Unique(),
+		index.Edges("user", "season").Unique(),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Rendition holds the schema definition for the Rendition entity, one
+// encoding of an episode's video.
+type Rendition struct {
+	ent.Schema
+}
+
+// Mixin of the Rendition.
+func (Rendition) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Rendition.
+func (Rendition) Fields() []ent.Field {
+	return []ent.Field{
+		// name identifies the rendition within its episode, e.g. "2160p-hdr10".
+		field.String("name").NotEmpty(),
+		field.Int("width"),
+		field.Int("height"),
+		// codecs is an RFC 6381 codecs list, e.g. "avc1.640028,mp4a.40.2".
+		field.String("codecs"),
+		// bitrate is the peak bitrate in bits per second.
+		field.Int("bitrate"),
+		field.Float("frame_rate").Optional(),
+		field.String("dynamic_range"),
+		field.String("container"),
+		// path is the object key of the file, or of the media playlist for
+		// HLS containers; it may also be an absolute URL.
+		field.String("path").NotEmpty(),
+	}
+}
+
+// Edges of the Rendition.
+func (Rendition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("episode", Episode.Type).Ref("renditions").Unique().Required(),
+	}
+}
+
+// Indexes of the Rendition.
+func (Rendition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Edges("episode").Unique(),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -11,6 +12,13 @@ type Season struct {
 	ent.Schema
 }
 
+// Mixin of the Season.
+func (Season) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
 // Fields of the Season.
 func (Season) Fields() []ent.Field {
 	return []ent.Field{
@@ -24,6 +32,8 @@ func (Season) Fields() []ent.Field {
 		field.Int("first_month").Optional(),
 		field.Int("first_end_year").Optional(),
 		field.Int("first_end_month").Optional(),
+		// media maps asset names to object keys overriding the configured layout.
+		field.JSON("media", map[string]string{}).Optional(),
 	}
 }
 
@@ -32,5 +42,11 @@ func (Season) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("series", Series.Type).Ref("seasons").Unique().Required(),
 		edge.To("episodes", Episode.Type),
+		edge.To("favorites", Favorite.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("ratings", Rating.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("watchlist_items", WatchlistItem.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -11,6 +12,13 @@ type Series struct {
 	ent.Schema
 }
 
+// Mixin of the Series.
+func (Series) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
 // Fields of the Series.
 func (Series) Fields() []ent.Field {
 	return []ent.Field{
@@ -19,6 +27,8 @@ func (Series) Fields() []ent.Field {
 		field.String("title_yomi").Optional(),
 		field.String("title_en").Optional(),
 		field.Text("description").Optional(),
+		// media maps asset names to object keys overriding the configured layout.
+		field.JSON("media", map[string]string{}).Optional(),
 	}
 }
 
@@ -26,5 +36,11 @@ func (Series) Fields() []ent.Field {
 func (Series) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("seasons", Season.Type),
+		edge.To("favorites", Favorite.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("ratings", Rating.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("watchlist_items", WatchlistItem.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Subtitle holds the schema definition for the Subtitle entity, a
+// subtitle file of an episode.
+type Subtitle struct {
+	ent.Schema
+}
+
+// Mixin of the Subtitle.
+func (Subtitle) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Subtitle.
+func (Subtitle) Fields() []ent.Field {
+	return []ent.Field{
+		// language is a BCP 47 tag, e.g. "ja" or "pt-BR".
+		field.String("language").NotEmpty(),
+		// format is the file format: srt, ass, ssa or vtt.
+		field.String("format"),
+		field.String("label").Optional(),
+		// is_default marks the track players select; at most one per episode.
+		field.Bool("is_default").Default(false),
+		// path is the object key of the file; it may also be an absolute URL.
+		field.String("path").NotEmpty(),
+	}
+}
+
+// Edges of the Subtitle.
+func (Subtitle) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("episode", Episode.Type).Ref("subtitles").Unique().Required(),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// User holds the schema definition for the User entity, an account of a
+// viewer.
+type User struct {
+	ent.Schema
+}
+
+// Mixin of the User.
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		// name is the account name the request was authenticated as.
+		field.String("name").Unique().NotEmpty().MaxLen(255),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("watch_progress", WatchProgress.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("favorites", Favorite.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("ratings", Rating.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("watchlists", Watchlist.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("api_keys", APIKey.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
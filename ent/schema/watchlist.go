@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Watchlist holds the schema definition for the Watchlist entity, a named
+// list of series and seasons a user keeps.
+type Watchlist struct {
+	ent.Schema
+}
+
+// Mixin of the Watchlist.
+func (Watchlist) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Watchlist.
+func (Watchlist) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty().MaxLen(100),
+	}
+}
+
+// Edges of the Watchlist.
+func (Watchlist) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("watchlists").Unique().Required(),
+		edge.To("items", WatchlistItem.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Watchlist.
+func (Watchlist) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Edges("user").Unique(),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/index"
+)
+
+// WatchlistItem holds the schema definition for the WatchlistItem entity,
+// a series or season on a watchlist. Exactly one of the series and season
+// edges is set; created_at is when it was added.
+type WatchlistItem struct {
+	ent.Schema
+}
+
+// Mixin of the WatchlistItem.
+func (WatchlistItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Edges of the WatchlistItem.
+func (WatchlistItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("watchlist", Watchlist.Type).Ref("items").Unique().Required(),
+		edge.From("series", Series.Type).Ref("watchlist_items").Unique(),
+		edge.From("season", Season.Type).Ref("watchlist_items").Unique(),
+	}
+}
+
+// Indexes of the WatchlistItem.
+func (WatchlistItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("watchlist", "series").Unique(),
+		index.Edges("watchlist", "season").Unique(),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WatchProgress holds the schema definition for the WatchProgress entity,
+// how far a user has watched an episode. updated_at is when they last
+// watched it.
+type WatchProgress struct {
+	ent.Schema
+}
+
+// Mixin of the WatchProgress.
+func (WatchProgress) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the WatchProgress.
+func (WatchProgress) Fields() []ent.Field {
+	return []ent.Field{
+		// position is the offset in seconds playback resumes at.
+		field.Float("position"),
+		field.Bool("completed").Default(false),
+	}
+}
+
+// Edges of the WatchProgress.
+func (WatchProgress) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("watch_progress").Unique().Required(),
+		edge.From("episode", Episode.Type).Ref("watch_progress").Unique().Required(),
+	}
+}
+
+// Indexes of the WatchProgress.
+func (WatchProgress) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("user", "episode").Unique(),
+	}
+}
@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -17,6 +19,10 @@ type Season struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// SeasonID holds the value of the "season_id" field.
 	SeasonID string `json:"season_id,omitempty"`
 	// SeasonTitle holds the value of the "season_title" field.
@@ -37,6 +43,8 @@ type Season struct {
 	FirstEndYear int `json:"first_end_year,omitempty"`
 	// FirstEndMonth holds the value of the "first_end_month" field.
 	FirstEndMonth int `json:"first_end_month,omitempty"`
+	// Media holds the value of the "media" field.
+	Media map[string]string `json:"media,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SeasonQuery when eager-loading is set.
 	Edges          SeasonEdges `json:"edges"`
@@ -50,9 +58,15 @@ type SeasonEdges struct {
 	Series *Series `json:"series,omitempty"`
 	// Episodes holds the value of the episodes edge.
 	Episodes []*Episode `json:"episodes,omitempty"`
+	// Favorites holds the value of the favorites edge.
+	Favorites []*Favorite `json:"favorites,omitempty"`
+	// Ratings holds the value of the ratings edge.
+	Ratings []*Rating `json:"ratings,omitempty"`
+	// WatchlistItems holds the value of the watchlist_items edge.
+	WatchlistItems []*WatchlistItem `json:"watchlist_items,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
 }
 
 // SeriesOrErr returns the Series value or an error if the edge
@@ -75,15 +89,46 @@ func (e SeasonEdges) EpisodesOrErr() ([]*Episode, error) {
 	return nil, &NotLoadedError{edge: "episodes"}
 }
 
+// FavoritesOrErr returns the Favorites value or an error if the edge
+// was not loaded in eager-loading.
+func (e SeasonEdges) FavoritesOrErr() ([]*Favorite, error) {
+	if e.loadedTypes[2] {
+		return e.Favorites, nil
+	}
+	return nil, &NotLoadedError{edge: "favorites"}
+}
+
+// RatingsOrErr returns the Ratings value or an error if the edge
+// was not loaded in eager-loading.
+func (e SeasonEdges) RatingsOrErr() ([]*Rating, error) {
+	if e.loadedTypes[3] {
+		return e.Ratings, nil
+	}
+	return nil, &NotLoadedError{edge: "ratings"}
+}
+
+// WatchlistItemsOrErr returns the WatchlistItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e SeasonEdges) WatchlistItemsOrErr() ([]*WatchlistItem, error) {
+	if e.loadedTypes[4] {
+		return e.WatchlistItems, nil
+	}
+	return nil, &NotLoadedError{edge: "watchlist_items"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Season) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case season.FieldMedia:
+			values[i] = new([]byte)
 		case season.FieldID, season.FieldSeasonNumber, season.FieldShoboiTid, season.FieldFirstYear, season.FieldFirstMonth, season.FieldFirstEndYear, season.FieldFirstEndMonth:
 			values[i] = new(sql.NullInt64)
 		case season.FieldSeasonID, season.FieldSeasonTitle, season.FieldSeasonTitleYomi, season.FieldDescription:
 			values[i] = new(sql.NullString)
+		case season.FieldCreatedAt, season.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
 		case season.ForeignKeys[0]: // series_seasons
 			values[i] = new(sql.NullInt64)
 		default:
@@ -107,6 +152,18 @@ func (s *Season) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			s.ID = int(value.Int64)
+		case season.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case season.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
 		case season.FieldSeasonID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field season_id", values[i])
@@ -167,6 +224,14 @@ func (s *Season) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.FirstEndMonth = int(value.Int64)
 			}
+		case season.FieldMedia:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field media", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Media); err != nil {
+					return fmt.Errorf("unmarshal field media: %w", err)
+				}
+			}
 		case season.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field series_seasons", value)
@@ -197,6 +262,21 @@ func (s *Season) QueryEpisodes() *EpisodeQuery {
 	return NewSeasonClient(s.config).QueryEpisodes(s)
 }
 
+// QueryFavorites queries the "favorites" edge of the Season entity.
+func (s *Season) QueryFavorites() *FavoriteQuery {
+	return NewSeasonClient(s.config).QueryFavorites(s)
+}
+
+// QueryRatings queries the "ratings" edge of the Season entity.
+func (s *Season) QueryRatings() *RatingQuery {
+	return NewSeasonClient(s.config).QueryRatings(s)
+}
+
+// QueryWatchlistItems queries the "watchlist_items" edge of the Season entity.
+func (s *Season) QueryWatchlistItems() *WatchlistItemQuery {
+	return NewSeasonClient(s.config).QueryWatchlistItems(s)
+}
+
 // Update returns a builder for updating this Season.
 // Note that you need to call Season.Unwrap() before calling this method if this Season
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -220,6 +300,12 @@ func (s *Season) String() string {
 	var builder strings.Builder
 	builder.WriteString("Season(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("season_id=")
 	builder.WriteString(s.SeasonID)
 	builder.WriteString(", ")
@@ -249,6 +335,9 @@ func (s *Season) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("first_end_month=")
 	builder.WriteString(fmt.Sprintf("%v", s.FirstEndMonth))
+	builder.WriteString(", ")
+	builder.WriteString("media=")
+	builder.WriteString(fmt.Sprintf("%v", s.Media))
 	builder.WriteByte(')')
 	return builder.String()
 }
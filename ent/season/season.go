@@ -3,6 +3,8 @@
 package season
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -12,6 +14,10 @@ const (
 	Label = "season"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
 	// FieldSeasonID holds the string denoting the season_id field in the database.
 	FieldSeasonID = "season_id"
 	// FieldSeasonTitle holds the string denoting the season_title field in the database.
@@ -32,10 +38,18 @@ const (
 	FieldFirstEndYear = "first_end_year"
 	// FieldFirstEndMonth holds the string denoting the first_end_month field in the database.
 	FieldFirstEndMonth = "first_end_month"
+	// FieldMedia holds the string denoting the media field in the database.
+	FieldMedia = "media"
 	// EdgeSeries holds the string denoting the series edge name in mutations.
 	EdgeSeries = "series"
 	// EdgeEpisodes holds the string denoting the episodes edge name in mutations.
 	EdgeEpisodes = "episodes"
+	// EdgeFavorites holds the string denoting the favorites edge name in mutations.
+	EdgeFavorites = "favorites"
+	// EdgeRatings holds the string denoting the ratings edge name in mutations.
+	EdgeRatings = "ratings"
+	// EdgeWatchlistItems holds the string denoting the watchlist_items edge name in mutations.
+	EdgeWatchlistItems = "watchlist_items"
 	// Table holds the table name of the season in the database.
 	Table = "seasons"
 	// SeriesTable is the table that holds the series relation/edge.
@@ -52,11 +66,34 @@ const (
 	EpisodesInverseTable = "episodes"
 	// EpisodesColumn is the table column denoting the episodes relation/edge.
 	EpisodesColumn = "season_episodes"
+	// FavoritesTable is the table that holds the favorites relation/edge.
+	FavoritesTable = "favorites"
+	// FavoritesInverseTable is the table name for the Favorite entity.
+	// It exists in this package in order to avoid circular dependency with the "favorite" package.
+	FavoritesInverseTable = "favorites"
+	// FavoritesColumn is the table column denoting the favorites relation/edge.
+	FavoritesColumn = "season_favorites"
+	// RatingsTable is the table that holds the ratings relation/edge.
+	RatingsTable = "ratings"
+	// RatingsInverseTable is the table name for the Rating entity.
+	// It exists in this package in order to avoid circular dependency with the "rating" package.
+	RatingsInverseTable = "ratings"
+	// RatingsColumn is the table column denoting the ratings relation/edge.
+	RatingsColumn = "season_ratings"
+	// WatchlistItemsTable is the table that holds the watchlist_items relation/edge.
+	WatchlistItemsTable = "watchlist_items"
+	// WatchlistItemsInverseTable is the table name for the WatchlistItem entity.
+	// It exists in this package in order to avoid circular dependency with the "watchlistitem" package.
+	WatchlistItemsInverseTable = "watchlist_items"
+	// WatchlistItemsColumn is the table column denoting the watchlist_items relation/edge.
+	WatchlistItemsColumn = "season_watchlist_items"
 )
 
 // Columns holds all SQL columns for season fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
 	FieldSeasonID,
 	FieldSeasonTitle,
 	FieldSeasonTitleYomi,
@@ -67,6 +104,7 @@ var Columns = []string{
 	FieldFirstMonth,
 	FieldFirstEndYear,
 	FieldFirstEndMonth,
+	FieldMedia,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "seasons"
@@ -91,6 +129,12 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
 	// SeasonIDValidator is a validator for the "season_id" field. It is called by the builders before save.
 	SeasonIDValidator func(string) error
 	// SeasonTitleValidator is a validator for the "season_title" field. It is called by the builders before save.
@@ -105,6 +149,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
 // BySeasonID orders the results by the season_id field.
 func BySeasonID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSeasonID, opts...).ToFunc()
@@ -175,6 +229,48 @@ func ByEpisodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newEpisodesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByFavoritesCount orders the results by favorites count.
+func ByFavoritesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFavoritesStep(), opts...)
+	}
+}
+
+// ByFavorites orders the results by favorites terms.
+func ByFavorites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFavoritesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRatingsCount orders the results by ratings count.
+func ByRatingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRatingsStep(), opts...)
+	}
+}
+
+// ByRatings orders the results by ratings terms.
+func ByRatings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRatingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWatchlistItemsCount orders the results by watchlist_items count.
+func ByWatchlistItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWatchlistItemsStep(), opts...)
+	}
+}
+
+// ByWatchlistItems orders the results by watchlist_items terms.
+func ByWatchlistItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWatchlistItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSeriesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -189,3 +285,24 @@ func newEpisodesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, EpisodesTable, EpisodesColumn),
 	)
 }
+func newFavoritesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FavoritesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FavoritesTable, FavoritesColumn),
+	)
+}
+func newRatingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RatingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RatingsTable, RatingsColumn),
+	)
+}
+func newWatchlistItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WatchlistItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WatchlistItemsTable, WatchlistItemsColumn),
+	)
+}
@@ -6,12 +6,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/favorite"
+	"github.com/clustlight/animatrix-api/ent/rating"
 	"github.com/clustlight/animatrix-api/ent/season"
 	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
 )
 
 // SeasonCreate is the builder for creating a Season entity.
@@ -21,6 +25,34 @@ type SeasonCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (sc *SeasonCreate) SetCreatedAt(t time.Time) *SeasonCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SeasonCreate) SetNillableCreatedAt(t *time.Time) *SeasonCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SeasonCreate) SetUpdatedAt(t time.Time) *SeasonCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SeasonCreate) SetNillableUpdatedAt(t *time.Time) *SeasonCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
 // SetSeasonID sets the "season_id" field.
 func (sc *SeasonCreate) SetSeasonID(s string) *SeasonCreate {
 	sc.mutation.SetSeasonID(s)
@@ -137,6 +169,12 @@ func (sc *SeasonCreate) SetNillableFirstEndMonth(i *int) *SeasonCreate {
 	return sc
 }
 
+// SetMedia sets the "media" field.
+func (sc *SeasonCreate) SetMedia(m map[string]string) *SeasonCreate {
+	sc.mutation.SetMedia(m)
+	return sc
+}
+
 // SetSeriesID sets the "series" edge to the Series entity by ID.
 func (sc *SeasonCreate) SetSeriesID(id int) *SeasonCreate {
 	sc.mutation.SetSeriesID(id)
@@ -163,6 +201,51 @@ func (sc *SeasonCreate) AddEpisodes(e ...*Episode) *SeasonCreate {
 	return sc.AddEpisodeIDs(ids...)
 }
 
+// AddFavoriteIDs adds the "favorites" edge to the Favorite entity by IDs.
+func (sc *SeasonCreate) AddFavoriteIDs(ids ...int) *SeasonCreate {
+	sc.mutation.AddFavoriteIDs(ids...)
+	return sc
+}
+
+// AddFavorites adds the "favorites" edges to the Favorite entity.
+func (sc *SeasonCreate) AddFavorites(f ...*Favorite) *SeasonCreate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return sc.AddFavoriteIDs(ids...)
+}
+
+// AddRatingIDs adds the "ratings" edge to the Rating entity by IDs.
+func (sc *SeasonCreate) AddRatingIDs(ids ...int) *SeasonCreate {
+	sc.mutation.AddRatingIDs(ids...)
+	return sc
+}
+
+// AddRatings adds the "ratings" edges to the Rating entity.
+func (sc *SeasonCreate) AddRatings(r ...*Rating) *SeasonCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return sc.AddRatingIDs(ids...)
+}
+
+// AddWatchlistItemIDs adds the "watchlist_items" edge to the WatchlistItem entity by IDs.
+func (sc *SeasonCreate) AddWatchlistItemIDs(ids ...int) *SeasonCreate {
+	sc.mutation.AddWatchlistItemIDs(ids...)
+	return sc
+}
+
+// AddWatchlistItems adds the "watchlist_items" edges to the WatchlistItem entity.
+func (sc *SeasonCreate) AddWatchlistItems(w ...*WatchlistItem) *SeasonCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return sc.AddWatchlistItemIDs(ids...)
+}
+
 // Mutation returns the SeasonMutation object of the builder.
 func (sc *SeasonCreate) Mutation() *SeasonMutation {
 	return sc.mutation
@@ -170,6 +253,7 @@ func (sc *SeasonCreate) Mutation() *SeasonMutation {
 
 // Save creates the Season in the database.
 func (sc *SeasonCreate) Save(ctx context.Context) (*Season, error) {
+	sc.defaults()
 	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
 }
 
@@ -195,8 +279,26 @@ func (sc *SeasonCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (sc *SeasonCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := season.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := season.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (sc *SeasonCreate) check() error {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Season.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Season.updated_at"`)}
+	}
 	if _, ok := sc.mutation.SeasonID(); !ok {
 		return &ValidationError{Name: "season_id", err: errors.New(`ent: missing required field "Season.season_id"`)}
 	}
@@ -245,6 +347,14 @@ func (sc *SeasonCreate) createSpec() (*Season, *sqlgraph.CreateSpec) {
 		_node = &Season{config: sc.config}
 		_spec = sqlgraph.NewCreateSpec(season.Table, sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt))
 	)
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(season.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(season.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
 	if value, ok := sc.mutation.SeasonID(); ok {
 		_spec.SetField(season.FieldSeasonID, field.TypeString, value)
 		_node.SeasonID = value
@@ -285,6 +395,10 @@ func (sc *SeasonCreate) createSpec() (*Season, *sqlgraph.CreateSpec) {
 		_spec.SetField(season.FieldFirstEndMonth, field.TypeInt, value)
 		_node.FirstEndMonth = value
 	}
+	if value, ok := sc.mutation.Media(); ok {
+		_spec.SetField(season.FieldMedia, field.TypeJSON, value)
+		_node.Media = value
+	}
 	if nodes := sc.mutation.SeriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -318,6 +432,54 @@ func (sc *SeasonCreate) createSpec() (*Season, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.FavoritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.FavoritesTable,
+			Columns: []string{season.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.RatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.RatingsTable,
+			Columns: []string{season.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.WatchlistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.WatchlistItemsTable,
+			Columns: []string{season.WatchlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -339,6 +501,7 @@ func (scb *SeasonCreateBulk) Save(ctx context.Context) ([]*Season, error) {
 	for i := range scb.builders {
 		func(i int, root context.Context) {
 			builder := scb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*SeasonMutation)
 				if !ok {
@@ -9,25 +9,33 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/favorite"
 	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/rating"
 	"github.com/clustlight/animatrix-api/ent/season"
 	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
 )
 
 // SeasonQuery is the builder for querying Season entities.
 type SeasonQuery struct {
 	config
-	ctx          *QueryContext
-	order        []season.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Season
-	withSeries   *SeriesQuery
-	withEpisodes *EpisodeQuery
-	withFKs      bool
+	ctx                *QueryContext
+	order              []season.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Season
+	withSeries         *SeriesQuery
+	withEpisodes       *EpisodeQuery
+	withFavorites      *FavoriteQuery
+	withRatings        *RatingQuery
+	withWatchlistItems *WatchlistItemQuery
+	withFKs            bool
+	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +116,72 @@ func (sq *SeasonQuery) QueryEpisodes() *EpisodeQuery {
 	return query
 }
 
+// QueryFavorites chains the current query on the "favorites" edge.
+func (sq *SeasonQuery) QueryFavorites() *FavoriteQuery {
+	query := (&FavoriteClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(season.Table, season.FieldID, selector),
+			sqlgraph.To(favorite.Table, favorite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, season.FavoritesTable, season.FavoritesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRatings chains the current query on the "ratings" edge.
+func (sq *SeasonQuery) QueryRatings() *RatingQuery {
+	query := (&RatingClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(season.Table, season.FieldID, selector),
+			sqlgraph.To(rating.Table, rating.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, season.RatingsTable, season.RatingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWatchlistItems chains the current query on the "watchlist_items" edge.
+func (sq *SeasonQuery) QueryWatchlistItems() *WatchlistItemQuery {
+	query := (&WatchlistItemClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(season.Table, season.FieldID, selector),
+			sqlgraph.To(watchlistitem.Table, watchlistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, season.WatchlistItemsTable, season.WatchlistItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Season entity from the query.
 // Returns a *NotFoundError when no Season was found.
 func (sq *SeasonQuery) First(ctx context.Context) (*Season, error) {
@@ -295,13 +369,16 @@ func (sq *SeasonQuery) Clone() *SeasonQuery {
 		return nil
 	}
 	return &SeasonQuery{
-		config:       sq.config,
-		ctx:          sq.ctx.Clone(),
-		order:        append([]season.OrderOption{}, sq.order...),
-		inters:       append([]Interceptor{}, sq.inters...),
-		predicates:   append([]predicate.Season{}, sq.predicates...),
-		withSeries:   sq.withSeries.Clone(),
-		withEpisodes: sq.withEpisodes.Clone(),
+		config:             sq.config,
+		ctx:                sq.ctx.Clone(),
+		order:              append([]season.OrderOption{}, sq.order...),
+		inters:             append([]Interceptor{}, sq.inters...),
+		predicates:         append([]predicate.Season{}, sq.predicates...),
+		withSeries:         sq.withSeries.Clone(),
+		withEpisodes:       sq.withEpisodes.Clone(),
+		withFavorites:      sq.withFavorites.Clone(),
+		withRatings:        sq.withRatings.Clone(),
+		withWatchlistItems: sq.withWatchlistItems.Clone(),
 		// clone intermediate query.
 		sql:  sq.sql.Clone(),
 		path: sq.path,
@@ -330,18 +407,51 @@ func (sq *SeasonQuery) WithEpisodes(opts ...func(*EpisodeQuery)) *SeasonQuery {
 	return sq
 }
 
+// WithFavorites tells the query-builder to eager-load the nodes that are connected to
+// the "favorites" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SeasonQuery) WithFavorites(opts ...func(*FavoriteQuery)) *SeasonQuery {
+	query := (&FavoriteClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withFavorites = query
+	return sq
+}
+
+// WithRatings tells the query-builder to eager-load the nodes that are connected to
+// the "ratings" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SeasonQuery) WithRatings(opts ...func(*RatingQuery)) *SeasonQuery {
+	query := (&RatingClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withRatings = query
+	return sq
+}
+
+// WithWatchlistItems tells the query-builder to eager-load the nodes that are connected to
+// the "watchlist_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SeasonQuery) WithWatchlistItems(opts ...func(*WatchlistItemQuery)) *SeasonQuery {
+	query := (&WatchlistItemClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withWatchlistItems = query
+	return sq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		SeasonID string `json:"season_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Season.Query().
-//		GroupBy(season.FieldSeasonID).
+//		GroupBy(season.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (sq *SeasonQuery) GroupBy(field string, fields ...string) *SeasonGroupBy {
@@ -359,11 +469,11 @@ func (sq *SeasonQuery) GroupBy(field string, fields ...string) *SeasonGroupBy {
 // Example:
 //
 //	var v []struct {
-//		SeasonID string `json:"season_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.Season.Query().
-//		Select(season.FieldSeasonID).
+//		Select(season.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (sq *SeasonQuery) Select(fields ...string) *SeasonSelect {
 	sq.ctx.Fields = append(sq.ctx.Fields, fields...)
@@ -409,9 +519,12 @@ func (sq *SeasonQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Seaso
 		nodes       = []*Season{}
 		withFKs     = sq.withFKs
 		_spec       = sq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [5]bool{
 			sq.withSeries != nil,
 			sq.withEpisodes != nil,
+			sq.withFavorites != nil,
+			sq.withRatings != nil,
+			sq.withWatchlistItems != nil,
 		}
 	)
 	if sq.withSeries != nil {
@@ -429,6 +542,9 @@ func (sq *SeasonQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Seaso
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(sq.modifiers) > 0 {
+		_spec.Modifiers = sq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -451,6 +567,27 @@ func (sq *SeasonQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Seaso
 			return nil, err
 		}
 	}
+	if query := sq.withFavorites; query != nil {
+		if err := sq.loadFavorites(ctx, query, nodes,
+			func(n *Season) { n.Edges.Favorites = []*Favorite{} },
+			func(n *Season, e *Favorite) { n.Edges.Favorites = append(n.Edges.Favorites, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withRatings; query != nil {
+		if err := sq.loadRatings(ctx, query, nodes,
+			func(n *Season) { n.Edges.Ratings = []*Rating{} },
+			func(n *Season, e *Rating) { n.Edges.Ratings = append(n.Edges.Ratings, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withWatchlistItems; query != nil {
+		if err := sq.loadWatchlistItems(ctx, query, nodes,
+			func(n *Season) { n.Edges.WatchlistItems = []*WatchlistItem{} },
+			func(n *Season, e *WatchlistItem) { n.Edges.WatchlistItems = append(n.Edges.WatchlistItems, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -517,9 +654,105 @@ func (sq *SeasonQuery) loadEpisodes(ctx context.Context, query *EpisodeQuery, no
 	}
 	return nil
 }
+func (sq *SeasonQuery) loadFavorites(ctx context.Context, query *FavoriteQuery, nodes []*Season, init func(*Season), assign func(*Season, *Favorite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Season)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Favorite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(season.FavoritesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.season_favorites
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "season_favorites" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "season_favorites" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *SeasonQuery) loadRatings(ctx context.Context, query *RatingQuery, nodes []*Season, init func(*Season), assign func(*Season, *Rating)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Season)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Rating(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(season.RatingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.season_ratings
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "season_ratings" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "season_ratings" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *SeasonQuery) loadWatchlistItems(ctx context.Context, query *WatchlistItemQuery, nodes []*Season, init func(*Season), assign func(*Season, *WatchlistItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Season)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.WatchlistItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(season.WatchlistItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.season_watchlist_items
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "season_watchlist_items" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "season_watchlist_items" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (sq *SeasonQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
+	if len(sq.modifiers) > 0 {
+		_spec.Modifiers = sq.modifiers
+	}
 	_spec.Node.Columns = sq.ctx.Fields
 	if len(sq.ctx.Fields) > 0 {
 		_spec.Unique = sq.ctx.Unique != nil && *sq.ctx.Unique
@@ -582,6 +815,9 @@ func (sq *SeasonQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if sq.ctx.Unique != nil && *sq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range sq.modifiers {
+		m(selector)
+	}
 	for _, p := range sq.predicates {
 		p(selector)
 	}
@@ -599,6 +835,32 @@ func (sq *SeasonQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (sq *SeasonQuery) ForUpdate(opts ...sql.LockOption) *SeasonQuery {
+	if sq.driver.Dialect() == dialect.Postgres {
+		sq.Unique(false)
+	}
+	sq.modifiers = append(sq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return sq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (sq *SeasonQuery) ForShare(opts ...sql.LockOption) *SeasonQuery {
+	if sq.driver.Dialect() == dialect.Postgres {
+		sq.Unique(false)
+	}
+	sq.modifiers = append(sq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return sq
+}
+
 // SeasonGroupBy is the group-by builder for Season entities.
 type SeasonGroupBy struct {
 	selector
@@ -6,14 +6,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/favorite"
 	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/rating"
 	"github.com/clustlight/animatrix-api/ent/season"
 	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
 )
 
 // SeasonUpdate is the builder for updating Season entities.
@@ -29,6 +33,12 @@ func (su *SeasonUpdate) Where(ps ...predicate.Season) *SeasonUpdate {
 	return su
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SeasonUpdate) SetUpdatedAt(t time.Time) *SeasonUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
 // SetSeasonID sets the "season_id" field.
 func (su *SeasonUpdate) SetSeasonID(s string) *SeasonUpdate {
 	su.mutation.SetSeasonID(s)
@@ -253,6 +263,18 @@ func (su *SeasonUpdate) ClearFirstEndMonth() *SeasonUpdate {
 	return su
 }
 
+// SetMedia sets the "media" field.
+func (su *SeasonUpdate) SetMedia(m map[string]string) *SeasonUpdate {
+	su.mutation.SetMedia(m)
+	return su
+}
+
+// ClearMedia clears the value of the "media" field.
+func (su *SeasonUpdate) ClearMedia() *SeasonUpdate {
+	su.mutation.ClearMedia()
+	return su
+}
+
 // SetSeriesID sets the "series" edge to the Series entity by ID.
 func (su *SeasonUpdate) SetSeriesID(id int) *SeasonUpdate {
 	su.mutation.SetSeriesID(id)
@@ -279,6 +301,51 @@ func (su *SeasonUpdate) AddEpisodes(e ...*Episode) *SeasonUpdate {
 	return su.AddEpisodeIDs(ids...)
 }
 
+// AddFavoriteIDs adds the "favorites" edge to the Favorite entity by IDs.
+func (su *SeasonUpdate) AddFavoriteIDs(ids ...int) *SeasonUpdate {
+	su.mutation.AddFavoriteIDs(ids...)
+	return su
+}
+
+// AddFavorites adds the "favorites" edges to the Favorite entity.
+func (su *SeasonUpdate) AddFavorites(f ...*Favorite) *SeasonUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return su.AddFavoriteIDs(ids...)
+}
+
+// AddRatingIDs adds the "ratings" edge to the Rating entity by IDs.
+func (su *SeasonUpdate) AddRatingIDs(ids ...int) *SeasonUpdate {
+	su.mutation.AddRatingIDs(ids...)
+	return su
+}
+
+// AddRatings adds the "ratings" edges to the Rating entity.
+func (su *SeasonUpdate) AddRatings(r ...*Rating) *SeasonUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return su.AddRatingIDs(ids...)
+}
+
+// AddWatchlistItemIDs adds the "watchlist_items" edge to the WatchlistItem entity by IDs.
+func (su *SeasonUpdate) AddWatchlistItemIDs(ids ...int) *SeasonUpdate {
+	su.mutation.AddWatchlistItemIDs(ids...)
+	return su
+}
+
+// AddWatchlistItems adds the "watchlist_items" edges to the WatchlistItem entity.
+func (su *SeasonUpdate) AddWatchlistItems(w ...*WatchlistItem) *SeasonUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return su.AddWatchlistItemIDs(ids...)
+}
+
 // Mutation returns the SeasonMutation object of the builder.
 func (su *SeasonUpdate) Mutation() *SeasonMutation {
 	return su.mutation
@@ -311,8 +378,72 @@ func (su *SeasonUpdate) RemoveEpisodes(e ...*Episode) *SeasonUpdate {
 	return su.RemoveEpisodeIDs(ids...)
 }
 
+// ClearFavorites clears all "favorites" edges to the Favorite entity.
+func (su *SeasonUpdate) ClearFavorites() *SeasonUpdate {
+	su.mutation.ClearFavorites()
+	return su
+}
+
+// RemoveFavoriteIDs removes the "favorites" edge to Favorite entities by IDs.
+func (su *SeasonUpdate) RemoveFavoriteIDs(ids ...int) *SeasonUpdate {
+	su.mutation.RemoveFavoriteIDs(ids...)
+	return su
+}
+
+// RemoveFavorites removes "favorites" edges to Favorite entities.
+func (su *SeasonUpdate) RemoveFavorites(f ...*Favorite) *SeasonUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return su.RemoveFavoriteIDs(ids...)
+}
+
+// ClearRatings clears all "ratings" edges to the Rating entity.
+func (su *SeasonUpdate) ClearRatings() *SeasonUpdate {
+	su.mutation.ClearRatings()
+	return su
+}
+
+// RemoveRatingIDs removes the "ratings" edge to Rating entities by IDs.
+func (su *SeasonUpdate) RemoveRatingIDs(ids ...int) *SeasonUpdate {
+	su.mutation.RemoveRatingIDs(ids...)
+	return su
+}
+
+// RemoveRatings removes "ratings" edges to Rating entities.
+func (su *SeasonUpdate) RemoveRatings(r ...*Rating) *SeasonUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return su.RemoveRatingIDs(ids...)
+}
+
+// ClearWatchlistItems clears all "watchlist_items" edges to the WatchlistItem entity.
+func (su *SeasonUpdate) ClearWatchlistItems() *SeasonUpdate {
+	su.mutation.ClearWatchlistItems()
+	return su
+}
+
+// RemoveWatchlistItemIDs removes the "watchlist_items" edge to WatchlistItem entities by IDs.
+func (su *SeasonUpdate) RemoveWatchlistItemIDs(ids ...int) *SeasonUpdate {
+	su.mutation.RemoveWatchlistItemIDs(ids...)
+	return su
+}
+
+// RemoveWatchlistItems removes "watchlist_items" edges to WatchlistItem entities.
+func (su *SeasonUpdate) RemoveWatchlistItems(w ...*WatchlistItem) *SeasonUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return su.RemoveWatchlistItemIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *SeasonUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
 	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
 }
 
@@ -338,6 +469,14 @@ func (su *SeasonUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (su *SeasonUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := season.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (su *SeasonUpdate) check() error {
 	if v, ok := su.mutation.SeasonID(); ok {
@@ -368,6 +507,9 @@ func (su *SeasonUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(season.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if value, ok := su.mutation.SeasonID(); ok {
 		_spec.SetField(season.FieldSeasonID, field.TypeString, value)
 	}
@@ -437,6 +579,12 @@ func (su *SeasonUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.FirstEndMonthCleared() {
 		_spec.ClearField(season.FieldFirstEndMonth, field.TypeInt)
 	}
+	if value, ok := su.mutation.Media(); ok {
+		_spec.SetField(season.FieldMedia, field.TypeJSON, value)
+	}
+	if su.mutation.MediaCleared() {
+		_spec.ClearField(season.FieldMedia, field.TypeJSON)
+	}
 	if su.mutation.SeriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -511,6 +659,141 @@ func (su *SeasonUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.FavoritesTable,
+			Columns: []string{season.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedFavoritesIDs(); len(nodes) > 0 && !su.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.FavoritesTable,
+			Columns: []string{season.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.FavoritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.FavoritesTable,
+			Columns: []string{season.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.RatingsTable,
+			Columns: []string{season.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedRatingsIDs(); len(nodes) > 0 && !su.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.RatingsTable,
+			Columns: []string{season.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.RatingsTable,
+			Columns: []string{season.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.WatchlistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.WatchlistItemsTable,
+			Columns: []string{season.WatchlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedWatchlistItemsIDs(); len(nodes) > 0 && !su.mutation.WatchlistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.WatchlistItemsTable,
+			Columns: []string{season.WatchlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.WatchlistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.WatchlistItemsTable,
+			Columns: []string{season.WatchlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{season.Label}
@@ -531,6 +814,12 @@ type SeasonUpdateOne struct {
 	mutation *SeasonMutation
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SeasonUpdateOne) SetUpdatedAt(t time.Time) *SeasonUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
 // SetSeasonID sets the "season_id" field.
 func (suo *SeasonUpdateOne) SetSeasonID(s string) *SeasonUpdateOne {
 	suo.mutation.SetSeasonID(s)
@@ -755,6 +1044,18 @@ func (suo *SeasonUpdateOne) ClearFirstEndMonth() *SeasonUpdateOne {
 	return suo
 }
 
+// SetMedia sets the "media" field.
+func (suo *SeasonUpdateOne) SetMedia(m map[string]string) *SeasonUpdateOne {
+	suo.mutation.SetMedia(m)
+	return suo
+}
+
+// ClearMedia clears the value of the "media" field.
+func (suo *SeasonUpdateOne) ClearMedia() *SeasonUpdateOne {
+	suo.mutation.ClearMedia()
+	return suo
+}
+
 // SetSeriesID sets the "series" edge to the Series entity by ID.
 func (suo *SeasonUpdateOne) SetSeriesID(id int) *SeasonUpdateOne {
 	suo.mutation.SetSeriesID(id)
@@ -781,6 +1082,51 @@ func (suo *SeasonUpdateOne) AddEpisodes(e ...*Episode) *SeasonUpdateOne {
 	return suo.AddEpisodeIDs(ids...)
 }
 
+// AddFavoriteIDs adds the "favorites" edge to the Favorite entity by IDs.
+func (suo *SeasonUpdateOne) AddFavoriteIDs(ids ...int) *SeasonUpdateOne {
+	suo.mutation.AddFavoriteIDs(ids...)
+	return suo
+}
+
+// AddFavorites adds the "favorites" edges to the Favorite entity.
+func (suo *SeasonUpdateOne) AddFavorites(f ...*Favorite) *SeasonUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return suo.AddFavoriteIDs(ids...)
+}
+
+// AddRatingIDs adds the "ratings" edge to the Rating entity by IDs.
+func (suo *SeasonUpdateOne) AddRatingIDs(ids ...int) *SeasonUpdateOne {
+	suo.mutation.AddRatingIDs(ids...)
+	return suo
+}
+
+// AddRatings adds the "ratings" edges to the Rating entity.
+func (suo *SeasonUpdateOne) AddRatings(r ...*Rating) *SeasonUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return suo.AddRatingIDs(ids...)
+}
+
+// AddWatchlistItemIDs adds the "watchlist_items" edge to the WatchlistItem entity by IDs.
+func (suo *SeasonUpdateOne) AddWatchlistItemIDs(ids ...int) *SeasonUpdateOne {
+	suo.mutation.AddWatchlistItemIDs(ids...)
+	return suo
+}
+
+// AddWatchlistItems adds the "watchlist_items" edges to the WatchlistItem entity.
+func (suo *SeasonUpdateOne) AddWatchlistItems(w ...*WatchlistItem) *SeasonUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return suo.AddWatchlistItemIDs(ids...)
+}
+
 // Mutation returns the SeasonMutation object of the builder.
 func (suo *SeasonUpdateOne) Mutation() *SeasonMutation {
 	return suo.mutation
@@ -813,6 +1159,69 @@ func (suo *SeasonUpdateOne) RemoveEpisodes(e ...*Episode) *SeasonUpdateOne {
 	return suo.RemoveEpisodeIDs(ids...)
 }
 
+// ClearFavorites clears all "favorites" edges to the Favorite entity.
+func (suo *SeasonUpdateOne) ClearFavorites() *SeasonUpdateOne {
+	suo.mutation.ClearFavorites()
+	return suo
+}
+
+// RemoveFavoriteIDs removes the "favorites" edge to Favorite entities by IDs.
+func (suo *SeasonUpdateOne) RemoveFavoriteIDs(ids ...int) *SeasonUpdateOne {
+	suo.mutation.RemoveFavoriteIDs(ids...)
+	return suo
+}
+
+// RemoveFavorites removes "favorites" edges to Favorite entities.
+func (suo *SeasonUpdateOne) RemoveFavorites(f ...*Favorite) *SeasonUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return suo.RemoveFavoriteIDs(ids...)
+}
+
+// ClearRatings clears all "ratings" edges to the Rating entity.
+func (suo *SeasonUpdateOne) ClearRatings() *SeasonUpdateOne {
+	suo.mutation.ClearRatings()
+	return suo
+}
+
+// RemoveRatingIDs removes the "ratings" edge to Rating entities by IDs.
+func (suo *SeasonUpdateOne) RemoveRatingIDs(ids ...int) *SeasonUpdateOne {
+	suo.mutation.RemoveRatingIDs(ids...)
+	return suo
+}
+
+// RemoveRatings removes "ratings" edges to Rating entities.
+func (suo *SeasonUpdateOne) RemoveRatings(r ...*Rating) *SeasonUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return suo.RemoveRatingIDs(ids...)
+}
+
+// ClearWatchlistItems clears all "watchlist_items" edges to the WatchlistItem entity.
+func (suo *SeasonUpdateOne) ClearWatchlistItems() *SeasonUpdateOne {
+	suo.mutation.ClearWatchlistItems()
+	return suo
+}
+
+// RemoveWatchlistItemIDs removes the "watchlist_items" edge to WatchlistItem entities by IDs.
+func (suo *SeasonUpdateOne) RemoveWatchlistItemIDs(ids ...int) *SeasonUpdateOne {
+	suo.mutation.RemoveWatchlistItemIDs(ids...)
+	return suo
+}
+
+// RemoveWatchlistItems removes "watchlist_items" edges to WatchlistItem entities.
+func (suo *SeasonUpdateOne) RemoveWatchlistItems(w ...*WatchlistItem) *SeasonUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return suo.RemoveWatchlistItemIDs(ids...)
+}
+
 // Where appends a list predicates to the SeasonUpdate builder.
 func (suo *SeasonUpdateOne) Where(ps ...predicate.Season) *SeasonUpdateOne {
 	suo.mutation.Where(ps...)
@@ -828,6 +1237,7 @@ func (suo *SeasonUpdateOne) Select(field string, fields ...string) *SeasonUpdate
 
 // Save executes the query and returns the updated Season entity.
 func (suo *SeasonUpdateOne) Save(ctx context.Context) (*Season, error) {
+	suo.defaults()
 	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
 }
 
@@ -853,6 +1263,14 @@ func (suo *SeasonUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (suo *SeasonUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := season.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (suo *SeasonUpdateOne) check() error {
 	if v, ok := suo.mutation.SeasonID(); ok {
@@ -900,6 +1318,9 @@ func (suo *SeasonUpdateOne) sqlSave(ctx context.Context) (_node *Season, err err
 			}
 		}
 	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(season.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if value, ok := suo.mutation.SeasonID(); ok {
 		_spec.SetField(season.FieldSeasonID, field.TypeString, value)
 	}
@@ -969,6 +1390,12 @@ func (suo *SeasonUpdateOne) sqlSave(ctx context.Context) (_node *Season, err err
 	if suo.mutation.FirstEndMonthCleared() {
 		_spec.ClearField(season.FieldFirstEndMonth, field.TypeInt)
 	}
+	if value, ok := suo.mutation.Media(); ok {
+		_spec.SetField(season.FieldMedia, field.TypeJSON, value)
+	}
+	if suo.mutation.MediaCleared() {
+		_spec.ClearField(season.FieldMedia, field.TypeJSON)
+	}
 	if suo.mutation.SeriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1043,6 +1470,141 @@ func (suo *SeasonUpdateOne) sqlSave(ctx context.Context) (_node *Season, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.FavoritesTable,
+			Columns: []string{season.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedFavoritesIDs(); len(nodes) > 0 && !suo.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.FavoritesTable,
+			Columns: []string{season.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.FavoritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.FavoritesTable,
+			Columns: []string{season.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.RatingsTable,
+			Columns: []string{season.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedRatingsIDs(); len(nodes) > 0 && !suo.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.RatingsTable,
+			Columns: []string{season.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.RatingsTable,
+			Columns: []string{season.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.WatchlistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.WatchlistItemsTable,
+			Columns: []string{season.WatchlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedWatchlistItemsIDs(); len(nodes) > 0 && !suo.mutation.WatchlistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.WatchlistItemsTable,
+			Columns: []string{season.WatchlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.WatchlistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.WatchlistItemsTable,
+			Columns: []string{season.WatchlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Season{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
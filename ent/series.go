@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -16,6 +18,10 @@ type Series struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// SeriesID holds the value of the "series_id" field.
 	SeriesID string `json:"series_id,omitempty"`
 	// Title holds the value of the "title" field.
@@ -26,6 +32,8 @@ type Series struct {
 	TitleEn string `json:"title_en,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// Media holds the value of the "media" field.
+	Media map[string]string `json:"media,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SeriesQuery when eager-loading is set.
 	Edges        SeriesEdges `json:"edges"`
@@ -36,9 +44,15 @@ type Series struct {
 type SeriesEdges struct {
 	// Seasons holds the value of the seasons edge.
 	Seasons []*Season `json:"seasons,omitempty"`
+	// Favorites holds the value of the favorites edge.
+	Favorites []*Favorite `json:"favorites,omitempty"`
+	// Ratings holds the value of the ratings edge.
+	Ratings []*Rating `json:"ratings,omitempty"`
+	// WatchlistItems holds the value of the watchlist_items edge.
+	WatchlistItems []*WatchlistItem `json:"watchlist_items,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // SeasonsOrErr returns the Seasons value or an error if the edge
@@ -50,15 +64,46 @@ func (e SeriesEdges) SeasonsOrErr() ([]*Season, error) {
 	return nil, &NotLoadedError{edge: "seasons"}
 }
 
+// FavoritesOrErr returns the Favorites value or an error if the edge
+// was not loaded in eager-loading.
+func (e SeriesEdges) FavoritesOrErr() ([]*Favorite, error) {
+	if e.loadedTypes[1] {
+		return e.Favorites, nil
+	}
+	return nil, &NotLoadedError{edge: "favorites"}
+}
+
+// RatingsOrErr returns the Ratings value or an error if the edge
+// was not loaded in eager-loading.
+func (e SeriesEdges) RatingsOrErr() ([]*Rating, error) {
+	if e.loadedTypes[2] {
+		return e.Ratings, nil
+	}
+	return nil, &NotLoadedError{edge: "ratings"}
+}
+
+// WatchlistItemsOrErr returns the WatchlistItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e SeriesEdges) WatchlistItemsOrErr() ([]*WatchlistItem, error) {
+	if e.loadedTypes[3] {
+		return e.WatchlistItems, nil
+	}
+	return nil, &NotLoadedError{edge: "watchlist_items"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Series) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case series.FieldMedia:
+			values[i] = new([]byte)
 		case series.FieldID:
 			values[i] = new(sql.NullInt64)
 		case series.FieldSeriesID, series.FieldTitle, series.FieldTitleYomi, series.FieldTitleEn, series.FieldDescription:
 			values[i] = new(sql.NullString)
+		case series.FieldCreatedAt, series.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -80,6 +125,18 @@ func (s *Series) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			s.ID = int(value.Int64)
+		case series.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case series.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
 		case series.FieldSeriesID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field series_id", values[i])
@@ -110,6 +167,14 @@ func (s *Series) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.Description = value.String
 			}
+		case series.FieldMedia:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field media", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Media); err != nil {
+					return fmt.Errorf("unmarshal field media: %w", err)
+				}
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -128,6 +193,21 @@ func (s *Series) QuerySeasons() *SeasonQuery {
 	return NewSeriesClient(s.config).QuerySeasons(s)
 }
 
+// QueryFavorites queries the "favorites" edge of the Series entity.
+func (s *Series) QueryFavorites() *FavoriteQuery {
+	return NewSeriesClient(s.config).QueryFavorites(s)
+}
+
+// QueryRatings queries the "ratings" edge of the Series entity.
+func (s *Series) QueryRatings() *RatingQuery {
+	return NewSeriesClient(s.config).QueryRatings(s)
+}
+
+// QueryWatchlistItems queries the "watchlist_items" edge of the Series entity.
+func (s *Series) QueryWatchlistItems() *WatchlistItemQuery {
+	return NewSeriesClient(s.config).QueryWatchlistItems(s)
+}
+
 // Update returns a builder for updating this Series.
 // Note that you need to call Series.Unwrap() before calling this method if this Series
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -151,6 +231,12 @@ func (s *Series) String() string {
 	var builder strings.Builder
 	builder.WriteString("Series(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("series_id=")
 	builder.WriteString(s.SeriesID)
 	builder.WriteString(", ")
@@ -165,6 +251,9 @@ func (s *Series) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(s.Description)
+	builder.WriteString(", ")
+	builder.WriteString("media=")
+	builder.WriteString(fmt.Sprintf("%v", s.Media))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package series
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -12,6 +14,10 @@ const (
 	Label = "series"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
 	// FieldSeriesID holds the string denoting the series_id field in the database.
 	FieldSeriesID = "series_id"
 	// FieldTitle holds the string denoting the title field in the database.
@@ -22,8 +28,16 @@ const (
 	FieldTitleEn = "title_en"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldMedia holds the string denoting the media field in the database.
+	FieldMedia = "media"
 	// EdgeSeasons holds the string denoting the seasons edge name in mutations.
 	EdgeSeasons = "seasons"
+	// EdgeFavorites holds the string denoting the favorites edge name in mutations.
+	EdgeFavorites = "favorites"
+	// EdgeRatings holds the string denoting the ratings edge name in mutations.
+	EdgeRatings = "ratings"
+	// EdgeWatchlistItems holds the string denoting the watchlist_items edge name in mutations.
+	EdgeWatchlistItems = "watchlist_items"
 	// Table holds the table name of the series in the database.
 	Table = "series"
 	// SeasonsTable is the table that holds the seasons relation/edge.
@@ -33,16 +47,40 @@ const (
 	SeasonsInverseTable = "seasons"
 	// SeasonsColumn is the table column denoting the seasons relation/edge.
 	SeasonsColumn = "series_seasons"
+	// FavoritesTable is the table that holds the favorites relation/edge.
+	FavoritesTable = "favorites"
+	// FavoritesInverseTable is the table name for the Favorite entity.
+	// It exists in this package in order to avoid circular dependency with the "favorite" package.
+	FavoritesInverseTable = "favorites"
+	// FavoritesColumn is the table column denoting the favorites relation/edge.
+	FavoritesColumn = "series_favorites"
+	// RatingsTable is the table that holds the ratings relation/edge.
+	RatingsTable = "ratings"
+	// RatingsInverseTable is the table name for the Rating entity.
+	// It exists in this package in order to avoid circular dependency with the "rating" package.
+	RatingsInverseTable = "ratings"
+	// RatingsColumn is the table column denoting the ratings relation/edge.
+	RatingsColumn = "series_ratings"
+	// WatchlistItemsTable is the table that holds the watchlist_items relation/edge.
+	WatchlistItemsTable = "watchlist_items"
+	// WatchlistItemsInverseTable is the table name for the WatchlistItem entity.
+	// It exists in this package in order to avoid circular dependency with the "watchlistitem" package.
+	WatchlistItemsInverseTable = "watchlist_items"
+	// WatchlistItemsColumn is the table column denoting the watchlist_items relation/edge.
+	WatchlistItemsColumn = "series_watchlist_items"
 )
 
 // Columns holds all SQL columns for series fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
 	FieldSeriesID,
 	FieldTitle,
 	FieldTitleYomi,
 	FieldTitleEn,
 	FieldDescription,
+	FieldMedia,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -56,6 +94,12 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
 	// SeriesIDValidator is a validator for the "series_id" field. It is called by the builders before save.
 	SeriesIDValidator func(string) error
 	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
@@ -70,6 +114,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
 // BySeriesID orders the results by the series_id field.
 func BySeriesID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSeriesID, opts...).ToFunc()
@@ -108,6 +162,48 @@ func BySeasons(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSeasonsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByFavoritesCount orders the results by favorites count.
+func ByFavoritesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFavoritesStep(), opts...)
+	}
+}
+
+// ByFavorites orders the results by favorites terms.
+func ByFavorites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFavoritesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRatingsCount orders the results by ratings count.
+func ByRatingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRatingsStep(), opts...)
+	}
+}
+
+// ByRatings orders the results by ratings terms.
+func ByRatings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRatingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWatchlistItemsCount orders the results by watchlist_items count.
+func ByWatchlistItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWatchlistItemsStep(), opts...)
+	}
+}
+
+// ByWatchlistItems orders the results by watchlist_items terms.
+func ByWatchlistItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWatchlistItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSeasonsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -115,3 +211,24 @@ func newSeasonsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SeasonsTable, SeasonsColumn),
 	)
 }
+func newFavoritesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FavoritesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FavoritesTable, FavoritesColumn),
+	)
+}
+func newRatingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RatingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RatingsTable, RatingsColumn),
+	)
+}
+func newWatchlistItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WatchlistItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WatchlistItemsTable, WatchlistItemsColumn),
+	)
+}
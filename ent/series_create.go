@@ -6,11 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/favorite"
+	"github.com/clustlight/animatrix-api/ent/rating"
 	"github.com/clustlight/animatrix-api/ent/season"
 	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
 )
 
 // SeriesCreate is the builder for creating a Series entity.
@@ -20,6 +24,34 @@ type SeriesCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (sc *SeriesCreate) SetCreatedAt(t time.Time) *SeriesCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SeriesCreate) SetNillableCreatedAt(t *time.Time) *SeriesCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SeriesCreate) SetUpdatedAt(t time.Time) *SeriesCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SeriesCreate) SetNillableUpdatedAt(t *time.Time) *SeriesCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
 // SetSeriesID sets the "series_id" field.
 func (sc *SeriesCreate) SetSeriesID(s string) *SeriesCreate {
 	sc.mutation.SetSeriesID(s)
@@ -74,6 +106,12 @@ func (sc *SeriesCreate) SetNillableDescription(s *string) *SeriesCreate {
 	return sc
 }
 
+// SetMedia sets the "media" field.
+func (sc *SeriesCreate) SetMedia(m map[string]string) *SeriesCreate {
+	sc.mutation.SetMedia(m)
+	return sc
+}
+
 // AddSeasonIDs adds the "seasons" edge to the Season entity by IDs.
 func (sc *SeriesCreate) AddSeasonIDs(ids ...int) *SeriesCreate {
 	sc.mutation.AddSeasonIDs(ids...)
@@ -89,6 +127,51 @@ func (sc *SeriesCreate) AddSeasons(s ...*Season) *SeriesCreate {
 	return sc.AddSeasonIDs(ids...)
 }
 
+// AddFavoriteIDs adds the "favorites" edge to the Favorite entity by IDs.
+func (sc *SeriesCreate) AddFavoriteIDs(ids ...int) *SeriesCreate {
+	sc.mutation.AddFavoriteIDs(ids...)
+	return sc
+}
+
+// AddFavorites adds the "favorites" edges to the Favorite entity.
+func (sc *SeriesCreate) AddFavorites(f ...*Favorite) *SeriesCreate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return sc.AddFavoriteIDs(ids...)
+}
+
+// AddRatingIDs adds the "ratings" edge to the Rating entity by IDs.
+func (sc *SeriesCreate) AddRatingIDs(ids ...int) *SeriesCreate {
+	sc.mutation.AddRatingIDs(ids...)
+	return sc
+}
+
+// AddRatings adds the "ratings" edges to the Rating entity.
+func (sc *SeriesCreate) AddRatings(r ...*Rating) *SeriesCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return sc.AddRatingIDs(ids...)
+}
+
+// AddWatchlistItemIDs adds the "watchlist_items" edge to the WatchlistItem entity by IDs.
+func (sc *SeriesCreate) AddWatchlistItemIDs(ids ...int) *SeriesCreate {
+	sc.mutation.AddWatchlistItemIDs(ids...)
+	return sc
+}
+
+// AddWatchlistItems adds the "watchlist_items" edges to the WatchlistItem entity.
+func (sc *SeriesCreate) AddWatchlistItems(w ...*WatchlistItem) *SeriesCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return sc.AddWatchlistItemIDs(ids...)
+}
+
 // Mutation returns the SeriesMutation object of the builder.
 func (sc *SeriesCreate) Mutation() *SeriesMutation {
 	return sc.mutation
@@ -96,6 +179,7 @@ func (sc *SeriesCreate) Mutation() *SeriesMutation {
 
 // Save creates the Series in the database.
 func (sc *SeriesCreate) Save(ctx context.Context) (*Series, error) {
+	sc.defaults()
 	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
 }
 
@@ -121,8 +205,26 @@ func (sc *SeriesCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (sc *SeriesCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := series.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := series.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (sc *SeriesCreate) check() error {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Series.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Series.updated_at"`)}
+	}
 	if _, ok := sc.mutation.SeriesID(); !ok {
 		return &ValidationError{Name: "series_id", err: errors.New(`ent: missing required field "Series.series_id"`)}
 	}
@@ -165,6 +267,14 @@ func (sc *SeriesCreate) createSpec() (*Series, *sqlgraph.CreateSpec) {
 		_node = &Series{config: sc.config}
 		_spec = sqlgraph.NewCreateSpec(series.Table, sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt))
 	)
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(series.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(series.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
 	if value, ok := sc.mutation.SeriesID(); ok {
 		_spec.SetField(series.FieldSeriesID, field.TypeString, value)
 		_node.SeriesID = value
@@ -185,6 +295,10 @@ func (sc *SeriesCreate) createSpec() (*Series, *sqlgraph.CreateSpec) {
 		_spec.SetField(series.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := sc.mutation.Media(); ok {
+		_spec.SetField(series.FieldMedia, field.TypeJSON, value)
+		_node.Media = value
+	}
 	if nodes := sc.mutation.SeasonsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -201,6 +315,54 @@ func (sc *SeriesCreate) createSpec() (*Series, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.FavoritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.FavoritesTable,
+			Columns: []string{series.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.RatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.RatingsTable,
+			Columns: []string{series.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.WatchlistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.WatchlistItemsTable,
+			Columns: []string{series.WatchlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -222,6 +384,7 @@ func (scb *SeriesCreateBulk) Save(ctx context.Context) ([]*Series, error) {
 	for i := range scb.builders {
 		func(i int, root context.Context) {
 			builder := scb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*SeriesMutation)
 				if !ok {
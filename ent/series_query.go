@@ -9,22 +9,30 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/favorite"
 	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/rating"
 	"github.com/clustlight/animatrix-api/ent/season"
 	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
 )
 
 // SeriesQuery is the builder for querying Series entities.
 type SeriesQuery struct {
 	config
-	ctx         *QueryContext
-	order       []series.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Series
-	withSeasons *SeasonQuery
+	ctx                *QueryContext
+	order              []series.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Series
+	withSeasons        *SeasonQuery
+	withFavorites      *FavoriteQuery
+	withRatings        *RatingQuery
+	withWatchlistItems *WatchlistItemQuery
+	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +91,72 @@ func (sq *SeriesQuery) QuerySeasons() *SeasonQuery {
 	return query
 }
 
+// QueryFavorites chains the current query on the "favorites" edge.
+func (sq *SeriesQuery) QueryFavorites() *FavoriteQuery {
+	query := (&FavoriteClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(series.Table, series.FieldID, selector),
+			sqlgraph.To(favorite.Table, favorite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, series.FavoritesTable, series.FavoritesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRatings chains the current query on the "ratings" edge.
+func (sq *SeriesQuery) QueryRatings() *RatingQuery {
+	query := (&RatingClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(series.Table, series.FieldID, selector),
+			sqlgraph.To(rating.Table, rating.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, series.RatingsTable, series.RatingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWatchlistItems chains the current query on the "watchlist_items" edge.
+func (sq *SeriesQuery) QueryWatchlistItems() *WatchlistItemQuery {
+	query := (&WatchlistItemClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(series.Table, series.FieldID, selector),
+			sqlgraph.To(watchlistitem.Table, watchlistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, series.WatchlistItemsTable, series.WatchlistItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Series entity from the query.
 // Returns a *NotFoundError when no Series was found.
 func (sq *SeriesQuery) First(ctx context.Context) (*Series, error) {
@@ -270,12 +344,15 @@ func (sq *SeriesQuery) Clone() *SeriesQuery {
 		return nil
 	}
 	return &SeriesQuery{
-		config:      sq.config,
-		ctx:         sq.ctx.Clone(),
-		order:       append([]series.OrderOption{}, sq.order...),
-		inters:      append([]Interceptor{}, sq.inters...),
-		predicates:  append([]predicate.Series{}, sq.predicates...),
-		withSeasons: sq.withSeasons.Clone(),
+		config:             sq.config,
+		ctx:                sq.ctx.Clone(),
+		order:              append([]series.OrderOption{}, sq.order...),
+		inters:             append([]Interceptor{}, sq.inters...),
+		predicates:         append([]predicate.Series{}, sq.predicates...),
+		withSeasons:        sq.withSeasons.Clone(),
+		withFavorites:      sq.withFavorites.Clone(),
+		withRatings:        sq.withRatings.Clone(),
+		withWatchlistItems: sq.withWatchlistItems.Clone(),
 		// clone intermediate query.
 		sql:  sq.sql.Clone(),
 		path: sq.path,
@@ -293,18 +370,51 @@ func (sq *SeriesQuery) WithSeasons(opts ...func(*SeasonQuery)) *SeriesQuery {
 	return sq
 }
 
+// WithFavorites tells the query-builder to eager-load the nodes that are connected to
+// the "favorites" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SeriesQuery) WithFavorites(opts ...func(*FavoriteQuery)) *SeriesQuery {
+	query := (&FavoriteClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withFavorites = query
+	return sq
+}
+
+// WithRatings tells the query-builder to eager-load the nodes that are connected to
+// the "ratings" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SeriesQuery) WithRatings(opts ...func(*RatingQuery)) *SeriesQuery {
+	query := (&RatingClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withRatings = query
+	return sq
+}
+
+// WithWatchlistItems tells the query-builder to eager-load the nodes that are connected to
+// the "watchlist_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SeriesQuery) WithWatchlistItems(opts ...func(*WatchlistItemQuery)) *SeriesQuery {
+	query := (&WatchlistItemClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withWatchlistItems = query
+	return sq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		SeriesID string `json:"series_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Series.Query().
-//		GroupBy(series.FieldSeriesID).
+//		GroupBy(series.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (sq *SeriesQuery) GroupBy(field string, fields ...string) *SeriesGroupBy {
@@ -322,11 +432,11 @@ func (sq *SeriesQuery) GroupBy(field string, fields ...string) *SeriesGroupBy {
 // Example:
 //
 //	var v []struct {
-//		SeriesID string `json:"series_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.Series.Query().
-//		Select(series.FieldSeriesID).
+//		Select(series.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (sq *SeriesQuery) Select(fields ...string) *SeriesSelect {
 	sq.ctx.Fields = append(sq.ctx.Fields, fields...)
@@ -371,8 +481,11 @@ func (sq *SeriesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Serie
 	var (
 		nodes       = []*Series{}
 		_spec       = sq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			sq.withSeasons != nil,
+			sq.withFavorites != nil,
+			sq.withRatings != nil,
+			sq.withWatchlistItems != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -384,6 +497,9 @@ func (sq *SeriesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Serie
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(sq.modifiers) > 0 {
+		_spec.Modifiers = sq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -400,6 +516,27 @@ func (sq *SeriesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Serie
 			return nil, err
 		}
 	}
+	if query := sq.withFavorites; query != nil {
+		if err := sq.loadFavorites(ctx, query, nodes,
+			func(n *Series) { n.Edges.Favorites = []*Favorite{} },
+			func(n *Series, e *Favorite) { n.Edges.Favorites = append(n.Edges.Favorites, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withRatings; query != nil {
+		if err := sq.loadRatings(ctx, query, nodes,
+			func(n *Series) { n.Edges.Ratings = []*Rating{} },
+			func(n *Series, e *Rating) { n.Edges.Ratings = append(n.Edges.Ratings, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withWatchlistItems; query != nil {
+		if err := sq.loadWatchlistItems(ctx, query, nodes,
+			func(n *Series) { n.Edges.WatchlistItems = []*WatchlistItem{} },
+			func(n *Series, e *WatchlistItem) { n.Edges.WatchlistItems = append(n.Edges.WatchlistItems, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -434,9 +571,105 @@ func (sq *SeriesQuery) loadSeasons(ctx context.Context, query *SeasonQuery, node
 	}
 	return nil
 }
+func (sq *SeriesQuery) loadFavorites(ctx context.Context, query *FavoriteQuery, nodes []*Series, init func(*Series), assign func(*Series, *Favorite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Series)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Favorite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(series.FavoritesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.series_favorites
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "series_favorites" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "series_favorites" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *SeriesQuery) loadRatings(ctx context.Context, query *RatingQuery, nodes []*Series, init func(*Series), assign func(*Series, *Rating)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Series)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Rating(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(series.RatingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.series_ratings
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "series_ratings" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "series_ratings" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *SeriesQuery) loadWatchlistItems(ctx context.Context, query *WatchlistItemQuery, nodes []*Series, init func(*Series), assign func(*Series, *WatchlistItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Series)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.WatchlistItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(series.WatchlistItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.series_watchlist_items
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "series_watchlist_items" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "series_watchlist_items" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (sq *SeriesQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
+	if len(sq.modifiers) > 0 {
+		_spec.Modifiers = sq.modifiers
+	}
 	_spec.Node.Columns = sq.ctx.Fields
 	if len(sq.ctx.Fields) > 0 {
 		_spec.Unique = sq.ctx.Unique != nil && *sq.ctx.Unique
@@ -499,6 +732,9 @@ func (sq *SeriesQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if sq.ctx.Unique != nil && *sq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range sq.modifiers {
+		m(selector)
+	}
 	for _, p := range sq.predicates {
 		p(selector)
 	}
@@ -516,6 +752,32 @@ func (sq *SeriesQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (sq *SeriesQuery) ForUpdate(opts ...sql.LockOption) *SeriesQuery {
+	if sq.driver.Dialect() == dialect.Postgres {
+		sq.Unique(false)
+	}
+	sq.modifiers = append(sq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return sq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (sq *SeriesQuery) ForShare(opts ...sql.LockOption) *SeriesQuery {
+	if sq.driver.Dialect() == dialect.Postgres {
+		sq.Unique(false)
+	}
+	sq.modifiers = append(sq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return sq
+}
+
 // SeriesGroupBy is the group-by builder for Series entities.
 type SeriesGroupBy struct {
 	selector
@@ -6,13 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/favorite"
 	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/rating"
 	"github.com/clustlight/animatrix-api/ent/season"
 	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
 )
 
 // SeriesUpdate is the builder for updating Series entities.
@@ -28,6 +32,12 @@ func (su *SeriesUpdate) Where(ps ...predicate.Series) *SeriesUpdate {
 	return su
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SeriesUpdate) SetUpdatedAt(t time.Time) *SeriesUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
 // SetSeriesID sets the "series_id" field.
 func (su *SeriesUpdate) SetSeriesID(s string) *SeriesUpdate {
 	su.mutation.SetSeriesID(s)
@@ -116,6 +126,18 @@ func (su *SeriesUpdate) ClearDescription() *SeriesUpdate {
 	return su
 }
 
+// SetMedia sets the "media" field.
+func (su *SeriesUpdate) SetMedia(m map[string]string) *SeriesUpdate {
+	su.mutation.SetMedia(m)
+	return su
+}
+
+// ClearMedia clears the value of the "media" field.
+func (su *SeriesUpdate) ClearMedia() *SeriesUpdate {
+	su.mutation.ClearMedia()
+	return su
+}
+
 // AddSeasonIDs adds the "seasons" edge to the Season entity by IDs.
 func (su *SeriesUpdate) AddSeasonIDs(ids ...int) *SeriesUpdate {
 	su.mutation.AddSeasonIDs(ids...)
@@ -131,6 +153,51 @@ func (su *SeriesUpdate) AddSeasons(s ...*Season) *SeriesUpdate {
 	return su.AddSeasonIDs(ids...)
 }
 
+// AddFavoriteIDs adds the "favorites" edge to the Favorite entity by IDs.
+func (su *SeriesUpdate) AddFavoriteIDs(ids ...int) *SeriesUpdate {
+	su.mutation.AddFavoriteIDs(ids...)
+	return su
+}
+
+// AddFavorites adds the "favorites" edges to the Favorite entity.
+func (su *SeriesUpdate) AddFavorites(f ...*Favorite) *SeriesUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return su.AddFavoriteIDs(ids...)
+}
+
+// AddRatingIDs adds the "ratings" edge to the Rating entity by IDs.
+func (su *SeriesUpdate) AddRatingIDs(ids ...int) *SeriesUpdate {
+	su.mutation.AddRatingIDs(ids...)
+	return su
+}
+
+// AddRatings adds the "ratings" edges to the Rating entity.
+func (su *SeriesUpdate) AddRatings(r ...*Rating) *SeriesUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return su.AddRatingIDs(ids...)
+}
+
+// AddWatchlistItemIDs adds the "watchlist_items" edge to the WatchlistItem entity by IDs.
+func (su *SeriesUpdate) AddWatchlistItemIDs(ids ...int) *SeriesUpdate {
+	su.mutation.AddWatchlistItemIDs(ids...)
+	return su
+}
+
+// AddWatchlistItems adds the "watchlist_items" edges to the WatchlistItem entity.
+func (su *SeriesUpdate) AddWatchlistItems(w ...*WatchlistItem) *SeriesUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return su.AddWatchlistItemIDs(ids...)
+}
+
 // Mutation returns the SeriesMutation object of the builder.
 func (su *SeriesUpdate) Mutation() *SeriesMutation {
 	return su.mutation
@@ -157,8 +224,72 @@ func (su *SeriesUpdate) RemoveSeasons(s ...*Season) *SeriesUpdate {
 	return su.RemoveSeasonIDs(ids...)
 }
 
+// ClearFavorites clears all "favorites" edges to the Favorite entity.
+func (su *SeriesUpdate) ClearFavorites() *SeriesUpdate {
+	su.mutation.ClearFavorites()
+	return su
+}
+
+// RemoveFavoriteIDs removes the "favorites" edge to Favorite entities by IDs.
+func (su *SeriesUpdate) RemoveFavoriteIDs(ids ...int) *SeriesUpdate {
+	su.mutation.RemoveFavoriteIDs(ids...)
+	return su
+}
+
+// RemoveFavorites removes "favorites" edges to Favorite entities.
+func (su *SeriesUpdate) RemoveFavorites(f ...*Favorite) *SeriesUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return su.RemoveFavoriteIDs(ids...)
+}
+
+// ClearRatings clears all "ratings" edges to the Rating entity.
+func (su *SeriesUpdate) ClearRatings() *SeriesUpdate {
+	su.mutation.ClearRatings()
+	return su
+}
+
+// RemoveRatingIDs removes the "ratings" edge to Rating entities by IDs.
+func (su *SeriesUpdate) RemoveRatingIDs(ids ...int) *SeriesUpdate {
+	su.mutation.RemoveRatingIDs(ids...)
+	return su
+}
+
+// RemoveRatings removes "ratings" edges to Rating entities.
+func (su *SeriesUpdate) RemoveRatings(r ...*Rating) *SeriesUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return su.RemoveRatingIDs(ids...)
+}
+
+// ClearWatchlistItems clears all "watchlist_items" edges to the WatchlistItem entity.
+func (su *SeriesUpdate) ClearWatchlistItems() *SeriesUpdate {
+	su.mutation.ClearWatchlistItems()
+	return su
+}
+
+// RemoveWatchlistItemIDs removes the "watchlist_items" edge to WatchlistItem entities by IDs.
+func (su *SeriesUpdate) RemoveWatchlistItemIDs(ids ...int) *SeriesUpdate {
+	su.mutation.RemoveWatchlistItemIDs(ids...)
+	return su
+}
+
+// RemoveWatchlistItems removes "watchlist_items" edges to WatchlistItem entities.
+func (su *SeriesUpdate) RemoveWatchlistItems(w ...*WatchlistItem) *SeriesUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return su.RemoveWatchlistItemIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *SeriesUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
 	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
 }
 
@@ -184,6 +315,14 @@ func (su *SeriesUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (su *SeriesUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := series.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (su *SeriesUpdate) check() error {
 	if v, ok := su.mutation.SeriesID(); ok {
@@ -211,6 +350,9 @@ func (su *SeriesUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(series.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if value, ok := su.mutation.SeriesID(); ok {
 		_spec.SetField(series.FieldSeriesID, field.TypeString, value)
 	}
@@ -235,6 +377,12 @@ func (su *SeriesUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.DescriptionCleared() {
 		_spec.ClearField(series.FieldDescription, field.TypeString)
 	}
+	if value, ok := su.mutation.Media(); ok {
+		_spec.SetField(series.FieldMedia, field.TypeJSON, value)
+	}
+	if su.mutation.MediaCleared() {
+		_spec.ClearField(series.FieldMedia, field.TypeJSON)
+	}
 	if su.mutation.SeasonsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -280,6 +428,141 @@ func (su *SeriesUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.FavoritesTable,
+			Columns: []string{series.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedFavoritesIDs(); len(nodes) > 0 && !su.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.FavoritesTable,
+			Columns: []string{series.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.FavoritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.FavoritesTable,
+			Columns: []string{series.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.RatingsTable,
+			Columns: []string{series.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedRatingsIDs(); len(nodes) > 0 && !su.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.RatingsTable,
+			Columns: []string{series.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.RatingsTable,
+			Columns: []string{series.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.WatchlistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.WatchlistItemsTable,
+			Columns: []string{series.WatchlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedWatchlistItemsIDs(); len(nodes) > 0 && !su.mutation.WatchlistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.WatchlistItemsTable,
+			Columns: []string{series.WatchlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.WatchlistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.WatchlistItemsTable,
+			Columns: []string{series.WatchlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{series.Label}
@@ -300,6 +583,12 @@ type SeriesUpdateOne struct {
 	mutation *SeriesMutation
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SeriesUpdateOne) SetUpdatedAt(t time.Time) *SeriesUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
 // SetSeriesID sets the "series_id" field.
 func (suo *SeriesUpdateOne) SetSeriesID(s string) *SeriesUpdateOne {
 	suo.mutation.SetSeriesID(s)
@@ -388,6 +677,18 @@ func (suo *SeriesUpdateOne) ClearDescription() *SeriesUpdateOne {
 	return suo
 }
 
+// SetMedia sets the "media" field.
+func (suo *SeriesUpdateOne) SetMedia(m map[string]string) *SeriesUpdateOne {
+	suo.mutation.SetMedia(m)
+	return suo
+}
+
+// ClearMedia clears the value of the "media" field.
+func (suo *SeriesUpdateOne) ClearMedia() *SeriesUpdateOne {
+	suo.mutation.ClearMedia()
+	return suo
+}
+
 // AddSeasonIDs adds the "seasons" edge to the Season entity by IDs.
 func (suo *SeriesUpdateOne) AddSeasonIDs(ids ...int) *SeriesUpdateOne {
 	suo.mutation.AddSeasonIDs(ids...)
@@ -403,6 +704,51 @@ func (suo *SeriesUpdateOne) AddSeasons(s ...*Season) *SeriesUpdateOne {
 	return suo.AddSeasonIDs(ids...)
 }
 
+// AddFavoriteIDs adds the "favorites" edge to the Favorite entity by IDs.
+func (suo *SeriesUpdateOne) AddFavoriteIDs(ids ...int) *SeriesUpdateOne {
+	suo.mutation.AddFavoriteIDs(ids...)
+	return suo
+}
+
+// AddFavorites adds the "favorites" edges to the Favorite entity.
+func (suo *SeriesUpdateOne) AddFavorites(f ...*Favorite) *SeriesUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return suo.AddFavoriteIDs(ids...)
+}
+
+// AddRatingIDs adds the "ratings" edge to the Rating entity by IDs.
+func (suo *SeriesUpdateOne) AddRatingIDs(ids ...int) *SeriesUpdateOne {
+	suo.mutation.AddRatingIDs(ids...)
+	return suo
+}
+
+// AddRatings adds the "ratings" edges to the Rating entity.
+func (suo *SeriesUpdateOne) AddRatings(r ...*Rating) *SeriesUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return suo.AddRatingIDs(ids...)
+}
+
+// AddWatchlistItemIDs adds the "watchlist_items" edge to the WatchlistItem entity by IDs.
+func (suo *SeriesUpdateOne) AddWatchlistItemIDs(ids ...int) *SeriesUpdateOne {
+	suo.mutation.AddWatchlistItemIDs(ids...)
+	return suo
+}
+
+// AddWatchlistItems adds the "watchlist_items" edges to the WatchlistItem entity.
+func (suo *SeriesUpdateOne) AddWatchlistItems(w ...*WatchlistItem) *SeriesUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return suo.AddWatchlistItemIDs(ids...)
+}
+
 // Mutation returns the SeriesMutation object of the builder.
 func (suo *SeriesUpdateOne) Mutation() *SeriesMutation {
 	return suo.mutation
@@ -429,6 +775,69 @@ func (suo *SeriesUpdateOne) RemoveSeasons(s ...*Season) *SeriesUpdateOne {
 	return suo.RemoveSeasonIDs(ids...)
 }
 
+// ClearFavorites clears all "favorites" edges to the Favorite entity.
+func (suo *SeriesUpdateOne) ClearFavorites() *SeriesUpdateOne {
+	suo.mutation.ClearFavorites()
+	return suo
+}
+
+// RemoveFavoriteIDs removes the "favorites" edge to Favorite entities by IDs.
+func (suo *SeriesUpdateOne) RemoveFavoriteIDs(ids ...int) *SeriesUpdateOne {
+	suo.mutation.RemoveFavoriteIDs(ids...)
+	return suo
+}
+
+// RemoveFavorites removes "favorites" edges to Favorite entities.
+func (suo *SeriesUpdateOne) RemoveFavorites(f ...*Favorite) *SeriesUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return suo.RemoveFavoriteIDs(ids...)
+}
+
+// ClearRatings clears all "ratings" edges to the Rating entity.
+func (suo *SeriesUpdateOne) ClearRatings() *SeriesUpdateOne {
+	suo.mutation.ClearRatings()
+	return suo
+}
+
+// RemoveRatingIDs removes the "ratings" edge to Rating entities by IDs.
+func (suo *SeriesUpdateOne) RemoveRatingIDs(ids ...int) *SeriesUpdateOne {
+	suo.mutation.RemoveRatingIDs(ids...)
+	return suo
+}
+
+// RemoveRatings removes "ratings" edges to Rating entities.
+func (suo *SeriesUpdateOne) RemoveRatings(r ...*Rating) *SeriesUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return suo.RemoveRatingIDs(ids...)
+}
+
+// ClearWatchlistItems clears all "watchlist_items" edges to the WatchlistItem entity.
+func (suo *SeriesUpdateOne) ClearWatchlistItems() *SeriesUpdateOne {
+	suo.mutation.ClearWatchlistItems()
+	return suo
+}
+
+// RemoveWatchlistItemIDs removes the "watchlist_items" edge to WatchlistItem entities by IDs.
+func (suo *SeriesUpdateOne) RemoveWatchlistItemIDs(ids ...int) *SeriesUpdateOne {
+	suo.mutation.RemoveWatchlistItemIDs(ids...)
+	return suo
+}
+
+// RemoveWatchlistItems removes "watchlist_items" edges to WatchlistItem entities.
+func (suo *SeriesUpdateOne) RemoveWatchlistItems(w ...*WatchlistItem) *SeriesUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return suo.RemoveWatchlistItemIDs(ids...)
+}
+
 // Where appends a list predicates to the SeriesUpdate builder.
 func (suo *SeriesUpdateOne) Where(ps ...predicate.Series) *SeriesUpdateOne {
 	suo.mutation.Where(ps...)
@@ -444,6 +853,7 @@ func (suo *SeriesUpdateOne) Select(field string, fields ...string) *SeriesUpdate
 
 // Save executes the query and returns the updated Series entity.
 func (suo *SeriesUpdateOne) Save(ctx context.Context) (*Series, error) {
+	suo.defaults()
 	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
 }
 
@@ -469,6 +879,14 @@ func (suo *SeriesUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (suo *SeriesUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := series.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (suo *SeriesUpdateOne) check() error {
 	if v, ok := suo.mutation.SeriesID(); ok {
@@ -513,6 +931,9 @@ func (suo *SeriesUpdateOne) sqlSave(ctx context.Context) (_node *Series, err err
 			}
 		}
 	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(series.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if value, ok := suo.mutation.SeriesID(); ok {
 		_spec.SetField(series.FieldSeriesID, field.TypeString, value)
 	}
@@ -537,6 +958,12 @@ func (suo *SeriesUpdateOne) sqlSave(ctx context.Context) (_node *Series, err err
 	if suo.mutation.DescriptionCleared() {
 		_spec.ClearField(series.FieldDescription, field.TypeString)
 	}
+	if value, ok := suo.mutation.Media(); ok {
+		_spec.SetField(series.FieldMedia, field.TypeJSON, value)
+	}
+	if suo.mutation.MediaCleared() {
+		_spec.ClearField(series.FieldMedia, field.TypeJSON)
+	}
 	if suo.mutation.SeasonsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -582,6 +1009,141 @@ func (suo *SeriesUpdateOne) sqlSave(ctx context.Context) (_node *Series, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.FavoritesTable,
+			Columns: []string{series.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedFavoritesIDs(); len(nodes) > 0 && !suo.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.FavoritesTable,
+			Columns: []string{series.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.FavoritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.FavoritesTable,
+			Columns: []string{series.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.RatingsTable,
+			Columns: []string{series.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedRatingsIDs(); len(nodes) > 0 && !suo.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.RatingsTable,
+			Columns: []string{series.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.RatingsTable,
+			Columns: []string{series.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.WatchlistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.WatchlistItemsTable,
+			Columns: []string{series.WatchlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedWatchlistItemsIDs(); len(nodes) > 0 && !suo.mutation.WatchlistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.WatchlistItemsTable,
+			Columns: []string{series.WatchlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.WatchlistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   series.WatchlistItemsTable,
+			Columns: []string{series.WatchlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Series{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
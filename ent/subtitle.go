@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/subtitle"
+)
+
+// Subtitle is the model entity for the Subtitle schema.
+type Subtitle struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Language holds the value of the "language" field.
+	Language string `json:"language,omitempty"`
+	// Format holds the value of the "format" field.
+	Format string `json:"format,omitempty"`
+	// Label holds the value of the "label" field.
+	Label string `json:"label,omitempty"`
+	// IsDefault holds the value of the "is_default" field.
+	IsDefault bool `json:"is_default,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SubtitleQuery when eager-loading is set.
+	Edges             SubtitleEdges `json:"edges"`
+	episode_subtitles *int
+	selectValues      sql.SelectValues
+}
+
+// SubtitleEdges holds the relations/edges for other nodes in the graph.
+type SubtitleEdges struct {
+	// Episode holds the value of the episode edge.
+	Episode *Episode `json:"episode,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EpisodeOrErr returns the Episode value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SubtitleEdges) EpisodeOrErr() (*Episode, error) {
+	if e.Episode != nil {
+		return e.Episode, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: episode.Label}
+	}
+	return nil, &NotLoadedError{edge: "episode"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Subtitle) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subtitle.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case subtitle.FieldID:
+			values[i] = new(sql.NullInt64)
+		case subtitle.FieldLanguage, subtitle.FieldFormat, subtitle.FieldLabel, subtitle.FieldPath:
+			values[i] = new(sql.NullString)
+		case subtitle.FieldCreatedAt, subtitle.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case subtitle.ForeignKeys[0]: // episode_subtitles
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Subtitle fields.
+func (s *Subtitle) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subtitle.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case subtitle.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case subtitle.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case subtitle.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				s.Language = value.String
+			}
+		case subtitle.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				s.Format = value.String
+			}
+		case subtitle.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				s.Label = value.String
+			}
+		case subtitle.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				s.IsDefault = value.Bool
+			}
+		case subtitle.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				s.Path = value.String
+			}
+		case subtitle.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field episode_subtitles", value)
+			} else if value.Valid {
+				s.episode_subtitles = new(int)
+				*s.episode_subtitles = int(value.Int64)
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Subtitle.
+// This includes values selected through modifiers, order, etc.
+func (s *Subtitle) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryEpisode queries the "episode" edge of the Subtitle entity.
+func (s *Subtitle) QueryEpisode() *EpisodeQuery {
+	return NewSubtitleClient(s.config).QueryEpisode(s)
+}
+
+// Update returns a builder for updating this Subtitle.
+// Note that you need to call Subtitle.Unwrap() before calling this method if this Subtitle
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Subtitle) Update() *SubtitleUpdateOne {
+	return NewSubtitleClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Subtitle entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Subtitle) Unwrap() *Subtitle {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Subtitle is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Subtitle) String() string {
+	var builder strings.Builder
+	builder.WriteString("Subtitle(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(s.Language)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(s.Format)
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(s.Label)
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", s.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(s.Path)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Subtitles is a parsable slice of Subtitle.
+type Subtitles []*Subtitle
@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/subtitle"
+)
+
+// SubtitleUpdate is the builder for updating Subtitle entities.
+type SubtitleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubtitleMutation
+}
+
+// Where appends a list predicates to the SubtitleUpdate builder.
+func (su *SubtitleUpdate) Where(ps ...predicate.Subtitle) *SubtitleUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SubtitleUpdate) SetUpdatedAt(t time.Time) *SubtitleUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetLanguage sets the "language" field.
+func (su *SubtitleUpdate) SetLanguage(s string) *SubtitleUpdate {
+	su.mutation.SetLanguage(s)
+	return su
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (su *SubtitleUpdate) SetNillableLanguage(s *string) *SubtitleUpdate {
+	if s != nil {
+		su.SetLanguage(*s)
+	}
+	return su
+}
+
+// SetFormat sets the "format" field.
+func (su *SubtitleUpdate) SetFormat(s string) *SubtitleUpdate {
+	su.mutation.SetFormat(s)
+	return su
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (su *SubtitleUpdate) SetNillableFormat(s *string) *SubtitleUpdate {
+	if s != nil {
+		su.SetFormat(*s)
+	}
+	return su
+}
+
+// SetLabel sets the "label" field.
+func (su *SubtitleUpdate) SetLabel(s string) *SubtitleUpdate {
+	su.mutation.SetLabel(s)
+	return su
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (su *SubtitleUpdate) SetNillableLabel(s *string) *SubtitleUpdate {
+	if s != nil {
+		su.SetLabel(*s)
+	}
+	return su
+}
+
+// ClearLabel clears the value of the "label" field.
+func (su *SubtitleUpdate) ClearLabel() *SubtitleUpdate {
+	su.mutation.ClearLabel()
+	return su
+}
+
+// SetIsDefault sets the "is_default" field.
+func (su *SubtitleUpdate) SetIsDefault(b bool) *SubtitleUpdate {
+	su.mutation.SetIsDefault(b)
+	return su
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (su *SubtitleUpdate) SetNillableIsDefault(b *bool) *SubtitleUpdate {
+	if b != nil {
+		su.SetIsDefault(*b)
+	}
+	return su
+}
+
+// SetPath sets the "path" field.
+func (su *SubtitleUpdate) SetPath(s string) *SubtitleUpdate {
+	su.mutation.SetPath(s)
+	return su
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (su *SubtitleUpdate) SetNillablePath(s *string) *SubtitleUpdate {
+	if s != nil {
+		su.SetPath(*s)
+	}
+	return su
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (su *SubtitleUpdate) SetEpisodeID(id int) *SubtitleUpdate {
+	su.mutation.SetEpisodeID(id)
+	return su
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (su *SubtitleUpdate) SetEpisode(e *Episode) *SubtitleUpdate {
+	return su.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the SubtitleMutation object of the builder.
+func (su *SubtitleUpdate) Mutation() *SubtitleMutation {
+	return su.mutation
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (su *SubtitleUpdate) ClearEpisode() *SubtitleUpdate {
+	su.mutation.ClearEpisode()
+	return su
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SubtitleUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SubtitleUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SubtitleUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SubtitleUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SubtitleUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := subtitle.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SubtitleUpdate) check() error {
+	if v, ok := su.mutation.Language(); ok {
+		if err := subtitle.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "Subtitle.language": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Path(); ok {
+		if err := subtitle.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Subtitle.path": %w`, err)}
+		}
+	}
+	if su.mutation.EpisodeCleared() && len(su.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Subtitle.episode"`)
+	}
+	return nil
+}
+
+func (su *SubtitleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subtitle.Table, subtitle.Columns, sqlgraph.NewFieldSpec(subtitle.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(subtitle.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.Language(); ok {
+		_spec.SetField(subtitle.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Format(); ok {
+		_spec.SetField(subtitle.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Label(); ok {
+		_spec.SetField(subtitle.FieldLabel, field.TypeString, value)
+	}
+	if su.mutation.LabelCleared() {
+		_spec.ClearField(subtitle.FieldLabel, field.TypeString)
+	}
+	if value, ok := su.mutation.IsDefault(); ok {
+		_spec.SetField(subtitle.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.Path(); ok {
+		_spec.SetField(subtitle.FieldPath, field.TypeString, value)
+	}
+	if su.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subtitle.EpisodeTable,
+			Columns: []string{subtitle.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subtitle.EpisodeTable,
+			Columns: []string{subtitle.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subtitle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SubtitleUpdateOne is the builder for updating a single Subtitle entity.
+type SubtitleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubtitleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SubtitleUpdateOne) SetUpdatedAt(t time.Time) *SubtitleUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetLanguage sets the "language" field.
+func (suo *SubtitleUpdateOne) SetLanguage(s string) *SubtitleUpdateOne {
+	suo.mutation.SetLanguage(s)
+	return suo
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (suo *SubtitleUpdateOne) SetNillableLanguage(s *string) *SubtitleUpdateOne {
+	if s != nil {
+		suo.SetLanguage(*s)
+	}
+	return suo
+}
+
+// SetFormat sets the "format" field.
+func (suo *SubtitleUpdateOne) SetFormat(s string) *SubtitleUpdateOne {
+	suo.mutation.SetFormat(s)
+	return suo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (suo *SubtitleUpdateOne) SetNillableFormat(s *string) *SubtitleUpdateOne {
+	if s != nil {
+		suo.SetFormat(*s)
+	}
+	return suo
+}
+
+// SetLabel sets the "label" field.
+func (suo *SubtitleUpdateOne) SetLabel(s string) *SubtitleUpdateOne {
+	suo.mutation.SetLabel(s)
+	return suo
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (suo *SubtitleUpdateOne) SetNillableLabel(s *string) *SubtitleUpdateOne {
+	if s != nil {
+		suo.SetLabel(*s)
+	}
+	return suo
+}
+
+// ClearLabel clears the value of the "label" field.
+func (suo *SubtitleUpdateOne) ClearLabel() *SubtitleUpdateOne {
+	suo.mutation.ClearLabel()
+	return suo
+}
+
+// SetIsDefault sets the "is_default" field.
+func (suo *SubtitleUpdateOne) SetIsDefault(b bool) *SubtitleUpdateOne {
+	suo.mutation.SetIsDefault(b)
+	return suo
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (suo *SubtitleUpdateOne) SetNillableIsDefault(b *bool) *SubtitleUpdateOne {
+	if b != nil {
+		suo.SetIsDefault(*b)
+	}
+	return suo
+}
+
+// SetPath sets the "path" field.
+func (suo *SubtitleUpdateOne) SetPath(s string) *SubtitleUpdateOne {
+	suo.mutation.SetPath(s)
+	return suo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (suo *SubtitleUpdateOne) SetNillablePath(s *string) *SubtitleUpdateOne {
+	if s != nil {
+		suo.SetPath(*s)
+	}
+	return suo
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (suo *SubtitleUpdateOne) SetEpisodeID(id int) *SubtitleUpdateOne {
+	suo.mutation.SetEpisodeID(id)
+	return suo
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (suo *SubtitleUpdateOne) SetEpisode(e *Episode) *SubtitleUpdateOne {
+	return suo.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the SubtitleMutation object of the builder.
+func (suo *SubtitleUpdateOne) Mutation() *SubtitleMutation {
+	return suo.mutation
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (suo *SubtitleUpdateOne) ClearEpisode() *SubtitleUpdateOne {
+	suo.mutation.ClearEpisode()
+	return suo
+}
+
+// Where appends a list predicates to the SubtitleUpdate builder.
+func (suo *SubtitleUpdateOne) Where(ps ...predicate.Subtitle) *SubtitleUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SubtitleUpdateOne) Select(field string, fields ...string) *SubtitleUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Subtitle entity.
+func (suo *SubtitleUpdateOne) Save(ctx context.Context) (*Subtitle, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SubtitleUpdateOne) SaveX(ctx context.Context) *Subtitle {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SubtitleUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SubtitleUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SubtitleUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := subtitle.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SubtitleUpdateOne) check() error {
+	if v, ok := suo.mutation.Language(); ok {
+		if err := subtitle.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "Subtitle.language": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Path(); ok {
+		if err := subtitle.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Subtitle.path": %w`, err)}
+		}
+	}
+	if suo.mutation.EpisodeCleared() && len(suo.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Subtitle.episode"`)
+	}
+	return nil
+}
+
+func (suo *SubtitleUpdateOne) sqlSave(ctx context.Context) (_node *Subtitle, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subtitle.Table, subtitle.Columns, sqlgraph.NewFieldSpec(subtitle.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Subtitle.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subtitle.FieldID)
+		for _, f := range fields {
+			if !subtitle.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subtitle.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(subtitle.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.Language(); ok {
+		_spec.SetField(subtitle.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Format(); ok {
+		_spec.SetField(subtitle.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Label(); ok {
+		_spec.SetField(subtitle.FieldLabel, field.TypeString, value)
+	}
+	if suo.mutation.LabelCleared() {
+		_spec.ClearField(subtitle.FieldLabel, field.TypeString)
+	}
+	if value, ok := suo.mutation.IsDefault(); ok {
+		_spec.SetField(subtitle.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.Path(); ok {
+		_spec.SetField(subtitle.FieldPath, field.TypeString, value)
+	}
+	if suo.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subtitle.EpisodeTable,
+			Columns: []string{subtitle.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subtitle.EpisodeTable,
+			Columns: []string{subtitle.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Subtitle{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subtitle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -12,12 +12,34 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// AudioTrack is the client for interacting with the AudioTrack builders.
+	AudioTrack *AudioTrackClient
+	// Chapter is the client for interacting with the Chapter builders.
+	Chapter *ChapterClient
 	// Episode is the client for interacting with the Episode builders.
 	Episode *EpisodeClient
+	// Favorite is the client for interacting with the Favorite builders.
+	Favorite *FavoriteClient
+	// Rating is the client for interacting with the Rating builders.
+	Rating *RatingClient
+	// Rendition is the client for interacting with the Rendition builders.
+	Rendition *RenditionClient
 	// Season is the client for interacting with the Season builders.
 	Season *SeasonClient
 	// Series is the client for interacting with the Series builders.
 	Series *SeriesClient
+	// Subtitle is the client for interacting with the Subtitle builders.
+	Subtitle *SubtitleClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
+	// WatchProgress is the client for interacting with the WatchProgress builders.
+	WatchProgress *WatchProgressClient
+	// Watchlist is the client for interacting with the Watchlist builders.
+	Watchlist *WatchlistClient
+	// WatchlistItem is the client for interacting with the WatchlistItem builders.
+	WatchlistItem *WatchlistItemClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,9 +171,20 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.AudioTrack = NewAudioTrackClient(tx.config)
+	tx.Chapter = NewChapterClient(tx.config)
 	tx.Episode = NewEpisodeClient(tx.config)
+	tx.Favorite = NewFavoriteClient(tx.config)
+	tx.Rating = NewRatingClient(tx.config)
+	tx.Rendition = NewRenditionClient(tx.config)
 	tx.Season = NewSeasonClient(tx.config)
 	tx.Series = NewSeriesClient(tx.config)
+	tx.Subtitle = NewSubtitleClient(tx.config)
+	tx.User = NewUserClient(tx.config)
+	tx.WatchProgress = NewWatchProgressClient(tx.config)
+	tx.Watchlist = NewWatchlistClient(tx.config)
+	tx.WatchlistItem = NewWatchlistItemClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +194,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Episode.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
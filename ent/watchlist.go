@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/ent/watchlist"
+)
+
+// Watchlist is the model entity for the Watchlist schema.
+type Watchlist struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WatchlistQuery when eager-loading is set.
+	Edges           WatchlistEdges `json:"edges"`
+	user_watchlists *int
+	selectValues    sql.SelectValues
+}
+
+// WatchlistEdges holds the relations/edges for other nodes in the graph.
+type WatchlistEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Items holds the value of the items edge.
+	Items []*WatchlistItem `json:"items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WatchlistEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e WatchlistEdges) ItemsOrErr() ([]*WatchlistItem, error) {
+	if e.loadedTypes[1] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Watchlist) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case watchlist.FieldID:
+			values[i] = new(sql.NullInt64)
+		case watchlist.FieldName:
+			values[i] = new(sql.NullString)
+		case watchlist.FieldCreatedAt, watchlist.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case watchlist.ForeignKeys[0]: // user_watchlists
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Watchlist fields.
+func (w *Watchlist) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case watchlist.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			w.ID = int(value.Int64)
+		case watchlist.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				w.CreatedAt = value.Time
+			}
+		case watchlist.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				w.UpdatedAt = value.Time
+			}
+		case watchlist.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				w.Name = value.String
+			}
+		case watchlist.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_watchlists", value)
+			} else if value.Valid {
+				w.user_watchlists = new(int)
+				*w.user_watchlists = int(value.Int64)
+			}
+		default:
+			w.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Watchlist.
+// This includes values selected through modifiers, order, etc.
+func (w *Watchlist) Value(name string) (ent.Value, error) {
+	return w.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Watchlist entity.
+func (w *Watchlist) QueryUser() *UserQuery {
+	return NewWatchlistClient(w.config).QueryUser(w)
+}
+
+// QueryItems queries the "items" edge of the Watchlist entity.
+func (w *Watchlist) QueryItems() *WatchlistItemQuery {
+	return NewWatchlistClient(w.config).QueryItems(w)
+}
+
+// Update returns a builder for updating this Watchlist.
+// Note that you need to call Watchlist.Unwrap() before calling this method if this Watchlist
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (w *Watchlist) Update() *WatchlistUpdateOne {
+	return NewWatchlistClient(w.config).UpdateOne(w)
+}
+
+// Unwrap unwraps the Watchlist entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (w *Watchlist) Unwrap() *Watchlist {
+	_tx, ok := w.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Watchlist is not a transactional entity")
+	}
+	w.config.driver = _tx.drv
+	return w
+}
+
+// String implements the fmt.Stringer.
+func (w *Watchlist) String() string {
+	var builder strings.Builder
+	builder.WriteString("Watchlist(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", w.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(w.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(w.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(w.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Watchlists is a parsable slice of Watchlist.
+type Watchlists []*Watchlist
@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/ent/watchlist"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
+)
+
+// WatchlistCreate is the builder for creating a Watchlist entity.
+type WatchlistCreate struct {
+	config
+	mutation *WatchlistMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wc *WatchlistCreate) SetCreatedAt(t time.Time) *WatchlistCreate {
+	wc.mutation.SetCreatedAt(t)
+	return wc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wc *WatchlistCreate) SetNillableCreatedAt(t *time.Time) *WatchlistCreate {
+	if t != nil {
+		wc.SetCreatedAt(*t)
+	}
+	return wc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wc *WatchlistCreate) SetUpdatedAt(t time.Time) *WatchlistCreate {
+	wc.mutation.SetUpdatedAt(t)
+	return wc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wc *WatchlistCreate) SetNillableUpdatedAt(t *time.Time) *WatchlistCreate {
+	if t != nil {
+		wc.SetUpdatedAt(*t)
+	}
+	return wc
+}
+
+// SetName sets the "name" field.
+func (wc *WatchlistCreate) SetName(s string) *WatchlistCreate {
+	wc.mutation.SetName(s)
+	return wc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (wc *WatchlistCreate) SetUserID(id int) *WatchlistCreate {
+	wc.mutation.SetUserID(id)
+	return wc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wc *WatchlistCreate) SetUser(u *User) *WatchlistCreate {
+	return wc.SetUserID(u.ID)
+}
+
+// AddItemIDs adds the "items" edge to the WatchlistItem entity by IDs.
+func (wc *WatchlistCreate) AddItemIDs(ids ...int) *WatchlistCreate {
+	wc.mutation.AddItemIDs(ids...)
+	return wc
+}
+
+// AddItems adds the "items" edges to the WatchlistItem entity.
+func (wc *WatchlistCreate) AddItems(w ...*WatchlistItem) *WatchlistCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wc.AddItemIDs(ids...)
+}
+
+// Mutation returns the WatchlistMutation object of the builder.
+func (wc *WatchlistCreate) Mutation() *WatchlistMutation {
+	return wc.mutation
+}
+
+// Save creates the Watchlist in the database.
+func (wc *WatchlistCreate) Save(ctx context.Context) (*Watchlist, error) {
+	wc.defaults()
+	return withHooks(ctx, wc.sqlSave, wc.mutation, wc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WatchlistCreate) SaveX(ctx context.Context) *Watchlist {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wc *WatchlistCreate) Exec(ctx context.Context) error {
+	_, err := wc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wc *WatchlistCreate) ExecX(ctx context.Context) {
+	if err := wc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wc *WatchlistCreate) defaults() {
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		v := watchlist.DefaultCreatedAt()
+		wc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		v := watchlist.DefaultUpdatedAt()
+		wc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wc *WatchlistCreate) check() error {
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Watchlist.created_at"`)}
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Watchlist.updated_at"`)}
+	}
+	if _, ok := wc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Watchlist.name"`)}
+	}
+	if v, ok := wc.mutation.Name(); ok {
+		if err := watchlist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Watchlist.name": %w`, err)}
+		}
+	}
+	if len(wc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Watchlist.user"`)}
+	}
+	return nil
+}
+
+func (wc *WatchlistCreate) sqlSave(ctx context.Context) (*Watchlist, error) {
+	if err := wc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wc.mutation.id = &_node.ID
+	wc.mutation.done = true
+	return _node, nil
+}
+
+func (wc *WatchlistCreate) createSpec() (*Watchlist, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Watchlist{config: wc.config}
+		_spec = sqlgraph.NewCreateSpec(watchlist.Table, sqlgraph.NewFieldSpec(watchlist.FieldID, field.TypeInt))
+	)
+	if value, ok := wc.mutation.CreatedAt(); ok {
+		_spec.SetField(watchlist.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wc.mutation.UpdatedAt(); ok {
+		_spec.SetField(watchlist.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wc.mutation.Name(); ok {
+		_spec.SetField(watchlist.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if nodes := wc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlist.UserTable,
+			Columns: []string{watchlist.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_watchlists = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   watchlist.ItemsTable,
+			Columns: []string{watchlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WatchlistCreateBulk is the builder for creating many Watchlist entities in bulk.
+type WatchlistCreateBulk struct {
+	config
+	err      error
+	builders []*WatchlistCreate
+}
+
+// Save creates the Watchlist entities in the database.
+func (wcb *WatchlistCreateBulk) Save(ctx context.Context) ([]*Watchlist, error) {
+	if wcb.err != nil {
+		return nil, wcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wcb.builders))
+	nodes := make([]*Watchlist, len(wcb.builders))
+	mutators := make([]Mutator, len(wcb.builders))
+	for i := range wcb.builders {
+		func(i int, root context.Context) {
+			builder := wcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WatchlistMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcb *WatchlistCreateBulk) SaveX(ctx context.Context) []*Watchlist {
+	v, err := wcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcb *WatchlistCreateBulk) Exec(ctx context.Context) error {
+	_, err := wcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcb *WatchlistCreateBulk) ExecX(ctx context.Context) {
+	if err := wcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
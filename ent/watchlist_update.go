@@ -0,0 +1,534 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/ent/watchlist"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
+)
+
+// WatchlistUpdate is the builder for updating Watchlist entities.
+type WatchlistUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WatchlistMutation
+}
+
+// Where appends a list predicates to the WatchlistUpdate builder.
+func (wu *WatchlistUpdate) Where(ps ...predicate.Watchlist) *WatchlistUpdate {
+	wu.mutation.Where(ps...)
+	return wu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wu *WatchlistUpdate) SetUpdatedAt(t time.Time) *WatchlistUpdate {
+	wu.mutation.SetUpdatedAt(t)
+	return wu
+}
+
+// SetName sets the "name" field.
+func (wu *WatchlistUpdate) SetName(s string) *WatchlistUpdate {
+	wu.mutation.SetName(s)
+	return wu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wu *WatchlistUpdate) SetNillableName(s *string) *WatchlistUpdate {
+	if s != nil {
+		wu.SetName(*s)
+	}
+	return wu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (wu *WatchlistUpdate) SetUserID(id int) *WatchlistUpdate {
+	wu.mutation.SetUserID(id)
+	return wu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wu *WatchlistUpdate) SetUser(u *User) *WatchlistUpdate {
+	return wu.SetUserID(u.ID)
+}
+
+// AddItemIDs adds the "items" edge to the WatchlistItem entity by IDs.
+func (wu *WatchlistUpdate) AddItemIDs(ids ...int) *WatchlistUpdate {
+	wu.mutation.AddItemIDs(ids...)
+	return wu
+}
+
+// AddItems adds the "items" edges to the WatchlistItem entity.
+func (wu *WatchlistUpdate) AddItems(w ...*WatchlistItem) *WatchlistUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.AddItemIDs(ids...)
+}
+
+// Mutation returns the WatchlistMutation object of the builder.
+func (wu *WatchlistUpdate) Mutation() *WatchlistMutation {
+	return wu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (wu *WatchlistUpdate) ClearUser() *WatchlistUpdate {
+	wu.mutation.ClearUser()
+	return wu
+}
+
+// ClearItems clears all "items" edges to the WatchlistItem entity.
+func (wu *WatchlistUpdate) ClearItems() *WatchlistUpdate {
+	wu.mutation.ClearItems()
+	return wu
+}
+
+// RemoveItemIDs removes the "items" edge to WatchlistItem entities by IDs.
+func (wu *WatchlistUpdate) RemoveItemIDs(ids ...int) *WatchlistUpdate {
+	wu.mutation.RemoveItemIDs(ids...)
+	return wu
+}
+
+// RemoveItems removes "items" edges to WatchlistItem entities.
+func (wu *WatchlistUpdate) RemoveItems(w ...*WatchlistItem) *WatchlistUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WatchlistUpdate) Save(ctx context.Context) (int, error) {
+	wu.defaults()
+	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WatchlistUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WatchlistUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WatchlistUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wu *WatchlistUpdate) defaults() {
+	if _, ok := wu.mutation.UpdatedAt(); !ok {
+		v := watchlist.UpdateDefaultUpdatedAt()
+		wu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wu *WatchlistUpdate) check() error {
+	if v, ok := wu.mutation.Name(); ok {
+		if err := watchlist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Watchlist.name": %w`, err)}
+		}
+	}
+	if wu.mutation.UserCleared() && len(wu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Watchlist.user"`)
+	}
+	return nil
+}
+
+func (wu *WatchlistUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(watchlist.Table, watchlist.Columns, sqlgraph.NewFieldSpec(watchlist.FieldID, field.TypeInt))
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.UpdatedAt(); ok {
+		_spec.SetField(watchlist.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Name(); ok {
+		_spec.SetField(watchlist.FieldName, field.TypeString, value)
+	}
+	if wu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlist.UserTable,
+			Columns: []string{watchlist.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlist.UserTable,
+			Columns: []string{watchlist.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   watchlist.ItemsTable,
+			Columns: []string{watchlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedItemsIDs(); len(nodes) > 0 && !wu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   watchlist.ItemsTable,
+			Columns: []string{watchlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   watchlist.ItemsTable,
+			Columns: []string{watchlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{watchlist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wu.mutation.done = true
+	return n, nil
+}
+
+// WatchlistUpdateOne is the builder for updating a single Watchlist entity.
+type WatchlistUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WatchlistMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wuo *WatchlistUpdateOne) SetUpdatedAt(t time.Time) *WatchlistUpdateOne {
+	wuo.mutation.SetUpdatedAt(t)
+	return wuo
+}
+
+// SetName sets the "name" field.
+func (wuo *WatchlistUpdateOne) SetName(s string) *WatchlistUpdateOne {
+	wuo.mutation.SetName(s)
+	return wuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wuo *WatchlistUpdateOne) SetNillableName(s *string) *WatchlistUpdateOne {
+	if s != nil {
+		wuo.SetName(*s)
+	}
+	return wuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (wuo *WatchlistUpdateOne) SetUserID(id int) *WatchlistUpdateOne {
+	wuo.mutation.SetUserID(id)
+	return wuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wuo *WatchlistUpdateOne) SetUser(u *User) *WatchlistUpdateOne {
+	return wuo.SetUserID(u.ID)
+}
+
+// AddItemIDs adds the "items" edge to the WatchlistItem entity by IDs.
+func (wuo *WatchlistUpdateOne) AddItemIDs(ids ...int) *WatchlistUpdateOne {
+	wuo.mutation.AddItemIDs(ids...)
+	return wuo
+}
+
+// AddItems adds the "items" edges to the WatchlistItem entity.
+func (wuo *WatchlistUpdateOne) AddItems(w ...*WatchlistItem) *WatchlistUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.AddItemIDs(ids...)
+}
+
+// Mutation returns the WatchlistMutation object of the builder.
+func (wuo *WatchlistUpdateOne) Mutation() *WatchlistMutation {
+	return wuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (wuo *WatchlistUpdateOne) ClearUser() *WatchlistUpdateOne {
+	wuo.mutation.ClearUser()
+	return wuo
+}
+
+// ClearItems clears all "items" edges to the WatchlistItem entity.
+func (wuo *WatchlistUpdateOne) ClearItems() *WatchlistUpdateOne {
+	wuo.mutation.ClearItems()
+	return wuo
+}
+
+// RemoveItemIDs removes the "items" edge to WatchlistItem entities by IDs.
+func (wuo *WatchlistUpdateOne) RemoveItemIDs(ids ...int) *WatchlistUpdateOne {
+	wuo.mutation.RemoveItemIDs(ids...)
+	return wuo
+}
+
+// RemoveItems removes "items" edges to WatchlistItem entities.
+func (wuo *WatchlistUpdateOne) RemoveItems(w ...*WatchlistItem) *WatchlistUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.RemoveItemIDs(ids...)
+}
+
+// Where appends a list predicates to the WatchlistUpdate builder.
+func (wuo *WatchlistUpdateOne) Where(ps ...predicate.Watchlist) *WatchlistUpdateOne {
+	wuo.mutation.Where(ps...)
+	return wuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuo *WatchlistUpdateOne) Select(field string, fields ...string) *WatchlistUpdateOne {
+	wuo.fields = append([]string{field}, fields...)
+	return wuo
+}
+
+// Save executes the query and returns the updated Watchlist entity.
+func (wuo *WatchlistUpdateOne) Save(ctx context.Context) (*Watchlist, error) {
+	wuo.defaults()
+	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WatchlistUpdateOne) SaveX(ctx context.Context) *Watchlist {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WatchlistUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WatchlistUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wuo *WatchlistUpdateOne) defaults() {
+	if _, ok := wuo.mutation.UpdatedAt(); !ok {
+		v := watchlist.UpdateDefaultUpdatedAt()
+		wuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wuo *WatchlistUpdateOne) check() error {
+	if v, ok := wuo.mutation.Name(); ok {
+		if err := watchlist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Watchlist.name": %w`, err)}
+		}
+	}
+	if wuo.mutation.UserCleared() && len(wuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Watchlist.user"`)
+	}
+	return nil
+}
+
+func (wuo *WatchlistUpdateOne) sqlSave(ctx context.Context) (_node *Watchlist, err error) {
+	if err := wuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(watchlist.Table, watchlist.Columns, sqlgraph.NewFieldSpec(watchlist.FieldID, field.TypeInt))
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Watchlist.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, watchlist.FieldID)
+		for _, f := range fields {
+			if !watchlist.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != watchlist.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(watchlist.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Name(); ok {
+		_spec.SetField(watchlist.FieldName, field.TypeString, value)
+	}
+	if wuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlist.UserTable,
+			Columns: []string{watchlist.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlist.UserTable,
+			Columns: []string{watchlist.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   watchlist.ItemsTable,
+			Columns: []string{watchlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !wuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   watchlist.ItemsTable,
+			Columns: []string{watchlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   watchlist.ItemsTable,
+			Columns: []string{watchlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Watchlist{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{watchlist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wuo.mutation.done = true
+	return _node, nil
+}
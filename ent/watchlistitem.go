@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/watchlist"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
+)
+
+// WatchlistItem is the model entity for the WatchlistItem schema.
+type WatchlistItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WatchlistItemQuery when eager-loading is set.
+	Edges                  WatchlistItemEdges `json:"edges"`
+	season_watchlist_items *int
+	series_watchlist_items *int
+	watchlist_items        *int
+	selectValues           sql.SelectValues
+}
+
+// WatchlistItemEdges holds the relations/edges for other nodes in the graph.
+type WatchlistItemEdges struct {
+	// Watchlist holds the value of the watchlist edge.
+	Watchlist *Watchlist `json:"watchlist,omitempty"`
+	// Series holds the value of the series edge.
+	Series *Series `json:"series,omitempty"`
+	// Season holds the value of the season edge.
+	Season *Season `json:"season,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// WatchlistOrErr returns the Watchlist value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WatchlistItemEdges) WatchlistOrErr() (*Watchlist, error) {
+	if e.Watchlist != nil {
+		return e.Watchlist, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: watchlist.Label}
+	}
+	return nil, &NotLoadedError{edge: "watchlist"}
+}
+
+// SeriesOrErr returns the Series value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WatchlistItemEdges) SeriesOrErr() (*Series, error) {
+	if e.Series != nil {
+		return e.Series, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: series.Label}
+	}
+	return nil, &NotLoadedError{edge: "series"}
+}
+
+// SeasonOrErr returns the Season value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WatchlistItemEdges) SeasonOrErr() (*Season, error) {
+	if e.Season != nil {
+		return e.Season, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: season.Label}
+	}
+	return nil, &NotLoadedError{edge: "season"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WatchlistItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case watchlistitem.FieldID:
+			values[i] = new(sql.NullInt64)
+		case watchlistitem.FieldCreatedAt, watchlistitem.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case watchlistitem.ForeignKeys[0]: // season_watchlist_items
+			values[i] = new(sql.NullInt64)
+		case watchlistitem.ForeignKeys[1]: // series_watchlist_items
+			values[i] = new(sql.NullInt64)
+		case watchlistitem.ForeignKeys[2]: // watchlist_items
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WatchlistItem fields.
+func (wi *WatchlistItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case watchlistitem.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wi.ID = int(value.Int64)
+		case watchlistitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wi.CreatedAt = value.Time
+			}
+		case watchlistitem.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wi.UpdatedAt = value.Time
+			}
+		case watchlistitem.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field season_watchlist_items", value)
+			} else if value.Valid {
+				wi.season_watchlist_items = new(int)
+				*wi.season_watchlist_items = int(value.Int64)
+			}
+		case watchlistitem.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field series_watchlist_items", value)
+			} else if value.Valid {
+				wi.series_watchlist_items = new(int)
+				*wi.series_watchlist_items = int(value.Int64)
+			}
+		case watchlistitem.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field watchlist_items", value)
+			} else if value.Valid {
+				wi.watchlist_items = new(int)
+				*wi.watchlist_items = int(value.Int64)
+			}
+		default:
+			wi.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WatchlistItem.
+// This includes values selected through modifiers, order, etc.
+func (wi *WatchlistItem) Value(name string) (ent.Value, error) {
+	return wi.selectValues.Get(name)
+}
+
+// QueryWatchlist queries the "watchlist" edge of the WatchlistItem entity.
+func (wi *WatchlistItem) QueryWatchlist() *WatchlistQuery {
+	return NewWatchlistItemClient(wi.config).QueryWatchlist(wi)
+}
+
+// QuerySeries queries the "series" edge of the WatchlistItem entity.
+func (wi *WatchlistItem) QuerySeries() *SeriesQuery {
+	return NewWatchlistItemClient(wi.config).QuerySeries(wi)
+}
+
+// QuerySeason queries the "season" edge of the WatchlistItem entity.
+func (wi *WatchlistItem) QuerySeason() *SeasonQuery {
+	return NewWatchlistItemClient(wi.config).QuerySeason(wi)
+}
+
+// Update returns a builder for updating this WatchlistItem.
+// Note that you need to call WatchlistItem.Unwrap() before calling this method if this WatchlistItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wi *WatchlistItem) Update() *WatchlistItemUpdateOne {
+	return NewWatchlistItemClient(wi.config).UpdateOne(wi)
+}
+
+// Unwrap unwraps the WatchlistItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wi *WatchlistItem) Unwrap() *WatchlistItem {
+	_tx, ok := wi.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WatchlistItem is not a transactional entity")
+	}
+	wi.config.driver = _tx.drv
+	return wi
+}
+
+// String implements the fmt.Stringer.
+func (wi *WatchlistItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("WatchlistItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wi.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(wi.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wi.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WatchlistItems is a parsable slice of WatchlistItem.
+type WatchlistItems []*WatchlistItem
@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/watchlist"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
+)
+
+// WatchlistItemCreate is the builder for creating a WatchlistItem entity.
+type WatchlistItemCreate struct {
+	config
+	mutation *WatchlistItemMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wic *WatchlistItemCreate) SetCreatedAt(t time.Time) *WatchlistItemCreate {
+	wic.mutation.SetCreatedAt(t)
+	return wic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wic *WatchlistItemCreate) SetNillableCreatedAt(t *time.Time) *WatchlistItemCreate {
+	if t != nil {
+		wic.SetCreatedAt(*t)
+	}
+	return wic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wic *WatchlistItemCreate) SetUpdatedAt(t time.Time) *WatchlistItemCreate {
+	wic.mutation.SetUpdatedAt(t)
+	return wic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wic *WatchlistItemCreate) SetNillableUpdatedAt(t *time.Time) *WatchlistItemCreate {
+	if t != nil {
+		wic.SetUpdatedAt(*t)
+	}
+	return wic
+}
+
+// SetWatchlistID sets the "watchlist" edge to the Watchlist entity by ID.
+func (wic *WatchlistItemCreate) SetWatchlistID(id int) *WatchlistItemCreate {
+	wic.mutation.SetWatchlistID(id)
+	return wic
+}
+
+// SetWatchlist sets the "watchlist" edge to the Watchlist entity.
+func (wic *WatchlistItemCreate) SetWatchlist(w *Watchlist) *WatchlistItemCreate {
+	return wic.SetWatchlistID(w.ID)
+}
+
+// SetSeriesID sets the "series" edge to the Series entity by ID.
+func (wic *WatchlistItemCreate) SetSeriesID(id int) *WatchlistItemCreate {
+	wic.mutation.SetSeriesID(id)
+	return wic
+}
+
+// SetNillableSeriesID sets the "series" edge to the Series entity by ID if the given value is not nil.
+func (wic *WatchlistItemCreate) SetNillableSeriesID(id *int) *WatchlistItemCreate {
+	if id != nil {
+		wic = wic.SetSeriesID(*id)
+	}
+	return wic
+}
+
+// SetSeries sets the "series" edge to the Series entity.
+func (wic *WatchlistItemCreate) SetSeries(s *Series) *WatchlistItemCreate {
+	return wic.SetSeriesID(s.ID)
+}
+
+// SetSeasonID sets the "season" edge to the Season entity by ID.
+func (wic *WatchlistItemCreate) SetSeasonID(id int) *WatchlistItemCreate {
+	wic.mutation.SetSeasonID(id)
+	return wic
+}
+
+// SetNillableSeasonID sets the "season" edge to the Season entity by ID if the given value is not nil.
+func (wic *WatchlistItemCreate) SetNillableSeasonID(id *int) *WatchlistItemCreate {
+	if id != nil {
+		wic = wic.SetSeasonID(*id)
+	}
+	return wic
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (wic *WatchlistItemCreate) SetSeason(s *Season) *WatchlistItemCreate {
+	return wic.SetSeasonID(s.ID)
+}
+
+// Mutation returns the WatchlistItemMutation object of the builder.
+func (wic *WatchlistItemCreate) Mutation() *WatchlistItemMutation {
+	return wic.mutation
+}
+
+// Save creates the WatchlistItem in the database.
+func (wic *WatchlistItemCreate) Save(ctx context.Context) (*WatchlistItem, error) {
+	wic.defaults()
+	return withHooks(ctx, wic.sqlSave, wic.mutation, wic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wic *WatchlistItemCreate) SaveX(ctx context.Context) *WatchlistItem {
+	v, err := wic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wic *WatchlistItemCreate) Exec(ctx context.Context) error {
+	_, err := wic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wic *WatchlistItemCreate) ExecX(ctx context.Context) {
+	if err := wic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wic *WatchlistItemCreate) defaults() {
+	if _, ok := wic.mutation.CreatedAt(); !ok {
+		v := watchlistitem.DefaultCreatedAt()
+		wic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wic.mutation.UpdatedAt(); !ok {
+		v := watchlistitem.DefaultUpdatedAt()
+		wic.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wic *WatchlistItemCreate) check() error {
+	if _, ok := wic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WatchlistItem.created_at"`)}
+	}
+	if _, ok := wic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WatchlistItem.updated_at"`)}
+	}
+	if len(wic.mutation.WatchlistIDs()) == 0 {
+		return &ValidationError{Name: "watchlist", err: errors.New(`ent: missing required edge "WatchlistItem.watchlist"`)}
+	}
+	return nil
+}
+
+func (wic *WatchlistItemCreate) sqlSave(ctx context.Context) (*WatchlistItem, error) {
+	if err := wic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wic.mutation.id = &_node.ID
+	wic.mutation.done = true
+	return _node, nil
+}
+
+func (wic *WatchlistItemCreate) createSpec() (*WatchlistItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WatchlistItem{config: wic.config}
+		_spec = sqlgraph.NewCreateSpec(watchlistitem.Table, sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt))
+	)
+	if value, ok := wic.mutation.CreatedAt(); ok {
+		_spec.SetField(watchlistitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wic.mutation.UpdatedAt(); ok {
+		_spec.SetField(watchlistitem.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := wic.mutation.WatchlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.WatchlistTable,
+			Columns: []string{watchlistitem.WatchlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.watchlist_items = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wic.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.SeriesTable,
+			Columns: []string{watchlistitem.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.series_watchlist_items = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wic.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.SeasonTable,
+			Columns: []string{watchlistitem.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.season_watchlist_items = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WatchlistItemCreateBulk is the builder for creating many WatchlistItem entities in bulk.
+type WatchlistItemCreateBulk struct {
+	config
+	err      error
+	builders []*WatchlistItemCreate
+}
+
+// Save creates the WatchlistItem entities in the database.
+func (wicb *WatchlistItemCreateBulk) Save(ctx context.Context) ([]*WatchlistItem, error) {
+	if wicb.err != nil {
+		return nil, wicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wicb.builders))
+	nodes := make([]*WatchlistItem, len(wicb.builders))
+	mutators := make([]Mutator, len(wicb.builders))
+	for i := range wicb.builders {
+		func(i int, root context.Context) {
+			builder := wicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WatchlistItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wicb *WatchlistItemCreateBulk) SaveX(ctx context.Context) []*WatchlistItem {
+	v, err := wicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wicb *WatchlistItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := wicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wicb *WatchlistItemCreateBulk) ExecX(ctx context.Context) {
+	if err := wicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
+)
+
+// WatchlistItemDelete is the builder for deleting a WatchlistItem entity.
+type WatchlistItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *WatchlistItemMutation
+}
+
+// Where appends a list predicates to the WatchlistItemDelete builder.
+func (wid *WatchlistItemDelete) Where(ps ...predicate.WatchlistItem) *WatchlistItemDelete {
+	wid.mutation.Where(ps...)
+	return wid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wid *WatchlistItemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wid.sqlExec, wid.mutation, wid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wid *WatchlistItemDelete) ExecX(ctx context.Context) int {
+	n, err := wid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wid *WatchlistItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(watchlistitem.Table, sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt))
+	if ps := wid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wid.mutation.done = true
+	return affected, err
+}
+
+// WatchlistItemDeleteOne is the builder for deleting a single WatchlistItem entity.
+type WatchlistItemDeleteOne struct {
+	wid *WatchlistItemDelete
+}
+
+// Where appends a list predicates to the WatchlistItemDelete builder.
+func (wido *WatchlistItemDeleteOne) Where(ps ...predicate.WatchlistItem) *WatchlistItemDeleteOne {
+	wido.wid.mutation.Where(ps...)
+	return wido
+}
+
+// Exec executes the deletion query.
+func (wido *WatchlistItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := wido.wid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{watchlistitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wido *WatchlistItemDeleteOne) ExecX(ctx context.Context) {
+	if err := wido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
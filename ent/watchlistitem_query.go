@@ -0,0 +1,801 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/watchlist"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
+)
+
+// WatchlistItemQuery is the builder for querying WatchlistItem entities.
+type WatchlistItemQuery struct {
+	config
+	ctx           *QueryContext
+	order         []watchlistitem.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.WatchlistItem
+	withWatchlist *WatchlistQuery
+	withSeries    *SeriesQuery
+	withSeason    *SeasonQuery
+	withFKs       bool
+	modifiers     []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WatchlistItemQuery builder.
+func (wiq *WatchlistItemQuery) Where(ps ...predicate.WatchlistItem) *WatchlistItemQuery {
+	wiq.predicates = append(wiq.predicates, ps...)
+	return wiq
+}
+
+// Limit the number of records to be returned by this query.
+func (wiq *WatchlistItemQuery) Limit(limit int) *WatchlistItemQuery {
+	wiq.ctx.Limit = &limit
+	return wiq
+}
+
+// Offset to start from.
+func (wiq *WatchlistItemQuery) Offset(offset int) *WatchlistItemQuery {
+	wiq.ctx.Offset = &offset
+	return wiq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wiq *WatchlistItemQuery) Unique(unique bool) *WatchlistItemQuery {
+	wiq.ctx.Unique = &unique
+	return wiq
+}
+
+// Order specifies how the records should be ordered.
+func (wiq *WatchlistItemQuery) Order(o ...watchlistitem.OrderOption) *WatchlistItemQuery {
+	wiq.order = append(wiq.order, o...)
+	return wiq
+}
+
+// QueryWatchlist chains the current query on the "watchlist" edge.
+func (wiq *WatchlistItemQuery) QueryWatchlist() *WatchlistQuery {
+	query := (&WatchlistClient{config: wiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(watchlistitem.Table, watchlistitem.FieldID, selector),
+			sqlgraph.To(watchlist.Table, watchlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, watchlistitem.WatchlistTable, watchlistitem.WatchlistColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySeries chains the current query on the "series" edge.
+func (wiq *WatchlistItemQuery) QuerySeries() *SeriesQuery {
+	query := (&SeriesClient{config: wiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(watchlistitem.Table, watchlistitem.FieldID, selector),
+			sqlgraph.To(series.Table, series.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, watchlistitem.SeriesTable, watchlistitem.SeriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySeason chains the current query on the "season" edge.
+func (wiq *WatchlistItemQuery) QuerySeason() *SeasonQuery {
+	query := (&SeasonClient{config: wiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(watchlistitem.Table, watchlistitem.FieldID, selector),
+			sqlgraph.To(season.Table, season.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, watchlistitem.SeasonTable, watchlistitem.SeasonColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WatchlistItem entity from the query.
+// Returns a *NotFoundError when no WatchlistItem was found.
+func (wiq *WatchlistItemQuery) First(ctx context.Context) (*WatchlistItem, error) {
+	nodes, err := wiq.Limit(1).All(setContextOp(ctx, wiq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{watchlistitem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wiq *WatchlistItemQuery) FirstX(ctx context.Context) *WatchlistItem {
+	node, err := wiq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WatchlistItem ID from the query.
+// Returns a *NotFoundError when no WatchlistItem ID was found.
+func (wiq *WatchlistItemQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wiq.Limit(1).IDs(setContextOp(ctx, wiq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{watchlistitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wiq *WatchlistItemQuery) FirstIDX(ctx context.Context) int {
+	id, err := wiq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WatchlistItem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WatchlistItem entity is found.
+// Returns a *NotFoundError when no WatchlistItem entities are found.
+func (wiq *WatchlistItemQuery) Only(ctx context.Context) (*WatchlistItem, error) {
+	nodes, err := wiq.Limit(2).All(setContextOp(ctx, wiq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{watchlistitem.Label}
+	default:
+		return nil, &NotSingularError{watchlistitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wiq *WatchlistItemQuery) OnlyX(ctx context.Context) *WatchlistItem {
+	node, err := wiq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WatchlistItem ID in the query.
+// Returns a *NotSingularError when more than one WatchlistItem ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wiq *WatchlistItemQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wiq.Limit(2).IDs(setContextOp(ctx, wiq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{watchlistitem.Label}
+	default:
+		err = &NotSingularError{watchlistitem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wiq *WatchlistItemQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wiq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WatchlistItems.
+func (wiq *WatchlistItemQuery) All(ctx context.Context) ([]*WatchlistItem, error) {
+	ctx = setContextOp(ctx, wiq.ctx, ent.OpQueryAll)
+	if err := wiq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WatchlistItem, *WatchlistItemQuery]()
+	return withInterceptors[[]*WatchlistItem](ctx, wiq, qr, wiq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wiq *WatchlistItemQuery) AllX(ctx context.Context) []*WatchlistItem {
+	nodes, err := wiq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WatchlistItem IDs.
+func (wiq *WatchlistItemQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if wiq.ctx.Unique == nil && wiq.path != nil {
+		wiq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wiq.ctx, ent.OpQueryIDs)
+	if err = wiq.Select(watchlistitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wiq *WatchlistItemQuery) IDsX(ctx context.Context) []int {
+	ids, err := wiq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wiq *WatchlistItemQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wiq.ctx, ent.OpQueryCount)
+	if err := wiq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wiq, querierCount[*WatchlistItemQuery](), wiq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wiq *WatchlistItemQuery) CountX(ctx context.Context) int {
+	count, err := wiq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wiq *WatchlistItemQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wiq.ctx, ent.OpQueryExist)
+	switch _, err := wiq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wiq *WatchlistItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := wiq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WatchlistItemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wiq *WatchlistItemQuery) Clone() *WatchlistItemQuery {
+	if wiq == nil {
+		return nil
+	}
+	return &WatchlistItemQuery{
+		config:        wiq.config,
+		ctx:           wiq.ctx.Clone(),
+		order:         append([]watchlistitem.OrderOption{}, wiq.order...),
+		inters:        append([]Interceptor{}, wiq.inters...),
+		predicates:    append([]predicate.WatchlistItem{}, wiq.predicates...),
+		withWatchlist: wiq.withWatchlist.Clone(),
+		withSeries:    wiq.withSeries.Clone(),
+		withSeason:    wiq.withSeason.Clone(),
+		// clone intermediate query.
+		sql:  wiq.sql.Clone(),
+		path: wiq.path,
+	}
+}
+
+// WithWatchlist tells the query-builder to eager-load the nodes that are connected to
+// the "watchlist" edge. The optional arguments are used to configure the query builder of the edge.
+func (wiq *WatchlistItemQuery) WithWatchlist(opts ...func(*WatchlistQuery)) *WatchlistItemQuery {
+	query := (&WatchlistClient{config: wiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wiq.withWatchlist = query
+	return wiq
+}
+
+// WithSeries tells the query-builder to eager-load the nodes that are connected to
+// the "series" edge. The optional arguments are used to configure the query builder of the edge.
+func (wiq *WatchlistItemQuery) WithSeries(opts ...func(*SeriesQuery)) *WatchlistItemQuery {
+	query := (&SeriesClient{config: wiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wiq.withSeries = query
+	return wiq
+}
+
+// WithSeason tells the query-builder to eager-load the nodes that are connected to
+// the "season" edge. The optional arguments are used to configure the query builder of the edge.
+func (wiq *WatchlistItemQuery) WithSeason(opts ...func(*SeasonQuery)) *WatchlistItemQuery {
+	query := (&SeasonClient{config: wiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wiq.withSeason = query
+	return wiq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WatchlistItem.Query().
+//		GroupBy(watchlistitem.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wiq *WatchlistItemQuery) GroupBy(field string, fields ...string) *WatchlistItemGroupBy {
+	wiq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WatchlistItemGroupBy{build: wiq}
+	grbuild.flds = &wiq.ctx.Fields
+	grbuild.label = watchlistitem.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.WatchlistItem.Query().
+//		Select(watchlistitem.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (wiq *WatchlistItemQuery) Select(fields ...string) *WatchlistItemSelect {
+	wiq.ctx.Fields = append(wiq.ctx.Fields, fields...)
+	sbuild := &WatchlistItemSelect{WatchlistItemQuery: wiq}
+	sbuild.label = watchlistitem.Label
+	sbuild.flds, sbuild.scan = &wiq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WatchlistItemSelect configured with the given aggregations.
+func (wiq *WatchlistItemQuery) Aggregate(fns ...AggregateFunc) *WatchlistItemSelect {
+	return wiq.Select().Aggregate(fns...)
+}
+
+func (wiq *WatchlistItemQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wiq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wiq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wiq.ctx.Fields {
+		if !watchlistitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wiq.path != nil {
+		prev, err := wiq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wiq.sql = prev
+	}
+	return nil
+}
+
+func (wiq *WatchlistItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WatchlistItem, error) {
+	var (
+		nodes       = []*WatchlistItem{}
+		withFKs     = wiq.withFKs
+		_spec       = wiq.querySpec()
+		loadedTypes = [3]bool{
+			wiq.withWatchlist != nil,
+			wiq.withSeries != nil,
+			wiq.withSeason != nil,
+		}
+	)
+	if wiq.withWatchlist != nil || wiq.withSeries != nil || wiq.withSeason != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, watchlistitem.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WatchlistItem).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WatchlistItem{config: wiq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(wiq.modifiers) > 0 {
+		_spec.Modifiers = wiq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wiq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wiq.withWatchlist; query != nil {
+		if err := wiq.loadWatchlist(ctx, query, nodes, nil,
+			func(n *WatchlistItem, e *Watchlist) { n.Edges.Watchlist = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wiq.withSeries; query != nil {
+		if err := wiq.loadSeries(ctx, query, nodes, nil,
+			func(n *WatchlistItem, e *Series) { n.Edges.Series = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wiq.withSeason; query != nil {
+		if err := wiq.loadSeason(ctx, query, nodes, nil,
+			func(n *WatchlistItem, e *Season) { n.Edges.Season = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wiq *WatchlistItemQuery) loadWatchlist(ctx context.Context, query *WatchlistQuery, nodes []*WatchlistItem, init func(*WatchlistItem), assign func(*WatchlistItem, *Watchlist)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*WatchlistItem)
+	for i := range nodes {
+		if nodes[i].watchlist_items == nil {
+			continue
+		}
+		fk := *nodes[i].watchlist_items
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(watchlist.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "watchlist_items" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (wiq *WatchlistItemQuery) loadSeries(ctx context.Context, query *SeriesQuery, nodes []*WatchlistItem, init func(*WatchlistItem), assign func(*WatchlistItem, *Series)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*WatchlistItem)
+	for i := range nodes {
+		if nodes[i].series_watchlist_items == nil {
+			continue
+		}
+		fk := *nodes[i].series_watchlist_items
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(series.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "series_watchlist_items" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (wiq *WatchlistItemQuery) loadSeason(ctx context.Context, query *SeasonQuery, nodes []*WatchlistItem, init func(*WatchlistItem), assign func(*WatchlistItem, *Season)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*WatchlistItem)
+	for i := range nodes {
+		if nodes[i].season_watchlist_items == nil {
+			continue
+		}
+		fk := *nodes[i].season_watchlist_items
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(season.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "season_watchlist_items" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (wiq *WatchlistItemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wiq.querySpec()
+	if len(wiq.modifiers) > 0 {
+		_spec.Modifiers = wiq.modifiers
+	}
+	_spec.Node.Columns = wiq.ctx.Fields
+	if len(wiq.ctx.Fields) > 0 {
+		_spec.Unique = wiq.ctx.Unique != nil && *wiq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wiq.driver, _spec)
+}
+
+func (wiq *WatchlistItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(watchlistitem.Table, watchlistitem.Columns, sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt))
+	_spec.From = wiq.sql
+	if unique := wiq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wiq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wiq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, watchlistitem.FieldID)
+		for i := range fields {
+			if fields[i] != watchlistitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wiq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wiq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wiq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wiq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wiq *WatchlistItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wiq.driver.Dialect())
+	t1 := builder.Table(watchlistitem.Table)
+	columns := wiq.ctx.Fields
+	if len(columns) == 0 {
+		columns = watchlistitem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wiq.sql != nil {
+		selector = wiq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wiq.ctx.Unique != nil && *wiq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range wiq.modifiers {
+		m(selector)
+	}
+	for _, p := range wiq.predicates {
+		p(selector)
+	}
+	for _, p := range wiq.order {
+		p(selector)
+	}
+	if offset := wiq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wiq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (wiq *WatchlistItemQuery) ForUpdate(opts ...sql.LockOption) *WatchlistItemQuery {
+	if wiq.driver.Dialect() == dialect.Postgres {
+		wiq.Unique(false)
+	}
+	wiq.modifiers = append(wiq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return wiq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (wiq *WatchlistItemQuery) ForShare(opts ...sql.LockOption) *WatchlistItemQuery {
+	if wiq.driver.Dialect() == dialect.Postgres {
+		wiq.Unique(false)
+	}
+	wiq.modifiers = append(wiq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return wiq
+}
+
+// WatchlistItemGroupBy is the group-by builder for WatchlistItem entities.
+type WatchlistItemGroupBy struct {
+	selector
+	build *WatchlistItemQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wigb *WatchlistItemGroupBy) Aggregate(fns ...AggregateFunc) *WatchlistItemGroupBy {
+	wigb.fns = append(wigb.fns, fns...)
+	return wigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wigb *WatchlistItemGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wigb.build.ctx, ent.OpQueryGroupBy)
+	if err := wigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WatchlistItemQuery, *WatchlistItemGroupBy](ctx, wigb.build, wigb, wigb.build.inters, v)
+}
+
+func (wigb *WatchlistItemGroupBy) sqlScan(ctx context.Context, root *WatchlistItemQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wigb.fns))
+	for _, fn := range wigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wigb.flds)+len(wigb.fns))
+		for _, f := range *wigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WatchlistItemSelect is the builder for selecting fields of WatchlistItem entities.
+type WatchlistItemSelect struct {
+	*WatchlistItemQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wis *WatchlistItemSelect) Aggregate(fns ...AggregateFunc) *WatchlistItemSelect {
+	wis.fns = append(wis.fns, fns...)
+	return wis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wis *WatchlistItemSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wis.ctx, ent.OpQuerySelect)
+	if err := wis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WatchlistItemQuery, *WatchlistItemSelect](ctx, wis.WatchlistItemQuery, wis, wis.inters, v)
+}
+
+func (wis *WatchlistItemSelect) sqlScan(ctx context.Context, root *WatchlistItemQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wis.fns))
+	for _, fn := range wis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,545 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/watchlist"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
+)
+
+// WatchlistItemUpdate is the builder for updating WatchlistItem entities.
+type WatchlistItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WatchlistItemMutation
+}
+
+// Where appends a list predicates to the WatchlistItemUpdate builder.
+func (wiu *WatchlistItemUpdate) Where(ps ...predicate.WatchlistItem) *WatchlistItemUpdate {
+	wiu.mutation.Where(ps...)
+	return wiu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wiu *WatchlistItemUpdate) SetUpdatedAt(t time.Time) *WatchlistItemUpdate {
+	wiu.mutation.SetUpdatedAt(t)
+	return wiu
+}
+
+// SetWatchlistID sets the "watchlist" edge to the Watchlist entity by ID.
+func (wiu *WatchlistItemUpdate) SetWatchlistID(id int) *WatchlistItemUpdate {
+	wiu.mutation.SetWatchlistID(id)
+	return wiu
+}
+
+// SetWatchlist sets the "watchlist" edge to the Watchlist entity.
+func (wiu *WatchlistItemUpdate) SetWatchlist(w *Watchlist) *WatchlistItemUpdate {
+	return wiu.SetWatchlistID(w.ID)
+}
+
+// SetSeriesID sets the "series" edge to the Series entity by ID.
+func (wiu *WatchlistItemUpdate) SetSeriesID(id int) *WatchlistItemUpdate {
+	wiu.mutation.SetSeriesID(id)
+	return wiu
+}
+
+// SetNillableSeriesID sets the "series" edge to the Series entity by ID if the given value is not nil.
+func (wiu *WatchlistItemUpdate) SetNillableSeriesID(id *int) *WatchlistItemUpdate {
+	if id != nil {
+		wiu = wiu.SetSeriesID(*id)
+	}
+	return wiu
+}
+
+// SetSeries sets the "series" edge to the Series entity.
+func (wiu *WatchlistItemUpdate) SetSeries(s *Series) *WatchlistItemUpdate {
+	return wiu.SetSeriesID(s.ID)
+}
+
+// SetSeasonID sets the "season" edge to the Season entity by ID.
+func (wiu *WatchlistItemUpdate) SetSeasonID(id int) *WatchlistItemUpdate {
+	wiu.mutation.SetSeasonID(id)
+	return wiu
+}
+
+// SetNillableSeasonID sets the "season" edge to the Season entity by ID if the given value is not nil.
+func (wiu *WatchlistItemUpdate) SetNillableSeasonID(id *int) *WatchlistItemUpdate {
+	if id != nil {
+		wiu = wiu.SetSeasonID(*id)
+	}
+	return wiu
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (wiu *WatchlistItemUpdate) SetSeason(s *Season) *WatchlistItemUpdate {
+	return wiu.SetSeasonID(s.ID)
+}
+
+// Mutation returns the WatchlistItemMutation object of the builder.
+func (wiu *WatchlistItemUpdate) Mutation() *WatchlistItemMutation {
+	return wiu.mutation
+}
+
+// ClearWatchlist clears the "watchlist" edge to the Watchlist entity.
+func (wiu *WatchlistItemUpdate) ClearWatchlist() *WatchlistItemUpdate {
+	wiu.mutation.ClearWatchlist()
+	return wiu
+}
+
+// ClearSeries clears the "series" edge to the Series entity.
+func (wiu *WatchlistItemUpdate) ClearSeries() *WatchlistItemUpdate {
+	wiu.mutation.ClearSeries()
+	return wiu
+}
+
+// ClearSeason clears the "season" edge to the Season entity.
+func (wiu *WatchlistItemUpdate) ClearSeason() *WatchlistItemUpdate {
+	wiu.mutation.ClearSeason()
+	return wiu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wiu *WatchlistItemUpdate) Save(ctx context.Context) (int, error) {
+	wiu.defaults()
+	return withHooks(ctx, wiu.sqlSave, wiu.mutation, wiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wiu *WatchlistItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := wiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wiu *WatchlistItemUpdate) Exec(ctx context.Context) error {
+	_, err := wiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wiu *WatchlistItemUpdate) ExecX(ctx context.Context) {
+	if err := wiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wiu *WatchlistItemUpdate) defaults() {
+	if _, ok := wiu.mutation.UpdatedAt(); !ok {
+		v := watchlistitem.UpdateDefaultUpdatedAt()
+		wiu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wiu *WatchlistItemUpdate) check() error {
+	if wiu.mutation.WatchlistCleared() && len(wiu.mutation.WatchlistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WatchlistItem.watchlist"`)
+	}
+	return nil
+}
+
+func (wiu *WatchlistItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(watchlistitem.Table, watchlistitem.Columns, sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt))
+	if ps := wiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wiu.mutation.UpdatedAt(); ok {
+		_spec.SetField(watchlistitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wiu.mutation.WatchlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.WatchlistTable,
+			Columns: []string{watchlistitem.WatchlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wiu.mutation.WatchlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.WatchlistTable,
+			Columns: []string{watchlistitem.WatchlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wiu.mutation.SeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.SeriesTable,
+			Columns: []string{watchlistitem.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wiu.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.SeriesTable,
+			Columns: []string{watchlistitem.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wiu.mutation.SeasonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.SeasonTable,
+			Columns: []string{watchlistitem.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wiu.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.SeasonTable,
+			Columns: []string{watchlistitem.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{watchlistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wiu.mutation.done = true
+	return n, nil
+}
+
+// WatchlistItemUpdateOne is the builder for updating a single WatchlistItem entity.
+type WatchlistItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WatchlistItemMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wiuo *WatchlistItemUpdateOne) SetUpdatedAt(t time.Time) *WatchlistItemUpdateOne {
+	wiuo.mutation.SetUpdatedAt(t)
+	return wiuo
+}
+
+// SetWatchlistID sets the "watchlist" edge to the Watchlist entity by ID.
+func (wiuo *WatchlistItemUpdateOne) SetWatchlistID(id int) *WatchlistItemUpdateOne {
+	wiuo.mutation.SetWatchlistID(id)
+	return wiuo
+}
+
+// SetWatchlist sets the "watchlist" edge to the Watchlist entity.
+func (wiuo *WatchlistItemUpdateOne) SetWatchlist(w *Watchlist) *WatchlistItemUpdateOne {
+	return wiuo.SetWatchlistID(w.ID)
+}
+
+// SetSeriesID sets the "series" edge to the Series entity by ID.
+func (wiuo *WatchlistItemUpdateOne) SetSeriesID(id int) *WatchlistItemUpdateOne {
+	wiuo.mutation.SetSeriesID(id)
+	return wiuo
+}
+
+// SetNillableSeriesID sets the "series" edge to the Series entity by ID if the given value is not nil.
+func (wiuo *WatchlistItemUpdateOne) SetNillableSeriesID(id *int) *WatchlistItemUpdateOne {
+	if id != nil {
+		wiuo = wiuo.SetSeriesID(*id)
+	}
+	return wiuo
+}
+
+// SetSeries sets the "series" edge to the Series entity.
+func (wiuo *WatchlistItemUpdateOne) SetSeries(s *Series) *WatchlistItemUpdateOne {
+	return wiuo.SetSeriesID(s.ID)
+}
+
+// SetSeasonID sets the "season" edge to the Season entity by ID.
+func (wiuo *WatchlistItemUpdateOne) SetSeasonID(id int) *WatchlistItemUpdateOne {
+	wiuo.mutation.SetSeasonID(id)
+	return wiuo
+}
+
+// SetNillableSeasonID sets the "season" edge to the Season entity by ID if the given value is not nil.
+func (wiuo *WatchlistItemUpdateOne) SetNillableSeasonID(id *int) *WatchlistItemUpdateOne {
+	if id != nil {
+		wiuo = wiuo.SetSeasonID(*id)
+	}
+	return wiuo
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (wiuo *WatchlistItemUpdateOne) SetSeason(s *Season) *WatchlistItemUpdateOne {
+	return wiuo.SetSeasonID(s.ID)
+}
+
+// Mutation returns the WatchlistItemMutation object of the builder.
+func (wiuo *WatchlistItemUpdateOne) Mutation() *WatchlistItemMutation {
+	return wiuo.mutation
+}
+
+// ClearWatchlist clears the "watchlist" edge to the Watchlist entity.
+func (wiuo *WatchlistItemUpdateOne) ClearWatchlist() *WatchlistItemUpdateOne {
+	wiuo.mutation.ClearWatchlist()
+	return wiuo
+}
+
+// ClearSeries clears the "series" edge to the Series entity.
+func (wiuo *WatchlistItemUpdateOne) ClearSeries() *WatchlistItemUpdateOne {
+	wiuo.mutation.ClearSeries()
+	return wiuo
+}
+
+// ClearSeason clears the "season" edge to the Season entity.
+func (wiuo *WatchlistItemUpdateOne) ClearSeason() *WatchlistItemUpdateOne {
+	wiuo.mutation.ClearSeason()
+	return wiuo
+}
+
+// Where appends a list predicates to the WatchlistItemUpdate builder.
+func (wiuo *WatchlistItemUpdateOne) Where(ps ...predicate.WatchlistItem) *WatchlistItemUpdateOne {
+	wiuo.mutation.Where(ps...)
+	return wiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wiuo *WatchlistItemUpdateOne) Select(field string, fields ...string) *WatchlistItemUpdateOne {
+	wiuo.fields = append([]string{field}, fields...)
+	return wiuo
+}
+
+// Save executes the query and returns the updated WatchlistItem entity.
+func (wiuo *WatchlistItemUpdateOne) Save(ctx context.Context) (*WatchlistItem, error) {
+	wiuo.defaults()
+	return withHooks(ctx, wiuo.sqlSave, wiuo.mutation, wiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wiuo *WatchlistItemUpdateOne) SaveX(ctx context.Context) *WatchlistItem {
+	node, err := wiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wiuo *WatchlistItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := wiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wiuo *WatchlistItemUpdateOne) ExecX(ctx context.Context) {
+	if err := wiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wiuo *WatchlistItemUpdateOne) defaults() {
+	if _, ok := wiuo.mutation.UpdatedAt(); !ok {
+		v := watchlistitem.UpdateDefaultUpdatedAt()
+		wiuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wiuo *WatchlistItemUpdateOne) check() error {
+	if wiuo.mutation.WatchlistCleared() && len(wiuo.mutation.WatchlistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WatchlistItem.watchlist"`)
+	}
+	return nil
+}
+
+func (wiuo *WatchlistItemUpdateOne) sqlSave(ctx context.Context) (_node *WatchlistItem, err error) {
+	if err := wiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(watchlistitem.Table, watchlistitem.Columns, sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeInt))
+	id, ok := wiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WatchlistItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, watchlistitem.FieldID)
+		for _, f := range fields {
+			if !watchlistitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != watchlistitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wiuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(watchlistitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wiuo.mutation.WatchlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.WatchlistTable,
+			Columns: []string{watchlistitem.WatchlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wiuo.mutation.WatchlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.WatchlistTable,
+			Columns: []string{watchlistitem.WatchlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wiuo.mutation.SeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.SeriesTable,
+			Columns: []string{watchlistitem.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wiuo.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.SeriesTable,
+			Columns: []string{watchlistitem.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wiuo.mutation.SeasonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.SeasonTable,
+			Columns: []string{watchlistitem.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wiuo.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.SeasonTable,
+			Columns: []string{watchlistitem.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WatchlistItem{config: wiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{watchlistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wiuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/ent/watchprogress"
+)
+
+// WatchProgress is the model entity for the WatchProgress schema.
+type WatchProgress struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Position holds the value of the "position" field.
+	Position float64 `json:"position,omitempty"`
+	// Completed holds the value of the "completed" field.
+	Completed bool `json:"completed,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WatchProgressQuery when eager-loading is set.
+	Edges                  WatchProgressEdges `json:"edges"`
+	episode_watch_progress *int
+	user_watch_progress    *int
+	selectValues           sql.SelectValues
+}
+
+// WatchProgressEdges holds the relations/edges for other nodes in the graph.
+type WatchProgressEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Episode holds the value of the episode edge.
+	Episode *Episode `json:"episode,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WatchProgressEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// EpisodeOrErr returns the Episode value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WatchProgressEdges) EpisodeOrErr() (*Episode, error) {
+	if e.Episode != nil {
+		return e.Episode, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: episode.Label}
+	}
+	return nil, &NotLoadedError{edge: "episode"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WatchProgress) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case watchprogress.FieldCompleted:
+			values[i] = new(sql.NullBool)
+		case watchprogress.FieldPosition:
+			values[i] = new(sql.NullFloat64)
+		case watchprogress.FieldID:
+			values[i] = new(sql.NullInt64)
+		case watchprogress.FieldCreatedAt, watchprogress.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case watchprogress.ForeignKeys[0]: // episode_watch_progress
+			values[i] = new(sql.NullInt64)
+		case watchprogress.ForeignKeys[1]: // user_watch_progress
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WatchProgress fields.
+func (wp *WatchProgress) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case watchprogress.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wp.ID = int(value.Int64)
+		case watchprogress.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wp.CreatedAt = value.Time
+			}
+		case watchprogress.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wp.UpdatedAt = value.Time
+			}
+		case watchprogress.FieldPosition:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				wp.Position = value.Float64
+			}
+		case watchprogress.FieldCompleted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field completed", values[i])
+			} else if value.Valid {
+				wp.Completed = value.Bool
+			}
+		case watchprogress.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field episode_watch_progress", value)
+			} else if value.Valid {
+				wp.episode_watch_progress = new(int)
+				*wp.episode_watch_progress = int(value.Int64)
+			}
+		case watchprogress.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_watch_progress", value)
+			} else if value.Valid {
+				wp.user_watch_progress = new(int)
+				*wp.user_watch_progress = int(value.Int64)
+			}
+		default:
+			wp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WatchProgress.
+// This includes values selected through modifiers, order, etc.
+func (wp *WatchProgress) Value(name string) (ent.Value, error) {
+	return wp.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the WatchProgress entity.
+func (wp *WatchProgress) QueryUser() *UserQuery {
+	return NewWatchProgressClient(wp.config).QueryUser(wp)
+}
+
+// QueryEpisode queries the "episode" edge of the WatchProgress entity.
+func (wp *WatchProgress) QueryEpisode() *EpisodeQuery {
+	return NewWatchProgressClient(wp.config).QueryEpisode(wp)
+}
+
+// Update returns a builder for updating this WatchProgress.
+// Note that you need to call WatchProgress.Unwrap() before calling this method if this WatchProgress
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wp *WatchProgress) Update() *WatchProgressUpdateOne {
+	return NewWatchProgressClient(wp.config).UpdateOne(wp)
+}
+
+// Unwrap unwraps the WatchProgress entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wp *WatchProgress) Unwrap() *WatchProgress {
+	_tx, ok := wp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WatchProgress is not a transactional entity")
+	}
+	wp.config.driver = _tx.drv
+	return wp
+}
+
+// String implements the fmt.Stringer.
+func (wp *WatchProgress) String() string {
+	var builder strings.Builder
+	builder.WriteString("WatchProgress(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wp.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(wp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", wp.Position))
+	builder.WriteString(", ")
+	builder.WriteString("completed=")
+	builder.WriteString(fmt.Sprintf("%v", wp.Completed))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WatchProgresses is a parsable slice of WatchProgress.
+type WatchProgresses []*WatchProgress
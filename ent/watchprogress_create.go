@@ -0,0 +1,329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/ent/watchprogress"
+)
+
+// WatchProgressCreate is the builder for creating a WatchProgress entity.
+type WatchProgressCreate struct {
+	config
+	mutation *WatchProgressMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wpc *WatchProgressCreate) SetCreatedAt(t time.Time) *WatchProgressCreate {
+	wpc.mutation.SetCreatedAt(t)
+	return wpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wpc *WatchProgressCreate) SetNillableCreatedAt(t *time.Time) *WatchProgressCreate {
+	if t != nil {
+		wpc.SetCreatedAt(*t)
+	}
+	return wpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wpc *WatchProgressCreate) SetUpdatedAt(t time.Time) *WatchProgressCreate {
+	wpc.mutation.SetUpdatedAt(t)
+	return wpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wpc *WatchProgressCreate) SetNillableUpdatedAt(t *time.Time) *WatchProgressCreate {
+	if t != nil {
+		wpc.SetUpdatedAt(*t)
+	}
+	return wpc
+}
+
+// SetPosition sets the "position" field.
+func (wpc *WatchProgressCreate) SetPosition(f float64) *WatchProgressCreate {
+	wpc.mutation.SetPosition(f)
+	return wpc
+}
+
+// SetCompleted sets the "completed" field.
+func (wpc *WatchProgressCreate) SetCompleted(b bool) *WatchProgressCreate {
+	wpc.mutation.SetCompleted(b)
+	return wpc
+}
+
+// SetNillableCompleted sets the "completed" field if the given value is not nil.
+func (wpc *WatchProgressCreate) SetNillableCompleted(b *bool) *WatchProgressCreate {
+	if b != nil {
+		wpc.SetCompleted(*b)
+	}
+	return wpc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (wpc *WatchProgressCreate) SetUserID(id int) *WatchProgressCreate {
+	wpc.mutation.SetUserID(id)
+	return wpc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wpc *WatchProgressCreate) SetUser(u *User) *WatchProgressCreate {
+	return wpc.SetUserID(u.ID)
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (wpc *WatchProgressCreate) SetEpisodeID(id int) *WatchProgressCreate {
+	wpc.mutation.SetEpisodeID(id)
+	return wpc
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (wpc *WatchProgressCreate) SetEpisode(e *Episode) *WatchProgressCreate {
+	return wpc.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the WatchProgressMutation object of the builder.
+func (wpc *WatchProgressCreate) Mutation() *WatchProgressMutation {
+	return wpc.mutation
+}
+
+// Save creates the WatchProgress in the database.
+func (wpc *WatchProgressCreate) Save(ctx context.Context) (*WatchProgress, error) {
+	wpc.defaults()
+	return withHooks(ctx, wpc.sqlSave, wpc.mutation, wpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wpc *WatchProgressCreate) SaveX(ctx context.Context) *WatchProgress {
+	v, err := wpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wpc *WatchProgressCreate) Exec(ctx context.Context) error {
+	_, err := wpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpc *WatchProgressCreate) ExecX(ctx context.Context) {
+	if err := wpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wpc *WatchProgressCreate) defaults() {
+	if _, ok := wpc.mutation.CreatedAt(); !ok {
+		v := watchprogress.DefaultCreatedAt()
+		wpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wpc.mutation.UpdatedAt(); !ok {
+		v := watchprogress.DefaultUpdatedAt()
+		wpc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wpc.mutation.Completed(); !ok {
+		v := watchprogress.DefaultCompleted
+		wpc.mutation.SetCompleted(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wpc *WatchProgressCreate) check() error {
+	if _, ok := wpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WatchProgress.created_at"`)}
+	}
+	if _, ok := wpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WatchProgress.updated_at"`)}
+	}
+	if _, ok := wpc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "WatchProgress.position"`)}
+	}
+	if _, ok := wpc.mutation.Completed(); !ok {
+		return &ValidationError{Name: "completed", err: errors.New(`ent: missing required field "WatchProgress.completed"`)}
+	}
+	if len(wpc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "WatchProgress.user"`)}
+	}
+	if len(wpc.mutation.EpisodeIDs()) == 0 {
+		return &ValidationError{Name: "episode", err: errors.New(`ent: missing required edge "WatchProgress.episode"`)}
+	}
+	return nil
+}
+
+func (wpc *WatchProgressCreate) sqlSave(ctx context.Context) (*WatchProgress, error) {
+	if err := wpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wpc.mutation.id = &_node.ID
+	wpc.mutation.done = true
+	return _node, nil
+}
+
+func (wpc *WatchProgressCreate) createSpec() (*WatchProgress, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WatchProgress{config: wpc.config}
+		_spec = sqlgraph.NewCreateSpec(watchprogress.Table, sqlgraph.NewFieldSpec(watchprogress.FieldID, field.TypeInt))
+	)
+	if value, ok := wpc.mutation.CreatedAt(); ok {
+		_spec.SetField(watchprogress.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(watchprogress.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wpc.mutation.Position(); ok {
+		_spec.SetField(watchprogress.FieldPosition, field.TypeFloat64, value)
+		_node.Position = value
+	}
+	if value, ok := wpc.mutation.Completed(); ok {
+		_spec.SetField(watchprogress.FieldCompleted, field.TypeBool, value)
+		_node.Completed = value
+	}
+	if nodes := wpc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchprogress.UserTable,
+			Columns: []string{watchprogress.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_watch_progress = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wpc.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchprogress.EpisodeTable,
+			Columns: []string{watchprogress.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.episode_watch_progress = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WatchProgressCreateBulk is the builder for creating many WatchProgress entities in bulk.
+type WatchProgressCreateBulk struct {
+	config
+	err      error
+	builders []*WatchProgressCreate
+}
+
+// Save creates the WatchProgress entities in the database.
+func (wpcb *WatchProgressCreateBulk) Save(ctx context.Context) ([]*WatchProgress, error) {
+	if wpcb.err != nil {
+		return nil, wpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wpcb.builders))
+	nodes := make([]*WatchProgress, len(wpcb.builders))
+	mutators := make([]Mutator, len(wpcb.builders))
+	for i := range wpcb.builders {
+		func(i int, root context.Context) {
+			builder := wpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WatchProgressMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wpcb *WatchProgressCreateBulk) SaveX(ctx context.Context) []*WatchProgress {
+	v, err := wpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wpcb *WatchProgressCreateBulk) Exec(ctx context.Context) error {
+	_, err := wpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpcb *WatchProgressCreateBulk) ExecX(ctx context.Context) {
+	if err := wpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
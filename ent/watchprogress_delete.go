@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/watchprogress"
+)
+
+// WatchProgressDelete is the builder for deleting a WatchProgress entity.
+type WatchProgressDelete struct {
+	config
+	hooks    []Hook
+	mutation *WatchProgressMutation
+}
+
+// Where appends a list predicates to the WatchProgressDelete builder.
+func (wpd *WatchProgressDelete) Where(ps ...predicate.WatchProgress) *WatchProgressDelete {
+	wpd.mutation.Where(ps...)
+	return wpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wpd *WatchProgressDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wpd.sqlExec, wpd.mutation, wpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpd *WatchProgressDelete) ExecX(ctx context.Context) int {
+	n, err := wpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wpd *WatchProgressDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(watchprogress.Table, sqlgraph.NewFieldSpec(watchprogress.FieldID, field.TypeInt))
+	if ps := wpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wpd.mutation.done = true
+	return affected, err
+}
+
+// WatchProgressDeleteOne is the builder for deleting a single WatchProgress entity.
+type WatchProgressDeleteOne struct {
+	wpd *WatchProgressDelete
+}
+
+// Where appends a list predicates to the WatchProgressDelete builder.
+func (wpdo *WatchProgressDeleteOne) Where(ps ...predicate.WatchProgress) *WatchProgressDeleteOne {
+	wpdo.wpd.mutation.Where(ps...)
+	return wpdo
+}
+
+// Exec executes the deletion query.
+func (wpdo *WatchProgressDeleteOne) Exec(ctx context.Context) error {
+	n, err := wpdo.wpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{watchprogress.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpdo *WatchProgressDeleteOne) ExecX(ctx context.Context) {
+	if err := wpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/ent/watchprogress"
+)
+
+// WatchProgressQuery is the builder for querying WatchProgress entities.
+type WatchProgressQuery struct {
+	config
+	ctx         *QueryContext
+	order       []watchprogress.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.WatchProgress
+	withUser    *UserQuery
+	withEpisode *EpisodeQuery
+	withFKs     bool
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WatchProgressQuery builder.
+func (wpq *WatchProgressQuery) Where(ps ...predicate.WatchProgress) *WatchProgressQuery {
+	wpq.predicates = append(wpq.predicates, ps...)
+	return wpq
+}
+
+// Limit the number of records to be returned by this query.
+func (wpq *WatchProgressQuery) Limit(limit int) *WatchProgressQuery {
+	wpq.ctx.Limit = &limit
+	return wpq
+}
+
+// Offset to start from.
+func (wpq *WatchProgressQuery) Offset(offset int) *WatchProgressQuery {
+	wpq.ctx.Offset = &offset
+	return wpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wpq *WatchProgressQuery) Unique(unique bool) *WatchProgressQuery {
+	wpq.ctx.Unique = &unique
+	return wpq
+}
+
+// Order specifies how the records should be ordered.
+func (wpq *WatchProgressQuery) Order(o ...watchprogress.OrderOption) *WatchProgressQuery {
+	wpq.order = append(wpq.order, o...)
+	return wpq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (wpq *WatchProgressQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: wpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(watchprogress.Table, watchprogress.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, watchprogress.UserTable, watchprogress.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEpisode chains the current query on the "episode" edge.
+func (wpq *WatchProgressQuery) QueryEpisode() *EpisodeQuery {
+	query := (&EpisodeClient{config: wpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(watchprogress.Table, watchprogress.FieldID, selector),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, watchprogress.EpisodeTable, watchprogress.EpisodeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WatchProgress entity from the query.
+// Returns a *NotFoundError when no WatchProgress was found.
+func (wpq *WatchProgressQuery) First(ctx context.Context) (*WatchProgress, error) {
+	nodes, err := wpq.Limit(1).All(setContextOp(ctx, wpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{watchprogress.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wpq *WatchProgressQuery) FirstX(ctx context.Context) *WatchProgress {
+	node, err := wpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WatchProgress ID from the query.
+// Returns a *NotFoundError when no WatchProgress ID was found.
+func (wpq *WatchProgressQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wpq.Limit(1).IDs(setContextOp(ctx, wpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{watchprogress.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wpq *WatchProgressQuery) FirstIDX(ctx context.Context) int {
+	id, err := wpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WatchProgress entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WatchProgress entity is found.
+// Returns a *NotFoundError when no WatchProgress entities are found.
+func (wpq *WatchProgressQuery) Only(ctx context.Context) (*WatchProgress, error) {
+	nodes, err := wpq.Limit(2).All(setContextOp(ctx, wpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{watchprogress.Label}
+	default:
+		return nil, &NotSingularError{watchprogress.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wpq *WatchProgressQuery) OnlyX(ctx context.Context) *WatchProgress {
+	node, err := wpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WatchProgress ID in the query.
+// Returns a *NotSingularError when more than one WatchProgress ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wpq *WatchProgressQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wpq.Limit(2).IDs(setContextOp(ctx, wpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{watchprogress.Label}
+	default:
+		err = &NotSingularError{watchprogress.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wpq *WatchProgressQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WatchProgresses.
+func (wpq *WatchProgressQuery) All(ctx context.Context) ([]*WatchProgress, error) {
+	ctx = setContextOp(ctx, wpq.ctx, ent.OpQueryAll)
+	if err := wpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WatchProgress, *WatchProgressQuery]()
+	return withInterceptors[[]*WatchProgress](ctx, wpq, qr, wpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wpq *WatchProgressQuery) AllX(ctx context.Context) []*WatchProgress {
+	nodes, err := wpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WatchProgress IDs.
+func (wpq *WatchProgressQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if wpq.ctx.Unique == nil && wpq.path != nil {
+		wpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wpq.ctx, ent.OpQueryIDs)
+	if err = wpq.Select(watchprogress.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wpq *WatchProgressQuery) IDsX(ctx context.Context) []int {
+	ids, err := wpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wpq *WatchProgressQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wpq.ctx, ent.OpQueryCount)
+	if err := wpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wpq, querierCount[*WatchProgressQuery](), wpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wpq *WatchProgressQuery) CountX(ctx context.Context) int {
+	count, err := wpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wpq *WatchProgressQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wpq.ctx, ent.OpQueryExist)
+	switch _, err := wpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wpq *WatchProgressQuery) ExistX(ctx context.Context) bool {
+	exist, err := wpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WatchProgressQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wpq *WatchProgressQuery) Clone() *WatchProgressQuery {
+	if wpq == nil {
+		return nil
+	}
+	return &WatchProgressQuery{
+		config:      wpq.config,
+		ctx:         wpq.ctx.Clone(),
+		order:       append([]watchprogress.OrderOption{}, wpq.order...),
+		inters:      append([]Interceptor{}, wpq.inters...),
+		predicates:  append([]predicate.WatchProgress{}, wpq.predicates...),
+		withUser:    wpq.withUser.Clone(),
+		withEpisode: wpq.withEpisode.Clone(),
+		// clone intermediate query.
+		sql:  wpq.sql.Clone(),
+		path: wpq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (wpq *WatchProgressQuery) WithUser(opts ...func(*UserQuery)) *WatchProgressQuery {
+	query := (&UserClient{config: wpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wpq.withUser = query
+	return wpq
+}
+
+// WithEpisode tells the query-builder to eager-load the nodes that are connected to
+// the "episode" edge. The optional arguments are used to configure the query builder of the edge.
+func (wpq *WatchProgressQuery) WithEpisode(opts ...func(*EpisodeQuery)) *WatchProgressQuery {
+	query := (&EpisodeClient{config: wpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wpq.withEpisode = query
+	return wpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WatchProgress.Query().
+//		GroupBy(watchprogress.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wpq *WatchProgressQuery) GroupBy(field string, fields ...string) *WatchProgressGroupBy {
+	wpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WatchProgressGroupBy{build: wpq}
+	grbuild.flds = &wpq.ctx.Fields
+	grbuild.label = watchprogress.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.WatchProgress.Query().
+//		Select(watchprogress.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (wpq *WatchProgressQuery) Select(fields ...string) *WatchProgressSelect {
+	wpq.ctx.Fields = append(wpq.ctx.Fields, fields...)
+	sbuild := &WatchProgressSelect{WatchProgressQuery: wpq}
+	sbuild.label = watchprogress.Label
+	sbuild.flds, sbuild.scan = &wpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WatchProgressSelect configured with the given aggregations.
+func (wpq *WatchProgressQuery) Aggregate(fns ...AggregateFunc) *WatchProgressSelect {
+	return wpq.Select().Aggregate(fns...)
+}
+
+func (wpq *WatchProgressQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wpq.ctx.Fields {
+		if !watchprogress.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wpq.path != nil {
+		prev, err := wpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wpq.sql = prev
+	}
+	return nil
+}
+
+func (wpq *WatchProgressQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WatchProgress, error) {
+	var (
+		nodes       = []*WatchProgress{}
+		withFKs     = wpq.withFKs
+		_spec       = wpq.querySpec()
+		loadedTypes = [2]bool{
+			wpq.withUser != nil,
+			wpq.withEpisode != nil,
+		}
+	)
+	if wpq.withUser != nil || wpq.withEpisode != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, watchprogress.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WatchProgress).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WatchProgress{config: wpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(wpq.modifiers) > 0 {
+		_spec.Modifiers = wpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wpq.withUser; query != nil {
+		if err := wpq.loadUser(ctx, query, nodes, nil,
+			func(n *WatchProgress, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wpq.withEpisode; query != nil {
+		if err := wpq.loadEpisode(ctx, query, nodes, nil,
+			func(n *WatchProgress, e *Episode) { n.Edges.Episode = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wpq *WatchProgressQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*WatchProgress, init func(*WatchProgress), assign func(*WatchProgress, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*WatchProgress)
+	for i := range nodes {
+		if nodes[i].user_watch_progress == nil {
+			continue
+		}
+		fk := *nodes[i].user_watch_progress
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_watch_progress" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (wpq *WatchProgressQuery) loadEpisode(ctx context.Context, query *EpisodeQuery, nodes []*WatchProgress, init func(*WatchProgress), assign func(*WatchProgress, *Episode)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*WatchProgress)
+	for i := range nodes {
+		if nodes[i].episode_watch_progress == nil {
+			continue
+		}
+		fk := *nodes[i].episode_watch_progress
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(episode.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "episode_watch_progress" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (wpq *WatchProgressQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wpq.querySpec()
+	if len(wpq.modifiers) > 0 {
+		_spec.Modifiers = wpq.modifiers
+	}
+	_spec.Node.Columns = wpq.ctx.Fields
+	if len(wpq.ctx.Fields) > 0 {
+		_spec.Unique = wpq.ctx.Unique != nil && *wpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wpq.driver, _spec)
+}
+
+func (wpq *WatchProgressQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(watchprogress.Table, watchprogress.Columns, sqlgraph.NewFieldSpec(watchprogress.FieldID, field.TypeInt))
+	_spec.From = wpq.sql
+	if unique := wpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, watchprogress.FieldID)
+		for i := range fields {
+			if fields[i] != watchprogress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wpq *WatchProgressQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wpq.driver.Dialect())
+	t1 := builder.Table(watchprogress.Table)
+	columns := wpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = watchprogress.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wpq.sql != nil {
+		selector = wpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wpq.ctx.Unique != nil && *wpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range wpq.modifiers {
+		m(selector)
+	}
+	for _, p := range wpq.predicates {
+		p(selector)
+	}
+	for _, p := range wpq.order {
+		p(selector)
+	}
+	if offset := wpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (wpq *WatchProgressQuery) ForUpdate(opts ...sql.LockOption) *WatchProgressQuery {
+	if wpq.driver.Dialect() == dialect.Postgres {
+		wpq.Unique(false)
+	}
+	wpq.modifiers = append(wpq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return wpq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (wpq *WatchProgressQuery) ForShare(opts ...sql.LockOption) *WatchProgressQuery {
+	if wpq.driver.Dialect() == dialect.Postgres {
+		wpq.Unique(false)
+	}
+	wpq.modifiers = append(wpq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return wpq
+}
+
+// WatchProgressGroupBy is the group-by builder for WatchProgress entities.
+type WatchProgressGroupBy struct {
+	selector
+	build *WatchProgressQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wpgb *WatchProgressGroupBy) Aggregate(fns ...AggregateFunc) *WatchProgressGroupBy {
+	wpgb.fns = append(wpgb.fns, fns...)
+	return wpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wpgb *WatchProgressGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WatchProgressQuery, *WatchProgressGroupBy](ctx, wpgb.build, wpgb, wpgb.build.inters, v)
+}
+
+func (wpgb *WatchProgressGroupBy) sqlScan(ctx context.Context, root *WatchProgressQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wpgb.fns))
+	for _, fn := range wpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wpgb.flds)+len(wpgb.fns))
+		for _, f := range *wpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WatchProgressSelect is the builder for selecting fields of WatchProgress entities.
+type WatchProgressSelect struct {
+	*WatchProgressQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wps *WatchProgressSelect) Aggregate(fns ...AggregateFunc) *WatchProgressSelect {
+	wps.fns = append(wps.fns, fns...)
+	return wps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wps *WatchProgressSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wps.ctx, ent.OpQuerySelect)
+	if err := wps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WatchProgressQuery, *WatchProgressSelect](ctx, wps.WatchProgressQuery, wps, wps.inters, v)
+}
+
+func (wps *WatchProgressSelect) sqlScan(ctx context.Context, root *WatchProgressQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wps.fns))
+	for _, fn := range wps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
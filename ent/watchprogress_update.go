@@ -0,0 +1,514 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/ent/watchprogress"
+)
+
+// WatchProgressUpdate is the builder for updating WatchProgress entities.
+type WatchProgressUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WatchProgressMutation
+}
+
+// Where appends a list predicates to the WatchProgressUpdate builder.
+func (wpu *WatchProgressUpdate) Where(ps ...predicate.WatchProgress) *WatchProgressUpdate {
+	wpu.mutation.Where(ps...)
+	return wpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wpu *WatchProgressUpdate) SetUpdatedAt(t time.Time) *WatchProgressUpdate {
+	wpu.mutation.SetUpdatedAt(t)
+	return wpu
+}
+
+// SetPosition sets the "position" field.
+func (wpu *WatchProgressUpdate) SetPosition(f float64) *WatchProgressUpdate {
+	wpu.mutation.ResetPosition()
+	wpu.mutation.SetPosition(f)
+	return wpu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (wpu *WatchProgressUpdate) SetNillablePosition(f *float64) *WatchProgressUpdate {
+	if f != nil {
+		wpu.SetPosition(*f)
+	}
+	return wpu
+}
+
+// AddPosition adds f to the "position" field.
+func (wpu *WatchProgressUpdate) AddPosition(f float64) *WatchProgressUpdate {
+	wpu.mutation.AddPosition(f)
+	return wpu
+}
+
+// SetCompleted sets the "completed" field.
+func (wpu *WatchProgressUpdate) SetCompleted(b bool) *WatchProgressUpdate {
+	wpu.mutation.SetCompleted(b)
+	return wpu
+}
+
+// SetNillableCompleted sets the "completed" field if the given value is not nil.
+func (wpu *WatchProgressUpdate) SetNillableCompleted(b *bool) *WatchProgressUpdate {
+	if b != nil {
+		wpu.SetCompleted(*b)
+	}
+	return wpu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (wpu *WatchProgressUpdate) SetUserID(id int) *WatchProgressUpdate {
+	wpu.mutation.SetUserID(id)
+	return wpu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wpu *WatchProgressUpdate) SetUser(u *User) *WatchProgressUpdate {
+	return wpu.SetUserID(u.ID)
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (wpu *WatchProgressUpdate) SetEpisodeID(id int) *WatchProgressUpdate {
+	wpu.mutation.SetEpisodeID(id)
+	return wpu
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (wpu *WatchProgressUpdate) SetEpisode(e *Episode) *WatchProgressUpdate {
+	return wpu.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the WatchProgressMutation object of the builder.
+func (wpu *WatchProgressUpdate) Mutation() *WatchProgressMutation {
+	return wpu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (wpu *WatchProgressUpdate) ClearUser() *WatchProgressUpdate {
+	wpu.mutation.ClearUser()
+	return wpu
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (wpu *WatchProgressUpdate) ClearEpisode() *WatchProgressUpdate {
+	wpu.mutation.ClearEpisode()
+	return wpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wpu *WatchProgressUpdate) Save(ctx context.Context) (int, error) {
+	wpu.defaults()
+	return withHooks(ctx, wpu.sqlSave, wpu.mutation, wpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wpu *WatchProgressUpdate) SaveX(ctx context.Context) int {
+	affected, err := wpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wpu *WatchProgressUpdate) Exec(ctx context.Context) error {
+	_, err := wpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpu *WatchProgressUpdate) ExecX(ctx context.Context) {
+	if err := wpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wpu *WatchProgressUpdate) defaults() {
+	if _, ok := wpu.mutation.UpdatedAt(); !ok {
+		v := watchprogress.UpdateDefaultUpdatedAt()
+		wpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wpu *WatchProgressUpdate) check() error {
+	if wpu.mutation.UserCleared() && len(wpu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WatchProgress.user"`)
+	}
+	if wpu.mutation.EpisodeCleared() && len(wpu.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WatchProgress.episode"`)
+	}
+	return nil
+}
+
+func (wpu *WatchProgressUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(watchprogress.Table, watchprogress.Columns, sqlgraph.NewFieldSpec(watchprogress.FieldID, field.TypeInt))
+	if ps := wpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(watchprogress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wpu.mutation.Position(); ok {
+		_spec.SetField(watchprogress.FieldPosition, field.TypeFloat64, value)
+	}
+	if value, ok := wpu.mutation.AddedPosition(); ok {
+		_spec.AddField(watchprogress.FieldPosition, field.TypeFloat64, value)
+	}
+	if value, ok := wpu.mutation.Completed(); ok {
+		_spec.SetField(watchprogress.FieldCompleted, field.TypeBool, value)
+	}
+	if wpu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchprogress.UserTable,
+			Columns: []string{watchprogress.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wpu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchprogress.UserTable,
+			Columns: []string{watchprogress.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wpu.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchprogress.EpisodeTable,
+			Columns: []string{watchprogress.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wpu.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchprogress.EpisodeTable,
+			Columns: []string{watchprogress.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{watchprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wpu.mutation.done = true
+	return n, nil
+}
+
+// WatchProgressUpdateOne is the builder for updating a single WatchProgress entity.
+type WatchProgressUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WatchProgressMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wpuo *WatchProgressUpdateOne) SetUpdatedAt(t time.Time) *WatchProgressUpdateOne {
+	wpuo.mutation.SetUpdatedAt(t)
+	return wpuo
+}
+
+// SetPosition sets the "position" field.
+func (wpuo *WatchProgressUpdateOne) SetPosition(f float64) *WatchProgressUpdateOne {
+	wpuo.mutation.ResetPosition()
+	wpuo.mutation.SetPosition(f)
+	return wpuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (wpuo *WatchProgressUpdateOne) SetNillablePosition(f *float64) *WatchProgressUpdateOne {
+	if f != nil {
+		wpuo.SetPosition(*f)
+	}
+	return wpuo
+}
+
+// AddPosition adds f to the "position" field.
+func (wpuo *WatchProgressUpdateOne) AddPosition(f float64) *WatchProgressUpdateOne {
+	wpuo.mutation.AddPosition(f)
+	return wpuo
+}
+
+// SetCompleted sets the "completed" field.
+func (wpuo *WatchProgressUpdateOne) SetCompleted(b bool) *WatchProgressUpdateOne {
+	wpuo.mutation.SetCompleted(b)
+	return wpuo
+}
+
+// SetNillableCompleted sets the "completed" field if the given value is not nil.
+func (wpuo *WatchProgressUpdateOne) SetNillableCompleted(b *bool) *WatchProgressUpdateOne {
+	if b != nil {
+		wpuo.SetCompleted(*b)
+	}
+	return wpuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (wpuo *WatchProgressUpdateOne) SetUserID(id int) *WatchProgressUpdateOne {
+	wpuo.mutation.SetUserID(id)
+	return wpuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wpuo *WatchProgressUpdateOne) SetUser(u *User) *WatchProgressUpdateOne {
+	return wpuo.SetUserID(u.ID)
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (wpuo *WatchProgressUpdateOne) SetEpisodeID(id int) *WatchProgressUpdateOne {
+	wpuo.mutation.SetEpisodeID(id)
+	return wpuo
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (wpuo *WatchProgressUpdateOne) SetEpisode(e *Episode) *WatchProgressUpdateOne {
+	return wpuo.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the WatchProgressMutation object of the builder.
+func (wpuo *WatchProgressUpdateOne) Mutation() *WatchProgressMutation {
+	return wpuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (wpuo *WatchProgressUpdateOne) ClearUser() *WatchProgressUpdateOne {
+	wpuo.mutation.ClearUser()
+	return wpuo
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (wpuo *WatchProgressUpdateOne) ClearEpisode() *WatchProgressUpdateOne {
+	wpuo.mutation.ClearEpisode()
+	return wpuo
+}
+
+// Where appends a list predicates to the WatchProgressUpdate builder.
+func (wpuo *WatchProgressUpdateOne) Where(ps ...predicate.WatchProgress) *WatchProgressUpdateOne {
+	wpuo.mutation.Where(ps...)
+	return wpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wpuo *WatchProgressUpdateOne) Select(field string, fields ...string) *WatchProgressUpdateOne {
+	wpuo.fields = append([]string{field}, fields...)
+	return wpuo
+}
+
+// Save executes the query and returns the updated WatchProgress entity.
+func (wpuo *WatchProgressUpdateOne) Save(ctx context.Context) (*WatchProgress, error) {
+	wpuo.defaults()
+	return withHooks(ctx, wpuo.sqlSave, wpuo.mutation, wpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wpuo *WatchProgressUpdateOne) SaveX(ctx context.Context) *WatchProgress {
+	node, err := wpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wpuo *WatchProgressUpdateOne) Exec(ctx context.Context) error {
+	_, err := wpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpuo *WatchProgressUpdateOne) ExecX(ctx context.Context) {
+	if err := wpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wpuo *WatchProgressUpdateOne) defaults() {
+	if _, ok := wpuo.mutation.UpdatedAt(); !ok {
+		v := watchprogress.UpdateDefaultUpdatedAt()
+		wpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wpuo *WatchProgressUpdateOne) check() error {
+	if wpuo.mutation.UserCleared() && len(wpuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WatchProgress.user"`)
+	}
+	if wpuo.mutation.EpisodeCleared() && len(wpuo.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WatchProgress.episode"`)
+	}
+	return nil
+}
+
+func (wpuo *WatchProgressUpdateOne) sqlSave(ctx context.Context) (_node *WatchProgress, err error) {
+	if err := wpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(watchprogress.Table, watchprogress.Columns, sqlgraph.NewFieldSpec(watchprogress.FieldID, field.TypeInt))
+	id, ok := wpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WatchProgress.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, watchprogress.FieldID)
+		for _, f := range fields {
+			if !watchprogress.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != watchprogress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(watchprogress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wpuo.mutation.Position(); ok {
+		_spec.SetField(watchprogress.FieldPosition, field.TypeFloat64, value)
+	}
+	if value, ok := wpuo.mutation.AddedPosition(); ok {
+		_spec.AddField(watchprogress.FieldPosition, field.TypeFloat64, value)
+	}
+	if value, ok := wpuo.mutation.Completed(); ok {
+		_spec.SetField(watchprogress.FieldCompleted, field.TypeBool, value)
+	}
+	if wpuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchprogress.UserTable,
+			Columns: []string{watchprogress.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wpuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchprogress.UserTable,
+			Columns: []string{watchprogress.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wpuo.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchprogress.EpisodeTable,
+			Columns: []string{watchprogress.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wpuo.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchprogress.EpisodeTable,
+			Columns: []string{watchprogress.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WatchProgress{config: wpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{watchprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wpuo.mutation.done = true
+	return _node, nil
+}
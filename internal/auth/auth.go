@@ -0,0 +1,167 @@
+// Package auth authenticates requests by API key or by the bearer token of
+// an OpenID Connect identity provider, and tells which user they act for.
+//
+// A request acts for the user its API key is bound to, or the one named by
+// its token. Otherwise the user is named by a request header that an
+// authenticating reverse proxy sets; the API must then only be reachable
+// through that proxy. Keys not bound to a user may only use the header
+// with the admin scope.
+package auth
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const DefaultUserHeader = "X-User"
+
+// maxUserName bounds account names, like the User schema.
+const maxUserName = 255
+
+type Config struct {
+	// UserHeader names the header carrying the account name; "" turns
+	// it off. With a principal, only an admin one may use it.
+	UserHeader string
+	// APIKeys requires requests to present an API key, or a token when
+	// JWT is set, with the scope their route needs.
+	APIKeys bool
+	// PublicRead lets reads of the catalog through without a key.
+	PublicRead bool
+	// JWT accepts bearer tokens of an identity provider; nil when none is
+	// configured.
+	JWT *JWTConfig
+}
+
+// FromEnv reads AUTH_API_KEYS ("on" unless "off"), AUTH_USER_HEADER, the
+// header naming the user ("off" disables it; unset, "X-User" with keys off
+// and none with keys on), AUTH_PUBLIC_READ (false unless set) and the
+// token settings (see JWTFromEnv). On error the returned Config is still
+// usable, with reads not public and no tokens accepted.
+func FromEnv() (Config, error) {
+	c := Config{APIKeys: true}
+	switch keys := os.Getenv("AUTH_API_KEYS"); keys {
+	case "", "on":
+	case "off":
+		c.APIKeys = false
+	default:
+		return c, fmt.Errorf("AUTH_API_KEYS must be on or off")
+	}
+	switch header := os.Getenv("AUTH_USER_HEADER"); header {
+	case "":
+		if !c.APIKeys {
+			c.UserHeader = DefaultUserHeader
+		}
+	case "off":
+	default:
+		c.UserHeader = header
+	}
+	if raw := os.Getenv("AUTH_PUBLIC_READ"); raw != "" {
+		var err error
+		if c.PublicRead, err = strconv.ParseBool(raw); err != nil {
+			return c, fmt.Errorf("AUTH_PUBLIC_READ must be true or false")
+		}
+	}
+	jwt, err := JWTFromEnv()
+	if err != nil {
+		return c, err
+	}
+	c.JWT = jwt
+	return c, nil
+}
+
+// JWTFromEnv reads AUTH_JWKS_URL, the key set of the identity provider,
+// which it returns nil without. AUTH_JWT_ISSUER and AUTH_JWT_AUDIENCE are
+// then required; AUTH_JWKS_TTL, AUTH_JWT_ROLES_CLAIM, AUTH_JWT_USER_CLAIM
+// and AUTH_JWT_ROLES, a ';' separated list of role=scope entries,
+// override the defaults.
+func JWTFromEnv() (*JWTConfig, error) {
+	raw := os.Getenv("AUTH_JWKS_URL")
+	if raw == "" {
+		return nil, nil
+	}
+	ttl := DefaultJWKSTTL
+	if v := os.Getenv("AUTH_JWKS_TTL"); v != "" {
+		var err error
+		if ttl, err = time.ParseDuration(v); err != nil || ttl <= 0 {
+			return nil, fmt.Errorf("AUTH_JWKS_TTL must be a positive duration")
+		}
+	}
+	keys, err := NewKeySet(raw, ttl)
+	if err != nil {
+		return nil, fmt.Errorf("AUTH_JWKS_URL: %w", err)
+	}
+	c := &JWTConfig{
+		Keys:       keys,
+		Issuer:     os.Getenv("AUTH_JWT_ISSUER"),
+		Audience:   os.Getenv("AUTH_JWT_AUDIENCE"),
+		RolesClaim: cmp.Or(os.Getenv("AUTH_JWT_ROLES_CLAIM"), DefaultRolesClaim),
+		UserClaim:  cmp.Or(os.Getenv("AUTH_JWT_USER_CLAIM"), DefaultUserClaim),
+		RoleScopes: DefaultRoleScopes,
+	}
+	if c.Issuer == "" || c.Audience == "" {
+		return nil, fmt.Errorf("AUTH_JWT_ISSUER and AUTH_JWT_AUDIENCE are required with AUTH_JWKS_URL")
+	}
+	if v := os.Getenv("AUTH_JWT_ROLES"); v != "" {
+		c.RoleScopes = map[string]Scope{}
+		for _, entry := range strings.Split(v, ";") {
+			if strings.TrimSpace(entry) == "" {
+				continue
+			}
+			role, raw, ok := strings.Cut(entry, "=")
+			s, err := ParseScope(raw)
+			if !ok || err != nil {
+				return nil, fmt.Errorf("AUTH_JWT_ROLES: invalid entry %q, want role=read, write or admin", entry)
+			}
+			c.RoleScopes[strings.TrimSpace(role)] = s
+		}
+	}
+	return c, nil
+}
+
+var (
+	mu      sync.RWMutex
+	current *Config
+)
+
+// Current returns the configured Config, reading the environment on first
+// use unless Configure was called.
+func Current() Config {
+	mu.RLock()
+	c := current
+	mu.RUnlock()
+	if c != nil {
+		return *c
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if current == nil {
+		env, _ := FromEnv()
+		current = &env
+	}
+	return *current
+}
+
+func Configure(c Config) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = &c
+}
+
+// User returns the account name r acts for, or "" when it names none or
+// an invalid one.
+func (c Config) User(r *http.Request) string {
+	if c.UserHeader == "" {
+		return ""
+	}
+	name := strings.TrimSpace(r.Header.Get(c.UserHeader))
+	if len(name) > maxUserName {
+		return ""
+	}
+	return name
+}
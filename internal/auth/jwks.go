@@ -0,0 +1,227 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultJWKSTTL is how long a fetched key set is used before it is
+// fetched again.
+const DefaultJWKSTTL = 15 * time.Minute
+
+// jwksMinRefresh bounds how often a token signed by an unknown key makes
+// the key set be fetched again.
+const jwksMinRefresh = 10 * time.Second
+
+// maxJWKSBytes bounds the key set documents read.
+const maxJWKSBytes = 1 << 20
+
+// JWK is a public key in JSON Web Key form. Only RSA and EC signing keys
+// are used.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+var curves = map[string]elliptic.Curve{
+	"P-256": elliptic.P256(),
+	"P-384": elliptic.P384(),
+	"P-521": elliptic.P521(),
+}
+
+// PublicKey decodes k into an *rsa.PublicKey or *ecdsa.PublicKey.
+func (k JWK) PublicKey() (crypto.PublicKey, error) {
+	b64 := base64.RawURLEncoding
+	switch k.Kty {
+	case "RSA":
+		n, err1 := b64.DecodeString(k.N)
+		e, err2 := b64.DecodeString(k.E)
+		if err := errors.Join(err1, err2); err != nil || len(n) == 0 || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("malformed RSA key %q", k.Kid)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		curve, ok := curves[k.Crv]
+		if !ok {
+			return nil, fmt.Errorf("unsupported curve %q of key %q", k.Crv, k.Kid)
+		}
+		x, err1 := b64.DecodeString(k.X)
+		y, err2 := b64.DecodeString(k.Y)
+		if err := errors.Join(err1, err2); err != nil {
+			return nil, fmt.Errorf("malformed EC key %q", k.Kid)
+		}
+		pub := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, fmt.Errorf("malformed EC key %q", k.Kid)
+		}
+		return pub, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q of key %q", k.Kty, k.Kid)
+}
+
+// PublicJWK describes the public half of key, an RSA or ECDSA key, as a
+// signing key with the given ID.
+func PublicJWK(key crypto.PublicKey, kid string) (JWK, error) {
+	b64 := base64.RawURLEncoding
+	switch pub := key.(type) {
+	case *rsa.PublicKey:
+		return JWK{Kty: "RSA", Kid: kid, Use: "sig", Alg: "RS256",
+			N: b64.EncodeToString(pub.N.Bytes()), E: b64.EncodeToString(big.NewInt(int64(pub.E)).Bytes())}, nil
+	case *ecdsa.PublicKey:
+		alg, ok := ecdsaAlgs[pub.Curve]
+		if !ok {
+			return JWK{}, fmt.Errorf("unsupported curve %s", pub.Curve.Params().Name)
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		return JWK{Kty: "EC", Kid: kid, Use: "sig", Alg: alg, Crv: pub.Curve.Params().Name,
+			X: b64.EncodeToString(pub.X.FillBytes(make([]byte, size))),
+			Y: b64.EncodeToString(pub.Y.FillBytes(make([]byte, size)))}, nil
+	}
+	return JWK{}, fmt.Errorf("unsupported key type %T", key)
+}
+
+// signingKey is a key of the set, with the algorithm it is restricted to
+// ("" when any of its type).
+type signingKey struct {
+	key crypto.PublicKey
+	alg string
+}
+
+// KeySet is the verification keys at a JWKS URL, fetched on first use and
+// again once they are older than the TTL or a token names an unknown key.
+// file: URLs read a local key set.
+type KeySet struct {
+	url    string
+	ttl    time.Duration
+	client *http.Client
+
+	mu      sync.Mutex
+	keys    map[string]signingKey
+	fetched time.Time
+	// tried is the last fetch attempt, successful or not.
+	tried time.Time
+}
+
+func NewKeySet(rawURL string, ttl time.Duration) (*KeySet, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http" && u.Scheme != "file") {
+		return nil, fmt.Errorf("JWKS URL must be an http(s) or file URL")
+	}
+	return &KeySet{url: rawURL, ttl: ttl, client: &http.Client{Timeout: 10 * time.Second}}, nil
+}
+
+// Key returns the key with the given ID; "" matches the only key of a set
+// of one. It fails with ErrKeysUnavailable when no key set could be
+// fetched yet.
+func (s *KeySet) Key(ctx context.Context, kid string) (signingKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	k, ok := s.lookup(kid)
+	stale := now.Sub(s.fetched) > s.ttl
+	if (stale || !ok) && now.Sub(s.tried) > jwksMinRefresh {
+		s.tried = now
+		keys, err := s.fetch(ctx)
+		switch {
+		case err == nil:
+			s.keys, s.fetched = keys, now
+			k, ok = s.lookup(kid)
+		case s.keys == nil:
+			return signingKey{}, fmt.Errorf("%w: %v", ErrKeysUnavailable, err)
+		}
+		// Otherwise keep using the keys fetched before.
+	}
+	if s.keys == nil {
+		return signingKey{}, ErrKeysUnavailable
+	}
+	if !ok {
+		return signingKey{}, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return k, nil
+}
+
+// ErrKeysUnavailable reports that the key set could not be fetched.
+var ErrKeysUnavailable = errors.New("token signing keys unavailable")
+
+func (s *KeySet) lookup(kid string) (signingKey, bool) {
+	if kid == "" && len(s.keys) == 1 {
+		for _, k := range s.keys {
+			return k, true
+		}
+	}
+	k, ok := s.keys[kid]
+	return k, ok
+}
+
+func (s *KeySet) fetch(ctx context.Context) (map[string]signingKey, error) {
+	var body io.Reader
+	if u, _ := url.Parse(s.url); u.Scheme == "file" {
+		f, err := os.Open(u.Path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		body = f
+	} else {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("GET %s: %s", s.url, res.Status)
+		}
+		body = res.Body
+	}
+	var set JWKS
+	if err := json.NewDecoder(io.LimitReader(body, maxJWKSBytes)).Decode(&set); err != nil {
+		return nil, fmt.Errorf("decoding key set: %w", err)
+	}
+	keys := map[string]signingKey{}
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.PublicKey()
+		if err != nil {
+			// Skip keys of types we cannot verify with.
+			continue
+		}
+		keys[k.Kid] = signingKey{key: pub, alg: k.Alg}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("key set at %s has no usable signing keys", s.url)
+	}
+	return keys, nil
+}
@@ -0,0 +1,254 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+// leeway absorbs clock skew with the identity provider.
+const leeway = time.Minute
+
+// Defaults of JWTConfig.
+const (
+	DefaultRolesClaim = "roles"
+	DefaultUserClaim  = "sub"
+)
+
+// DefaultRoleScopes grants scopes to the roles of the roles claim.
+var DefaultRoleScopes = map[string]Scope{
+	"viewer": ScopeRead,
+	"editor": ScopeWrite,
+	"admin":  ScopeAdmin,
+}
+
+// algHashes are the signature algorithms accepted, by JWS name.
+var algHashes = map[string]crypto.Hash{
+	"RS256": crypto.SHA256, "RS384": crypto.SHA384, "RS512": crypto.SHA512,
+	"ES256": crypto.SHA256, "ES384": crypto.SHA384, "ES512": crypto.SHA512,
+}
+
+// ecdsaAlgs is the algorithm used with the keys of each curve.
+var ecdsaAlgs = map[elliptic.Curve]string{
+	elliptic.P256(): "ES256",
+	elliptic.P384(): "ES384",
+	elliptic.P521(): "ES512",
+}
+
+// JWTConfig verifies bearer tokens issued by an OpenID Connect identity
+// provider and maps their roles to scopes.
+type JWTConfig struct {
+	Keys     *KeySet
+	Issuer   string
+	Audience string
+	// RolesClaim names the claim listing the roles; dots reach into
+	// objects, as in "realm_access.roles".
+	RolesClaim string
+	// UserClaim names the claim holding the account name.
+	UserClaim  string
+	RoleScopes map[string]Scope
+}
+
+// claims are the registered claims checked, besides those mapped to roles
+// and the user.
+type claims struct {
+	Issuer    string   `json:"iss"`
+	Subject   string   `json:"sub"`
+	Audience  audience `json:"aud"`
+	ExpiresAt *float64 `json:"exp"`
+	NotBefore *float64 `json:"nbf"`
+}
+
+// audience is the aud claim, a string or an array of them.
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var one string
+	if err := json.Unmarshal(b, &one); err == nil {
+		*a = audience{one}
+		return nil
+	}
+	return json.Unmarshal(b, (*[]string)(a))
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// LooksLikeJWT reports whether token has the three parts of a compact
+// JWS.
+func LooksLikeJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+// Verify checks the signature, issuer, audience and lifetime of token and
+// returns its principal: the subject, acting for the user claim (none
+// when it is missing), with the scopes of its roles. Errors wrapping ErrKeysUnavailable are the
+// provider's fault, others the token's.
+func (c *JWTConfig) Verify(ctx context.Context, token string) (*Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, errors.New("malformed token header")
+	}
+	hash, ok := algHashes[h.Alg]
+	if !ok {
+		return nil, fmt.Errorf("unsupported algorithm %q", h.Alg)
+	}
+	k, err := c.Keys.Key(ctx, h.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if k.alg != "" && k.alg != h.Alg {
+		return nil, fmt.Errorf("key %q is not for %s", h.Kid, h.Alg)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.New("malformed token signature")
+	}
+	if err := verifySignature(k.key, h.Alg, hash, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	var std claims
+	var all map[string]any
+	if err := errors.Join(decodeSegment(parts[1], &std), decodeSegment(parts[1], &all)); err != nil {
+		return nil, errors.New("malformed token claims")
+	}
+	now := float64(time.Now().Unix())
+	switch {
+	case std.Issuer != c.Issuer:
+		return nil, fmt.Errorf("token issuer %q is not %q", std.Issuer, c.Issuer)
+	case !slices.Contains(std.Audience, c.Audience):
+		return nil, fmt.Errorf("token is not for audience %q", c.Audience)
+	case std.ExpiresAt == nil:
+		return nil, errors.New("token has no expiry")
+	case now > *std.ExpiresAt+leeway.Seconds():
+		return nil, errors.New("token expired")
+	case std.NotBefore != nil && now < *std.NotBefore-leeway.Seconds():
+		return nil, errors.New("token not valid yet")
+	}
+
+	p := &Principal{Name: std.Subject}
+	if user, ok := claim(all, c.UserClaim).(string); ok && len(user) <= maxUserName {
+		p.User = user
+	}
+	roles, _ := claim(all, c.RolesClaim).([]any)
+	for _, r := range roles {
+		name, _ := r.(string)
+		if s, ok := c.RoleScopes[name]; ok && !slices.Contains(p.Scopes, s) {
+			p.Scopes = append(p.Scopes, s)
+			p.Roles = append(p.Roles, name)
+		}
+	}
+	return p, nil
+}
+
+// claim looks up a dotted path in the claims.
+func claim(all map[string]any, path string) any {
+	var v any = all
+	for _, name := range strings.Split(path, ".") {
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = obj[name]
+	}
+	return v
+}
+
+func decodeSegment(seg string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func verifySignature(key crypto.PublicKey, alg string, hash crypto.Hash, signed string, sig []byte) error {
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+	invalid := errors.New("invalid token signature")
+	switch pub := key.(type) {
+	case *rsa.PublicKey:
+		if alg[:2] != "RS" || rsa.VerifyPKCS1v15(pub, hash, digest, sig) != nil {
+			return invalid
+		}
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if ecdsaAlgs[pub.Curve] != alg || len(sig) != 2*size {
+			return invalid
+		}
+		r, s := new(big.Int).SetBytes(sig[:size]), new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return invalid
+		}
+	default:
+		return invalid
+	}
+	return nil
+}
+
+// SignJWT signs claims with key, an *rsa.PrivateKey (RS256) or
+// *ecdsa.PrivateKey (ES256, ES384 or ES512 by curve), naming the key kid.
+// It issues tokens for local testing.
+func SignJWT(key crypto.PrivateKey, kid string, payload map[string]any) (string, error) {
+	var alg string
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		alg = "RS256"
+	case *ecdsa.PrivateKey:
+		alg = ecdsaAlgs[k.Curve]
+	}
+	if alg == "" {
+		return "", fmt.Errorf("unsupported key type %T", key)
+	}
+	h, err := json.Marshal(map[string]string{"alg": alg, "typ": "JWT", "kid": kid})
+	if err != nil {
+		return "", err
+	}
+	c, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	b64 := base64.RawURLEncoding
+	signed := b64.EncodeToString(h) + "." + b64.EncodeToString(c)
+	hash := algHashes[alg]
+	d := hash.New()
+	d.Write([]byte(signed))
+	var sig []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, hash, d.Sum(nil))
+	case *ecdsa.PrivateKey:
+		// JWS wants r and s concatenated rather than ASN.1.
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, k, d.Sum(nil))
+		if err == nil {
+			size := (k.Curve.Params().BitSize + 7) / 8
+			sig = append(r.FillBytes(make([]byte, size)), s.FillBytes(make([]byte, size))...)
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return signed + "." + b64.EncodeToString(sig), nil
+}
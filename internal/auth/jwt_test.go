@@ -0,0 +1,224 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testKeys is a locally generated key set: an RSA key restricted to
+// RS256, an EC P-256 key and an RSA key published without an algorithm.
+type testKeys struct {
+	rsa, anyAlg *rsa.PrivateKey
+	ec          *ecdsa.PrivateKey
+	set         JWKS
+}
+
+func newTestKeys(t *testing.T) *testKeys {
+	t.Helper()
+	rk, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ak, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ek, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k := &testKeys{rsa: rk, anyAlg: ak, ec: ek}
+	for kid, pub := range map[string]any{"rsa": &rk.PublicKey, "ec": &ek.PublicKey, "any": &ak.PublicKey} {
+		jwk, err := PublicJWK(pub, kid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if kid == "any" {
+			jwk.Alg = ""
+		}
+		k.set.Keys = append(k.set.Keys, jwk)
+	}
+	return k
+}
+
+// signAs signs claims with an RSA key using the hash of alg, which unlike
+// with SignJWT can be any algorithm, even one for another key type.
+func signAs(t *testing.T, key *rsa.PrivateKey, alg, kid string, payload map[string]any) string {
+	t.Helper()
+	h, _ := json.Marshal(map[string]string{"alg": alg, "typ": "JWT", "kid": kid})
+	c, _ := json.Marshal(payload)
+	b64 := base64.RawURLEncoding
+	signed := b64.EncodeToString(h) + "." + b64.EncodeToString(c)
+	hash := algHashes[alg]
+	d := hash.New()
+	d.Write([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, hash, d.Sum(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + b64.EncodeToString(sig)
+}
+
+func testConfig(keys *KeySet) *JWTConfig {
+	return &JWTConfig{
+		Keys:       keys,
+		Issuer:     "https://idp.example.com",
+		Audience:   "animatrix",
+		RolesClaim: "realm_access.roles",
+		UserClaim:  DefaultUserClaim,
+		RoleScopes: DefaultRoleScopes,
+	}
+}
+
+// validClaims are accepted by testConfig; each case of TestVerify breaks
+// one of them.
+func validClaims() map[string]any {
+	now := time.Now()
+	return map[string]any{
+		"iss":          "https://idp.example.com",
+		"aud":          []string{"other", "animatrix"},
+		"sub":          "alice",
+		"exp":          now.Add(time.Hour).Unix(),
+		"nbf":          now.Add(-time.Minute).Unix(),
+		"realm_access": map[string]any{"roles": []string{"viewer", "editor", "unmapped"}},
+	}
+}
+
+func TestVerify(t *testing.T) {
+	keys := newTestKeys(t)
+	var fetches atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		json.NewEncoder(w).Encode(keys.set)
+	}))
+	defer srv.Close()
+	set, err := NewKeySet(srv.URL, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := testConfig(set)
+	ctx := context.Background()
+
+	sign := func(key any, kid string, claims map[string]any) string {
+		token, err := SignJWT(key, kid, claims)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	with := func(name string, v any) map[string]any {
+		claims := validClaims()
+		if v == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = v
+		}
+		return claims
+	}
+
+	for _, tc := range []struct {
+		name  string
+		token string
+	}{
+		{"RSA", sign(keys.rsa, "rsa", validClaims())},
+		{"EC", sign(keys.ec, "ec", validClaims())},
+		{"key without alg", signAs(t, keys.anyAlg, "RS512", "any", validClaims())},
+		{"single aud", sign(keys.rsa, "rsa", with("aud", "animatrix"))},
+		{"exp within leeway", sign(keys.rsa, "rsa", with("exp", time.Now().Add(-leeway/2).Unix()))},
+		{"nbf within leeway", sign(keys.rsa, "rsa", with("nbf", time.Now().Add(leeway/2).Unix()))},
+	} {
+		p, err := c.Verify(ctx, tc.token)
+		if err != nil {
+			t.Errorf("%s: rejected: %v", tc.name, err)
+			continue
+		}
+		if p.Name != "alice" || p.User != "alice" || p.KeyID != 0 {
+			t.Errorf("%s: principal %+v, want alice acting for alice", tc.name, p)
+		}
+		if !slices.Equal(p.Scopes, []Scope{ScopeRead, ScopeWrite}) || !slices.Equal(p.Roles, []string{"viewer", "editor"}) {
+			t.Errorf("%s: scopes %v of roles %v, want [read write] of [viewer editor]", tc.name, p.Scopes, p.Roles)
+		}
+	}
+
+	for _, tc := range []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{"wrong iss", sign(keys.rsa, "rsa", with("iss", "https://evil.example.com")), "issuer"},
+		{"wrong aud", sign(keys.rsa, "rsa", with("aud", []string{"other"})), "audience"},
+		{"no exp", sign(keys.rsa, "rsa", with("exp", nil)), "no expiry"},
+		{"expired", sign(keys.rsa, "rsa", with("exp", time.Now().Add(-2*leeway).Unix())), "expired"},
+		{"nbf in the future", sign(keys.rsa, "rsa", with("nbf", time.Now().Add(2*leeway).Unix())), "not valid yet"},
+		{"alg other than the key's", signAs(t, keys.rsa, "RS384", "rsa", validClaims()), "not for RS384"},
+		{"alg of another key type", signAs(t, keys.anyAlg, "ES256", "any", validClaims()), "signature"},
+		{"unknown kid", sign(keys.rsa, "gone", validClaims()), "unknown signing key"},
+		{"signed by another key", sign(keys.anyAlg, "rsa", validClaims()), "signature"},
+		{"unsupported alg", "eyJhbGciOiJIUzI1NiJ9." + strings.SplitN(sign(keys.rsa, "rsa", validClaims()), ".", 2)[1], "unsupported algorithm"},
+	} {
+		if _, err := c.Verify(ctx, tc.token); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: err = %v, want one mentioning %q", tc.name, err, tc.want)
+		}
+	}
+	// The unknown kid came right after the first fetch, within
+	// jwksMinRefresh, so it did not fetch the key set again.
+	if n := fetches.Load(); n != 1 {
+		t.Errorf("key set fetched %d times, want 1", n)
+	}
+
+	// Without the user claim a token acts for no one, whatever its roles.
+	p, err := c.Verify(ctx, sign(keys.ec, "ec", with("sub", nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.User != "" || !p.Allows(ScopeWrite) {
+		t.Errorf("token without sub: principal %+v, want no user with write scope", p)
+	}
+}
+
+func TestKeySetFile(t *testing.T) {
+	keys := newTestKeys(t)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	b, _ := json.Marshal(keys.set)
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	set, err := NewKeySet("file://"+path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := testConfig(set)
+	token, err := SignJWT(keys.ec, "ec", validClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Verify(context.Background(), token); err != nil {
+		t.Errorf("rejected: %v", err)
+	}
+	token, err = SignJWT(keys.ec, "rsa", validClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Verify(context.Background(), token); err == nil {
+		t.Error("accepted an ES256 token naming the RS256 key")
+	}
+
+	missing, _ := NewKeySet("file://"+filepath.Join(t.TempDir(), "none.json"), time.Hour)
+	if _, err := testConfig(missing).Verify(context.Background(), token); err == nil || !strings.Contains(err.Error(), ErrKeysUnavailable.Error()) {
+		t.Errorf("missing key set: err = %v, want %v", err, ErrKeysUnavailable)
+	}
+}
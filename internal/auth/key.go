@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Scope is a level of access granted to an API key. Each scope includes
+// the ones before it: write allows reading, admin allows writing.
+type Scope string
+
+const (
+	ScopeRead  Scope = "read"
+	ScopeWrite Scope = "write"
+	ScopeAdmin Scope = "admin"
+)
+
+// Scopes lists the scopes, least access first.
+var Scopes = []Scope{ScopeRead, ScopeWrite, ScopeAdmin}
+
+func ParseScope(s string) (Scope, error) {
+	if sc := Scope(strings.TrimSpace(s)); slices.Contains(Scopes, sc) {
+		return sc, nil
+	}
+	return "", fmt.Errorf("unknown scope %q (want read, write or admin)", s)
+}
+
+// Includes reports whether s grants what other does.
+func (s Scope) Includes(other Scope) bool {
+	return slices.Index(Scopes, s) >= slices.Index(Scopes, other) && slices.Contains(Scopes, other)
+}
+
+// KeyPrefix starts every API key, telling it apart from other bearer
+// tokens.
+const KeyPrefix = "amx_"
+
+// NewKey returns a random API key, the prefix shown to tell it apart and
+// the hash to store.
+func NewKey() (key, prefix, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", "", err
+	}
+	key = KeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return key, key[:len(KeyPrefix)+8], HashKey(key), nil
+}
+
+// HashKey returns the hex SHA-256 of key, as stored for it.
+func HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// KeyFrom returns the API key of r, sent as "Authorization: Bearer <key>"
+// or in X-API-Key, or "" when there is none.
+func KeyFrom(r *http.Request) string {
+	if k := strings.TrimSpace(r.Header.Get("X-API-Key")); k != "" {
+		return k
+	}
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+	return ""
+}
+
+// Principal is who an authenticated request comes from: an API key, or
+// the subject of a token.
+type Principal struct {
+	// KeyID is the row ID of the API key used; 0 for tokens.
+	KeyID  int
+	Name   string
+	Scopes []Scope
+	// Roles are the roles of a token that granted Scopes.
+	Roles []string
+	// User is the account the principal acts for; "" when it is not bound
+	// to one.
+	User string
+}
+
+// Allows reports whether p has a scope including s.
+func (p *Principal) Allows(s Scope) bool {
+	return slices.ContainsFunc(p.Scopes, func(have Scope) bool { return have.Includes(s) })
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFrom returns the principal stored by WithPrincipal, or nil.
+func PrincipalFrom(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/clustlight/animatrix-api/internal/auth"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+const apiKeyUsage = "usage: animatrix-api api-key create -name NAME [-scopes read,write,admin] [-user NAME] | list | revoke ID"
+
+// runAPIKey manages API keys. create prints the new key once; only its
+// hash is stored.
+func runAPIKey(args []string) error {
+	if len(args) == 0 {
+		return errors.New(apiKeyUsage)
+	}
+	ctx := context.Background()
+	switch args[0] {
+	case "create":
+		fs := flag.NewFlagSet("api-key create", flag.ExitOnError)
+		name := fs.String("name", "", "what the key is for")
+		scopes := fs.String("scopes", string(auth.ScopeRead), "comma-separated scopes: read, write and/or admin")
+		user := fs.String("user", "", "account the key acts for under /v1/me")
+		fs.Parse(args[1:])
+		if *name == "" {
+			return fmt.Errorf("api-key create: -name is required")
+		}
+		var list []auth.Scope
+		for _, raw := range strings.Split(*scopes, ",") {
+			s, err := auth.ParseScope(raw)
+			if err != nil {
+				return fmt.Errorf("api-key create: %w", err)
+			}
+			list = append(list, s)
+		}
+
+		client := utils.NewDBClient()
+		defer client.Close()
+		key, err := controller.CreateAPIKey(ctx, client, *name, list, *user)
+		if err != nil {
+			return err
+		}
+		return printJSON(key)
+	case "list":
+		client := utils.NewDBClient()
+		defer client.Close()
+		keys, err := controller.ListAPIKeys(ctx, client)
+		if err != nil {
+			return err
+		}
+		return printJSON(keys)
+	case "revoke":
+		if len(args) != 2 {
+			return errors.New(apiKeyUsage)
+		}
+		client := utils.NewDBClient()
+		defer client.Close()
+		return controller.RevokeAPIKey(ctx, client, args[1])
+	}
+	return errors.New(apiKeyUsage)
+}
+
+func printJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
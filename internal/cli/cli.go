@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string) error
+}
+
+var commands = []command{
+	{"api-key", "create, list or revoke API keys", runAPIKey},
+	{"export", "write the catalog to a file or stdout", runExport},
+	{"jwt", "create a local signing key set and tokens for development", runJWT},
+	{"openapi", "print the OpenAPI document, or -check it against the router", runOpenAPI},
+	{"presign", "print presigned object storage URLs for keys", runPresign},
+	{"storage-check", "report media missing from or orphaned in object storage", runStorageCheck},
+}
+
+// Run executes the subcommand named by args[0].
+func Run(args []string) error {
+	for _, c := range commands {
+		if c.name == args[0] {
+			return c.run(args[1:])
+		}
+	}
+	printUsage()
+	return fmt.Errorf("unknown command %q", args[0])
+}
+
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "usage: animatrix-api [command] [flags]")
+	fmt.Fprintln(os.Stderr, "\nWithout a command the HTTP server is started. Commands:")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-14s %s\n", c.name, c.usage)
+	}
+}
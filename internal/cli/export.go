@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"io"
+	"os"
+
+	"github.com/clustlight/animatrix-api/internal/codec"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", "ndjson", "output format: json, ndjson or csv")
+	include := fs.String("include", "seasons,episodes", "comma separated children to include: seasons, episodes")
+	output := fs.String("o", "-", "output file, - for stdout")
+	fs.Parse(args)
+
+	opts, err := types.ParseExportOptions(*format, *include)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if *output != "-" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	bw := bufio.NewWriter(out)
+
+	client := utils.NewDBClient()
+	defer client.Close()
+
+	enc, err := codec.NewEncoder(opts.Format, bw, &types.CatalogRecord{})
+	if err != nil {
+		return err
+	}
+	if err := controller.ExportCatalog(context.Background(), client, enc, opts); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
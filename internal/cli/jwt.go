@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"cmp"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/clustlight/animatrix-api/internal/auth"
+)
+
+const jwtUsage = "usage: animatrix-api jwt keygen -key FILE -jwks FILE [-alg ES256|RS256] [-kid ID] | sign -key FILE -sub NAME [-roles editor] [flags]"
+
+// runJWT stands in for an identity provider in development and tests:
+// keygen writes a signing key and the key set to point AUTH_JWKS_URL at
+// (as a file: URL), sign issues tokens with it.
+func runJWT(args []string) error {
+	if len(args) == 0 {
+		return errors.New(jwtUsage)
+	}
+	switch args[0] {
+	case "keygen":
+		fs := flag.NewFlagSet("jwt keygen", flag.ExitOnError)
+		keyFile := fs.String("key", "", "file to write the private key to (PEM)")
+		jwksFile := fs.String("jwks", "", "file to write the public key set to")
+		alg := fs.String("alg", "ES256", "ES256 or RS256")
+		kid := fs.String("kid", "local", "key ID")
+		fs.Parse(args[1:])
+		if *keyFile == "" || *jwksFile == "" {
+			return errors.New("jwt keygen: -key and -jwks are required")
+		}
+		return keygen(*keyFile, *jwksFile, *alg, *kid)
+	case "sign":
+		fs := flag.NewFlagSet("jwt sign", flag.ExitOnError)
+		keyFile := fs.String("key", "", "private key written by keygen")
+		kid := fs.String("kid", "local", "key ID")
+		iss := fs.String("iss", os.Getenv("AUTH_JWT_ISSUER"), "issuer (default AUTH_JWT_ISSUER)")
+		aud := fs.String("aud", os.Getenv("AUTH_JWT_AUDIENCE"), "audience (default AUTH_JWT_AUDIENCE)")
+		sub := fs.String("sub", "", "subject, the user unless AUTH_JWT_USER_CLAIM names another claim")
+		roles := fs.String("roles", "", "comma-separated roles")
+		ttl := fs.Duration("ttl", time.Hour, "lifetime of the token")
+		fs.Parse(args[1:])
+		if *keyFile == "" || *sub == "" {
+			return errors.New("jwt sign: -key and -sub are required")
+		}
+		return sign(*keyFile, *kid, *iss, *aud, *sub, *roles, *ttl)
+	}
+	return errors.New(jwtUsage)
+}
+
+func keygen(keyFile, jwksFile, alg, kid string) error {
+	var key any
+	var err error
+	switch alg {
+	case "ES256":
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case "RS256":
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	default:
+		return fmt.Errorf("jwt keygen: -alg must be ES256 or RS256")
+	}
+	if err != nil {
+		return err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return err
+	}
+	var pub any
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		pub = &k.PublicKey
+	case *rsa.PrivateKey:
+		pub = &k.PublicKey
+	}
+	jwk, err := auth.PublicJWK(pub, kid)
+	if err != nil {
+		return err
+	}
+	set, err := json.MarshalIndent(auth.JWKS{Keys: []auth.JWK{jwk}}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		return err
+	}
+	return os.WriteFile(jwksFile, append(set, '\n'), 0o644)
+}
+
+func sign(keyFile, kid, iss, aud, sub, roles string, ttl time.Duration) error {
+	raw, err := os.ReadFile(keyFile)
+	if err != nil {
+		return err
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return fmt.Errorf("jwt sign: %s is not a PEM file", keyFile)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("jwt sign: %w", err)
+	}
+	now := time.Now()
+	claims := map[string]any{
+		"iss": iss,
+		"aud": aud,
+		"sub": sub,
+		"iat": now.Unix(),
+		"exp": now.Add(ttl).Unix(),
+	}
+	if roles != "" {
+		// Nest the roles under the configured claim path.
+		path := strings.Split(cmp.Or(os.Getenv("AUTH_JWT_ROLES_CLAIM"), auth.DefaultRolesClaim), ".")
+		obj := claims
+		for _, name := range path[:len(path)-1] {
+			next := map[string]any{}
+			obj[name] = next
+			obj = next
+		}
+		obj[path[len(path)-1]] = strings.Split(roles, ",")
+	}
+	token, err := auth.SignJWT(key, kid, claims)
+	if err != nil {
+		return err
+	}
+	fmt.Println(token)
+	return nil
+}
@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/clustlight/animatrix-api/internal"
+	"github.com/clustlight/animatrix-api/internal/openapi"
+)
+
+// runOpenAPI prints the OpenAPI document, or with -check fails when the
+// router and the document disagree. CI runs the latter, as does
+// TestRoutesDocumented.
+func runOpenAPI(args []string) error {
+	fs := flag.NewFlagSet("openapi", flag.ExitOnError)
+	check := fs.Bool("check", false, "verify every route in the router is documented, and vice versa")
+	fs.Parse(args)
+
+	if *check {
+		if err := openapi.Check(internal.NewRouter(nil, nil)); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "openapi: %d operations documented\n", len(openapi.Operations()))
+		return nil
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(openapi.Build())
+}
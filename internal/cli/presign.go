@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/clustlight/animatrix-api/internal/storage"
+	"github.com/joho/godotenv"
+)
+
+// runPresign prints presigned URLs for object keys using the
+// OBJECT_STORAGE_* settings, to check them against the bucket with curl.
+func runPresign(args []string) error {
+	fs := flag.NewFlagSet("presign", flag.ExitOnError)
+	expiry := fs.Duration("expiry", 0, "lifetime of the URLs (default OBJECT_STORAGE_URL_EXPIRY)")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: animatrix-api presign [-expiry 15m] key...")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return fmt.Errorf("presign: no keys given")
+	}
+
+	_ = godotenv.Load()
+	p, err := storage.S3FromEnv()
+	if err != nil {
+		return err
+	}
+	if *expiry == 0 {
+		*expiry = p.Expiry
+	}
+	if *expiry > storage.MaxExpiry {
+		return fmt.Errorf("presign: -expiry must be at most %s", storage.MaxExpiry)
+	}
+	for _, key := range fs.Args() {
+		fmt.Println(p.PresignAt(key, time.Now(), *expiry))
+	}
+	return nil
+}
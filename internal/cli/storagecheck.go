@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/storage"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+// runStorageCheck prints the storage report as JSON and fails when
+// anything is missing or orphaned, so it can run from cron or CI.
+func runStorageCheck(args []string) error {
+	fs := flag.NewFlagSet("storage-check", flag.ExitOnError)
+	method := fs.String("method", "list", "list the bucket (also finds orphans) or head each expected object")
+	prefix := fs.String("prefix", "", "only check keys under this prefix")
+	fs.Parse(args)
+
+	client := utils.NewDBClient()
+	defer client.Close()
+
+	p, err := storage.S3FromEnv()
+	if err != nil {
+		return err
+	}
+	report, err := controller.CheckStorage(context.Background(), client, storage.NewBucket(p), *method, *prefix)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+	if !report.OK() {
+		return fmt.Errorf("storage-check: %d missing, %d orphaned", len(report.Missing), len(report.Orphans))
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package codec
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+)
+
+const (
+	MediaTypeJSON   = "application/json"
+	MediaTypeNDJSON = "application/x-ndjson"
+	MediaTypeCSV    = "text/csv"
+)
+
+// ErrUnsupportedMediaType is returned when no decoder exists for a content type.
+var ErrUnsupportedMediaType = errors.New("unsupported media type")
+
+// Decoder reads records one at a time from a streamed request body.
+// Decode returns io.EOF once the stream is exhausted.
+type Decoder interface {
+	Decode(v any) error
+	// Line returns the input line of the record most recently decoded.
+	Line() int
+}
+
+// Encoder writes records one at a time. Close terminates the document.
+type Encoder interface {
+	Encode(v any) error
+	Flush() error
+	Close() error
+}
+
+// RecordError reports a malformed record. Decoding may continue after it.
+type RecordError struct {
+	Line int
+	Err  error
+}
+
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *RecordError) Unwrap() error {
+	return e.Err
+}
+
+// MediaType returns the bare media type of a Content-Type header value.
+func MediaType(contentType string) string {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	return mt
+}
+
+// IsStreaming reports whether the content type is decoded record by record.
+func IsStreaming(contentType string) bool {
+	switch MediaType(contentType) {
+	case MediaTypeNDJSON, MediaTypeCSV:
+		return true
+	}
+	return false
+}
+
+func NewDecoder(contentType string, r io.Reader) (Decoder, error) {
+	switch MediaType(contentType) {
+	case MediaTypeJSON:
+		return NewJSONArrayDecoder(r), nil
+	case MediaTypeNDJSON:
+		return NewNDJSONDecoder(r), nil
+	case MediaTypeCSV:
+		return NewCSVDecoder(r)
+	}
+	return nil, ErrUnsupportedMediaType
+}
+
+// NewEncoder returns an encoder for the named format: json, ndjson or csv.
+// CSV columns are taken from the json tags of the record type.
+func NewEncoder(format string, w io.Writer, record any) (Encoder, error) {
+	switch format {
+	case "json":
+		return NewJSONArrayEncoder(w), nil
+	case "ndjson":
+		return NewNDJSONEncoder(w), nil
+	case "csv":
+		return NewCSVEncoder(w, record)
+	}
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+// ContentType returns the media type for an export format.
+func ContentType(format string) string {
+	switch format {
+	case "ndjson":
+		return MediaTypeNDJSON
+	case "csv":
+		return MediaTypeCSV + "; charset=utf-8"
+	}
+	return MediaTypeJSON
+}
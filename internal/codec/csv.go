@@ -0,0 +1,261 @@
+package codec
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CSVDecoder maps columns onto struct fields by their json tag names.
+// Map and slice fields are held as JSON. The first row must be a header. Unknown columns are ignored and empty
+// cells leave the field at its zero value (nil for pointers).
+type CSVDecoder struct {
+	r      *csv.Reader
+	header []string
+	line   int
+}
+
+func NewCSVDecoder(r io.Reader) (*CSVDecoder, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("csv: missing header row")
+		}
+		return nil, fmt.Errorf("csv: %w", err)
+	}
+	for i, h := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))
+	}
+	return &CSVDecoder{r: cr, header: header, line: 1}, nil
+}
+
+func (d *CSVDecoder) Decode(v any) error {
+	record, err := d.r.Read()
+	if err != nil {
+		if pe, ok := err.(*csv.ParseError); ok {
+			d.line = pe.StartLine
+			return &RecordError{Line: pe.StartLine, Err: pe.Err}
+		}
+		return err
+	}
+	d.line, _ = d.r.FieldPos(0)
+
+	elem, err := structValue(v)
+	if err != nil {
+		return err
+	}
+	elem.SetZero()
+	fields := fieldsByName(elem.Type())
+	for i, cell := range record {
+		if i >= len(d.header) {
+			return &RecordError{Line: d.line, Err: fmt.Errorf("too many fields")}
+		}
+		f, ok := fields[d.header[i]]
+		if !ok || cell == "" {
+			continue
+		}
+		if err := setField(elem.FieldByIndex(f.index), cell); err != nil {
+			return &RecordError{Line: d.line, Err: fmt.Errorf("%s: %w", d.header[i], err)}
+		}
+	}
+	return nil
+}
+
+func (d *CSVDecoder) Line() int {
+	return d.line
+}
+
+// CSVEncoder writes structs of a single type as CSV rows, using the json
+// tag names as the header. Zero values of omitempty fields and nil
+// pointers become empty cells. CSV cannot tell those apart, so a pointer
+// to "" or 0 decodes back as nil; map and slice fields, held as JSON,
+// keep the difference.
+type CSVEncoder struct {
+	w      *csv.Writer
+	typ    reflect.Type
+	fields []structField
+}
+
+func NewCSVEncoder(w io.Writer, record any) (*CSVEncoder, error) {
+	elem, err := structValue(record)
+	if err != nil {
+		return nil, err
+	}
+	e := &CSVEncoder{w: csv.NewWriter(w), typ: elem.Type(), fields: structFields(elem.Type())}
+	header := make([]string, 0, len(e.fields))
+	for _, f := range e.fields {
+		header = append(header, f.name)
+	}
+	if err := e.w.Write(header); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *CSVEncoder) Encode(v any) error {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Type() != e.typ {
+		return fmt.Errorf("csv: cannot encode %s as %s", rv.Type(), e.typ)
+	}
+	row := make([]string, 0, len(e.fields))
+	for _, f := range e.fields {
+		fv := rv.FieldByIndex(f.index)
+		if f.omitEmpty && fv.IsZero() {
+			row = append(row, "")
+			continue
+		}
+		row = append(row, formatField(fv))
+	}
+	return e.w.Write(row)
+}
+
+func (e *CSVEncoder) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *CSVEncoder) Close() error {
+	return e.Flush()
+}
+
+type structField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// structFields lists exported fields by json tag name in declaration
+// order, descending into embedded structs.
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			for _, sf := range structFields(f.Type) {
+				sf.index = append([]int{i}, sf.index...)
+				fields = append(fields, sf)
+			}
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, structField{
+			name:      name,
+			index:     []int{i},
+			omitEmpty: strings.Contains(opts, "omitempty"),
+		})
+	}
+	return fields
+}
+
+func fieldsByName(t reflect.Type) map[string]structField {
+	fields := structFields(t)
+	m := make(map[string]structField, len(fields))
+	for _, f := range fields {
+		if _, dup := m[f.name]; !dup {
+			m[f.name] = f
+		}
+	}
+	return m
+}
+
+func structValue(v any) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("csv: target must be a pointer to struct")
+	}
+	return rv.Elem(), nil
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func setField(f reflect.Value, s string) error {
+	if f.Kind() == reflect.Pointer {
+		p := reflect.New(f.Type().Elem())
+		if err := setField(p.Elem(), s); err != nil {
+			return err
+		}
+		f.Set(p)
+		return nil
+	}
+	if f.Type() == timeType {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return err
+		}
+		f.Set(reflect.ValueOf(t))
+		return nil
+	}
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(s)
+	case reflect.Int, reflect.Int64, reflect.Int32:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		f.SetInt(n)
+	case reflect.Float64, reflect.Float32:
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		f.SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		f.SetBool(b)
+	case reflect.Map, reflect.Slice:
+		m := reflect.New(f.Type())
+		if err := json.Unmarshal([]byte(s), m.Interface()); err != nil {
+			return err
+		}
+		f.Set(m.Elem())
+	default:
+		return fmt.Errorf("unsupported field type %s", f.Type())
+	}
+	return nil
+}
+
+func formatField(f reflect.Value) string {
+	if f.Kind() == reflect.Pointer {
+		if f.IsNil() {
+			return ""
+		}
+		f = f.Elem()
+	}
+	if f.Type() == timeType {
+		return f.Interface().(time.Time).Format(time.RFC3339Nano)
+	}
+	switch f.Kind() {
+	case reflect.String:
+		return f.String()
+	case reflect.Int, reflect.Int64, reflect.Int32:
+		return strconv.FormatInt(f.Int(), 10)
+	case reflect.Float64, reflect.Float32:
+		return strconv.FormatFloat(f.Float(), 'g', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(f.Bool())
+	case reflect.Map, reflect.Slice:
+		b, _ := json.Marshal(f.Interface())
+		return string(b)
+	}
+	return fmt.Sprint(f.Interface())
+}
@@ -0,0 +1,95 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// JSONArrayDecoder streams the elements of a top-level JSON array.
+// Line reports the 1-based element index rather than a text line.
+type JSONArrayDecoder struct {
+	dec     *json.Decoder
+	started bool
+	index   int
+}
+
+func NewJSONArrayDecoder(r io.Reader) *JSONArrayDecoder {
+	return &JSONArrayDecoder{dec: json.NewDecoder(r)}
+}
+
+func (d *JSONArrayDecoder) Decode(v any) error {
+	if !d.started {
+		tok, err := d.dec.Token()
+		if err != nil {
+			if err == io.EOF {
+				return fmt.Errorf("json: empty body")
+			}
+			return err
+		}
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return fmt.Errorf("json: expected array")
+		}
+		d.started = true
+	}
+	if !d.dec.More() {
+		if _, err := d.dec.Token(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+	d.index++
+	var raw json.RawMessage
+	if err := d.dec.Decode(&raw); err != nil {
+		// the stream cannot be resynchronised after a syntax error
+		return err
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return &RecordError{Line: d.index, Err: err}
+	}
+	return nil
+}
+
+func (d *JSONArrayDecoder) Line() int {
+	return d.index
+}
+
+// JSONArrayEncoder writes values as the elements of a single JSON array.
+type JSONArrayEncoder struct {
+	w     io.Writer
+	count int
+}
+
+func NewJSONArrayEncoder(w io.Writer) *JSONArrayEncoder {
+	return &JSONArrayEncoder{w: w}
+}
+
+func (e *JSONArrayEncoder) Encode(v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	sep := ",\n"
+	if e.count == 0 {
+		sep = "[\n"
+	}
+	e.count++
+	if _, err := io.WriteString(e.w, sep); err != nil {
+		return err
+	}
+	_, err = e.w.Write(b)
+	return err
+}
+
+func (e *JSONArrayEncoder) Flush() error {
+	return nil
+}
+
+func (e *JSONArrayEncoder) Close() error {
+	end := "\n]\n"
+	if e.count == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(e.w, end)
+	return err
+}
@@ -0,0 +1,63 @@
+package codec
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+type NDJSONDecoder struct {
+	r    *bufio.Reader
+	line int
+}
+
+func NewNDJSONDecoder(r io.Reader) *NDJSONDecoder {
+	return &NDJSONDecoder{r: bufio.NewReader(r)}
+}
+
+func (d *NDJSONDecoder) Decode(v any) error {
+	for {
+		b, err := d.r.ReadBytes('\n')
+		if len(b) == 0 && err != nil {
+			return err
+		}
+		d.line++
+		b = bytes.TrimSpace(b)
+		if len(b) == 0 {
+			// skip blank lines
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if err := json.Unmarshal(b, v); err != nil {
+			return &RecordError{Line: d.line, Err: err}
+		}
+		return nil
+	}
+}
+
+func (d *NDJSONDecoder) Line() int {
+	return d.line
+}
+
+type NDJSONEncoder struct {
+	enc *json.Encoder
+}
+
+func NewNDJSONEncoder(w io.Writer) *NDJSONEncoder {
+	return &NDJSONEncoder{enc: json.NewEncoder(w)}
+}
+
+func (e *NDJSONEncoder) Encode(v any) error {
+	return e.enc.Encode(v)
+}
+
+func (e *NDJSONEncoder) Flush() error {
+	return nil
+}
+
+func (e *NDJSONEncoder) Close() error {
+	return nil
+}
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/apikey"
+	"github.com/clustlight/animatrix-api/internal/auth"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+// keyUseInterval bounds how often the last use of a key is recorded.
+const keyUseInterval = time.Minute
+
+// CreateAPIKey issues a key with the given scopes, acting for the account
+// user unless that is "". The response carries the key, which is not
+// stored and cannot be shown again.
+func CreateAPIKey(ctx context.Context, client *ent.Client, name string, scopes []auth.Scope, user string) (*types.APIKeyResponse, error) {
+	key, prefix, hash, err := auth.NewKey()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		names = append(names, string(s))
+	}
+	create := client.APIKey.Create().
+		SetName(name).
+		SetPrefix(prefix).
+		SetHash(hash).
+		SetScopes(names)
+	if user != "" {
+		u, err := EnsureUser(ctx, client, user)
+		if err != nil {
+			return nil, err
+		}
+		create.SetUser(u)
+	}
+	k, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	k.Edges.User, _ = k.QueryUser().Only(ctx)
+	resp := utils.BuildAPIKeyResponse(k)
+	resp.Key = key
+	return &resp, nil
+}
+
+// ListAPIKeys lists the keys, oldest first.
+func ListAPIKeys(ctx context.Context, client *ent.Client) ([]types.APIKeyResponse, error) {
+	keys, err := client.APIKey.Query().WithUser().Order(ent.Asc(apikey.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resps := make([]types.APIKeyResponse, 0, len(keys))
+	for _, k := range keys {
+		resps = append(resps, utils.BuildAPIKeyResponse(k))
+	}
+	return resps, nil
+}
+
+// RevokeAPIKey deletes the key with the given ID.
+func RevokeAPIKey(ctx context.Context, client *ent.Client, id string) error {
+	n, err := rowID("api key", id)
+	if err != nil {
+		return err
+	}
+	err = client.APIKey.DeleteOneID(n).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return NotFound("api key not found")
+	}
+	return err
+}
+
+// AuthenticateKey returns the principal presenting key, answering 401 for
+// unknown keys.
+func AuthenticateKey(ctx context.Context, client *ent.Client, key string) (*auth.Principal, error) {
+	k, err := client.APIKey.Query().Where(apikey.Hash(auth.HashKey(key))).WithUser().Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, Unauthorized("invalid API key")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if k.LastUsedAt == nil || time.Since(*k.LastUsedAt) > keyUseInterval {
+		if err := client.APIKey.UpdateOne(k).SetLastUsedAt(time.Now()).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	p := &auth.Principal{KeyID: k.ID, Name: k.Name}
+	for _, s := range k.Scopes {
+		p.Scopes = append(p.Scopes, auth.Scope(s))
+	}
+	if k.Edges.User != nil {
+		p.User = k.Edges.User.Name
+	}
+	return p, nil
+}
@@ -0,0 +1,27 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/auth"
+)
+
+// Authenticate returns the principal presenting credential: an API key,
+// or a token of the identity provider when auth.Config.JWT is set.
+func Authenticate(ctx context.Context, client *ent.Client, credential string) (*auth.Principal, error) {
+	c := auth.Current()
+	if c.JWT == nil || strings.HasPrefix(credential, auth.KeyPrefix) || !auth.LooksLikeJWT(credential) {
+		return AuthenticateKey(ctx, client, credential)
+	}
+	p, err := c.JWT.Verify(ctx, credential)
+	if errors.Is(err, auth.ErrKeysUnavailable) {
+		return nil, Unavailable("tokens cannot be verified right now", err)
+	}
+	if err != nil {
+		return nil, Unauthorized("invalid token: " + err.Error())
+	}
+	return p, nil
+}
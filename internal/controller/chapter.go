@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/chapter"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+func newChapterCreate(client *ent.Client, req *types.ChapterRequest, parent *ent.Episode) *ent.ChapterCreate {
+	return client.Chapter.Create().
+		SetStart(req.Start).
+		SetEnd(req.End).
+		SetKind(req.Kind).
+		SetTitle(req.Title).
+		SetEpisode(parent)
+}
+
+// createChapters inserts reqs[i] as the chapters of episodes[i] and sets
+// them as its edge.
+func createChapters(ctx context.Context, client *ent.Client, episodes []*ent.Episode, reqs [][]types.ChapterRequest) error {
+	var bulk []*ent.ChapterCreate
+	for i, e := range episodes {
+		for j := range reqs[i] {
+			bulk = append(bulk, newChapterCreate(client, &reqs[i][j], e))
+		}
+	}
+	if len(bulk) == 0 {
+		return nil
+	}
+	created, err := client.Chapter.CreateBulk(bulk...).Save(ctx)
+	if err != nil {
+		return err
+	}
+	for i, e := range episodes {
+		e.Edges.Chapters, created = created[:len(reqs[i])], created[len(reqs[i]):]
+	}
+	return nil
+}
+
+func GetChapters(ctx context.Context, client *ent.Client, episodeID string) ([]types.ChapterResponse, error) {
+	e, err := client.Episode.Query().
+		Where(episode.EpisodeIDEQ(episodeID)).
+		WithChapters().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resps := utils.BuildChapterResponses(e.Edges.Chapters)
+	if resps == nil {
+		resps = []types.ChapterResponse{}
+	}
+	return resps, nil
+}
+
+// ReplaceChapters sets the chapters of an episode, removing the previous
+// ones; an empty list clears them. Chapters must fit the episode's
+// duration and not overlap.
+func ReplaceChapters(ctx context.Context, client *ent.Client, episodeID string, reqs []types.ChapterRequest) ([]types.ChapterResponse, error) {
+	e, err := client.Episode.Query().Where(episode.EpisodeIDEQ(episodeID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.ValidateChapters("", reqs, e.Duration); err != nil {
+		return nil, ValidationFailed(err)
+	}
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		if _, err := tx.Chapter.Delete().
+			Where(chapter.HasEpisodeWith(episode.ID(e.ID))).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if err := createChapters(ctx, tx.Client(), []*ent.Episode{e}, [][]types.ChapterRequest{reqs}); err != nil {
+			return err
+		}
+		return touchEpisode(ctx, tx, e)
+	})
+	if err != nil {
+		return nil, err
+	}
+	resps := utils.BuildChapterResponses(e.Edges.Chapters)
+	if resps == nil {
+		resps = []types.ChapterResponse{}
+	}
+	return resps, nil
+}
+
+// checkChaptersFit reports a duration too short for the chapters of an
+// episode.
+func checkChaptersFit(chapters []*ent.Chapter, duration float64) error {
+	for _, c := range chapters {
+		if c.End > duration {
+			return ValidationFailed(types.FieldError{Field: "duration", Message: "is shorter than the chapters; replace them first"})
+		}
+	}
+	return nil
+}
+
+// EpisodeChapterVTT renders the chapters of an episode as WebVTT and
+// returns when they last changed.
+func EpisodeChapterVTT(ctx context.Context, client *ent.Client, episodeID string) (string, time.Time, error) {
+	e, err := client.Episode.Query().
+		Where(episode.EpisodeIDEQ(episodeID)).
+		WithChapters().
+		Only(ctx)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if len(e.Edges.Chapters) == 0 {
+		return "", time.Time{}, NotFound("episode has no chapters")
+	}
+	return utils.BuildChapterVTT(e.Edges.Chapters), e.UpdatedAt, nil
+}
@@ -2,16 +2,18 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/clustlight/animatrix-api/ent"
 	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/rendition"
 	"github.com/clustlight/animatrix-api/ent/season"
 	"github.com/clustlight/animatrix-api/internal/types"
 	"github.com/clustlight/animatrix-api/internal/utils"
 )
 
 func GetAllEpisodes(ctx context.Context, client *ent.Client) (*[]types.EpisodeResponse, error) {
-	episodes, err := client.Episode.Query().
+	episodes, err := withEpisodeChildren(withEpisodeParents(client.Episode.Query())).
 		Order(ent.Asc("episode_number")).
 		All(ctx)
 	if err != nil {
@@ -28,8 +30,7 @@ func GetAllEpisodes(ctx context.Context, client *ent.Client) (*[]types.EpisodeRe
 }
 
 func GetEpisode(ctx context.Context, client *ent.Client, episodeID string) (*types.EpisodeResponse, error) {
-	episode, err := client.Episode.
-		Query().
+	episode, err := withEpisodeChildren(withEpisodeParents(client.Episode.Query())).
 		Where(episode.EpisodeIDEQ(episodeID)).
 		Only(ctx)
 	if err != nil {
@@ -40,16 +41,8 @@ func GetEpisode(ctx context.Context, client *ent.Client, episodeID string) (*typ
 	return &resp, nil
 }
 
-func CreateEpisode(ctx context.Context, client *ent.Client, req *types.CreateEpisodeRequest) (*types.EpisodeResponse, error) {
-	season, err := client.Season.
-		Query().
-		Where(season.SeasonIDEQ(req.SeasonID)).
-		Only(ctx)
-	if err != nil {
-		return nil, err // Seasonが見つからない場合はエラー
-	}
-
-	newEpisode, err := client.Episode.Create().
+func newEpisodeCreate(client *ent.Client, req *types.CreateEpisodeRequest, parent *ent.Season) *ent.EpisodeCreate {
+	ec := client.Episode.Create().
 		SetEpisodeID(req.EpisodeID).
 		SetTitle(req.Title).
 		SetEpisodeNumber(req.EpisodeNumber).
@@ -62,98 +55,215 @@ func CreateEpisode(ctx context.Context, client *ent.Client, req *types.CreateEpi
 		SetDynamicRange(req.DynamicRange).
 		SetMetadata(req.Metadata).
 		SetDescription(req.Description).
-		SetSeason(season).
-		Save(ctx)
-	if err != nil {
-		return nil, err
+		SetSeason(parent)
+	if len(req.Media) > 0 {
+		ec = ec.SetMedia(req.Media)
 	}
+	return ec
+}
 
-	resp := utils.BuildEpisodeResponse(newEpisode)
-	return &resp, nil
+func newRenditionCreate(client *ent.Client, req *types.RenditionRequest, parent *ent.Episode) *ent.RenditionCreate {
+	rc := client.Rendition.Create().
+		SetName(req.Name).
+		SetWidth(req.Width).
+		SetHeight(req.Height).
+		SetCodecs(req.Codecs).
+		SetBitrate(req.Bitrate).
+		SetDynamicRange(req.DynamicRange).
+		SetContainer(req.Container).
+		SetPath(req.Path).
+		SetEpisode(parent)
+	if req.FrameRate > 0 {
+		rc = rc.SetFrameRate(req.FrameRate)
+	}
+	return rc
 }
 
-func UpdateEpisode(ctx context.Context, client *ent.Client, episodeID string, req *types.UpdateEpisodeRequest) (*types.EpisodeResponse, error) {
-	episodeObj, err := client.Episode.
-		Query().
-		Where(episode.EpisodeIDEQ(episodeID)).
-		Only(ctx)
+// createEpisodes inserts episodes under their parent seasons together
+// with their renditions, subtitles, audio tracks and chapters, in one
+// transaction.
+func createEpisodes(ctx context.Context, client *ent.Client, reqs []types.CreateEpisodeRequest, parents []*ent.Season) ([]*ent.Episode, error) {
+	var created []*ent.Episode
+	err := withTx(ctx, client, func(tx *ent.Tx) error {
+		bulk := make([]*ent.EpisodeCreate, 0, len(reqs))
+		for i := range reqs {
+			bulk = append(bulk, newEpisodeCreate(tx.Client(), &reqs[i], parents[i]))
+		}
+		var err error
+		if created, err = tx.Episode.CreateBulk(bulk...).Save(ctx); err != nil {
+			return err
+		}
+		renditions := make([][]types.RenditionRequest, len(reqs))
+		chapters := make([][]types.ChapterRequest, len(reqs))
+		for i := range reqs {
+			renditions[i] = reqs[i].Renditions
+			chapters[i] = reqs[i].Chapters
+		}
+		if err := createRenditions(ctx, tx.Client(), created, renditions); err != nil {
+			return err
+		}
+		if err := createChapters(ctx, tx.Client(), created, chapters); err != nil {
+			return err
+		}
+		return createTracks(ctx, tx.Client(), created, reqs)
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	update := episodeObj.Update()
-
-	if req.Title != nil {
-		update.SetTitle(*req.Title)
-	}
-	if req.EpisodeNumber != nil {
-		update.SetEpisodeNumber(*req.EpisodeNumber)
+	for i, e := range created {
+		e.Edges.Season = parents[i]
 	}
-	if req.Duration != nil {
-		update.SetDuration(*req.Duration)
+	return created, nil
+}
+
+// createRenditions inserts reqs[i] as the renditions of episodes[i] and
+// sets them as its edge.
+func createRenditions(ctx context.Context, client *ent.Client, episodes []*ent.Episode, reqs [][]types.RenditionRequest) error {
+	var bulk []*ent.RenditionCreate
+	for i, e := range episodes {
+		for j := range reqs[i] {
+			bulk = append(bulk, newRenditionCreate(client, &reqs[i][j], e))
+		}
 	}
-	if req.DurationString != nil {
-		update.SetDurationString(*req.DurationString)
+	if len(bulk) == 0 {
+		return nil
 	}
-	if req.Timestamp != nil {
-		update.SetTimestamp(*req.Timestamp)
+	created, err := client.Rendition.CreateBulk(bulk...).Save(ctx)
+	if err != nil {
+		return err
 	}
-	if req.FormatID != nil {
-		update.SetFormatID(*req.FormatID)
+	for i, e := range episodes {
+		e.Edges.Renditions, created = created[:len(reqs[i])], created[len(reqs[i]):]
 	}
-	if req.Width != nil {
-		update.SetWidth(*req.Width)
+	return nil
+}
+
+func CreateEpisode(ctx context.Context, client *ent.Client, req *types.CreateEpisodeRequest) (*types.EpisodeResponse, error) {
+	if err := assignEpisodeID(req); err != nil {
+		return nil, err
 	}
-	if req.Height != nil {
-		update.SetHeight(*req.Height)
+	season, err := client.Season.
+		Query().
+		Where(season.SeasonIDEQ(req.SeasonID)).
+		WithSeries().
+		Only(ctx)
+	if err != nil {
+		return nil, missingParent(err, "season_id", req.SeasonID) // Seasonが見つからない場合はエラー
 	}
-	if req.DynamicRange != nil {
-		update.SetDynamicRange(*req.DynamicRange)
+
+	created, err := createEpisodes(ctx, client, []types.CreateEpisodeRequest{*req}, []*ent.Season{season})
+	if err != nil {
+		return nil, err
 	}
-	if req.Metadata != nil {
-		update.SetMetadata(*req.Metadata)
+
+	resp := utils.BuildEpisodeResponse(created[0])
+	return &resp, nil
+}
+
+func UpdateEpisode(ctx context.Context, client *ent.Client, episodeID string, req *types.UpdateEpisodeRequest) (*types.EpisodeResponse, error) {
+	episodeObj, err := withEpisodeChildren(withEpisodeParents(client.Episode.Query())).
+		Where(episode.EpisodeIDEQ(episodeID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
 	}
-	if req.Description != nil {
-		update.SetDescription(*req.Description)
+	if req.Duration != nil {
+		if err := checkChaptersFit(episodeObj.Edges.Chapters, *req.Duration); err != nil {
+			return nil, err
+		}
 	}
 
-	updatedEpisode, err := update.Save(ctx)
+	var updatedEpisode *ent.Episode
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		update := tx.Episode.UpdateOne(episodeObj)
+
+		if req.Title != nil {
+			update.SetTitle(*req.Title)
+		}
+		if req.EpisodeNumber != nil {
+			update.SetEpisodeNumber(*req.EpisodeNumber)
+		}
+		if req.Duration != nil {
+			update.SetDuration(*req.Duration)
+		}
+		if req.DurationString != nil {
+			update.SetDurationString(*req.DurationString)
+		}
+		if req.Timestamp != nil {
+			update.SetTimestamp(*req.Timestamp)
+		}
+		if req.FormatID != nil {
+			update.SetFormatID(*req.FormatID)
+		}
+		if req.Width != nil {
+			update.SetWidth(*req.Width)
+		}
+		if req.Height != nil {
+			update.SetHeight(*req.Height)
+		}
+		if req.DynamicRange != nil {
+			update.SetDynamicRange(*req.DynamicRange)
+		}
+		if req.Metadata != nil {
+			update.SetMetadata(*req.Metadata)
+		}
+		if req.Description != nil {
+			update.SetDescription(*req.Description)
+		}
+		if req.Media != nil {
+			if len(req.Media) == 0 {
+				update.ClearMedia()
+			} else {
+				update.SetMedia(req.Media)
+			}
+		}
+
+		var err error
+		if updatedEpisode, err = update.Save(ctx); err != nil {
+			return err
+		}
+		updatedEpisode.Edges.Subtitles = episodeObj.Edges.Subtitles
+		updatedEpisode.Edges.AudioTracks = episodeObj.Edges.AudioTracks
+		updatedEpisode.Edges.Chapters = episodeObj.Edges.Chapters
+		if req.Renditions == nil {
+			updatedEpisode.Edges.Renditions = episodeObj.Edges.Renditions
+			return nil
+		}
+		if _, err := tx.Rendition.Delete().
+			Where(rendition.HasEpisodeWith(episode.ID(updatedEpisode.ID))).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return createRenditions(ctx, tx.Client(), []*ent.Episode{updatedEpisode}, [][]types.RenditionRequest{req.Renditions})
+	})
 	if err != nil {
 		return nil, err
 	}
+	updatedEpisode.Edges.Season = episodeObj.Edges.Season
 
 	resp := utils.BuildEpisodeResponse(updatedEpisode)
 	return &resp, nil
 }
 
 func BulkCreateEpisode(ctx context.Context, client *ent.Client, episodeList []types.CreateEpisodeRequest) ([]types.EpisodeResponse, error) {
-	bulk := make([]*ent.EpisodeCreate, 0, len(episodeList))
+	reqs := make([]types.CreateEpisodeRequest, 0, len(episodeList))
+	parents := make([]*ent.Season, 0, len(episodeList))
 	for _, req := range episodeList {
+		if err := assignEpisodeID(&req); err != nil {
+			return nil, err
+		}
 		season, err := client.Season.
 			Query().
 			Where(season.SeasonIDEQ(req.SeasonID)).
+			WithSeries().
 			Only(ctx)
 		if err != nil {
-			return nil, err
+			return nil, missingParent(err, "season_id", req.SeasonID)
 		}
-
-		ec := client.Episode.Create().
-			SetEpisodeID(req.EpisodeID).
-			SetTitle(req.Title).
-			SetEpisodeNumber(req.EpisodeNumber).
-			SetDuration(req.Duration).
-			SetDurationString(req.DurationString).
-			SetTimestamp(req.Timestamp).
-			SetFormatID(req.FormatID).
-			SetWidth(req.Width).
-			SetHeight(req.Height).
-			SetDynamicRange(req.DynamicRange).
-			SetMetadata(req.Metadata).
-			SetDescription(req.Description).
-			SetSeason(season)
-		bulk = append(bulk, ec)
-	}
-	created, err := client.Episode.CreateBulk(bulk...).Save(ctx)
+		reqs = append(reqs, req)
+		parents = append(parents, season)
+	}
+	created, err := createEpisodes(ctx, client, reqs, parents)
 	if err != nil {
 		return nil, err
 	}
@@ -174,3 +284,27 @@ func DeleteEpisode(ctx context.Context, client *ent.Client, episodeID string) er
 	}
 	return client.Episode.DeleteOneID(e.ID).Exec(ctx)
 }
+
+// EpisodeMasterPlaylist renders the HLS master playlist of an episode and
+// returns when it last changed.
+func EpisodeMasterPlaylist(ctx context.Context, client *ent.Client, episodeID string) (string, time.Time, error) {
+	e, err := client.Episode.Query().
+		Where(episode.EpisodeIDEQ(episodeID)).
+		WithRenditions().
+		Only(ctx)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	modified := e.UpdatedAt
+	hls := false
+	for _, r := range e.Edges.Renditions {
+		hls = hls || utils.IsHLS(r)
+		if r.UpdatedAt.After(modified) {
+			modified = r.UpdatedAt
+		}
+	}
+	if !hls {
+		return "", time.Time{}, NotFound("episode has no HLS renditions")
+	}
+	return utils.BuildMasterPlaylist(e.Edges.Renditions), modified, nil
+}
@@ -1,6 +1,220 @@
 package controller
 
-import "errors"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/codec"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/lib/pq"
+)
+
+// Code is a stable, machine-readable error identifier.
+type Code string
+
+const (
+	CodeBadRequest           Code = "bad_request"
+	CodeValidationFailed     Code = "validation_failed"
+	CodeUnauthorized         Code = "unauthorized"
+	CodeForbidden            Code = "forbidden"
+	CodeNotFound             Code = "not_found"
+	CodeMethodNotAllowed     Code = "method_not_allowed"
+	CodeConflict             Code = "conflict"
+	CodeHasChildren          Code = "has_children"
+	CodePreconditionFailed   Code = "precondition_failed"
+	CodePayloadTooLarge      Code = "payload_too_large"
+	CodeUnprocessable        Code = "unprocessable"
+	CodeUnsupportedMediaType Code = "unsupported_media_type"
+	CodeInternal             Code = "internal"
+	CodeUnavailable          Code = "unavailable"
+)
+
+var codeStatus = map[Code]int{
+	CodeBadRequest:           http.StatusBadRequest,
+	CodeValidationFailed:     http.StatusBadRequest,
+	CodeUnauthorized:         http.StatusUnauthorized,
+	CodeForbidden:            http.StatusForbidden,
+	CodeNotFound:             http.StatusNotFound,
+	CodeMethodNotAllowed:     http.StatusMethodNotAllowed,
+	CodeConflict:             http.StatusConflict,
+	CodeHasChildren:          http.StatusConflict,
+	CodePreconditionFailed:   http.StatusPreconditionFailed,
+	CodePayloadTooLarge:      http.StatusRequestEntityTooLarge,
+	CodeUnprocessable:        http.StatusUnprocessableEntity,
+	CodeUnsupportedMediaType: http.StatusUnsupportedMediaType,
+	CodeInternal:             http.StatusInternalServerError,
+	CodeUnavailable:          http.StatusServiceUnavailable,
+}
+
+// Error is an API error carrying a stable code. Any error returned by the
+// controllers can be turned into a response with ToProblem.
+type Error struct {
+	Code    Code
+	Message string
+	Fields  []types.FieldError
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func BadRequest(message string) *Error {
+	return &Error{Code: CodeBadRequest, Message: message}
+}
+
+func Unauthorized(message string) *Error {
+	return &Error{Code: CodeUnauthorized, Message: message}
+}
+
+func Forbidden(message string) *Error {
+	return &Error{Code: CodeForbidden, Message: message}
+}
+
+func NotFound(message string) *Error {
+	return &Error{Code: CodeNotFound, Message: message}
+}
+
+func PreconditionFailed(message string) *Error {
+	return &Error{Code: CodePreconditionFailed, Message: message}
+}
+
+func PayloadTooLarge(message string) *Error {
+	return &Error{Code: CodePayloadTooLarge, Message: message}
+}
+
+// Unavailable reports a dependency that is not configured or not
+// reachable; err is logged but not shown to the client.
+func Unavailable(message string, err error) *Error {
+	return &Error{Code: CodeUnavailable, Message: message, Err: err}
+}
+
+// ValidationFailed wraps a validation error, keeping its field details
+// when it is a FieldError or ValidationErrors.
+func ValidationFailed(err error) *Error {
+	e := &Error{Code: CodeValidationFailed, Message: "request validation failed"}
+	var fe types.FieldError
+	var ve types.ValidationErrors
+	switch {
+	case errors.As(err, &ve):
+		e.Fields = ve
+	case errors.As(err, &fe):
+		e.Fields = []types.FieldError{fe}
+	default:
+		e.Message = err.Error()
+	}
+	return e
+}
+
+// missingParent reports a create or update that references a parent which
+// does not exist as a validation failure on the referencing field.
+func missingParent(err error, field, id string) error {
+	if !ent.IsNotFound(err) {
+		return err
+	}
+	return &Error{
+		Code:    CodeValidationFailed,
+		Message: "referenced resource does not exist",
+		Fields:  []types.FieldError{{Field: field, Message: fmt.Sprintf("%q does not exist", id)}},
+		Err:     err,
+	}
+}
 
 // ErrHasChildren is returned when a resource has dependent child entities.
 var ErrHasChildren = errors.New("resource has children")
+
+// HasChildrenError lists the children blocking a non-cascading delete.
+// It matches ErrHasChildren with errors.Is.
+type HasChildrenError struct {
+	Kind string
+	IDs  []string
+}
+
+func (e *HasChildrenError) Error() string {
+	return fmt.Sprintf("resource has %d %s", len(e.IDs), e.Kind)
+}
+
+func (e *HasChildrenError) Is(target error) bool {
+	return target == ErrHasChildren
+}
+
+// ErrConflict is returned when a change collides with the current state of
+// other rows.
+var ErrConflict = errors.New("conflict")
+
+// ConflictError lists every collision found; nothing is written when it is
+// returned. It matches ErrConflict with errors.Is.
+type ConflictError struct {
+	Conflicts []types.Conflict
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%d conflicting changes", len(e.Conflicts))
+}
+
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// ToProblem maps any error to its problem details. This is the only place
+// errors are translated into status codes; unexpected errors are logged
+// and reported without their internals.
+func ToProblem(err error) types.Problem {
+	code, detail := CodeInternal, "internal server error"
+	p := types.Problem{}
+
+	var apiErr *Error
+	var hc *HasChildrenError
+	var ce *ConflictError
+	var ve *ent.ValidationError
+	var pqErr *pq.Error
+	switch {
+	case errors.As(err, &apiErr):
+		code, detail = apiErr.Code, apiErr.Message
+		p.Errors = apiErr.Fields
+		if codeStatus[code] >= http.StatusInternalServerError {
+			log.Printf("%v", apiErr)
+		}
+	case errors.As(err, &hc):
+		code, detail = CodeHasChildren, fmt.Sprintf("resource still has %d %s", len(hc.IDs), hc.Kind)
+		p.Children = map[string][]string{hc.Kind: hc.IDs}
+	case errors.As(err, &ce):
+		code, detail = CodeConflict, "conflicting changes; nothing was modified"
+		p.Conflicts = ce.Conflicts
+	case ent.IsNotFound(err):
+		code, detail = CodeNotFound, strings.TrimPrefix(err.Error(), "ent: ")
+	case errors.As(err, &ve):
+		code, detail = CodeValidationFailed, "request validation failed"
+		p.Errors = []types.FieldError{{Field: ve.Name, Message: "is invalid"}}
+	case ent.IsConstraintError(err):
+		code, detail = CodeConflict, "the change violates a uniqueness or reference constraint"
+		if errors.As(err, &pqErr) && pqErr.Detail != "" {
+			detail = pqErr.Detail
+		}
+	case errors.Is(err, codec.ErrUnsupportedMediaType):
+		code, detail = CodeUnsupportedMediaType, err.Error()
+	case errors.Is(err, context.Canceled):
+		code, detail = CodeBadRequest, "request canceled"
+	default:
+		log.Printf("internal error: %v", err)
+	}
+
+	p.Status = codeStatus[code]
+	p.Type = "about:blank"
+	p.Title = http.StatusText(p.Status)
+	p.Code = string(code)
+	p.Detail = detail
+	return p
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/internal/codec"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+// exportPageSize bounds how many series (with their subtrees) are held in
+// memory at once while exporting.
+const exportPageSize = 100
+
+// ExportCatalog streams every series, followed by its seasons and their
+// episodes when requested, as catalog records. Parents always precede
+// their children so the output can be fed back to ImportCatalog.
+func ExportCatalog(ctx context.Context, client *ent.Client, enc codec.Encoder, opts types.ExportOptions) error {
+	lastID := 0
+	for {
+		q := client.Series.Query().
+			Where(series.IDGT(lastID)).
+			Order(ent.Asc(series.FieldID)).
+			Limit(exportPageSize)
+		if opts.IncludeSeasons || opts.IncludeEpisodes {
+			q.WithSeasons(func(sq *ent.SeasonQuery) {
+				sq.Order(ent.Asc("season_number"))
+				if opts.IncludeEpisodes {
+					sq.WithEpisodes(func(eq *ent.EpisodeQuery) {
+						withEpisodeChildren(eq.Order(ent.Asc("episode_number")))
+					})
+				}
+			})
+		}
+		page, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range page {
+			if err := enc.Encode(utils.BuildSeriesRecord(s)); err != nil {
+				return err
+			}
+			for _, sn := range s.Edges.Seasons {
+				if opts.IncludeSeasons {
+					if err := enc.Encode(utils.BuildSeasonRecord(s.SeriesID, sn)); err != nil {
+						return err
+					}
+				}
+				for _, ep := range sn.Edges.Episodes {
+					if err := enc.Encode(utils.BuildEpisodeRecord(sn.SeasonID, ep)); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		if err := enc.Flush(); err != nil {
+			return err
+		}
+		if len(page) < exportPageSize {
+			return enc.Close()
+		}
+		lastID = page[len(page)-1].ID
+	}
+}
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/favorite"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+// favoriteOf matches the favorite of u on the series or season of the
+// given kind.
+func favoriteOf(u *ent.User, kind, id string) predicate.Favorite {
+	item := favorite.HasSeriesWith(series.SeriesIDEQ(id))
+	if kind == types.ItemSeason {
+		item = favorite.HasSeasonWith(season.SeasonIDEQ(id))
+	}
+	return favorite.And(favorite.HasUserWith(user.ID(u.ID)), item)
+}
+
+func withFavoriteItem(q *ent.FavoriteQuery) *ent.FavoriteQuery {
+	return q.WithSeries().WithSeason(func(sq *ent.SeasonQuery) { sq.WithSeries() })
+}
+
+// GetFavorites lists the favorites of u, most recently added first.
+func GetFavorites(ctx context.Context, client *ent.Client, u *ent.User) ([]types.FavoriteResponse, error) {
+	favorites, err := withFavoriteItem(client.Favorite.Query()).
+		Where(favorite.HasUserWith(user.ID(u.ID))).
+		Order(ent.Desc(favorite.FieldCreatedAt), ent.Desc(favorite.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resps := make([]types.FavoriteResponse, 0, len(favorites))
+	for _, f := range favorites {
+		resps = append(resps, utils.BuildFavoriteResponse(f))
+	}
+	return resps, nil
+}
+
+func GetFavorite(ctx context.Context, client *ent.Client, u *ent.User, kind, id string) (*types.FavoriteResponse, error) {
+	f, err := withFavoriteItem(client.Favorite.Query()).Where(favoriteOf(u, kind, id)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, NotFound(kind + " " + id + " is not a favorite")
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := utils.BuildFavoriteResponse(f)
+	return &resp, nil
+}
+
+// AddFavorite marks a series or season as a favorite of u. created is
+// false when it already was one.
+func AddFavorite(ctx context.Context, client *ent.Client, u *ent.User, kind, id string) (resp *types.FavoriteResponse, created bool, err error) {
+	item, err := findItem(ctx, client, kind, id)
+	if err != nil {
+		return nil, false, err
+	}
+	seriesID, seasonID := item.ids()
+	_, err = client.Favorite.Create().
+		SetUser(u).
+		SetNillableSeriesID(seriesID).
+		SetNillableSeasonID(seasonID).
+		Save(ctx)
+	if err != nil && !ent.IsConstraintError(err) {
+		return nil, false, err
+	}
+	created = err == nil
+	resp, err = GetFavorite(ctx, client, u, kind, id)
+	return resp, created, err
+}
+
+func RemoveFavorite(ctx context.Context, client *ent.Client, u *ent.User, kind, id string) error {
+	n, err := client.Favorite.Delete().Where(favoriteOf(u, kind, id)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return NotFound(kind + " " + id + " is not a favorite")
+	}
+	return nil
+}
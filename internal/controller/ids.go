@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/internal/idscheme"
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+// idCheckPageSize bounds how many rows CheckIDs loads at once.
+const idCheckPageSize = 1000
+
+// assignSeasonID fills in a season_id the client left out, derived from
+// the series ID and season number.
+func assignSeasonID(req *types.CreateSeasonRequest) error {
+	if req.SeasonID != "" {
+		return nil
+	}
+	id, err := idscheme.Current().SeasonID(req.SeriesID, req.SeasonNumber)
+	if err != nil {
+		return ValidationFailed(types.FieldError{Field: "season_id", Message: "is required: " + err.Error()})
+	}
+	req.SeasonID = id
+	return nil
+}
+
+// assignEpisodeID fills in an episode_id the client left out, derived
+// from the season ID and episode number.
+func assignEpisodeID(req *types.CreateEpisodeRequest) error {
+	if req.EpisodeID != "" {
+		return nil
+	}
+	id, err := idscheme.Current().EpisodeID(req.SeasonID, req.EpisodeNumber)
+	if err != nil {
+		return ValidationFailed(types.FieldError{Field: "episode_id", Message: "is required: " + err.Error()})
+	}
+	req.EpisodeID = id
+	return nil
+}
+
+// CheckIDs lists every season and episode whose ID does not match the
+// configured scheme for its current parent.
+func CheckIDs(ctx context.Context, client *ent.Client) (*types.IDCheckReport, error) {
+	scheme := idscheme.Current()
+	report := &types.IDCheckReport{Mismatches: []types.IDMismatch{}}
+
+	lastID := 0
+	for {
+		page, err := client.Season.Query().
+			Where(season.IDGT(lastID)).
+			Order(ent.Asc(season.FieldID)).
+			Limit(idCheckPageSize).
+			Select(season.FieldSeasonID).
+			WithSeries(func(q *ent.SeriesQuery) { q.Select(series.FieldSeriesID) }).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range page {
+			report.Checked.Seasons++
+			parent := ""
+			if s.Edges.Series != nil {
+				parent = s.Edges.Series.SeriesID
+			}
+			if parent == "" || !scheme.ValidSeasonID(s.SeasonID, parent) {
+				report.Mismatches = append(report.Mismatches, types.IDMismatch{
+					Kind: types.KindSeason, ID: s.SeasonID, ParentID: parent, Expected: scheme.Season,
+				})
+			}
+		}
+		if len(page) < idCheckPageSize {
+			break
+		}
+		lastID = page[len(page)-1].ID
+	}
+
+	lastID = 0
+	for {
+		page, err := client.Episode.Query().
+			Where(episode.IDGT(lastID)).
+			Order(ent.Asc(episode.FieldID)).
+			Limit(idCheckPageSize).
+			Select(episode.FieldEpisodeID).
+			WithSeason(func(q *ent.SeasonQuery) { q.Select(season.FieldSeasonID) }).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range page {
+			report.Checked.Episodes++
+			parent := ""
+			if e.Edges.Season != nil {
+				parent = e.Edges.Season.SeasonID
+			}
+			if parent == "" || !scheme.ValidEpisodeID(e.EpisodeID, parent) {
+				report.Mismatches = append(report.Mismatches, types.IDMismatch{
+					Kind: types.KindEpisode, ID: e.EpisodeID, ParentID: parent, Expected: scheme.Episode,
+				})
+			}
+		}
+		if len(page) < idCheckPageSize {
+			break
+		}
+		lastID = page[len(page)-1].ID
+	}
+	return report, nil
+}
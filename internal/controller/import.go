@@ -0,0 +1,297 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/internal/codec"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/validate"
+)
+
+const (
+	DefaultImportBatchSize = 500
+	MaxImportBatchSize     = 5000
+)
+
+// importSummary collects progress and per-line errors across batches.
+type importSummary struct {
+	*types.ImportSummary
+}
+
+func newImportSummary() importSummary {
+	return importSummary{&types.ImportSummary{Errors: []types.ImportError{}}}
+}
+
+func (s importSummary) reject(line int, id string, code Code, message string) {
+	s.Failed++
+	s.Errors = append(s.Errors, types.ImportError{Line: line, ID: id, Code: string(code), Error: message})
+}
+
+// fail records a rejected record, described the same way the API would
+// describe the error for a single create.
+func (s importSummary) fail(line int, id string, err error) {
+	p := ToProblem(err)
+	message := p.Detail
+	for _, fe := range p.Errors {
+		message += "; " + fe.Error()
+	}
+	s.reject(line, id, Code(p.Code), message)
+}
+
+func (s importSummary) done() *types.ImportSummary {
+	sort.SliceStable(s.Errors, func(i, j int) bool {
+		return s.Errors[i].Line < s.Errors[j].Line
+	})
+	return s.ImportSummary
+}
+
+// batcher validates records of one type, buffers them and inserts them
+// with a single bulk statement. When that fails, the batch is retried one record at a time
+// so errors can be reported per line.
+type batcher[T any] struct {
+	id         func(*T) string
+	createBulk func(context.Context, []T) error
+	createOne  func(context.Context, *T) error
+	// prepare fills in derived fields, such as generated IDs, before a
+	// record is validated.
+	prepare func(*T) error
+	// before runs ahead of every flush, so parents are written first.
+	before func(context.Context) error
+
+	summary importSummary
+	size    int
+	reqs    []T
+	lines   []int
+}
+
+func (b *batcher[T]) add(ctx context.Context, line int, req T) error {
+	if b.prepare != nil {
+		if err := b.prepare(&req); err != nil {
+			b.summary.fail(line, b.id(&req), err)
+			return nil
+		}
+	}
+	if err := validate.Struct(&req); err != nil {
+		b.summary.fail(line, b.id(&req), ValidationFailed(err))
+		return nil
+	}
+	b.reqs = append(b.reqs, req)
+	b.lines = append(b.lines, line)
+	if len(b.reqs) >= b.size {
+		return b.flush(ctx)
+	}
+	return nil
+}
+
+func (b *batcher[T]) flush(ctx context.Context) error {
+	if b.before != nil {
+		if err := b.before(ctx); err != nil {
+			return err
+		}
+	}
+	if len(b.reqs) == 0 {
+		return nil
+	}
+	b.summary.Batches++
+	if err := b.createBulk(ctx, b.reqs); err == nil {
+		b.summary.Created += len(b.reqs)
+	} else {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		for i := range b.reqs {
+			if err := b.createOne(ctx, &b.reqs[i]); err != nil {
+				b.summary.fail(b.lines[i], b.id(&b.reqs[i]), err)
+			} else {
+				b.summary.Created++
+			}
+		}
+	}
+	b.reqs = b.reqs[:0]
+	b.lines = b.lines[:0]
+	return nil
+}
+
+// decodeAll feeds every record in dec to add. Malformed records are
+// counted as failures; any other decoder error aborts the import.
+func decodeAll[T any](ctx context.Context, dec codec.Decoder, summary importSummary, add func(context.Context, int, *T) error) error {
+	for {
+		var rec T
+		err := dec.Decode(&rec)
+		if err == io.EOF {
+			return nil
+		}
+		summary.Total++
+		if err != nil {
+			var re *codec.RecordError
+			if !errors.As(err, &re) {
+				return err
+			}
+			summary.reject(re.Line, "", CodeBadRequest, re.Err.Error())
+			continue
+		}
+		if err := add(ctx, dec.Line(), &rec); err != nil {
+			return err
+		}
+	}
+}
+
+func importBatches[T any](ctx context.Context, dec codec.Decoder, b *batcher[T]) (*types.ImportSummary, error) {
+	err := decodeAll(ctx, dec, b.summary, func(ctx context.Context, line int, req *T) error {
+		return b.add(ctx, line, *req)
+	})
+	if err == nil {
+		err = b.flush(ctx)
+	}
+	return b.summary.done(), err
+}
+
+func batchSizeOrDefault(n int) int {
+	if n <= 0 {
+		return DefaultImportBatchSize
+	}
+	return n
+}
+
+func newSeriesBatcher(client *ent.Client, summary importSummary, size int) *batcher[types.CreateSeriesRequest] {
+	return &batcher[types.CreateSeriesRequest]{
+		id: func(r *types.CreateSeriesRequest) string { return r.SeriesID },
+		createBulk: func(ctx context.Context, reqs []types.CreateSeriesRequest) error {
+			bulk := make([]*ent.SeriesCreate, 0, len(reqs))
+			for i := range reqs {
+				bulk = append(bulk, newSeriesCreate(client, &reqs[i]))
+			}
+			return client.Series.CreateBulk(bulk...).Exec(ctx)
+		},
+		createOne: func(ctx context.Context, r *types.CreateSeriesRequest) error {
+			_, err := CreateSeries(ctx, client, r)
+			return err
+		},
+		summary: summary,
+		size:    size,
+	}
+}
+
+func newSeasonBatcher(client *ent.Client, summary importSummary, size int) *batcher[types.CreateSeasonRequest] {
+	return &batcher[types.CreateSeasonRequest]{
+		id: func(r *types.CreateSeasonRequest) string { return r.SeasonID },
+		createBulk: func(ctx context.Context, reqs []types.CreateSeasonRequest) error {
+			ids := make([]string, 0, len(reqs))
+			for _, r := range reqs {
+				ids = append(ids, r.SeriesID)
+			}
+			parents, err := client.Series.Query().Where(series.SeriesIDIn(ids...)).All(ctx)
+			if err != nil {
+				return err
+			}
+			byID := make(map[string]*ent.Series, len(parents))
+			for _, p := range parents {
+				byID[p.SeriesID] = p
+			}
+			bulk := make([]*ent.SeasonCreate, 0, len(reqs))
+			for i := range reqs {
+				parent, ok := byID[reqs[i].SeriesID]
+				if !ok {
+					return fmt.Errorf("series %q not found", reqs[i].SeriesID)
+				}
+				bulk = append(bulk, newSeasonCreate(client, &reqs[i], parent))
+			}
+			return client.Season.CreateBulk(bulk...).Exec(ctx)
+		},
+		createOne: func(ctx context.Context, r *types.CreateSeasonRequest) error {
+			_, err := CreateSeason(ctx, client, r)
+			return err
+		},
+		prepare: assignSeasonID,
+		summary: summary,
+		size:    size,
+	}
+}
+
+func newEpisodeBatcher(client *ent.Client, summary importSummary, size int) *batcher[types.CreateEpisodeRequest] {
+	return &batcher[types.CreateEpisodeRequest]{
+		id: func(r *types.CreateEpisodeRequest) string { return r.EpisodeID },
+		createBulk: func(ctx context.Context, reqs []types.CreateEpisodeRequest) error {
+			ids := make([]string, 0, len(reqs))
+			for _, r := range reqs {
+				ids = append(ids, r.SeasonID)
+			}
+			parents, err := client.Season.Query().Where(season.SeasonIDIn(ids...)).All(ctx)
+			if err != nil {
+				return err
+			}
+			byID := make(map[string]*ent.Season, len(parents))
+			for _, p := range parents {
+				byID[p.SeasonID] = p
+			}
+			seasons := make([]*ent.Season, 0, len(reqs))
+			for i := range reqs {
+				parent, ok := byID[reqs[i].SeasonID]
+				if !ok {
+					return fmt.Errorf("season %q not found", reqs[i].SeasonID)
+				}
+				seasons = append(seasons, parent)
+			}
+			_, err = createEpisodes(ctx, client, reqs, seasons)
+			return err
+		},
+		createOne: func(ctx context.Context, r *types.CreateEpisodeRequest) error {
+			_, err := CreateEpisode(ctx, client, r)
+			return err
+		},
+		prepare: assignEpisodeID,
+		summary: summary,
+		size:    size,
+	}
+}
+
+func ImportSeries(ctx context.Context, client *ent.Client, dec codec.Decoder, batchSize int) (*types.ImportSummary, error) {
+	return importBatches(ctx, dec, newSeriesBatcher(client, newImportSummary(), batchSizeOrDefault(batchSize)))
+}
+
+func ImportSeasons(ctx context.Context, client *ent.Client, dec codec.Decoder, batchSize int) (*types.ImportSummary, error) {
+	return importBatches(ctx, dec, newSeasonBatcher(client, newImportSummary(), batchSizeOrDefault(batchSize)))
+}
+
+func ImportEpisodes(ctx context.Context, client *ent.Client, dec codec.Decoder, batchSize int) (*types.ImportSummary, error) {
+	return importBatches(ctx, dec, newEpisodeBatcher(client, newImportSummary(), batchSizeOrDefault(batchSize)))
+}
+
+// ImportCatalog ingests a mixed stream of catalog records as produced by
+// ExportCatalog. Records of each kind are batched separately; pending
+// parents are always flushed before their children.
+func ImportCatalog(ctx context.Context, client *ent.Client, dec codec.Decoder, batchSize int) (*types.ImportSummary, error) {
+	size := batchSizeOrDefault(batchSize)
+	summary := newImportSummary()
+	seriesB := newSeriesBatcher(client, summary, size)
+	seasonB := newSeasonBatcher(client, summary, size)
+	seasonB.before = seriesB.flush
+	episodeB := newEpisodeBatcher(client, summary, size)
+	episodeB.before = seasonB.flush
+
+	err := decodeAll(ctx, dec, summary, func(ctx context.Context, line int, rec *types.CatalogRecord) error {
+		if err := rec.ValidateKind(); err != nil {
+			summary.fail(line, "", ValidationFailed(err))
+			return nil
+		}
+		switch rec.Kind {
+		case types.KindSeries:
+			return seriesB.add(ctx, line, rec.SeriesRequest())
+		case types.KindSeason:
+			return seasonB.add(ctx, line, rec.SeasonRequest())
+		default:
+			return episodeB.add(ctx, line, rec.EpisodeRequest())
+		}
+	})
+	if err == nil {
+		err = episodeB.flush(ctx)
+	}
+	return summary.done(), err
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+// Default includes of the read endpoints, used when a request names none.
+var (
+	SeriesDetailInclude = types.NewInclude(types.IncludeSeasonEpisodes)
+	SeasonDetailInclude = types.NewInclude(types.IncludeEpisodes)
+)
+
+// withSeriesIncludes eager-loads the seasons and episodes requested by in,
+// and nothing else.
+func withSeriesIncludes(q *ent.SeriesQuery, in types.Include) *ent.SeriesQuery {
+	if !in.Has(types.IncludeSeasons) {
+		return q
+	}
+	return q.WithSeasons(func(sq *ent.SeasonQuery) {
+		sq.Order(ent.Asc("season_number"))
+		if in.Has(types.IncludeSeasonEpisodes) {
+			withEpisodes(sq)
+		}
+	})
+}
+
+func withEpisodes(q *ent.SeasonQuery) *ent.SeasonQuery {
+	return q.WithEpisodes(func(eq *ent.EpisodeQuery) {
+		withEpisodeChildren(eq.Order(ent.Asc("episode_number")))
+	})
+}
+
+// withEpisodeChildren loads the renditions, subtitles, audio tracks and
+// chapters of episodes.
+func withEpisodeChildren(q *ent.EpisodeQuery) *ent.EpisodeQuery {
+	return q.WithRenditions().WithSubtitles().WithAudioTracks().WithChapters()
+}
+
+// withEpisodeParents loads the season and series of episodes, which their
+// media paths are derived from.
+func withEpisodeParents(q *ent.EpisodeQuery) *ent.EpisodeQuery {
+	return q.WithSeason(func(sq *ent.SeasonQuery) {
+		sq.WithSeries()
+	})
+}
+
+// linkSeasons points loaded seasons back at their series, which their
+// responses need for series_id, without querying it again.
+func linkSeasons(list ...*ent.Series) {
+	for _, s := range list {
+		for _, sn := range s.Edges.Seasons {
+			sn.Edges.Series = s
+		}
+	}
+}
+
+// buildSeriesResponses builds the responses of list with their rating and
+// favorite aggregates.
+func buildSeriesResponses(ctx context.Context, client *ent.Client, list []*ent.Series, in types.Include) ([]types.SeriesResponse, error) {
+	linkSeasons(list...)
+	responses := make([]types.SeriesResponse, 0, len(list))
+	for _, s := range list {
+		responses = append(responses, utils.BuildSeriesResponse(s, in.Has(types.IncludeSeasons), in.Has(types.IncludeSeasonEpisodes)))
+	}
+	if err := AddSeriesStats(ctx, client, list, responses); err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
+
+// loadSeriesIncludes reloads list with the edges requested by in, keeping
+// its order. It is a no-op when nothing is included.
+func loadSeriesIncludes(ctx context.Context, client *ent.Client, list []*ent.Series, in types.Include) ([]*ent.Series, error) {
+	if !in.Has(types.IncludeSeasons) || len(list) == 0 {
+		return list, nil
+	}
+	ids := make([]int, 0, len(list))
+	for _, s := range list {
+		ids = append(ids, s.ID)
+	}
+	loaded, err := withSeriesIncludes(client.Series.Query().Where(series.IDIn(ids...)), in).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*ent.Series, len(loaded))
+	for _, s := range loaded {
+		byID[s.ID] = s
+	}
+	out := make([]*ent.Series, 0, len(list))
+	for _, s := range list {
+		if l, ok := byID[s.ID]; ok {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,154 @@
+package controller
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/favorite"
+	"github.com/clustlight/animatrix-api/ent/rating"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/internal/types"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// libraryItem is the series or season a favorite, rating or watchlist item
+// refers to; exactly one is set.
+type libraryItem struct {
+	series *ent.Series
+	season *ent.Season
+}
+
+// ids returns the row IDs of the series and season of it; one is nil.
+func (it libraryItem) ids() (seriesID, seasonID *int) {
+	if it.series != nil {
+		return &it.series.ID, nil
+	}
+	return nil, &it.season.ID
+}
+
+// findItem loads the series or season of the given kind (types.ItemSeries
+// or types.ItemSeason) by its catalog ID.
+func findItem(ctx context.Context, client *ent.Client, kind, id string) (libraryItem, error) {
+	if kind == types.ItemSeries {
+		s, err := client.Series.Query().Where(series.SeriesIDEQ(id)).Only(ctx)
+		return libraryItem{series: s}, err
+	}
+	sn, err := client.Season.Query().Where(season.SeasonIDEQ(id)).WithSeries().Only(ctx)
+	return libraryItem{season: sn}, err
+}
+
+// seriesStats are the aggregates of the users' favorites and ratings of a
+// series.
+type seriesStats struct {
+	average            float64
+	ratings, favorites int
+}
+
+// loadSeriesStats returns the stats of the series in list by row ID;
+// series nobody rated or favorited are left out.
+func loadSeriesStats(ctx context.Context, client *ent.Client, list []*ent.Series) (map[int]seriesStats, error) {
+	stats := map[int]seriesStats{}
+	if len(list) == 0 {
+		return stats, nil
+	}
+	ids := make([]int, 0, len(list))
+	for _, s := range list {
+		ids = append(ids, s.ID)
+	}
+	// Rows are scanned by column name: the foreign key and the aliases.
+	count := func(s *sql.Selector) string { return sql.As(sql.Count("*"), "count") }
+	var ratings []struct {
+		Series  int     `json:"series_ratings"`
+		Average float64 `json:"average"`
+		Count   int     `json:"count"`
+	}
+	if err := client.Rating.Query().
+		Where(rating.HasSeriesWith(series.IDIn(ids...))).
+		GroupBy(rating.SeriesColumn).
+		Aggregate(func(s *sql.Selector) string {
+			return sql.As(sql.Avg(s.C(rating.FieldScore)), "average")
+		}, count).
+		Scan(ctx, &ratings); err != nil {
+		return nil, err
+	}
+	var favorites []struct {
+		Series int `json:"series_favorites"`
+		Count  int `json:"count"`
+	}
+	if err := client.Favorite.Query().
+		Where(favorite.HasSeriesWith(series.IDIn(ids...))).
+		GroupBy(favorite.SeriesColumn).
+		Aggregate(count).
+		Scan(ctx, &favorites); err != nil {
+		return nil, err
+	}
+	for _, r := range ratings {
+		st := stats[r.Series]
+		st.average, st.ratings = math.Round(r.Average*100)/100, r.Count
+		stats[r.Series] = st
+	}
+	for _, f := range favorites {
+		st := stats[f.Series]
+		st.favorites = f.Count
+		stats[f.Series] = st
+	}
+	return stats, nil
+}
+
+// AddSeriesStats sets the rating and favorite aggregates of resps, built
+// from list in the same order.
+func AddSeriesStats(ctx context.Context, client *ent.Client, list []*ent.Series, resps []types.SeriesResponse) error {
+	stats, err := loadSeriesStats(ctx, client, list)
+	if err != nil {
+		return err
+	}
+	for i, s := range list {
+		st := stats[s.ID]
+		if st.ratings > 0 {
+			resps[i].RatingAverage = &st.average
+		}
+		resps[i].RatingCount = st.ratings
+		resps[i].FavoriteCount = st.favorites
+	}
+	return nil
+}
+
+// sortSeries orders resps by s, breaking ties by series_id.
+func sortSeries(resps []types.SeriesResponse, s types.SeriesSort) {
+	if s.Key == "" {
+		return
+	}
+	slices.SortStableFunc(resps, func(a, b types.SeriesResponse) int {
+		var c int
+		switch s.Key {
+		case types.SortTitle:
+			c = cmp.Compare(a.Title, b.Title)
+		case types.SortTitleYomi:
+			c = cmp.Compare(a.TitleYomi, b.TitleYomi)
+		case types.SortUpdatedAt:
+			c = a.UpdatedAt.Compare(b.UpdatedAt)
+		case types.SortRating:
+			// Unrated series go last either way.
+			if (a.RatingAverage == nil) != (b.RatingAverage == nil) {
+				if a.RatingAverage == nil {
+					return 1
+				}
+				return -1
+			}
+			if a.RatingAverage != nil {
+				c = cmp.Or(cmp.Compare(*a.RatingAverage, *b.RatingAverage), cmp.Compare(a.RatingCount, b.RatingCount))
+			}
+		case types.SortFavorites:
+			c = cmp.Compare(a.FavoriteCount, b.FavoriteCount)
+		}
+		if s.Desc {
+			c = -c
+		}
+		return cmp.Or(c, cmp.Compare(a.SeriesID, b.SeriesID))
+	})
+}
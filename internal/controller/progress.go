@@ -0,0 +1,239 @@
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/ent/watchprogress"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+const (
+	DefaultWatchListLimit = 50
+	MaxWatchListLimit     = 200
+
+	// completedFraction of the duration marks an episode as watched when
+	// no ending or preview chapter says where its story ends.
+	completedFraction = 0.95
+)
+
+// EnsureUser returns the user with the given account name, creating it on
+// first use.
+func EnsureUser(ctx context.Context, client *ent.Client, name string) (*ent.User, error) {
+	u, err := client.User.Query().Where(user.NameEQ(name)).Only(ctx)
+	if !ent.IsNotFound(err) {
+		return u, err
+	}
+	u, err = client.User.Create().SetName(name).Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Created by a concurrent request.
+		return client.User.Query().Where(user.NameEQ(name)).Only(ctx)
+	}
+	return u, err
+}
+
+// completionPoint is the position from which an episode counts as watched:
+// the start of an ending or preview chapter in its second half, or
+// completedFraction of its duration.
+func completionPoint(e *ent.Episode) float64 {
+	point := e.Duration * completedFraction
+	for _, c := range e.Edges.Chapters {
+		if (c.Kind == types.ChapterEnding || c.Kind == types.ChapterPreview) && c.Start >= e.Duration/2 {
+			point = min(point, c.Start)
+		}
+	}
+	return point
+}
+
+// SaveProgress records how far u has watched an episode. Unless the
+// request says otherwise, the episode is completed once the position
+// reaches its completion point.
+func SaveProgress(ctx context.Context, client *ent.Client, u *ent.User, episodeID string, req *types.ProgressRequest) (*types.ProgressResponse, error) {
+	e, err := client.Episode.Query().
+		Where(episode.EpisodeIDEQ(episodeID)).
+		WithChapters().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Position > e.Duration {
+		return nil, ValidationFailed(types.FieldError{Field: "position", Message: fmt.Sprintf("must not exceed the duration of %g", e.Duration)})
+	}
+	completed := req.Position >= completionPoint(e)
+	if req.Completed != nil {
+		completed = *req.Completed
+	}
+
+	p, err := upsertProgress(ctx, client, u, e, req.Position, completed)
+	if err != nil {
+		return nil, err
+	}
+	return utils.BuildProgressResponse(e, p), nil
+}
+
+// upsertProgress updates the progress of u on e, or creates it. A create
+// losing the race against another request updates the row that one made.
+func upsertProgress(ctx context.Context, client *ent.Client, u *ent.User, e *ent.Episode, position float64, completed bool) (*ent.WatchProgress, error) {
+	mine := watchprogress.And(
+		watchprogress.HasUserWith(user.ID(u.ID)),
+		watchprogress.HasEpisodeWith(episode.ID(e.ID)),
+	)
+	update := func() (int, error) {
+		return client.WatchProgress.Update().
+			Where(mine).
+			SetPosition(position).
+			SetCompleted(completed).
+			Save(ctx)
+	}
+	n, err := update()
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		_, err = client.WatchProgress.Create().
+			SetUser(u).
+			SetEpisode(e).
+			SetPosition(position).
+			SetCompleted(completed).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			_, err = update()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return client.WatchProgress.Query().Where(mine).Only(ctx)
+}
+
+func GetProgress(ctx context.Context, client *ent.Client, u *ent.User, episodeID string) (*types.ProgressResponse, error) {
+	p, err := client.WatchProgress.Query().
+		Where(
+			watchprogress.HasUserWith(user.ID(u.ID)),
+			watchprogress.HasEpisodeWith(episode.EpisodeIDEQ(episodeID)),
+		).
+		WithEpisode().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, NotFound("no progress recorded for " + episodeID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return utils.BuildProgressResponse(p.Edges.Episode, p), nil
+}
+
+// History lists the episodes u watched, most recently watched first.
+func History(ctx context.Context, client *ent.Client, u *ent.User, limit int) ([]types.WatchEntry, error) {
+	progress, err := client.WatchProgress.Query().
+		Where(watchprogress.HasUserWith(user.ID(u.ID))).
+		Order(ent.Desc(watchprogress.FieldUpdatedAt), ent.Desc(watchprogress.FieldID)).
+		Limit(limit).
+		WithEpisode(func(q *ent.EpisodeQuery) { withEpisodeParents(q) }).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]types.WatchEntry, 0, len(progress))
+	for _, p := range progress {
+		entries = append(entries, utils.BuildWatchEntry(p.Edges.Episode, p.Position, p.Completed, p))
+	}
+	return entries, nil
+}
+
+// ContinueWatching lists, per series u watched, the episode to play next,
+// most recently watched series first: the last watched episode while it
+// is not completed, otherwise the first uncompleted episode after it by
+// season_number and episode_number. Finished series are left out.
+func ContinueWatching(ctx context.Context, client *ent.Client, u *ent.User, limit int) ([]types.WatchEntry, error) {
+	progress, err := client.WatchProgress.Query().
+		Where(watchprogress.HasUserWith(user.ID(u.ID))).
+		Order(ent.Desc(watchprogress.FieldUpdatedAt), ent.Desc(watchprogress.FieldID)).
+		WithEpisode(func(q *ent.EpisodeQuery) { withEpisodeParents(q) }).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byEpisode := make(map[int]*ent.WatchProgress, len(progress))
+	var latest []*ent.WatchProgress // per series, most recent first
+	seen := map[int]bool{}
+	for _, p := range progress {
+		byEpisode[p.Edges.Episode.ID] = p
+		if s := p.Edges.Episode.Edges.Season.Edges.Series; !seen[s.ID] {
+			seen[s.ID] = true
+			latest = append(latest, p)
+		}
+	}
+
+	var finished []int
+	for _, p := range latest {
+		if p.Completed {
+			finished = append(finished, p.Edges.Episode.Edges.Season.Edges.Series.ID)
+		}
+	}
+	following := map[int][]*ent.Episode{}
+	if len(finished) > 0 {
+		episodes, err := withEpisodeParents(client.Episode.Query()).
+			Where(episode.HasSeasonWith(season.HasSeriesWith(series.IDIn(finished...)))).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range episodes {
+			id := e.Edges.Season.Edges.Series.ID
+			following[id] = append(following[id], e)
+		}
+	}
+
+	entries := []types.WatchEntry{}
+	for _, p := range latest {
+		if len(entries) == limit {
+			break
+		}
+		if !p.Completed {
+			entries = append(entries, utils.BuildWatchEntry(p.Edges.Episode, p.Position, false, p))
+			continue
+		}
+		if next := nextEpisode(following[p.Edges.Episode.Edges.Season.Edges.Series.ID], p.Edges.Episode, byEpisode); next != nil {
+			position := 0.0
+			if np := byEpisode[next.ID]; np != nil {
+				position = np.Position
+			}
+			entries = append(entries, utils.BuildWatchEntry(next, position, false, p))
+		}
+	}
+	return entries, nil
+}
+
+// nextEpisode returns the first episode of a series after current that is
+// not completed, or nil.
+func nextEpisode(episodes []*ent.Episode, current *ent.Episode, progress map[int]*ent.WatchProgress) *ent.Episode {
+	slices.SortFunc(episodes, func(a, b *ent.Episode) int {
+		return cmp.Or(
+			cmp.Compare(a.Edges.Season.SeasonNumber, b.Edges.Season.SeasonNumber),
+			cmp.Compare(a.EpisodeNumber, b.EpisodeNumber),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+	after := false
+	for _, e := range episodes {
+		if e.ID == current.ID {
+			after = true
+			continue
+		}
+		if after {
+			if p := progress[e.ID]; p == nil || !p.Completed {
+				return e
+			}
+		}
+	}
+	return nil
+}
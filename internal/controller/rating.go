@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/rating"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+// ratingOf matches the rating of u of the series or season of the given
+// kind.
+func ratingOf(u *ent.User, kind, id string) predicate.Rating {
+	item := rating.HasSeriesWith(series.SeriesIDEQ(id))
+	if kind == types.ItemSeason {
+		item = rating.HasSeasonWith(season.SeasonIDEQ(id))
+	}
+	return rating.And(rating.HasUserWith(user.ID(u.ID)), item)
+}
+
+func withRatingItem(q *ent.RatingQuery) *ent.RatingQuery {
+	return q.WithSeries().WithSeason(func(sq *ent.SeasonQuery) { sq.WithSeries() })
+}
+
+// GetRatings lists the ratings of u, most recently changed first.
+func GetRatings(ctx context.Context, client *ent.Client, u *ent.User) ([]types.RatingResponse, error) {
+	ratings, err := withRatingItem(client.Rating.Query()).
+		Where(rating.HasUserWith(user.ID(u.ID))).
+		Order(ent.Desc(rating.FieldUpdatedAt), ent.Desc(rating.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resps := make([]types.RatingResponse, 0, len(ratings))
+	for _, r := range ratings {
+		resps = append(resps, utils.BuildRatingResponse(r))
+	}
+	return resps, nil
+}
+
+func GetRating(ctx context.Context, client *ent.Client, u *ent.User, kind, id string) (*types.RatingResponse, error) {
+	r, err := withRatingItem(client.Rating.Query()).Where(ratingOf(u, kind, id)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, NotFound("no rating of " + kind + " " + id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := utils.BuildRatingResponse(r)
+	return &resp, nil
+}
+
+// RateItem sets the score u gives a series or season, replacing an
+// earlier one.
+func RateItem(ctx context.Context, client *ent.Client, u *ent.User, kind, id string, req *types.RatingRequest) (*types.RatingResponse, error) {
+	item, err := findItem(ctx, client, kind, id)
+	if err != nil {
+		return nil, err
+	}
+	mine := ratingOf(u, kind, id)
+	update := func() (int, error) {
+		return client.Rating.Update().Where(mine).SetScore(req.Score).Save(ctx)
+	}
+	n, err := update()
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		seriesID, seasonID := item.ids()
+		_, err = client.Rating.Create().
+			SetUser(u).
+			SetNillableSeriesID(seriesID).
+			SetNillableSeasonID(seasonID).
+			SetScore(req.Score).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			// Created by a concurrent request.
+			_, err = update()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return GetRating(ctx, client, u, kind, id)
+}
+
+func RemoveRating(ctx context.Context, client *ent.Client, u *ent.User, kind, id string) error {
+	n, err := client.Rating.Delete().Where(ratingOf(u, kind, id)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return NotFound("no rating of " + kind + " " + id)
+	}
+	return nil
+}
@@ -0,0 +1,338 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/internal/idscheme"
+	"github.com/clustlight/animatrix-api/internal/media"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+// MoveEpisodes re-parents episodes from one season to another in a single
+// transaction. Episode numbers (and rewritten IDs) that would collide with
+// the target season are reported together as a ConflictError. Media keys
+// derived from the old IDs and numbers are kept as overrides (see
+// pinMedia).
+func MoveEpisodes(ctx context.Context, client *ent.Client, seasonID string, req *types.MoveEpisodesRequest) ([]types.EpisodeResponse, error) {
+	var moved []*ent.Episode
+	err := withTx(ctx, client, func(tx *ent.Tx) error {
+		src, err := tx.Season.Query().Where(season.SeasonIDEQ(seasonID)).WithSeries().Only(ctx)
+		if err != nil {
+			return err
+		}
+		dst, err := tx.Season.Query().
+			Where(season.SeasonIDEQ(req.TargetSeasonID)).
+			WithEpisodes().
+			WithSeries().
+			Only(ctx)
+		if err != nil {
+			return missingParent(err, "target_season_id", req.TargetSeasonID)
+		}
+		if src.ID == dst.ID {
+			return &ConflictError{Conflicts: []types.Conflict{{
+				ID: dst.SeasonID, Field: "target_season_id", Message: "target is the source season",
+			}}}
+		}
+
+		eps, err := withEpisodeChildren(tx.Episode.Query()).
+			Where(
+				episode.EpisodeIDIn(req.EpisodeIDs...),
+				episode.HasSeasonWith(season.ID(src.ID)),
+			).
+			Order(ent.Asc(episode.FieldEpisodeNumber), ent.Asc(episode.FieldTimestamp)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		var conflicts []types.Conflict
+		found := make(map[string]struct{}, len(eps))
+		for _, ep := range eps {
+			found[ep.EpisodeID] = struct{}{}
+		}
+		for _, id := range req.EpisodeIDs {
+			if _, ok := found[id]; !ok {
+				conflicts = append(conflicts, types.Conflict{
+					ID: id, Field: "episode_id", Message: "episode is not in season " + src.SeasonID,
+				})
+			}
+		}
+
+		usedNumbers := make(map[int]string, len(dst.Edges.Episodes)+len(eps))
+		for _, ep := range dst.Edges.Episodes {
+			usedNumbers[ep.EpisodeNumber] = ep.EpisodeID
+		}
+		newIDs := make([]string, len(eps))
+		newNumbers := make([]int, len(eps))
+		for i, ep := range eps {
+			newIDs[i] = ep.EpisodeID
+			if req.RewriteIDs {
+				rest, ok := strings.CutPrefix(ep.EpisodeID, src.SeasonID+"_")
+				if !ok {
+					conflicts = append(conflicts, types.Conflict{
+						ID: ep.EpisodeID, Field: "episode_id", Message: "episode_id does not start with " + src.SeasonID + "_",
+					})
+					continue
+				}
+				newIDs[i] = dst.SeasonID + "_" + rest
+			} else if !idscheme.Current().ValidEpisodeID(ep.EpisodeID, dst.SeasonID) {
+				conflicts = append(conflicts, types.Conflict{
+					ID: ep.EpisodeID, Field: "episode_id", Message: "episode_id does not match " + dst.SeasonID + "; set rewrite_ids",
+				})
+				continue
+			}
+			newNumbers[i] = ep.EpisodeNumber
+			if req.NumberFrom != nil {
+				newNumbers[i] = *req.NumberFrom + i
+			}
+			if other, ok := usedNumbers[newNumbers[i]]; ok {
+				conflicts = append(conflicts, types.Conflict{
+					ID:      ep.EpisodeID,
+					Field:   "episode_number",
+					Message: fmt.Sprintf("episode_number %d is already used by %s", newNumbers[i], other),
+				})
+				continue
+			}
+			usedNumbers[newNumbers[i]] = newIDs[i]
+		}
+		if req.RewriteIDs {
+			taken, err := tx.Episode.Query().
+				Where(episode.EpisodeIDIn(newIDs...)).
+				Select(episode.FieldEpisodeID).
+				Strings(ctx)
+			if err != nil {
+				return err
+			}
+			for _, id := range taken {
+				conflicts = append(conflicts, types.Conflict{
+					ID: id, Field: "episode_id", Message: "episode_id already exists",
+				})
+			}
+		}
+		if len(conflicts) > 0 {
+			return &ConflictError{Conflicts: conflicts}
+		}
+
+		for i, ep := range eps {
+			update := tx.Episode.UpdateOne(ep).
+				SetSeason(dst).
+				SetEpisodeID(newIDs[i]).
+				SetEpisodeNumber(newNumbers[i])
+			if pinned, ok := pinMedia(ep, src, newIDs[i], newNumbers[i], dst); ok {
+				update.SetMedia(pinned)
+			}
+			saved, err := update.Save(ctx)
+			if err != nil {
+				return err
+			}
+			saved.Edges.Season = dst
+			saved.Edges.Renditions = ep.Edges.Renditions
+			saved.Edges.Subtitles = ep.Edges.Subtitles
+			saved.Edges.AudioTracks = ep.Edges.AudioTracks
+			saved.Edges.Chapters = ep.Edges.Chapters
+			moved = append(moved, saved)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resps := make([]types.EpisodeResponse, 0, len(moved))
+	for _, ep := range moved {
+		resps = append(resps, utils.BuildEpisodeResponse(ep))
+	}
+	return resps, nil
+}
+
+// pinMedia returns the media overrides keeping the assets of ep, moved
+// from season from to season to as id and number, at the keys they had
+// before; ok is false when no key changes. Stored objects are not moved.
+func pinMedia(ep *ent.Episode, from *ent.Season, id string, number int, to *ent.Season) (pinned map[string]string, ok bool) {
+	after := &ent.Episode{EpisodeID: id, EpisodeNumber: number, Media: ep.Media}
+	return pinRef(utils.EpisodeMediaRef(ep, from), utils.EpisodeMediaRef(after, to))
+}
+
+// pinRef returns the overrides of before, extended with the keys of the
+// assets whose key the layout gives after changes; ok is false when none
+// does.
+func pinRef(before, after media.Ref) (pinned map[string]string, ok bool) {
+	resolver := media.Current()
+	pinned = maps.Clone(before.Overrides)
+	for _, a := range media.Assets[before.Kind] {
+		key := resolver.Path(before, a)
+		if key == "" || key == resolver.Path(after, a) {
+			continue
+		}
+		if pinned == nil {
+			pinned = map[string]string{}
+		}
+		pinned[string(a)] = key
+		ok = true
+	}
+	return pinned, ok
+}
+
+// numberedItem is a season or episode being resequenced.
+type numberedItem struct {
+	id     int
+	key    string
+	number int
+}
+
+// planRenumber returns the new number for each item, in the order given by
+// req.Order or, by default, the order of items.
+func planRenumber(items []numberedItem, req *types.RenumberRequest, field string) ([]numberedItem, []types.Conflict) {
+	var conflicts []types.Conflict
+	ordered := items
+	if len(req.Order) > 0 {
+		byKey := make(map[string]numberedItem, len(items))
+		for _, it := range items {
+			byKey[it.key] = it
+		}
+		ordered = make([]numberedItem, 0, len(items))
+		listed := make(map[string]struct{}, len(req.Order))
+		for _, key := range req.Order {
+			it, ok := byKey[key]
+			if !ok {
+				conflicts = append(conflicts, types.Conflict{ID: key, Field: "order", Message: "not a child of this resource"})
+				continue
+			}
+			if _, dup := listed[key]; dup {
+				conflicts = append(conflicts, types.Conflict{ID: key, Field: "order", Message: "listed more than once"})
+				continue
+			}
+			listed[key] = struct{}{}
+			ordered = append(ordered, it)
+		}
+		for _, it := range items {
+			if _, ok := listed[it.key]; !ok {
+				conflicts = append(conflicts, types.Conflict{ID: it.key, Field: "order", Message: "missing from order"})
+			}
+		}
+	}
+
+	result := make([]numberedItem, 0, len(ordered))
+	for i, it := range ordered {
+		n := it.number
+		if req.Start != nil {
+			n = *req.Start + i
+		} else {
+			n += *req.Offset
+		}
+		if n < 0 {
+			conflicts = append(conflicts, types.Conflict{
+				ID: it.key, Field: field, Message: field + " would become " + strconv.Itoa(n),
+			})
+		}
+		it.number = n
+		result = append(result, it)
+	}
+	return result, conflicts
+}
+
+// RenumberEpisodes resequences episode_number within a season. Media keys
+// derived from the old numbers are kept as overrides, as in MoveEpisodes.
+func RenumberEpisodes(ctx context.Context, client *ent.Client, seasonID string, req *types.RenumberRequest) (*types.SeasonResponse, error) {
+	err := withTx(ctx, client, func(tx *ent.Tx) error {
+		s, err := tx.Season.Query().
+			Where(season.SeasonIDEQ(seasonID)).
+			WithSeries().
+			WithEpisodes(func(q *ent.EpisodeQuery) {
+				q.Order(ent.Asc(episode.FieldEpisodeNumber), ent.Asc(episode.FieldTimestamp), ent.Asc(episode.FieldID))
+			}).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		items := make([]numberedItem, 0, len(s.Edges.Episodes))
+		byID := make(map[int]*ent.Episode, len(s.Edges.Episodes))
+		for _, ep := range s.Edges.Episodes {
+			items = append(items, numberedItem{id: ep.ID, key: ep.EpisodeID, number: ep.EpisodeNumber})
+			byID[ep.ID] = ep
+		}
+		planned, conflicts := planRenumber(items, req, episode.FieldEpisodeNumber)
+		if len(conflicts) > 0 {
+			return &ConflictError{Conflicts: conflicts}
+		}
+		for _, it := range planned {
+			ep := byID[it.id]
+			update := tx.Episode.UpdateOneID(it.id).SetEpisodeNumber(it.number)
+			if pinned, ok := pinMedia(ep, s, ep.EpisodeID, it.number, s); ok {
+				update.SetMedia(pinned)
+			}
+			if err := update.Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return GetSeason(ctx, client, seasonID, SeasonDetailInclude)
+}
+
+// RenumberSeasons resequences season_number within a series. Media keys
+// of the seasons and their episodes derived from the old numbers are kept
+// as overrides, as in MoveEpisodes.
+func RenumberSeasons(ctx context.Context, client *ent.Client, seriesID string, req *types.RenumberRequest) (*types.SeriesResponse, error) {
+	err := withTx(ctx, client, func(tx *ent.Tx) error {
+		s, err := tx.Series.Query().
+			Where(series.SeriesIDEQ(seriesID)).
+			WithSeasons(func(q *ent.SeasonQuery) {
+				q.Order(ent.Asc(season.FieldSeasonNumber), ent.Asc(season.FieldID))
+				q.WithEpisodes()
+			}).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		items := make([]numberedItem, 0, len(s.Edges.Seasons))
+		byID := make(map[int]*ent.Season, len(s.Edges.Seasons))
+		for _, sn := range s.Edges.Seasons {
+			sn.Edges.Series = s
+			items = append(items, numberedItem{id: sn.ID, key: sn.SeasonID, number: sn.SeasonNumber})
+			byID[sn.ID] = sn
+		}
+		planned, conflicts := planRenumber(items, req, season.FieldSeasonNumber)
+		if len(conflicts) > 0 {
+			return &ConflictError{Conflicts: conflicts}
+		}
+		for _, it := range planned {
+			sn := byID[it.id]
+			renumbered := *sn
+			renumbered.SeasonNumber = it.number
+			update := tx.Season.UpdateOneID(it.id).SetSeasonNumber(it.number)
+			if pinned, ok := pinRef(utils.SeasonMediaRef(sn), utils.SeasonMediaRef(&renumbered)); ok {
+				update.SetMedia(pinned)
+			}
+			if err := update.Exec(ctx); err != nil {
+				return err
+			}
+			for _, ep := range sn.Edges.Episodes {
+				pinned, ok := pinMedia(ep, sn, ep.EpisodeID, ep.EpisodeNumber, &renumbered)
+				if !ok {
+					continue
+				}
+				if err := tx.Episode.UpdateOne(ep).SetMedia(pinned).Exec(ctx); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return GetSeries(ctx, client, seriesID, SeriesDetailInclude)
+}
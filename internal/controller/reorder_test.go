@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/clustlight/animatrix-api/ent/enttest"
+	"github.com/clustlight/animatrix-api/internal/media"
+	"github.com/clustlight/animatrix-api/internal/storage"
+	"github.com/clustlight/animatrix-api/internal/types"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Renumbering under a layout built from the numbers keeps every URL where
+// the objects are, rather than moving them to keys nothing is stored at.
+func TestRenumberKeepsMediaKeys(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:renumber?mode=memory&_fk=1")
+	defer client.Close()
+
+	l := media.Default
+	l.SeasonThumbnail = "{series_id}/{season_number}/thumbnail.{ext}"
+	l.EpisodeVideo = "{series_id}/{season_number}/{episode_number}/video.{ext}"
+	l.EpisodeThumbnail = "{series_id}/{season_number}/{episode_number}/thumbnail.{ext}"
+	media.Configure(l)
+	storage.Configure(storage.Config{BaseURL: "https://cdn.example.com/"})
+	t.Cleanup(func() {
+		media.Configure(nil)
+		storage.Configure(storage.Config{})
+	})
+
+	if _, err := CreateSeries(ctx, client, &types.CreateSeriesRequest{SeriesID: "foo", Title: "Foo"}); err != nil {
+		t.Fatal(err)
+	}
+	for sn := 1; sn <= 2; sn++ {
+		seasonID := fmt.Sprintf("foo_s%d", sn)
+		if _, err := CreateSeason(ctx, client, &types.CreateSeasonRequest{SeriesID: "foo", SeasonID: seasonID, SeasonTitle: "S", SeasonNumber: sn}); err != nil {
+			t.Fatal(err)
+		}
+		for n := 1; n <= 2; n++ {
+			_, err := CreateEpisode(ctx, client, &types.CreateEpisodeRequest{
+				SeasonID: seasonID, EpisodeID: fmt.Sprintf("%s_%02d", seasonID, n), Title: "E", EpisodeNumber: n,
+				Duration: 1440, DurationString: "24:00", Timestamp: time.Date(2024, 1, n, 0, 0, 0, 0, time.UTC),
+				FormatID: "f", Width: 1920, Height: 1080, DynamicRange: "SDR",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// urls maps the season and episode IDs of the series to their URLs.
+	urls := func() map[string][]string {
+		t.Helper()
+		s, err := GetSeries(ctx, client, "foo", SeriesDetailInclude)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := map[string][]string{}
+		for _, sn := range s.Seasons {
+			got[sn.SeasonID] = []string{sn.ThumbnailURL}
+			for _, ep := range sn.Episodes {
+				got[ep.EpisodeID] = []string{ep.VideoURL, ep.ThumbnailURL}
+			}
+		}
+		return got
+	}
+	before := urls()
+	if want := "https://cdn.example.com/foo/2/1/video.mp4"; before["foo_s2_01"][0] != want {
+		t.Fatalf("foo_s2_01 video at %s, want %s", before["foo_s2_01"][0], want)
+	}
+
+	start, offset := 5, 10
+	if _, err := RenumberEpisodes(ctx, client, "foo_s1", &types.RenumberRequest{Start: &start}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := RenumberSeasons(ctx, client, "foo", &types.RenumberRequest{Offset: &offset}); err != nil {
+		t.Fatal(err)
+	}
+	// Renumbering twice pins the keys of the first numbers, not the second.
+	if _, err := RenumberEpisodes(ctx, client, "foo_s2", &types.RenumberRequest{Order: []string{"foo_s2_02", "foo_s2_01"}, Start: &start}); err != nil {
+		t.Fatal(err)
+	}
+
+	after := urls()
+	for id, want := range before {
+		got := after[id]
+		if len(got) != len(want) {
+			t.Errorf("%s: URLs %v, want %v", id, got, want)
+			continue
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("%s: URL %s, want %s", id, got[i], want[i])
+			}
+		}
+	}
+
+	s, err := GetSeason(ctx, client, "foo_s2", SeasonDetailInclude)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.SeasonNumber != 12 || s.Episodes[0].EpisodeID != "foo_s2_02" || s.Episodes[0].EpisodeNumber != 5 {
+		t.Errorf("foo_s2 renumbered to %d with first episode %s %d, want 12 with foo_s2_02 5",
+			s.SeasonNumber, s.Episodes[0].EpisodeID, s.Episodes[0].EpisodeNumber)
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/clustlight/animatrix-api/ent/season"
 	"github.com/clustlight/animatrix-api/ent/series"
 	"github.com/clustlight/animatrix-api/internal/types"
-	"github.com/clustlight/animatrix-api/internal/utils"
 	"github.com/ikawaha/kagome-dict/ipa"
 	"github.com/ikawaha/kagome/v2/tokenizer"
 )
@@ -102,7 +101,7 @@ func isKatakana(s string) bool {
 }
 
 // Search series by title or yomi, and also by related seasons, using kagome tokens for DB search
-func SearchSeries(ctx context.Context, client *ent.Client, query string) ([]types.SeriesResponse, error) {
+func SearchSeries(ctx context.Context, client *ent.Client, query string, in types.Include) ([]types.SeriesResponse, error) {
 	queryHira := normalizeJapanese(query)
 	tokens := tokenizeJapanese(query)
 	tokenSet := make(map[string]struct{})
@@ -241,10 +240,13 @@ func SearchSeries(ctx context.Context, client *ent.Client, query string) ([]type
 		}
 	}
 
-	seriesRes := make([]types.SeriesResponse, 0, len(seriesMap))
+	found := make([]*ent.Series, 0, len(seriesMap))
 	for _, s := range seriesMap {
-		seriesRes = append(seriesRes, utils.BuildSeriesResponse(s, false, false))
+		found = append(found, s)
 	}
-
-	return seriesRes, nil
+	found, err = loadSeriesIncludes(ctx, client, found, in)
+	if err != nil {
+		return nil, err
+	}
+	return buildSeriesResponses(ctx, client, found, in)
 }
@@ -2,91 +2,105 @@ package controller
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/episode"
 	"github.com/clustlight/animatrix-api/ent/season"
 	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/internal/idscheme"
 	"github.com/clustlight/animatrix-api/internal/types"
 	"github.com/clustlight/animatrix-api/internal/utils"
 )
 
-func GetAllSeasons(ctx context.Context, client *ent.Client) (*[]types.SeasonResponse, error) {
-	seasons, err := client.Season.Query().
+func GetAllSeasons(ctx context.Context, client *ent.Client, in types.Include) (*[]types.SeasonResponse, error) {
+	q := client.Season.Query().
 		WithSeries().
-		WithEpisodes().
-		Order(ent.Asc("season_number")).
-		All(ctx)
+		Order(ent.Asc("season_number"))
+	if in.Has(types.IncludeEpisodes) {
+		q = withEpisodes(q)
+	}
+	seasons, err := q.All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	responses := make([]types.SeasonResponse, 0, len(seasons))
 	for _, s := range seasons {
-		resp := utils.BuildSeasonResponse(s, false)
+		resp := utils.BuildSeasonResponse(s, in.Has(types.IncludeEpisodes))
 		responses = append(responses, resp)
 	}
 
 	return &responses, nil
 }
 
-func GetSeason(ctx context.Context, client *ent.Client, seasonID string) (*types.SeasonResponse, error) {
-	season, err := client.Season.
+func GetSeason(ctx context.Context, client *ent.Client, seasonID string, in types.Include) (*types.SeasonResponse, error) {
+	q := client.Season.
 		Query().
 		Where(season.SeasonIDEQ(seasonID)).
-		WithSeries().
-		WithEpisodes(func(q *ent.EpisodeQuery) {
-			q.Order(ent.Asc("episode_number"))
-		}).
-		Only(ctx)
+		WithSeries()
+	if in.Has(types.IncludeEpisodes) {
+		q = withEpisodes(q)
+	}
+	season, err := q.Only(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := utils.BuildSeasonResponse(season, true)
+	resp := utils.BuildSeasonResponse(season, in.Has(types.IncludeEpisodes))
 	return &resp, nil
 }
 
-func CreateSeason(ctx context.Context, client *ent.Client, req *types.CreateSeasonRequest) (*types.SeasonResponse, error) {
-	series, err := client.Series.
-		Query().
-		Where(series.SeriesIDEQ(req.SeriesID)).
-		Only(ctx)
-	if err != nil {
-		return nil, err // Seriesが見つからない場合はエラー
-	}
-
-	newSeason := client.Season.Create().
-		SetSeries(series).
+func newSeasonCreate(client *ent.Client, req *types.CreateSeasonRequest, parent *ent.Series) *ent.SeasonCreate {
+	sc := client.Season.Create().
+		SetSeries(parent).
 		SetSeasonID(req.SeasonID).
 		SetSeasonTitle(req.SeasonTitle).
 		SetSeasonNumber(req.SeasonNumber)
-
 	if req.SeasonTitleYomi != nil {
-		newSeason = newSeason.SetSeasonTitleYomi(*req.SeasonTitleYomi)
+		sc = sc.SetSeasonTitleYomi(*req.SeasonTitleYomi)
 	}
 	if req.ShoboiTID != nil {
-		newSeason = newSeason.SetShoboiTid(*req.ShoboiTID)
+		sc = sc.SetShoboiTid(*req.ShoboiTID)
 	}
 	if req.Description != nil {
-		newSeason = newSeason.SetDescription(*req.Description)
+		sc = sc.SetDescription(*req.Description)
 	}
 	if req.FirstYear != nil {
-		newSeason = newSeason.SetFirstYear(*req.FirstYear)
+		sc = sc.SetFirstYear(*req.FirstYear)
 	}
 	if req.FirstMonth != nil {
-		newSeason = newSeason.SetFirstMonth(*req.FirstMonth)
+		sc = sc.SetFirstMonth(*req.FirstMonth)
 	}
 	if req.FirstEndYear != nil {
-		newSeason = newSeason.SetFirstEndYear(*req.FirstEndYear)
+		sc = sc.SetFirstEndYear(*req.FirstEndYear)
 	}
 	if req.FirstEndMonth != nil {
-		newSeason = newSeason.SetFirstEndMonth(*req.FirstEndMonth)
+		sc = sc.SetFirstEndMonth(*req.FirstEndMonth)
+	}
+	if len(req.Media) > 0 {
+		sc = sc.SetMedia(req.Media)
+	}
+	return sc
+}
+
+func CreateSeason(ctx context.Context, client *ent.Client, req *types.CreateSeasonRequest) (*types.SeasonResponse, error) {
+	if err := assignSeasonID(req); err != nil {
+		return nil, err
+	}
+	series, err := client.Series.
+		Query().
+		Where(series.SeriesIDEQ(req.SeriesID)).
+		Only(ctx)
+	if err != nil {
+		return nil, missingParent(err, "series_id", req.SeriesID) // Seriesが見つからない場合はエラー
 	}
 
-	saved, err := newSeason.Save(ctx)
+	saved, err := newSeasonCreate(client, req, series).Save(ctx)
 	if err != nil {
 		return nil, err
 	}
+	saved.Edges.Series = series
 
 	resp := utils.BuildSeasonResponse(saved, true)
 	return &resp, nil
@@ -96,10 +110,12 @@ func UpdateSeason(ctx context.Context, client *ent.Client, seasonID string, req
 	season, err := client.Season.
 		Query().
 		Where(season.SeasonIDEQ(seasonID)).
+		WithSeries().
 		Only(ctx)
 	if err != nil {
 		return nil, err
 	}
+	parent := season.Edges.Series
 
 	update := season.Update()
 
@@ -130,6 +146,13 @@ func UpdateSeason(ctx context.Context, client *ent.Client, seasonID string, req
 	if req.FirstEndMonth != nil {
 		update.SetFirstEndMonth(*req.FirstEndMonth)
 	}
+	if req.Media != nil {
+		if len(req.Media) == 0 {
+			update.ClearMedia()
+		} else {
+			update.SetMedia(req.Media)
+		}
+	}
 
 	if req.SeriesID != nil {
 		srs, err := client.Series.
@@ -137,15 +160,23 @@ func UpdateSeason(ctx context.Context, client *ent.Client, seasonID string, req
 			Where(series.SeriesIDEQ(*req.SeriesID)).
 			Only(ctx)
 		if err != nil {
-			return nil, err
+			return nil, missingParent(err, "series_id", *req.SeriesID)
+		}
+		if !idscheme.Current().ValidSeasonID(season.SeasonID, srs.SeriesID) {
+			return nil, ValidationFailed(types.FieldError{
+				Field:   "series_id",
+				Message: fmt.Sprintf("season_id %q does not match series %q", season.SeasonID, srs.SeriesID),
+			})
 		}
 		update.SetSeries(srs)
+		parent = srs
 	}
 
 	saved, err := update.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
+	saved.Edges.Series = parent
 
 	resp := utils.BuildSeasonResponse(saved, true)
 	return &resp, nil
@@ -153,65 +184,67 @@ func UpdateSeason(ctx context.Context, client *ent.Client, seasonID string, req
 
 func BulkCreateSeason(ctx context.Context, client *ent.Client, seasonList []types.CreateSeasonRequest) ([]types.SeasonResponse, error) {
 	bulk := make([]*ent.SeasonCreate, 0, len(seasonList))
+	parents := make([]*ent.Series, 0, len(seasonList))
 	for _, req := range seasonList {
+		if err := assignSeasonID(&req); err != nil {
+			return nil, err
+		}
 		series, err := client.Series.
 			Query().
 			Where(series.SeriesIDEQ(req.SeriesID)).
 			Only(ctx)
 		if err != nil {
-			return nil, err
-		}
-
-		sc := client.Season.Create().
-			SetSeasonID(req.SeasonID).
-			SetSeasonTitle(req.SeasonTitle).
-			SetSeasonNumber(req.SeasonNumber).
-			SetSeries(series)
-		if req.SeasonTitleYomi != nil {
-			sc = sc.SetSeasonTitleYomi(*req.SeasonTitleYomi)
-		}
-		if req.ShoboiTID != nil {
-			sc = sc.SetShoboiTid(*req.ShoboiTID)
-		}
-		if req.Description != nil {
-			sc = sc.SetDescription(*req.Description)
-		}
-		if req.FirstYear != nil {
-			sc = sc.SetFirstYear(*req.FirstYear)
-		}
-		if req.FirstMonth != nil {
-			sc = sc.SetFirstMonth(*req.FirstMonth)
-		}
-		if req.FirstEndYear != nil {
-			sc = sc.SetFirstEndYear(*req.FirstEndYear)
-		}
-		if req.FirstEndMonth != nil {
-			sc = sc.SetFirstEndMonth(*req.FirstEndMonth)
+			return nil, missingParent(err, "series_id", req.SeriesID)
 		}
-		bulk = append(bulk, sc)
+		bulk = append(bulk, newSeasonCreate(client, &req, series))
+		parents = append(parents, series)
 	}
 	created, err := client.Season.CreateBulk(bulk...).Save(ctx)
 	if err != nil {
 		return nil, err
 	}
 	resps := make([]types.SeasonResponse, 0, len(created))
-	for _, s := range created {
+	for i, s := range created {
+		s.Edges.Series = parents[i]
 		resps = append(resps, utils.BuildSeasonResponse(s, false))
 	}
 	return resps, nil
 }
 
-func DeleteSeason(ctx context.Context, client *ent.Client, seasonID string) error {
-	s, err := client.Season.
-		Query().
-		Where(season.SeasonIDEQ(seasonID)).
-		WithEpisodes().
-		Only(ctx)
+func DeleteSeason(ctx context.Context, client *ent.Client, seasonID string, opts types.DeleteOptions) (*types.DeletionPlan, error) {
+	plan := &types.DeletionPlan{DryRun: opts.DryRun, Series: []string{}, Seasons: []string{}, Episodes: []string{}}
+	err := withTx(ctx, client, func(tx *ent.Tx) error {
+		s, err := tx.Season.
+			Query().
+			Where(season.SeasonIDEQ(seasonID)).
+			WithEpisodes(func(q *ent.EpisodeQuery) {
+				q.Order(ent.Asc("episode_number"))
+			}).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		plan.Seasons = append(plan.Seasons, s.SeasonID)
+		for _, ep := range s.Edges.Episodes {
+			plan.Episodes = append(plan.Episodes, ep.EpisodeID)
+		}
+		plan.Count()
+		if len(plan.Episodes) > 0 && !opts.Cascade {
+			return &HasChildrenError{Kind: "episodes", IDs: plan.Episodes}
+		}
+		if opts.DryRun {
+			return nil
+		}
+
+		if _, err := tx.Episode.Delete().
+			Where(episode.HasSeasonWith(season.ID(s.ID))).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return tx.Season.DeleteOneID(s.ID).Exec(ctx)
+	})
 	if err != nil {
-		return err
-	}
-	if len(s.Edges.Episodes) > 0 {
-		return ErrHasChildren
+		return nil, err
 	}
-	return client.Season.DeleteOneID(s.ID).Exec(ctx)
+	return plan, nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/clustlight/animatrix-api/ent"
 	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/season"
 	"github.com/clustlight/animatrix-api/ent/series"
 	"github.com/clustlight/animatrix-api/internal/types"
 	"github.com/clustlight/animatrix-api/internal/utils"
@@ -16,54 +17,49 @@ type seriesWithTimestamp struct {
 	timestamp int64
 }
 
-func GetAllSeries(ctx context.Context, client *ent.Client) (*[]types.SeriesResponse, error) {
-	series, err := client.Series.
-		Query().
-		WithSeasons(func(q *ent.SeasonQuery) {
-			q.WithSeries()
-		}).
-		All(ctx)
+func GetAllSeries(ctx context.Context, client *ent.Client, in types.Include, order types.SeriesSort) (*[]types.SeriesResponse, error) {
+	series, err := withSeriesIncludes(client.Series.Query(), in).All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	responses := make([]types.SeriesResponse, 0, len(series))
-	for _, s := range series {
-		resp := utils.BuildSeriesResponse(s, false, false)
-		responses = append(responses, resp)
+	responses, err := buildSeriesResponses(ctx, client, series, in)
+	if err != nil {
+		return nil, err
 	}
-
+	sortSeries(responses, order)
 	return &responses, nil
 }
 
-func GetSeries(ctx context.Context, client *ent.Client, seriesID string) (*types.SeriesResponse, error) {
-	series, err := client.Series.
-		Query().
-		Where(series.SeriesIDEQ(seriesID)).
-		WithSeasons(func(q *ent.SeasonQuery) {
-			q.WithSeries()
-			q.WithEpisodes(func(eq *ent.EpisodeQuery) {
-				eq.Order(ent.Asc("episode_number"))
-			}).
-				Order(ent.Asc("season_number"))
-		}).
+func GetSeries(ctx context.Context, client *ent.Client, seriesID string, in types.Include) (*types.SeriesResponse, error) {
+	series, err := withSeriesIncludes(client.Series.Query().Where(series.SeriesIDEQ(seriesID)), in).
 		Only(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := utils.BuildSeriesResponse(series, true, true)
-	return &resp, nil
+	resps, err := buildSeriesResponses(ctx, client, []*ent.Series{series}, in)
+	if err != nil {
+		return nil, err
+	}
+	return &resps[0], nil
 }
 
-func CreateSeries(ctx context.Context, client *ent.Client, req *types.CreateSeriesRequest) (*types.SeriesResponse, error) {
-	newSeries, err := client.Series.Create().
+func newSeriesCreate(client *ent.Client, req *types.CreateSeriesRequest) *ent.SeriesCreate {
+	sc := client.Series.Create().
 		SetSeriesID(req.SeriesID).
 		SetTitle(req.Title).
 		SetTitleYomi(req.TitleYomi).
 		SetTitleEn(req.TitleEn).
-		SetDescription(req.Description).
-		Save(ctx)
+		SetDescription(req.Description)
+	if len(req.Media) > 0 {
+		sc = sc.SetMedia(req.Media)
+	}
+	return sc
+}
+
+func CreateSeries(ctx context.Context, client *ent.Client, req *types.CreateSeriesRequest) (*types.SeriesResponse, error) {
+	newSeries, err := newSeriesCreate(client, req).Save(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -94,25 +90,29 @@ func UpdateSeries(ctx context.Context, client *ent.Client, seriesID string, req
 	if req.Description != nil {
 		upd = upd.SetDescription(*req.Description)
 	}
+	if req.Media != nil {
+		if len(req.Media) == 0 {
+			upd = upd.ClearMedia()
+		} else {
+			upd = upd.SetMedia(req.Media)
+		}
+	}
 	updatedSeries, err := upd.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := utils.BuildSeriesResponse(updatedSeries, false, false)
-	return &resp, nil
+	resps, err := buildSeriesResponses(ctx, client, []*ent.Series{updatedSeries}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &resps[0], nil
 }
 
 func BulkCreateSeries(ctx context.Context, client *ent.Client, seriesList []types.CreateSeriesRequest) ([]types.SeriesResponse, error) {
 	bulk := make([]*ent.SeriesCreate, 0, len(seriesList))
 	for _, req := range seriesList {
-		sc := client.Series.Create().
-			SetSeriesID(req.SeriesID).
-			SetTitle(req.Title).
-			SetTitleYomi(req.TitleYomi).
-			SetTitleEn(req.TitleEn).
-			SetDescription(req.Description)
-		bulk = append(bulk, sc)
+		bulk = append(bulk, newSeriesCreate(client, &req))
 	}
 	created, err := client.Series.CreateBulk(bulk...).Save(ctx)
 	if err != nil {
@@ -139,7 +139,7 @@ func BulkCreateSeries(ctx context.Context, client *ent.Client, seriesList []type
 	return resps, nil
 }
 
-func GetRecentlyUpdatedSeries(ctx context.Context, client *ent.Client) ([]types.SeriesResponse, error) {
+func GetRecentlyUpdatedSeries(ctx context.Context, client *ent.Client, in types.Include) ([]types.SeriesResponse, error) {
 	episodes, err := client.Episode.
 		Query().
 		Order(ent.Desc(episode.FieldTimestamp)).
@@ -176,26 +176,62 @@ func GetRecentlyUpdatedSeries(ctx context.Context, client *ent.Client) ([]types.
 		return list[i].timestamp > list[j].timestamp
 	})
 
-	// Build response
-	responses := make([]types.SeriesResponse, 0, len(list))
+	recent := make([]*ent.Series, 0, len(list))
 	for _, s := range list {
-		resp := utils.BuildSeriesResponse(s.series, false, false)
-		responses = append(responses, resp)
+		recent = append(recent, s.series)
+	}
+	recent, err = loadSeriesIncludes(ctx, client, recent, in)
+	if err != nil {
+		return nil, err
 	}
-	return responses, nil
+	return buildSeriesResponses(ctx, client, recent, in)
 }
 
-func DeleteSeries(ctx context.Context, client *ent.Client, seriesID string) error {
-	s, err := client.Series.
-		Query().
-		Where(series.SeriesIDEQ(seriesID)).
-		WithSeasons().
-		Only(ctx)
+func DeleteSeries(ctx context.Context, client *ent.Client, seriesID string, opts types.DeleteOptions) (*types.DeletionPlan, error) {
+	plan := &types.DeletionPlan{DryRun: opts.DryRun, Series: []string{}, Seasons: []string{}, Episodes: []string{}}
+	err := withTx(ctx, client, func(tx *ent.Tx) error {
+		s, err := tx.Series.
+			Query().
+			Where(series.SeriesIDEQ(seriesID)).
+			WithSeasons(func(q *ent.SeasonQuery) {
+				q.Order(ent.Asc("season_number")).
+					WithEpisodes(func(eq *ent.EpisodeQuery) {
+						eq.Order(ent.Asc("episode_number"))
+					})
+			}).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		plan.Series = append(plan.Series, s.SeriesID)
+		for _, sn := range s.Edges.Seasons {
+			plan.Seasons = append(plan.Seasons, sn.SeasonID)
+			for _, ep := range sn.Edges.Episodes {
+				plan.Episodes = append(plan.Episodes, ep.EpisodeID)
+			}
+		}
+		plan.Count()
+		if len(plan.Seasons) > 0 && !opts.Cascade {
+			return &HasChildrenError{Kind: "seasons", IDs: plan.Seasons}
+		}
+		if opts.DryRun {
+			return nil
+		}
+
+		if _, err := tx.Episode.Delete().
+			Where(episode.HasSeasonWith(season.HasSeriesWith(series.ID(s.ID)))).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.Season.Delete().
+			Where(season.HasSeriesWith(series.ID(s.ID))).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return tx.Series.DeleteOneID(s.ID).Exec(ctx)
+	})
 	if err != nil {
-		return err
-	}
-	if len(s.Edges.Seasons) > 0 {
-		return ErrHasChildren
+		return nil, err
 	}
-	return client.Series.DeleteOneID(s.ID).Exec(ctx)
+	return plan, nil
 }
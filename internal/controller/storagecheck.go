@@ -0,0 +1,223 @@
+package controller
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/internal/media"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+// ObjectStore is the part of storage.Bucket CheckStorage needs.
+type ObjectStore interface {
+	Exists(ctx context.Context, key string) (bool, error)
+	List(ctx context.Context, prefix string, fn func(key string) error) error
+}
+
+// CheckStorage compares the objects every series, season and episode
+// should have under the media layout with the bucket. The "list" method
+// lists the bucket below prefix, finding orphans as well; "head" looks up
+// each expected key instead, which is slower but needs no list permission.
+func CheckStorage(ctx context.Context, client *ent.Client, store ObjectStore, method, prefix string) (*types.StorageReport, error) {
+	if method == "" {
+		method = "list"
+	}
+	if method != "list" && method != "head" {
+		return nil, BadRequest(`method must be "list" or "head"`)
+	}
+	report := &types.StorageReport{Method: method, Prefix: prefix, Missing: []types.MissingObject{}, Orphans: []string{}}
+
+	expected, err := expectedObjects(ctx, client, report)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(expected))
+	for key := range expected {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	found := map[string]bool{}
+	if method == "head" {
+		for _, key := range keys {
+			ok, err := store.Exists(ctx, key)
+			if err != nil {
+				return nil, Unavailable("object storage request failed", err)
+			}
+			report.Checked.Objects++
+			found[key] = ok
+		}
+	} else {
+		dirs := map[string]bool{}
+		for key := range expected {
+			for i := strings.Index(key, "/"); i >= 0; i = nextSlash(key, i) {
+				dirs[key[:i+1]] = true
+			}
+		}
+		orphans := map[string]bool{}
+		err := store.List(ctx, prefix, func(key string) error {
+			report.Checked.Objects++
+			if _, ok := expected[key]; ok {
+				found[key] = true
+				return nil
+			}
+			if orphan := orphanPrefix(key, dirs); orphan != "" {
+				orphans[orphan] = true
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, Unavailable("object storage request failed", err)
+		}
+		for orphan := range orphans {
+			report.Orphans = append(report.Orphans, orphan)
+		}
+		sort.Strings(report.Orphans)
+	}
+
+	for _, key := range keys {
+		if !found[key] {
+			report.Missing = append(report.Missing, expected[key])
+		}
+	}
+	return report, nil
+}
+
+func nextSlash(key string, i int) int {
+	j := strings.Index(key[i+1:], "/")
+	if j < 0 {
+		return -1
+	}
+	return i + 1 + j
+}
+
+// orphanPrefix returns the shallowest directory of key that holds no
+// expected object, or key itself when it is a top-level object. Stray
+// files next to expected ones are not reported.
+func orphanPrefix(key string, dirs map[string]bool) string {
+	i := strings.Index(key, "/")
+	if i < 0 {
+		return key
+	}
+	for ; i >= 0; i = nextSlash(key, i) {
+		if !dirs[key[:i+1]] {
+			return key[:i+1]
+		}
+	}
+	return ""
+}
+
+// expectedObjects maps every key the catalog refers to to its owner,
+// counting the entities and external overrides in report.
+func expectedObjects(ctx context.Context, client *ent.Client, report *types.StorageReport) (map[string]types.MissingObject, error) {
+	layout := media.Current()
+	expected := map[string]types.MissingObject{}
+	addKey := func(kind, id, asset, key string) {
+		if key == "" {
+			return
+		}
+		if u, err := url.Parse(key); err == nil && u.IsAbs() {
+			report.Checked.External++
+			return
+		}
+		if _, ok := expected[key]; !ok {
+			expected[key] = types.MissingObject{Kind: kind, ID: id, Asset: asset, Key: key}
+		}
+	}
+	add := func(kind, id string, ref media.Ref) {
+		for _, a := range media.Assets[ref.Kind] {
+			addKey(kind, id, string(a), layout.Path(ref, a))
+		}
+	}
+
+	lastID := 0
+	for {
+		page, err := client.Series.Query().
+			Where(series.IDGT(lastID)).
+			Order(ent.Asc(series.FieldID)).
+			Limit(idCheckPageSize).
+			Select(series.FieldSeriesID, series.FieldMedia).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range page {
+			report.Checked.Series++
+			add(types.KindSeries, s.SeriesID, utils.SeriesMediaRef(s))
+		}
+		if len(page) < idCheckPageSize {
+			break
+		}
+		lastID = page[len(page)-1].ID
+	}
+
+	lastID = 0
+	for {
+		page, err := client.Season.Query().
+			Where(season.IDGT(lastID)).
+			Order(ent.Asc(season.FieldID)).
+			Limit(idCheckPageSize).
+			Select(season.FieldSeasonID, season.FieldSeasonNumber, season.FieldMedia).
+			WithSeries(func(q *ent.SeriesQuery) { q.Select(series.FieldSeriesID) }).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range page {
+			report.Checked.Seasons++
+			add(types.KindSeason, s.SeasonID, utils.SeasonMediaRef(s))
+		}
+		if len(page) < idCheckPageSize {
+			break
+		}
+		lastID = page[len(page)-1].ID
+	}
+
+	lastID = 0
+	for {
+		page, err := client.Episode.Query().
+			Where(episode.IDGT(lastID)).
+			Order(ent.Asc(episode.FieldID)).
+			Limit(idCheckPageSize).
+			Select(episode.FieldEpisodeID, episode.FieldEpisodeNumber, episode.FieldMedia).
+			WithSeason(func(q *ent.SeasonQuery) {
+				q.Select(season.FieldSeasonID, season.FieldSeasonNumber).
+					WithSeries(func(q *ent.SeriesQuery) { q.Select(series.FieldSeriesID) })
+			}).
+			WithRenditions().
+			WithSubtitles().
+			WithAudioTracks().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range page {
+			report.Checked.Episodes++
+			add(types.KindEpisode, e.EpisodeID, utils.EpisodeMediaRef(e, e.Edges.Season))
+			for _, r := range e.Edges.Renditions {
+				addKey(types.KindEpisode, e.EpisodeID, "rendition:"+r.Name, r.Path)
+			}
+			for _, s := range e.Edges.Subtitles {
+				addKey(types.KindEpisode, e.EpisodeID, "subtitle:"+strconv.Itoa(s.ID), s.Path)
+			}
+			for _, a := range e.Edges.AudioTracks {
+				addKey(types.KindEpisode, e.EpisodeID, "audio_track:"+strconv.Itoa(a.ID), a.Path)
+			}
+		}
+		if len(page) < idCheckPageSize {
+			break
+		}
+		lastID = page[len(page)-1].ID
+	}
+	return expected, nil
+}
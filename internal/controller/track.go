@@ -0,0 +1,377 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/audiotrack"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/subtitle"
+	"github.com/clustlight/animatrix-api/internal/media"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+	"github.com/clustlight/animatrix-api/internal/webvtt"
+)
+
+// maxSubtitleBytes bounds the subtitle files converted to WebVTT.
+const maxSubtitleBytes = 10 << 20
+
+// ObjectReader fetches stored objects; storage.Config implements it.
+type ObjectReader interface {
+	Open(ctx context.Context, key string, a media.Asset) (io.ReadCloser, error)
+}
+
+func newSubtitleCreate(client *ent.Client, req *types.SubtitleRequest, parent *ent.Episode) *ent.SubtitleCreate {
+	return client.Subtitle.Create().
+		SetLanguage(req.Language).
+		SetFormat(req.Format).
+		SetLabel(req.Label).
+		SetIsDefault(req.Default).
+		SetPath(req.Path).
+		SetEpisode(parent)
+}
+
+func newAudioTrackCreate(client *ent.Client, req *types.AudioTrackRequest, parent *ent.Episode) *ent.AudioTrackCreate {
+	return client.AudioTrack.Create().
+		SetLanguage(req.Language).
+		SetFormat(req.Format).
+		SetLabel(req.Label).
+		SetIsDefault(req.Default).
+		SetPath(req.Path).
+		SetEpisode(parent)
+}
+
+// createTracks inserts the subtitles and audio tracks of reqs[i] for
+// episodes[i] and sets them as its edges.
+func createTracks(ctx context.Context, client *ent.Client, episodes []*ent.Episode, reqs []types.CreateEpisodeRequest) error {
+	var (
+		subtitles []*ent.SubtitleCreate
+		audio     []*ent.AudioTrackCreate
+	)
+	for i, e := range episodes {
+		for j := range reqs[i].Subtitles {
+			subtitles = append(subtitles, newSubtitleCreate(client, &reqs[i].Subtitles[j], e))
+		}
+		for j := range reqs[i].AudioTracks {
+			audio = append(audio, newAudioTrackCreate(client, &reqs[i].AudioTracks[j], e))
+		}
+	}
+	if len(subtitles) > 0 {
+		created, err := client.Subtitle.CreateBulk(subtitles...).Save(ctx)
+		if err != nil {
+			return err
+		}
+		for i, e := range episodes {
+			e.Edges.Subtitles, created = created[:len(reqs[i].Subtitles)], created[len(reqs[i].Subtitles):]
+		}
+	}
+	if len(audio) > 0 {
+		created, err := client.AudioTrack.CreateBulk(audio...).Save(ctx)
+		if err != nil {
+			return err
+		}
+		for i, e := range episodes {
+			e.Edges.AudioTracks, created = created[:len(reqs[i].AudioTracks)], created[len(reqs[i].AudioTracks):]
+		}
+	}
+	return nil
+}
+
+// rowID parses the numeric ID of a subtitle, audio track or watchlist;
+// IDs that cannot exist are reported as not found.
+func rowID(kind, id string) (int, error) {
+	n, err := strconv.Atoi(id)
+	if err != nil || n <= 0 {
+		return 0, NotFound(kind + " not found")
+	}
+	return n, nil
+}
+
+// touchEpisode marks an episode as changed along with its tracks, so its
+// updated_at follows them.
+func touchEpisode(ctx context.Context, tx *ent.Tx, e *ent.Episode) error {
+	return tx.Episode.UpdateOne(e).SetUpdatedAt(time.Now()).Exec(ctx)
+}
+
+func GetSubtitles(ctx context.Context, client *ent.Client, episodeID string) ([]types.SubtitleResponse, error) {
+	e, err := client.Episode.Query().
+		Where(episode.EpisodeIDEQ(episodeID)).
+		WithSubtitles().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resps := utils.BuildSubtitleResponses(e.Edges.Subtitles)
+	if resps == nil {
+		resps = []types.SubtitleResponse{}
+	}
+	return resps, nil
+}
+
+func getSubtitle(ctx context.Context, client *ent.Client, episodeID, id string) (*ent.Subtitle, error) {
+	n, err := rowID("subtitle", id)
+	if err != nil {
+		return nil, err
+	}
+	return client.Subtitle.Query().
+		Where(subtitle.ID(n), subtitle.HasEpisodeWith(episode.EpisodeIDEQ(episodeID))).
+		WithEpisode().
+		Only(ctx)
+}
+
+func GetSubtitle(ctx context.Context, client *ent.Client, episodeID, id string) (*types.SubtitleResponse, error) {
+	s, err := getSubtitle(ctx, client, episodeID, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := utils.BuildSubtitleResponse(s)
+	return &resp, nil
+}
+
+// CreateSubtitle adds a subtitle to an episode. A new default subtitle
+// replaces the previous one.
+func CreateSubtitle(ctx context.Context, client *ent.Client, episodeID string, req *types.SubtitleRequest) (*types.SubtitleResponse, error) {
+	e, err := client.Episode.Query().Where(episode.EpisodeIDEQ(episodeID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var created *ent.Subtitle
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		if req.Default {
+			if err := tx.Subtitle.Update().
+				Where(subtitle.HasEpisodeWith(episode.ID(e.ID)), subtitle.IsDefault(true)).
+				SetIsDefault(false).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		var err error
+		if created, err = newSubtitleCreate(tx.Client(), req, e).Save(ctx); err != nil {
+			return err
+		}
+		return touchEpisode(ctx, tx, e)
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := utils.BuildSubtitleResponse(created)
+	return &resp, nil
+}
+
+func UpdateSubtitle(ctx context.Context, client *ent.Client, episodeID, id string, req *types.UpdateSubtitleRequest) (*types.SubtitleResponse, error) {
+	s, err := getSubtitle(ctx, client, episodeID, id)
+	if err != nil {
+		return nil, err
+	}
+	var updated *ent.Subtitle
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		update := tx.Subtitle.UpdateOne(s)
+		if req.Language != nil {
+			update.SetLanguage(*req.Language)
+		}
+		if req.Format != nil {
+			update.SetFormat(*req.Format)
+		}
+		if req.Label != nil {
+			update.SetLabel(*req.Label)
+		}
+		if req.Path != nil {
+			update.SetPath(*req.Path)
+		}
+		if req.Default != nil {
+			update.SetIsDefault(*req.Default)
+			if *req.Default {
+				if err := tx.Subtitle.Update().
+					Where(subtitle.HasEpisodeWith(episode.ID(s.Edges.Episode.ID)), subtitle.IDNEQ(s.ID), subtitle.IsDefault(true)).
+					SetIsDefault(false).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+		}
+		var err error
+		if updated, err = update.Save(ctx); err != nil {
+			return err
+		}
+		return touchEpisode(ctx, tx, s.Edges.Episode)
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := utils.BuildSubtitleResponse(updated)
+	return &resp, nil
+}
+
+func DeleteSubtitle(ctx context.Context, client *ent.Client, episodeID, id string) error {
+	s, err := getSubtitle(ctx, client, episodeID, id)
+	if err != nil {
+		return err
+	}
+	return withTx(ctx, client, func(tx *ent.Tx) error {
+		if err := tx.Subtitle.DeleteOne(s).Exec(ctx); err != nil {
+			return err
+		}
+		return touchEpisode(ctx, tx, s.Edges.Episode)
+	})
+}
+
+// SubtitleWebVTT fetches a subtitle file from the object storage and
+// converts it to WebVTT. It also returns when the subtitle last changed.
+// A subtitle whose path is an absolute URL is never fetched: when it is
+// already WebVTT, location is that URL to send the client to instead.
+func SubtitleWebVTT(ctx context.Context, client *ent.Client, store ObjectReader, episodeID, id string) (vtt, location string, modified time.Time, err error) {
+	s, err := getSubtitle(ctx, client, episodeID, id)
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+	if u, err := url.Parse(s.Path); err == nil && u.IsAbs() {
+		if s.Format == types.SubtitleVTT {
+			return "", s.Path, s.UpdatedAt, nil
+		}
+		return "", "", time.Time{}, &Error{Code: CodeUnprocessable, Message: "only subtitle files in the object storage are converted; this one is at an absolute URL"}
+	}
+	body, err := store.Open(ctx, s.Path, media.Video)
+	if err != nil {
+		return "", "", time.Time{}, Unavailable("could not fetch the subtitle file", err)
+	}
+	defer body.Close()
+	src, err := io.ReadAll(io.LimitReader(body, maxSubtitleBytes+1))
+	if err != nil {
+		return "", "", time.Time{}, Unavailable("could not fetch the subtitle file", err)
+	}
+	if len(src) > maxSubtitleBytes {
+		return "", "", time.Time{}, &Error{Code: CodeInternal, Message: fmt.Sprintf("subtitle file is larger than %d MiB", maxSubtitleBytes>>20)}
+	}
+	vtt, err = webvtt.Convert(s.Format, src)
+	if err != nil {
+		return "", "", time.Time{}, &Error{Code: CodeInternal, Message: "could not convert the subtitle file", Err: err}
+	}
+	return vtt, "", s.UpdatedAt, nil
+}
+
+func GetAudioTracks(ctx context.Context, client *ent.Client, episodeID string) ([]types.AudioTrackResponse, error) {
+	e, err := client.Episode.Query().
+		Where(episode.EpisodeIDEQ(episodeID)).
+		WithAudioTracks().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resps := utils.BuildAudioTrackResponses(e.Edges.AudioTracks)
+	if resps == nil {
+		resps = []types.AudioTrackResponse{}
+	}
+	return resps, nil
+}
+
+func getAudioTrack(ctx context.Context, client *ent.Client, episodeID, id string) (*ent.AudioTrack, error) {
+	n, err := rowID("audio track", id)
+	if err != nil {
+		return nil, err
+	}
+	return client.AudioTrack.Query().
+		Where(audiotrack.ID(n), audiotrack.HasEpisodeWith(episode.EpisodeIDEQ(episodeID))).
+		WithEpisode().
+		Only(ctx)
+}
+
+func GetAudioTrack(ctx context.Context, client *ent.Client, episodeID, id string) (*types.AudioTrackResponse, error) {
+	a, err := getAudioTrack(ctx, client, episodeID, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := utils.BuildAudioTrackResponse(a)
+	return &resp, nil
+}
+
+// CreateAudioTrack adds an audio track to an episode. A new default track
+// replaces the previous one.
+func CreateAudioTrack(ctx context.Context, client *ent.Client, episodeID string, req *types.AudioTrackRequest) (*types.AudioTrackResponse, error) {
+	e, err := client.Episode.Query().Where(episode.EpisodeIDEQ(episodeID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var created *ent.AudioTrack
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		if req.Default {
+			if err := tx.AudioTrack.Update().
+				Where(audiotrack.HasEpisodeWith(episode.ID(e.ID)), audiotrack.IsDefault(true)).
+				SetIsDefault(false).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		var err error
+		if created, err = newAudioTrackCreate(tx.Client(), req, e).Save(ctx); err != nil {
+			return err
+		}
+		return touchEpisode(ctx, tx, e)
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := utils.BuildAudioTrackResponse(created)
+	return &resp, nil
+}
+
+func UpdateAudioTrack(ctx context.Context, client *ent.Client, episodeID, id string, req *types.UpdateAudioTrackRequest) (*types.AudioTrackResponse, error) {
+	a, err := getAudioTrack(ctx, client, episodeID, id)
+	if err != nil {
+		return nil, err
+	}
+	var updated *ent.AudioTrack
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		update := tx.AudioTrack.UpdateOne(a)
+		if req.Language != nil {
+			update.SetLanguage(*req.Language)
+		}
+		if req.Format != nil {
+			update.SetFormat(*req.Format)
+		}
+		if req.Label != nil {
+			update.SetLabel(*req.Label)
+		}
+		if req.Path != nil {
+			update.SetPath(*req.Path)
+		}
+		if req.Default != nil {
+			update.SetIsDefault(*req.Default)
+			if *req.Default {
+				if err := tx.AudioTrack.Update().
+					Where(audiotrack.HasEpisodeWith(episode.ID(a.Edges.Episode.ID)), audiotrack.IDNEQ(a.ID), audiotrack.IsDefault(true)).
+					SetIsDefault(false).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+		}
+		var err error
+		if updated, err = update.Save(ctx); err != nil {
+			return err
+		}
+		return touchEpisode(ctx, tx, a.Edges.Episode)
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := utils.BuildAudioTrackResponse(updated)
+	return &resp, nil
+}
+
+func DeleteAudioTrack(ctx context.Context, client *ent.Client, episodeID, id string) error {
+	a, err := getAudioTrack(ctx, client, episodeID, id)
+	if err != nil {
+		return err
+	}
+	return withTx(ctx, client, func(tx *ent.Tx) error {
+		if err := tx.AudioTrack.DeleteOne(a).Exec(ctx); err != nil {
+			return err
+		}
+		return touchEpisode(ctx, tx, a.Edges.Episode)
+	})
+}
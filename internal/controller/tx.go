@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/user"
+)
+
+// withTx runs fn in a transaction, rolling back when fn fails or panics.
+// Inside Locked, fn joins the transaction Locked started instead.
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return fn(tx)
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// A Lock takes a row lock (SELECT ... FOR UPDATE) that serializes the
+// writes of a resource. A missing row is not an error; nothing is locked.
+type Lock func(ctx context.Context, tx *ent.Tx) error
+
+func LockSeries(seriesID string) Lock {
+	return func(ctx context.Context, tx *ent.Tx) error {
+		_, err := tx.Series.Query().Where(series.SeriesIDEQ(seriesID)).ForUpdate().IDs(ctx)
+		return err
+	}
+}
+
+func LockSeason(seasonID string) Lock {
+	return func(ctx context.Context, tx *ent.Tx) error {
+		_, err := tx.Season.Query().Where(season.SeasonIDEQ(seasonID)).ForUpdate().IDs(ctx)
+		return err
+	}
+}
+
+// LockEpisode also covers the renditions, tracks and chapters of the
+// episode, whose writes touch it.
+func LockEpisode(episodeID string) Lock {
+	return func(ctx context.Context, tx *ent.Tx) error {
+		_, err := tx.Episode.Query().Where(episode.EpisodeIDEQ(episodeID)).ForUpdate().IDs(ctx)
+		return err
+	}
+}
+
+// LockUser covers the progress, favorites, ratings and watchlists of the
+// user, which may not exist yet.
+func LockUser(u *ent.User) Lock {
+	return func(ctx context.Context, tx *ent.Tx) error {
+		_, err := tx.User.Query().Where(user.ID(u.ID)).ForUpdate().IDs(ctx)
+		return err
+	}
+}
+
+// Locked runs fn in a transaction that holds lock. fn gets a client bound
+// to the transaction; the controller functions it calls with that client
+// and ctx join the transaction, so what fn reads stays current until it
+// commits.
+func Locked(ctx context.Context, client *ent.Client, lock Lock, fn func(ctx context.Context, client *ent.Client) error) error {
+	return withTx(ctx, client, func(tx *ent.Tx) error {
+		ctx := ent.NewTxContext(ctx, tx)
+		if err := lock(ctx, tx); err != nil {
+			return err
+		}
+		return fn(ctx, tx.Client())
+	})
+}
@@ -0,0 +1,177 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"maps"
+	"path"
+	"strings"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/internal/media"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+// Limits of uploaded images. Converting an image decodes it completely,
+// so the side length also bounds the memory used.
+const (
+	maxImageBytes = 10 << 20
+	minImageSide  = 32
+	maxImageSide  = 4096
+)
+
+// imageFormats maps the extensions uploads can be converted to to their
+// image.DecodeConfig format names.
+var imageFormats = map[string]string{
+	".png":  "png",
+	".jpg":  "jpeg",
+	".jpeg": "jpeg",
+	".gif":  "gif",
+}
+
+// ObjectWriter stores objects; storage.Bucket implements it.
+type ObjectWriter interface {
+	Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error
+}
+
+// UploadImage stores a PNG, JPEG or GIF as asset a of an entity at the key
+// the media layout gives it, converting it when the key's extension asks
+// for another format. A media override for the asset is removed so the
+// upload is what gets served.
+func UploadImage(ctx context.Context, client *ent.Client, store ObjectWriter, kind media.Kind, id string, a media.Asset, body io.Reader) (*types.ImageUploadResponse, error) {
+	data, err := io.ReadAll(io.LimitReader(body, maxImageBytes+1))
+	if err != nil {
+		return nil, BadRequest("could not read the image")
+	}
+	if len(data) > maxImageBytes {
+		return nil, BadRequest(fmt.Sprintf("image is larger than %d MiB", maxImageBytes>>20))
+	}
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, &Error{Code: CodeUnsupportedMediaType, Message: "body must be a PNG, JPEG or GIF image"}
+	}
+	if cfg.Width < minImageSide || cfg.Height < minImageSide || cfg.Width > maxImageSide || cfg.Height > maxImageSide {
+		return nil, ValidationFailed(types.FieldError{
+			Field:   "image",
+			Message: fmt.Sprintf("is %dx%d; width and height must be between %d and %d pixels", cfg.Width, cfg.Height, minImageSide, maxImageSide),
+		})
+	}
+
+	ref, setMedia, err := imageOwner(ctx, client, kind, id)
+	if err != nil {
+		return nil, err
+	}
+	overrides := ref.Overrides
+	ref.Overrides = nil
+	key := media.Current().Path(ref, a)
+	if key == "" {
+		return nil, BadRequest(fmt.Sprintf("the media layout has no key for the %s %s", kind, a))
+	}
+	if target := imageFormats[strings.ToLower(path.Ext(key))]; target != "" && target != format {
+		if data, err = convertImage(data, target); err != nil {
+			return nil, ValidationFailed(types.FieldError{Field: "image", Message: "could not be decoded: " + err.Error()})
+		}
+		format = target
+	}
+
+	contentType := "image/" + format
+	if err := store.Put(ctx, key, contentType, bytes.NewReader(data), int64(len(data))); err != nil {
+		return nil, Unavailable("object storage request failed", err)
+	}
+	if overrides[string(a)] != "" {
+		overrides = maps.Clone(overrides)
+		delete(overrides, string(a))
+		if err := setMedia(overrides); err != nil {
+			return nil, err
+		}
+	}
+
+	ref.Overrides = overrides
+	url, urls := utils.ImageURLs(ref, a)
+	return &types.ImageUploadResponse{
+		Key:         key,
+		ContentType: contentType,
+		Width:       cfg.Width,
+		Height:      cfg.Height,
+		URL:         url,
+		URLs:        urls,
+	}, nil
+}
+
+// imageOwner loads the media ref of an entity and a func replacing its
+// media overrides.
+func imageOwner(ctx context.Context, client *ent.Client, kind media.Kind, id string) (media.Ref, func(map[string]string) error, error) {
+	switch kind {
+	case media.Series:
+		s, err := client.Series.Query().Where(series.SeriesIDEQ(id)).Only(ctx)
+		if err != nil {
+			return media.Ref{}, nil, err
+		}
+		return utils.SeriesMediaRef(s), func(m map[string]string) error {
+			upd := s.Update()
+			if len(m) == 0 {
+				upd.ClearMedia()
+			} else {
+				upd.SetMedia(m)
+			}
+			return upd.Exec(ctx)
+		}, nil
+	case media.Season:
+		s, err := client.Season.Query().Where(season.SeasonIDEQ(id)).WithSeries().Only(ctx)
+		if err != nil {
+			return media.Ref{}, nil, err
+		}
+		return utils.SeasonMediaRef(s), func(m map[string]string) error {
+			upd := s.Update()
+			if len(m) == 0 {
+				upd.ClearMedia()
+			} else {
+				upd.SetMedia(m)
+			}
+			return upd.Exec(ctx)
+		}, nil
+	case media.Episode:
+		e, err := withEpisodeParents(client.Episode.Query().Where(episode.EpisodeIDEQ(id))).Only(ctx)
+		if err != nil {
+			return media.Ref{}, nil, err
+		}
+		return utils.EpisodeMediaRef(e, e.Edges.Season), func(m map[string]string) error {
+			upd := e.Update()
+			if len(m) == 0 {
+				upd.ClearMedia()
+			} else {
+				upd.SetMedia(m)
+			}
+			return upd.Exec(ctx)
+		}, nil
+	}
+	return media.Ref{}, nil, fmt.Errorf("no images for %s", kind)
+}
+
+// convertImage re-encodes data as format, a value of imageFormats.
+func convertImage(data []byte, format string) ([]byte, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	switch format {
+	case "png":
+		err = png.Encode(&buf, img)
+	case "jpeg":
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90})
+	case "gif":
+		err = gif.Encode(&buf, img, nil)
+	}
+	return buf.Bytes(), err
+}
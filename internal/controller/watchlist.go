@@ -0,0 +1,182 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/ent/user"
+	"github.com/clustlight/animatrix-api/ent/watchlist"
+	"github.com/clustlight/animatrix-api/ent/watchlistitem"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+// watchlistItemOf matches the item of watchlist w on the series or season
+// of the given kind.
+func watchlistItemOf(w *ent.Watchlist, kind, id string) predicate.WatchlistItem {
+	item := watchlistitem.HasSeriesWith(series.SeriesIDEQ(id))
+	if kind == types.ItemSeason {
+		item = watchlistitem.HasSeasonWith(season.SeasonIDEQ(id))
+	}
+	return watchlistitem.And(watchlistitem.HasWatchlistWith(watchlist.ID(w.ID)), item)
+}
+
+func withWatchlistItem(q *ent.WatchlistItemQuery) *ent.WatchlistItemQuery {
+	return q.WithSeries().WithSeason(func(sq *ent.SeasonQuery) { sq.WithSeries() })
+}
+
+func withWatchlistItems(q *ent.WatchlistQuery) *ent.WatchlistQuery {
+	return q.WithItems(func(iq *ent.WatchlistItemQuery) {
+		withWatchlistItem(iq).Order(ent.Desc(watchlistitem.FieldCreatedAt), ent.Desc(watchlistitem.FieldID))
+	})
+}
+
+// findWatchlist loads the watchlist of u with the given ID.
+func findWatchlist(ctx context.Context, client *ent.Client, u *ent.User, id string) (*ent.Watchlist, error) {
+	n, err := rowID("watchlist", id)
+	if err != nil {
+		return nil, err
+	}
+	w, err := client.Watchlist.Query().
+		Where(watchlist.ID(n), watchlist.HasUserWith(user.ID(u.ID))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, NotFound("watchlist not found")
+	}
+	return w, err
+}
+
+// watchlistNameTaken reports a create or rename clashing with another
+// watchlist of the user.
+func watchlistNameTaken(err error, name string) error {
+	if ent.IsConstraintError(err) {
+		return &Error{Code: CodeConflict, Message: "a watchlist named " + name + " already exists", Err: err}
+	}
+	return err
+}
+
+// touchWatchlist marks a watchlist as changed along with its items.
+func touchWatchlist(ctx context.Context, client *ent.Client, w *ent.Watchlist) error {
+	return client.Watchlist.UpdateOne(w).SetUpdatedAt(time.Now()).Exec(ctx)
+}
+
+// GetWatchlists lists the watchlists of u by name.
+func GetWatchlists(ctx context.Context, client *ent.Client, u *ent.User) ([]types.WatchlistResponse, error) {
+	lists, err := withWatchlistItems(client.Watchlist.Query()).
+		Where(watchlist.HasUserWith(user.ID(u.ID))).
+		Order(ent.Asc(watchlist.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resps := make([]types.WatchlistResponse, 0, len(lists))
+	for _, w := range lists {
+		resps = append(resps, utils.BuildWatchlistResponse(w))
+	}
+	return resps, nil
+}
+
+func GetWatchlist(ctx context.Context, client *ent.Client, u *ent.User, id string) (*types.WatchlistResponse, error) {
+	w, err := findWatchlist(ctx, client, u, id)
+	if err != nil {
+		return nil, err
+	}
+	w, err = withWatchlistItems(client.Watchlist.Query().Where(watchlist.ID(w.ID))).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := utils.BuildWatchlistResponse(w)
+	return &resp, nil
+}
+
+func CreateWatchlist(ctx context.Context, client *ent.Client, u *ent.User, req *types.WatchlistRequest) (*types.WatchlistResponse, error) {
+	w, err := client.Watchlist.Create().SetUser(u).SetName(req.Name).Save(ctx)
+	if err != nil {
+		return nil, watchlistNameTaken(err, req.Name)
+	}
+	resp := utils.BuildWatchlistResponse(w)
+	return &resp, nil
+}
+
+func RenameWatchlist(ctx context.Context, client *ent.Client, u *ent.User, id string, req *types.WatchlistRequest) (*types.WatchlistResponse, error) {
+	w, err := findWatchlist(ctx, client, u, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Watchlist.UpdateOne(w).SetName(req.Name).Exec(ctx); err != nil {
+		return nil, watchlistNameTaken(err, req.Name)
+	}
+	return GetWatchlist(ctx, client, u, id)
+}
+
+func DeleteWatchlist(ctx context.Context, client *ent.Client, u *ent.User, id string) error {
+	w, err := findWatchlist(ctx, client, u, id)
+	if err != nil {
+		return err
+	}
+	return client.Watchlist.DeleteOne(w).Exec(ctx)
+}
+
+func GetWatchlistItem(ctx context.Context, client *ent.Client, u *ent.User, id, kind, itemID string) (*types.WatchlistItemResponse, error) {
+	w, err := findWatchlist(ctx, client, u, id)
+	if err != nil {
+		return nil, err
+	}
+	it, err := withWatchlistItem(client.WatchlistItem.Query()).Where(watchlistItemOf(w, kind, itemID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, NotFound(kind + " " + itemID + " is not on the watchlist")
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := utils.BuildWatchlistItemResponse(it)
+	return &resp, nil
+}
+
+// AddWatchlistItem puts a series or season on a watchlist of u. created is
+// false when it already was on it.
+func AddWatchlistItem(ctx context.Context, client *ent.Client, u *ent.User, id, kind, itemID string) (resp *types.WatchlistItemResponse, created bool, err error) {
+	w, err := findWatchlist(ctx, client, u, id)
+	if err != nil {
+		return nil, false, err
+	}
+	item, err := findItem(ctx, client, kind, itemID)
+	if err != nil {
+		return nil, false, err
+	}
+	seriesID, seasonID := item.ids()
+	_, err = client.WatchlistItem.Create().
+		SetWatchlist(w).
+		SetNillableSeriesID(seriesID).
+		SetNillableSeasonID(seasonID).
+		Save(ctx)
+	if err != nil && !ent.IsConstraintError(err) {
+		return nil, false, err
+	}
+	if created = err == nil; created {
+		if err := touchWatchlist(ctx, client, w); err != nil {
+			return nil, false, err
+		}
+	}
+	resp, err = GetWatchlistItem(ctx, client, u, id, kind, itemID)
+	return resp, created, err
+}
+
+func RemoveWatchlistItem(ctx context.Context, client *ent.Client, u *ent.User, id, kind, itemID string) error {
+	w, err := findWatchlist(ctx, client, u, id)
+	if err != nil {
+		return err
+	}
+	n, err := client.WatchlistItem.Delete().Where(watchlistItemOf(w, kind, itemID)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return NotFound(kind + " " + itemID + " is not on the watchlist")
+	}
+	return touchWatchlist(ctx, client, w)
+}
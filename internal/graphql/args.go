@@ -0,0 +1,92 @@
+package graphql
+
+import (
+	"time"
+)
+
+// Args holds the resolved arguments of a field, or the fields of an input
+// object. Absent and null arguments are left out.
+type Args map[string]any
+
+func (a Args) Int(name string) (int, bool, error) {
+	switch v := a[name].(type) {
+	case nil:
+		return 0, false, nil
+	case int64:
+		return int(v), true, nil
+	}
+	return 0, false, inputErrorf("argument %q must be an Int", name)
+}
+
+func (a Args) Bool(name string) (bool, bool, error) {
+	switch v := a[name].(type) {
+	case nil:
+		return false, false, nil
+	case bool:
+		return v, true, nil
+	}
+	return false, false, inputErrorf("argument %q must be a Boolean", name)
+}
+
+func (a Args) String(name string) (string, bool, error) {
+	switch v := a[name].(type) {
+	case nil:
+		return "", false, nil
+	case string:
+		return v, true, nil
+	}
+	return "", false, inputErrorf("argument %q must be a String", name)
+}
+
+func (a Args) Time(name string) (time.Time, bool, error) {
+	s, ok, err := a.String(name)
+	if !ok || err != nil {
+		return time.Time{}, ok, err
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, false, inputErrorf("argument %q must be an RFC 3339 time", name)
+	}
+	return t, true, nil
+}
+
+func (a Args) Strings(name string) ([]string, bool, error) {
+	switch v := a[name].(type) {
+	case nil:
+		return nil, false, nil
+	case string:
+		return []string{v}, true, nil // input coercion of a single value
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false, inputErrorf("argument %q must be a list of String", name)
+			}
+			out = append(out, s)
+		}
+		return out, true, nil
+	}
+	return nil, false, inputErrorf("argument %q must be a list of String", name)
+}
+
+// Object returns an input object argument, checking its fields against
+// the accepted names.
+func (a Args) Object(name string, fields ...string) (Args, error) {
+	switch v := a[name].(type) {
+	case nil:
+		return Args{}, nil
+	case map[string]any:
+		for k := range v {
+			known := false
+			for _, f := range fields {
+				known = known || f == k
+			}
+			if !known {
+				return nil, inputErrorf("unknown field %q in argument %q", k, name)
+			}
+		}
+		return Args(v), nil
+	}
+	return nil, inputErrorf("argument %q must be an input object", name)
+}
@@ -0,0 +1,43 @@
+package graphql
+
+import "encoding/json"
+
+// batch is the nodes of one list: a connection page, or the parents of
+// the nodes of another batch. Their nested fields are loaded for the whole
+// batch when the first node resolves them, with a query per field and
+// arguments rather than one per node.
+type batch struct {
+	ids    []int
+	nodes  []any
+	loaded map[string]*loaded
+}
+
+type loaded struct {
+	byID map[int]any
+	err  error
+}
+
+func (b *batch) add(id int, node any) {
+	b.ids = append(b.ids, id)
+	b.nodes = append(b.nodes, node)
+}
+
+// load returns the value of field, with args, for the node of row id.
+// fetch resolves the field for every node of the batch, by row ID.
+func (b *batch) load(field string, args Args, id int, fetch func() (map[int]any, error)) (any, error) {
+	key := field
+	if len(args) > 0 {
+		raw, _ := json.Marshal(args)
+		key += string(raw)
+	}
+	l, ok := b.loaded[key]
+	if !ok {
+		l = &loaded{}
+		l.byID, l.err = fetch()
+		if b.loaded == nil {
+			b.loaded = map[string]*loaded{}
+		}
+		b.loaded[key] = l
+	}
+	return l.byID[id], l.err
+}
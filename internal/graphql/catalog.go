@@ -0,0 +1,616 @@
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"net/http"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+// CatalogSDL is the catalog schema in the GraphQL schema language.
+//
+//go:embed schema.graphql
+var CatalogSDL string
+
+// Nodes pair the row, used for edges and cursors, with the REST response
+// built from it, so URLs are derived exactly as in the REST API. Nodes
+// listed together share a batch.
+type (
+	seriesNode struct {
+		row   *ent.Series
+		resp  types.SeriesResponse
+		batch *batch
+	}
+	seasonNode struct {
+		row   *ent.Season
+		resp  types.SeasonResponse
+		batch *batch
+	}
+	episodeNode struct {
+		row   *ent.Episode
+		resp  types.EpisodeResponse
+		batch *batch
+	}
+)
+
+func seriesNodes(rows []*ent.Series) []any {
+	b := &batch{}
+	for _, s := range rows {
+		b.add(s.ID, &seriesNode{s, utils.BuildSeriesResponse(s, false, false), b})
+	}
+	return b.nodes
+}
+
+func seasonNodes(rows []*ent.Season) []any {
+	b := &batch{}
+	for _, s := range rows {
+		b.add(s.ID, &seasonNode{s, utils.BuildSeasonResponse(s, false), b})
+	}
+	return b.nodes
+}
+
+func episodeNodes(rows []*ent.Episode) []any {
+	b := &batch{}
+	for _, e := range rows {
+		b.add(e.ID, &episodeNode{e, utils.BuildEpisodeResponse(e), b})
+	}
+	return b.nodes
+}
+
+func newSeriesNode(s *ent.Series) any { return seriesNodes([]*ent.Series{s})[0] }
+
+func newSeasonNode(s *ent.Season) any { return seasonNodes([]*ent.Season{s})[0] }
+
+func newEpisodeNode(e *ent.Episode) any { return episodeNodes([]*ent.Episode{e})[0] }
+
+func seriesRowID(s *ent.Series) int   { return s.ID }
+func seasonRowID(s *ent.Season) int   { return s.ID }
+func episodeRowID(e *ent.Episode) int { return e.ID }
+
+var (
+	seriesOrder = map[string]orderField{
+		"SERIES_ID":  stringField(series.FieldSeriesID, func(r any) string { return r.(*ent.Series).SeriesID }),
+		"TITLE":      stringField(series.FieldTitle, func(r any) string { return r.(*ent.Series).Title }),
+		"TITLE_YOMI": stringField(series.FieldTitleYomi, func(r any) string { return r.(*ent.Series).TitleYomi }),
+	}
+	seasonOrder = map[string]orderField{
+		"SEASON_ID":     stringField(season.FieldSeasonID, func(r any) string { return r.(*ent.Season).SeasonID }),
+		"SEASON_NUMBER": intField(season.FieldSeasonNumber, func(r any) int { return r.(*ent.Season).SeasonNumber }),
+		"FIRST_YEAR":    intField(season.FieldFirstYear, func(r any) int { return r.(*ent.Season).FirstYear }),
+	}
+	episodeOrder = map[string]orderField{
+		"EPISODE_ID":     stringField(episode.FieldEpisodeID, func(r any) string { return r.(*ent.Episode).EpisodeID }),
+		"EPISODE_NUMBER": intField(episode.FieldEpisodeNumber, func(r any) int { return r.(*ent.Episode).EpisodeNumber }),
+		"TIMESTAMP":      timeField(episode.FieldTimestamp, func(r any) time.Time { return r.(*ent.Episode).Timestamp }),
+	}
+)
+
+// NewCatalog returns the schema in schema.graphql resolved against client.
+func NewCatalog(client *ent.Client) *Schema {
+	c := &catalog{client: client}
+	seriesType := &Object{Name: "Series"}
+	seasonType := &Object{Name: "Season"}
+	episodeType := &Object{Name: "Episode"}
+	seriesConn := connectionType(seriesType)
+	seasonConn := connectionType(seasonType)
+	episodeConn := connectionType(episodeType)
+	renditionType := &Object{Name: "Rendition", Fields: map[string]*FieldDef{
+		"name":         prop(func(r types.RenditionResponse) any { return r.Name }),
+		"width":        prop(func(r types.RenditionResponse) any { return r.Width }),
+		"height":       prop(func(r types.RenditionResponse) any { return r.Height }),
+		"codecs":       prop(func(r types.RenditionResponse) any { return r.Codecs }),
+		"bitrate":      prop(func(r types.RenditionResponse) any { return r.Bitrate }),
+		"frameRate":    prop(func(r types.RenditionResponse) any { return r.FrameRate }),
+		"dynamicRange": prop(func(r types.RenditionResponse) any { return r.DynamicRange }),
+		"container":    prop(func(r types.RenditionResponse) any { return r.Container }),
+		"url":          prop(func(r types.RenditionResponse) any { return r.URL }),
+	}}
+	subtitleType := &Object{Name: "Subtitle", Fields: map[string]*FieldDef{
+		"id":        prop(func(t types.SubtitleResponse) any { return t.ID }),
+		"language":  prop(func(t types.SubtitleResponse) any { return t.Language }),
+		"format":    prop(func(t types.SubtitleResponse) any { return t.Format }),
+		"label":     prop(func(t types.SubtitleResponse) any { return t.Label }),
+		"default":   prop(func(t types.SubtitleResponse) any { return t.Default }),
+		"url":       prop(func(t types.SubtitleResponse) any { return t.URL }),
+		"updatedAt": prop(func(t types.SubtitleResponse) any { return t.UpdatedAt }),
+	}}
+	audioTrackType := &Object{Name: "AudioTrack", Fields: map[string]*FieldDef{
+		"id":        prop(func(t types.AudioTrackResponse) any { return t.ID }),
+		"language":  prop(func(t types.AudioTrackResponse) any { return t.Language }),
+		"format":    prop(func(t types.AudioTrackResponse) any { return t.Format }),
+		"label":     prop(func(t types.AudioTrackResponse) any { return t.Label }),
+		"default":   prop(func(t types.AudioTrackResponse) any { return t.Default }),
+		"url":       prop(func(t types.AudioTrackResponse) any { return t.URL }),
+		"updatedAt": prop(func(t types.AudioTrackResponse) any { return t.UpdatedAt }),
+	}}
+	chapterType := &Object{Name: "Chapter", Fields: map[string]*FieldDef{
+		"start":     prop(func(ch types.ChapterResponse) any { return ch.Start }),
+		"end":       prop(func(ch types.ChapterResponse) any { return ch.End }),
+		"kind":      prop(func(ch types.ChapterResponse) any { return ch.Kind }),
+		"title":     prop(func(ch types.ChapterResponse) any { return ch.Title }),
+		"updatedAt": prop(func(ch types.ChapterResponse) any { return ch.UpdatedAt }),
+	}}
+
+	seriesType.Fields = map[string]*FieldDef{
+		"seriesId":      prop(func(n *seriesNode) any { return n.resp.SeriesID }),
+		"title":         prop(func(n *seriesNode) any { return n.resp.Title }),
+		"titleYomi":     prop(func(n *seriesNode) any { return n.resp.TitleYomi }),
+		"titleEn":       prop(func(n *seriesNode) any { return n.resp.TitleEn }),
+		"description":   prop(func(n *seriesNode) any { return n.resp.Description }),
+		"thumbnailUrl":  prop(func(n *seriesNode) any { return n.resp.ThumbnailURL }),
+		"portraitUrl":   prop(func(n *seriesNode) any { return n.resp.PortraitURL }),
+		"updatedAt":     prop(func(n *seriesNode) any { return n.resp.UpdatedAt }),
+		"ratingAverage": c.seriesStat(func(r types.SeriesResponse) any { return r.RatingAverage }),
+		"ratingCount":   c.seriesStat(func(r types.SeriesResponse) any { return r.RatingCount }),
+		"favoriteCount": c.seriesStat(func(r types.SeriesResponse) any { return r.FavoriteCount }),
+		"seasons": {Args: connectionArgs, Type: seasonConn, Size: pageSize, Resolve: func(ctx context.Context, src any, args Args) (any, error) {
+			n := src.(*seriesNode)
+			return n.batch.load("seasons", args, n.row.ID, func() (map[int]any, error) {
+				return c.seasonsOf(ctx, args, n.batch.ids)
+			})
+		}},
+	}
+	seasonType.Fields = map[string]*FieldDef{
+		"seriesId":        prop(func(n *seasonNode) any { return n.resp.SeriesID }),
+		"seasonId":        prop(func(n *seasonNode) any { return n.resp.SeasonID }),
+		"seasonTitle":     prop(func(n *seasonNode) any { return n.resp.SeasonTitle }),
+		"seasonTitleYomi": prop(func(n *seasonNode) any { return n.resp.SeasonTitleYomi }),
+		"seasonNumber":    prop(func(n *seasonNode) any { return n.resp.SeasonNumber }),
+		"shoboiTid":       prop(func(n *seasonNode) any { return n.resp.ShoboiTID }),
+		"description":     prop(func(n *seasonNode) any { return n.resp.Description }),
+		"firstYear":       prop(func(n *seasonNode) any { return n.resp.FirstYear }),
+		"firstMonth":      prop(func(n *seasonNode) any { return n.resp.FirstMonth }),
+		"firstEndYear":    prop(func(n *seasonNode) any { return n.resp.FirstEndYear }),
+		"firstEndMonth":   prop(func(n *seasonNode) any { return n.resp.FirstEndMonth }),
+		"thumbnailUrl":    prop(func(n *seasonNode) any { return n.resp.ThumbnailURL }),
+		"updatedAt":       prop(func(n *seasonNode) any { return n.resp.UpdatedAt }),
+		"series": {Type: seriesType, Resolve: func(ctx context.Context, src any, _ Args) (any, error) {
+			n := src.(*seasonNode)
+			return n.batch.load("series", nil, n.row.ID, func() (map[int]any, error) {
+				return c.seriesOf(ctx, n.batch)
+			})
+		}},
+		"episodes": {Args: connectionArgs, Type: episodeConn, Size: pageSize, Resolve: func(ctx context.Context, src any, args Args) (any, error) {
+			n := src.(*seasonNode)
+			return n.batch.load("episodes", args, n.row.ID, func() (map[int]any, error) {
+				return c.episodesOf(ctx, args, n.batch.ids)
+			})
+		}},
+	}
+	episodeType.Fields = map[string]*FieldDef{
+		"episodeId":      prop(func(n *episodeNode) any { return n.resp.EpisodeID }),
+		"title":          prop(func(n *episodeNode) any { return n.resp.Title }),
+		"description":    prop(func(n *episodeNode) any { return n.resp.Description }),
+		"episodeNumber":  prop(func(n *episodeNode) any { return n.resp.EpisodeNumber }),
+		"duration":       prop(func(n *episodeNode) any { return n.resp.Duration }),
+		"durationString": prop(func(n *episodeNode) any { return n.resp.DurationString }),
+		"timestamp":      prop(func(n *episodeNode) any { return n.resp.Timestamp }),
+		"formatId":       prop(func(n *episodeNode) any { return n.resp.FormatID }),
+		"width":          prop(func(n *episodeNode) any { return n.resp.Width }),
+		"height":         prop(func(n *episodeNode) any { return n.resp.Height }),
+		"dynamicRange":   prop(func(n *episodeNode) any { return n.resp.DynamicRange }),
+		"videoUrl":       prop(func(n *episodeNode) any { return n.resp.VideoURL }),
+		"thumbnailUrl":   prop(func(n *episodeNode) any { return n.resp.ThumbnailURL }),
+		"updatedAt":      prop(func(n *episodeNode) any { return n.resp.UpdatedAt }),
+		"renditions":     c.episodeChildren(renditionType, func(r types.EpisodeResponse) any { return r.Renditions }),
+		"subtitles":      c.episodeChildren(subtitleType, func(r types.EpisodeResponse) any { return r.Subtitles }),
+		"audioTracks":    c.episodeChildren(audioTrackType, func(r types.EpisodeResponse) any { return r.AudioTracks }),
+		"chapters":       c.episodeChildren(chapterType, func(r types.EpisodeResponse) any { return r.Chapters }),
+		"season": {Type: seasonType, Resolve: func(ctx context.Context, src any, _ Args) (any, error) {
+			n := src.(*episodeNode)
+			return n.batch.load("season", nil, n.row.ID, func() (map[int]any, error) {
+				return c.seasonOf(ctx, n.batch)
+			})
+		}},
+	}
+
+	query := &Object{Name: "Query", Fields: map[string]*FieldDef{
+		"series": {Args: []string{"id"}, Type: seriesType, Resolve: func(ctx context.Context, _ any, args Args) (any, error) {
+			id, err := requiredID(args)
+			if err != nil {
+				return nil, err
+			}
+			s, err := client.Series.Query().Where(series.SeriesIDEQ(id)).Only(ctx)
+			return nodeOrNil(s, err, newSeriesNode)
+		}},
+		"seriesList": {Args: connectionArgs, Type: seriesConn, Size: pageSize, Resolve: func(ctx context.Context, _ any, args Args) (any, error) {
+			return c.seriesList(ctx, args)
+		}},
+		"season": {Args: []string{"id"}, Type: seasonType, Resolve: func(ctx context.Context, _ any, args Args) (any, error) {
+			id, err := requiredID(args)
+			if err != nil {
+				return nil, err
+			}
+			s, err := client.Season.Query().Where(season.SeasonIDEQ(id)).WithSeries().Only(ctx)
+			return nodeOrNil(s, err, newSeasonNode)
+		}},
+		"seasons": {Args: connectionArgs, Type: seasonConn, Size: pageSize, Resolve: func(ctx context.Context, _ any, args Args) (any, error) {
+			return c.seasons(ctx, args)
+		}},
+		"episode": {Args: []string{"id"}, Type: episodeType, Resolve: func(ctx context.Context, _ any, args Args) (any, error) {
+			id, err := requiredID(args)
+			if err != nil {
+				return nil, err
+			}
+			e, err := withEpisodeParents(client.Episode.Query()).Where(episode.EpisodeIDEQ(id)).Only(ctx)
+			return nodeOrNil(e, err, newEpisodeNode)
+		}},
+		"episodes": {Args: connectionArgs, Type: episodeConn, Size: pageSize, Resolve: func(ctx context.Context, _ any, args Args) (any, error) {
+			return c.episodes(ctx, args)
+		}},
+		// Search results are not paged; count them as a full page.
+		"search": {Args: []string{"query"}, Type: seriesType, Size: func(Args) int { return maxPageSize }, Resolve: c.search},
+	}}
+
+	return &Schema{Query: query, SDL: CatalogSDL, MaxDepth: DefaultMaxDepth, MaxCost: DefaultMaxCost, FormatError: formatError}
+}
+
+// formatError reports resolver errors with the same codes as the REST
+// problem responses.
+func formatError(err error) *Error {
+	p := controller.ToProblem(err)
+	msg := p.Detail
+	if msg == "" || p.Status == http.StatusInternalServerError {
+		msg = p.Title
+	}
+	return &Error{Message: msg, Extensions: map[string]any{"code": p.Code}}
+}
+
+func requiredID(args Args) (string, error) {
+	id, ok, err := args.String("id")
+	if err == nil && !ok {
+		err = inputErrorf("argument \"id\" is required")
+	}
+	return id, err
+}
+
+// nodeOrNil resolves a missing row to null rather than an error.
+func nodeOrNil[T any](row T, err error, node func(T) any) (any, error) {
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return node(row), nil
+}
+
+type catalog struct {
+	client *ent.Client
+}
+
+func (c *catalog) seriesList(ctx context.Context, args Args) (any, error) {
+	p, err := parsePage(args, seriesOrder)
+	if err != nil {
+		return nil, err
+	}
+	where, err := seriesWhere(args)
+	if err != nil {
+		return nil, err
+	}
+	q := c.client.Series.Query().Where(where...)
+	if ks := p.keyset(); ks != nil {
+		q.Where(predicate.Series(ks))
+	}
+	rows, err := q.Order(series.OrderOption(p.order())).Limit(p.limit()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := func(ctx context.Context) (int, error) {
+		return c.client.Series.Query().Where(where...).Count(ctx)
+	}
+	rows, more := pageRows(p, rows)
+	return connect(p, rows, more, seriesNodes(rows), seriesRowID, count), nil
+}
+
+func (c *catalog) seasons(ctx context.Context, args Args) (any, error) {
+	p, err := parsePage(args, seasonOrder)
+	if err != nil {
+		return nil, err
+	}
+	where, err := seasonWhere(args)
+	if err != nil {
+		return nil, err
+	}
+	q := c.client.Season.Query().Where(where...).WithSeries()
+	if ks := p.keyset(); ks != nil {
+		q.Where(predicate.Season(ks))
+	}
+	rows, err := q.Order(season.OrderOption(p.order())).Limit(p.limit()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := func(ctx context.Context) (int, error) {
+		return c.client.Season.Query().Where(where...).Count(ctx)
+	}
+	rows, more := pageRows(p, rows)
+	return connect(p, rows, more, seasonNodes(rows), seasonRowID, count), nil
+}
+
+// seasonsOf loads the seasons connections of the series with the given
+// row IDs.
+func (c *catalog) seasonsOf(ctx context.Context, args Args, seriesIDs []int) (map[int]any, error) {
+	p, err := parsePage(args, seasonOrder)
+	if err != nil {
+		return nil, err
+	}
+	where, err := seasonWhere(args)
+	if err != nil {
+		return nil, err
+	}
+	where = append(where, func(s *sql.Selector) {
+		s.Where(sql.InInts(s.C(season.SeriesColumn), seriesIDs...))
+	})
+	rows, err := c.client.Season.Query().
+		Where(p.perParent(season.Table, season.SeriesColumn, season.And(where...))).
+		WithSeries().
+		Order(season.OrderOption(p.order())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := groupCounts(func(ctx context.Context) (map[int]int, error) {
+		var rows []struct {
+			Series int `json:"series_seasons"`
+			Count  int `json:"count"`
+		}
+		err := c.client.Season.Query().Where(where...).GroupBy(season.SeriesColumn).Aggregate(ent.Count()).Scan(ctx, &rows)
+		counts := make(map[int]int, len(rows))
+		for _, r := range rows {
+			counts[r.Series] = r.Count
+		}
+		return counts, err
+	})
+	parent := func(s *ent.Season) int { return s.Edges.Series.ID }
+	return connectEach(p, seriesIDs, rows, parent, seasonRowID, seasonNodes, count), nil
+}
+
+func (c *catalog) episodes(ctx context.Context, args Args) (any, error) {
+	p, err := parsePage(args, episodeOrder)
+	if err != nil {
+		return nil, err
+	}
+	where, err := episodeWhere(args)
+	if err != nil {
+		return nil, err
+	}
+	q := withEpisodeParents(c.client.Episode.Query()).Where(where...)
+	if ks := p.keyset(); ks != nil {
+		q.Where(predicate.Episode(ks))
+	}
+	rows, err := q.Order(episode.OrderOption(p.order())).Limit(p.limit()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := func(ctx context.Context) (int, error) {
+		return c.client.Episode.Query().Where(where...).Count(ctx)
+	}
+	rows, more := pageRows(p, rows)
+	return connect(p, rows, more, episodeNodes(rows), episodeRowID, count), nil
+}
+
+// episodesOf loads the episodes connections of the seasons with the given
+// row IDs.
+func (c *catalog) episodesOf(ctx context.Context, args Args, seasonIDs []int) (map[int]any, error) {
+	p, err := parsePage(args, episodeOrder)
+	if err != nil {
+		return nil, err
+	}
+	where, err := episodeWhere(args)
+	if err != nil {
+		return nil, err
+	}
+	where = append(where, func(s *sql.Selector) {
+		s.Where(sql.InInts(s.C(episode.SeasonColumn), seasonIDs...))
+	})
+	rows, err := withEpisodeParents(c.client.Episode.Query()).
+		Where(p.perParent(episode.Table, episode.SeasonColumn, episode.And(where...))).
+		Order(episode.OrderOption(p.order())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := groupCounts(func(ctx context.Context) (map[int]int, error) {
+		var rows []struct {
+			Season int `json:"season_episodes"`
+			Count  int `json:"count"`
+		}
+		err := c.client.Episode.Query().Where(where...).GroupBy(episode.SeasonColumn).Aggregate(ent.Count()).Scan(ctx, &rows)
+		counts := make(map[int]int, len(rows))
+		for _, r := range rows {
+			counts[r.Season] = r.Count
+		}
+		return counts, err
+	})
+	parent := func(e *ent.Episode) int { return e.Edges.Season.ID }
+	return connectEach(p, seasonIDs, rows, parent, episodeRowID, episodeNodes, count), nil
+}
+
+// seriesOf resolves the series of a batch of seasons, as one batch.
+func (c *catalog) seriesOf(ctx context.Context, b *batch) (map[int]any, error) {
+	rows := make([]*ent.Season, 0, len(b.nodes))
+	var missing []int
+	for _, n := range b.nodes {
+		s := n.(*seasonNode).row
+		rows = append(rows, s)
+		if s.Edges.Series == nil {
+			missing = append(missing, s.ID)
+		}
+	}
+	if len(missing) > 0 {
+		loaded, err := c.client.Season.Query().Where(season.IDIn(missing...)).WithSeries().All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[int]*ent.Series, len(loaded))
+		for _, s := range loaded {
+			byID[s.ID] = s.Edges.Series
+		}
+		for _, s := range rows {
+			if s.Edges.Series == nil {
+				s.Edges.Series = byID[s.ID]
+			}
+		}
+	}
+	return parentsOf(rows, seasonRowID, func(s *ent.Season) *ent.Series { return s.Edges.Series }, seriesRowID, seriesNodes), nil
+}
+
+// seasonOf resolves the seasons of a batch of episodes, as one batch.
+func (c *catalog) seasonOf(ctx context.Context, b *batch) (map[int]any, error) {
+	rows := make([]*ent.Episode, 0, len(b.nodes))
+	var missing []int
+	for _, n := range b.nodes {
+		e := n.(*episodeNode).row
+		rows = append(rows, e)
+		if e.Edges.Season == nil {
+			missing = append(missing, e.ID)
+		}
+	}
+	if len(missing) > 0 {
+		loaded, err := withEpisodeParents(c.client.Episode.Query()).Where(episode.IDIn(missing...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[int]*ent.Season, len(loaded))
+		for _, e := range loaded {
+			byID[e.ID] = e.Edges.Season
+		}
+		for _, e := range rows {
+			if e.Edges.Season == nil {
+				e.Edges.Season = byID[e.ID]
+			}
+		}
+	}
+	return parentsOf(rows, episodeRowID, func(e *ent.Episode) *ent.Season { return e.Edges.Season }, seasonRowID, seasonNodes), nil
+}
+
+// parentsOf maps each row to the node of its parent, building one node per
+// distinct parent.
+func parentsOf[C, P any](rows []C, id func(C) int, parent func(C) P, parentID func(P) int, nodes func([]P) []any) map[int]any {
+	index := map[int]int{}
+	var parents []P
+	for _, r := range rows {
+		pid := parentID(parent(r))
+		if _, ok := index[pid]; !ok {
+			index[pid] = len(parents)
+			parents = append(parents, parent(r))
+		}
+	}
+	built := nodes(parents)
+	out := make(map[int]any, len(rows))
+	for _, r := range rows {
+		out[id(r)] = built[index[parentID(parent(r))]]
+	}
+	return out
+}
+
+// seriesStat is a rating or favorite aggregate; they are loaded for the
+// whole batch at once.
+func (c *catalog) seriesStat(get func(types.SeriesResponse) any) *FieldDef {
+	return &FieldDef{Resolve: func(ctx context.Context, src any, _ Args) (any, error) {
+		n := src.(*seriesNode)
+		_, err := n.batch.load("stats", nil, n.row.ID, func() (map[int]any, error) {
+			rows := make([]*ent.Series, 0, len(n.batch.nodes))
+			resps := make([]types.SeriesResponse, 0, len(n.batch.nodes))
+			for _, m := range n.batch.nodes {
+				rows = append(rows, m.(*seriesNode).row)
+				resps = append(resps, m.(*seriesNode).resp)
+			}
+			if err := controller.AddSeriesStats(ctx, c.client, rows, resps); err != nil {
+				return nil, err
+			}
+			for i, m := range n.batch.nodes {
+				m.(*seriesNode).resp = resps[i]
+			}
+			return nil, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return get(n.resp), nil
+	}}
+}
+
+// episodeChildren is the list of renditions, subtitles, audio tracks or
+// chapters of an episode; all four are loaded for the whole batch at once.
+func (c *catalog) episodeChildren(typ *Object, get func(types.EpisodeResponse) any) *FieldDef {
+	return &FieldDef{Type: typ, Resolve: func(ctx context.Context, src any, _ Args) (any, error) {
+		n := src.(*episodeNode)
+		_, err := n.batch.load("children", nil, n.row.ID, func() (map[int]any, error) {
+			rows, err := c.client.Episode.Query().Where(episode.IDIn(n.batch.ids...)).
+				WithRenditions().WithSubtitles().WithAudioTracks().WithChapters().
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[int]*ent.Episode, len(rows))
+			for _, e := range rows {
+				byID[e.ID] = e
+			}
+			for _, m := range n.batch.nodes {
+				m := m.(*episodeNode)
+				if e, ok := byID[m.row.ID]; ok {
+					m.row.Edges.Renditions, m.row.Edges.Subtitles = e.Edges.Renditions, e.Edges.Subtitles
+					m.row.Edges.AudioTracks, m.row.Edges.Chapters = e.Edges.AudioTracks, e.Edges.Chapters
+					m.resp = utils.BuildEpisodeResponse(m.row)
+				}
+			}
+			return nil, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return get(n.resp), nil
+	}}
+}
+
+// withEpisodeParents loads what episode media paths are derived from, as
+// the REST endpoints do.
+func withEpisodeParents(q *ent.EpisodeQuery) *ent.EpisodeQuery {
+	return q.WithSeason(func(sq *ent.SeasonQuery) { sq.WithSeries() })
+}
+
+// search runs the REST search and loads the matching rows in result order.
+func (c *catalog) search(ctx context.Context, _ any, args Args) (any, error) {
+	q, ok, err := args.String("query")
+	if err == nil && !ok {
+		err = inputErrorf("argument \"query\" is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+	found, err := controller.SearchSeries(ctx, c.client, q, nil)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(found))
+	for _, s := range found {
+		ids = append(ids, s.SeriesID)
+	}
+	rows, err := c.client.Series.Query().Where(series.SeriesIDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*ent.Series, len(rows))
+	for _, r := range rows {
+		byID[r.SeriesID] = r
+	}
+	ordered := make([]*ent.Series, 0, len(ids))
+	for _, id := range ids {
+		if r, ok := byID[id]; ok {
+			ordered = append(ordered, r)
+		}
+	}
+	return seriesNodes(ordered), nil
+}
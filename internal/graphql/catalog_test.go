@@ -0,0 +1,159 @@
+package graphql
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+var (
+	sdlStrings = regexp.MustCompile(`(?s)""".*?"""|"[^"\n]*"`)
+	sdlTypes   = regexp.MustCompile(`\btype (\w+)\s*\{([^}]*)\}`)
+	sdlArgs    = regexp.MustCompile(`\([^)]*\)`)
+	sdlFields  = regexp.MustCompile(`(\w+)\s*:`)
+)
+
+// sdlObjects returns the fields of the object types of CatalogSDL.
+func sdlObjects(t *testing.T) map[string][]string {
+	t.Helper()
+	sdl := sdlStrings.ReplaceAllString(CatalogSDL, "")
+	objects := map[string][]string{}
+	for _, m := range sdlTypes.FindAllStringSubmatch(sdl, -1) {
+		var fields []string
+		for _, f := range sdlFields.FindAllStringSubmatch(sdlArgs.ReplaceAllString(m[2], ""), -1) {
+			fields = append(fields, f[1])
+		}
+		slices.Sort(fields)
+		objects[m[1]] = fields
+	}
+	if len(objects) == 0 {
+		t.Fatal("no object types found in schema.graphql")
+	}
+	return objects
+}
+
+// resolvedObjects returns the fields of the object types reachable from
+// the query type.
+func resolvedObjects(s *Schema) map[string][]string {
+	objects := map[string][]string{}
+	var walk func(*Object)
+	walk = func(o *Object) {
+		if _, ok := objects[o.Name]; ok {
+			return
+		}
+		objects[o.Name] = nil
+		var fields []string
+		for name, def := range o.Fields {
+			fields = append(fields, name)
+			if def.Type != nil {
+				walk(def.Type)
+			}
+		}
+		slices.Sort(fields)
+		objects[o.Name] = fields
+	}
+	walk(s.Query)
+	return objects
+}
+
+func TestSchemaMatchesResolvers(t *testing.T) {
+	sdl := sdlObjects(t)
+	resolved := resolvedObjects(NewCatalog(nil))
+	for name, fields := range sdl {
+		if got, ok := resolved[name]; !ok {
+			t.Errorf("type %s is in schema.graphql but not resolved", name)
+		} else if !slices.Equal(got, fields) {
+			t.Errorf("type %s: schema.graphql has fields %v, resolvers %v", name, fields, got)
+		}
+	}
+	for name := range resolved {
+		if _, ok := sdl[name]; !ok {
+			t.Errorf("type %s is resolved but missing from schema.graphql", name)
+		}
+	}
+}
+
+// notExposed are REST response fields deliberately left out of the
+// schema: GraphQL has no map type for the imgproxy preset URLs.
+var notExposed = map[string]bool{
+	"Series.thumbnailUrls":  true,
+	"Series.portraitUrls":   true,
+	"Season.thumbnailUrls":  true,
+	"Episode.thumbnailUrls": true,
+}
+
+// TestSchemaCoversResponses fails when a field is added to a REST response
+// but not to the GraphQL type exposing the same object.
+func TestSchemaCoversResponses(t *testing.T) {
+	sdl := sdlObjects(t)
+	for name, resp := range map[string]any{
+		"Series":     types.SeriesResponse{},
+		"Season":     types.SeasonResponse{},
+		"Episode":    types.EpisodeResponse{},
+		"Rendition":  types.RenditionResponse{},
+		"Subtitle":   types.SubtitleResponse{},
+		"AudioTrack": types.AudioTrackResponse{},
+		"Chapter":    types.ChapterResponse{},
+	} {
+		rt := reflect.TypeOf(resp)
+		for i := range rt.NumField() {
+			tag, _, _ := strings.Cut(rt.Field(i).Tag.Get("json"), ",")
+			if tag == "" || tag == "-" {
+				continue
+			}
+			field := camelCase(tag)
+			if !notExposed[name+"."+field] && !slices.Contains(sdl[name], field) {
+				t.Errorf("%s.%s (%s.%s) is not in schema.graphql", name, field, rt.Name(), rt.Field(i).Name)
+			}
+		}
+	}
+}
+
+func camelCase(snake string) string {
+	parts := strings.Split(snake, "_")
+	for i := 1; i < len(parts); i++ {
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+	return strings.Join(parts, "")
+}
+
+func TestQueryCost(t *testing.T) {
+	s := NewCatalog(nil)
+	cost := func(query string) int {
+		t.Helper()
+		doc, err := Parse(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		e := &executor{schema: s, doc: doc}
+		return e.cost(s.Query, doc.Operations[0].Selections, 1, 1, s.MaxCost)
+	}
+	for _, tc := range []struct {
+		query string
+		want  int
+	}{
+		{`{ series(id: "a") { title } }`, 1},
+		{`{ seriesList(first: 10) { totalCount nodes { title } } }`, 1 + 10},
+		{`{ seriesList(first: 10) { nodes { seasons(first: 5) { nodes { seasonId } } } } }`, 1 + 10 + 10 + 50},
+		{`{ a: seriesList(first: 10) { nodes { title } } b: seriesList(first: 10) { nodes { title } } }`, 2 * (1 + 10)},
+		// Default page size of 20.
+		{`{ seasons { edges { node { episodes { nodes { episodeId } } } } } }`, 1 + 20 + 20 + 20 + 400},
+	} {
+		if got := cost(tc.query); got != tc.want {
+			t.Errorf("cost of %s = %d, want %d", tc.query, got, tc.want)
+		}
+	}
+
+	// Rejected before any resolver runs, so the nil client is not used.
+	resp := s.Execute(context.Background(), Request{Query: `{
+		seriesList(first: 100) { nodes { seasons(first: 100) { nodes { episodes(first: 100) { nodes { episodeId } } } } } }
+	}`})
+	if resp.Data != nil || len(resp.Errors) != 1 || !strings.Contains(resp.Errors[0].Message, "maximum cost") {
+		t.Errorf("expensive query: got data %v, errors %v", resp.Data, resp.Errors)
+	}
+}
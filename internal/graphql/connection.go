@@ -0,0 +1,317 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// orderField is a column a connection can be ordered by. value reads the
+// column from a row so it can be encoded into cursors.
+type orderField struct {
+	column string
+	value  func(row any) any
+	// decode parses a cursor value back into the column's type.
+	decode func(json.RawMessage) (any, error)
+}
+
+func decodeAs[T any](raw json.RawMessage) (any, error) {
+	var v T
+	err := json.Unmarshal(raw, &v)
+	return v, err
+}
+
+func intField(column string, value func(row any) int) orderField {
+	return orderField{column, func(r any) any { return value(r) }, decodeAs[int]}
+}
+
+func stringField(column string, value func(row any) string) orderField {
+	return orderField{column, func(r any) any { return value(r) }, decodeAs[string]}
+}
+
+func timeField(column string, value func(row any) time.Time) orderField {
+	return orderField{column, func(r any) any { return value(r) }, decodeAs[time.Time]}
+}
+
+type cursor struct {
+	ID    int             `json:"i"`
+	Value json.RawMessage `json:"v,omitempty"`
+}
+
+func encodeCursor(id int, value any) string {
+	c := cursor{ID: id}
+	if value != nil {
+		c.Value, _ = json.Marshal(value)
+	}
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// page holds the parsed first/after/orderBy arguments of a connection.
+// Rows are ordered by the chosen column with the primary key as a
+// tiebreaker, and after is applied as a keyset condition on both.
+type page struct {
+	first int
+	field *orderField
+	desc  bool
+
+	after      bool
+	afterID    int
+	afterValue any
+}
+
+var connectionArgs = []string{"first", "after", "where", "orderBy"}
+
+func parsePage(args Args, fields map[string]orderField) (*page, error) {
+	p := &page{first: defaultPageSize}
+	if n, ok, err := args.Int("first"); err != nil {
+		return nil, err
+	} else if ok {
+		if n < 0 || n > maxPageSize {
+			return nil, inputErrorf("first must be between 0 and %d", maxPageSize)
+		}
+		p.first = n
+	}
+
+	order, err := args.Object("orderBy", "field", "direction")
+	if err != nil {
+		return nil, err
+	}
+	if name, ok, err := order.String("field"); err != nil {
+		return nil, err
+	} else if ok {
+		f, known := fields[name]
+		if !known {
+			return nil, inputErrorf("unknown order field %q", name)
+		}
+		p.field = &f
+	}
+	switch dir, _, err := order.String("direction"); {
+	case err != nil:
+		return nil, err
+	case dir == "DESC":
+		p.desc = true
+	case dir != "" && dir != "ASC":
+		return nil, inputErrorf("unknown order direction %q", dir)
+	}
+
+	after, ok, err := args.String("after")
+	if err != nil || !ok {
+		return p, err
+	}
+	var c cursor
+	raw, err := base64.RawURLEncoding.DecodeString(after)
+	if err == nil {
+		err = json.Unmarshal(raw, &c)
+	}
+	if err == nil && p.field != nil {
+		p.afterValue, err = p.field.decode(c.Value)
+	}
+	if err != nil {
+		return nil, inputErrorf("invalid cursor %q", after)
+	}
+	p.after, p.afterID = true, c.ID
+	return p, nil
+}
+
+// keyset returns the predicate selecting rows after the cursor, or nil.
+func (p *page) keyset() func(*sql.Selector) {
+	if !p.after {
+		return nil
+	}
+	cmp := sql.GT
+	if p.desc {
+		cmp = sql.LT
+	}
+	return func(s *sql.Selector) {
+		byID := cmp(s.C("id"), p.afterID)
+		if p.field == nil {
+			s.Where(byID)
+			return
+		}
+		col := s.C(p.field.column)
+		s.Where(sql.Or(cmp(col, p.afterValue), sql.And(sql.EQ(col, p.afterValue), byID)))
+	}
+}
+
+func (p *page) order() func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.OrderBy(p.orderTerms(s)...)
+	}
+}
+
+func (p *page) orderTerms(s *sql.Selector) []string {
+	dir := sql.Asc
+	if p.desc {
+		dir = sql.Desc
+	}
+	var terms []string
+	if p.field != nil {
+		terms = append(terms, dir(s.C(p.field.column)))
+	}
+	return append(terms, dir(s.C("id")))
+}
+
+// limit fetches one extra row to learn whether there is a next page.
+func (p *page) limit() int { return p.first + 1 }
+
+// perParent selects the rows of table passing filter and the cursor,
+// keeping the first p.limit() of each parent, named by the foreign key
+// column fk, in page order. It loads the pages of many parents in one
+// query.
+func (p *page) perParent(table, fk string, filter func(*sql.Selector)) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		t := sql.Table(table)
+		inner := sql.Dialect(s.Dialect()).Select(t.C("id")).From(t)
+		filter(inner)
+		if ks := p.keyset(); ks != nil {
+			ks(inner)
+		}
+		inner.AppendSelectExprAs(sql.RowNumber().PartitionBy(t.C(fk)).OrderBy(p.orderTerms(inner)...), "position")
+		ranked := sql.Dialect(s.Dialect()).Select("id").From(inner.As("ranked")).
+			Where(sql.LTE("position", p.limit()))
+		s.Where(sql.In(s.C("id"), ranked))
+	}
+}
+
+// pageSize is the Size of connection fields.
+func pageSize(args Args) int {
+	n, ok, err := args.Int("first")
+	switch {
+	case !ok || err != nil:
+		return defaultPageSize
+	case n > maxPageSize:
+		return maxPageSize
+	}
+	return max(n, 0)
+}
+
+type connection struct {
+	Edges    []edge
+	Nodes    []any
+	PageInfo pageInfo
+	count    func(context.Context) (int, error)
+}
+
+type edge struct {
+	Cursor string
+	Node   any
+}
+
+type pageInfo struct {
+	HasNextPage     bool
+	HasPreviousPage bool
+	StartCursor     *string
+	EndCursor       *string
+}
+
+// pageRows trims rows fetched with p.limit() to the page, reporting
+// whether there are more.
+func pageRows[T any](p *page, rows []T) ([]T, bool) {
+	if len(rows) > p.first {
+		return rows[:p.first], true
+	}
+	return rows, false
+}
+
+// connect builds a connection from the rows of a page and their nodes.
+func connect[T any](p *page, rows []T, more bool, nodes []any, id func(T) int, count func(context.Context) (int, error)) *connection {
+	c := &connection{Edges: []edge{}, Nodes: []any{}, count: count}
+	c.PageInfo.HasPreviousPage = p.after
+	c.PageInfo.HasNextPage = more
+	for i, r := range rows {
+		var v any
+		if p.field != nil {
+			v = p.field.value(r)
+		}
+		c.Edges = append(c.Edges, edge{Cursor: encodeCursor(id(r), v), Node: nodes[i]})
+		c.Nodes = append(c.Nodes, nodes[i])
+	}
+	if len(c.Edges) > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[len(c.Edges)-1].Cursor
+	}
+	return c
+}
+
+// connectEach splits rows loaded with perParent into a connection for
+// each of parents. The nodes of all the pages form one batch.
+func connectEach[T any](p *page, parents []int, rows []T, parent, id func(T) int, nodes func([]T) []any, count func(parent int) func(context.Context) (int, error)) map[int]any {
+	byParent := map[int][]T{}
+	for _, r := range rows {
+		byParent[parent(r)] = append(byParent[parent(r)], r)
+	}
+	more := map[int]bool{}
+	var kept []T
+	for _, pid := range parents {
+		byParent[pid], more[pid] = pageRows(p, byParent[pid])
+		kept = append(kept, byParent[pid]...)
+	}
+	all := nodes(kept)
+	conns := make(map[int]any, len(parents))
+	for _, pid := range parents {
+		n := len(byParent[pid])
+		conns[pid] = connect(p, byParent[pid], more[pid], all[:n:n], id, count(pid))
+		all = all[n:]
+	}
+	return conns
+}
+
+// groupCounts returns the totalCount of each parent's connection; fetch
+// counts the rows of all of them when the first is resolved.
+func groupCounts(fetch func(context.Context) (map[int]int, error)) func(parent int) func(context.Context) (int, error) {
+	var counts map[int]int
+	var err error
+	return func(parent int) func(context.Context) (int, error) {
+		return func(ctx context.Context) (int, error) {
+			if counts == nil && err == nil {
+				counts, err = fetch(ctx)
+			}
+			return counts[parent], err
+		}
+	}
+}
+
+var pageInfoType = &Object{Name: "PageInfo", Fields: map[string]*FieldDef{
+	"hasNextPage":     prop(func(p pageInfo) any { return p.HasNextPage }),
+	"hasPreviousPage": prop(func(p pageInfo) any { return p.HasPreviousPage }),
+	"startCursor":     prop(func(p pageInfo) any { return p.StartCursor }),
+	"endCursor":       prop(func(p pageInfo) any { return p.EndCursor }),
+}}
+
+// connectionType returns the <Node>Connection object type for node.
+func connectionType(node *Object) *Object {
+	edgeType := &Object{Name: node.Name + "Edge", Fields: map[string]*FieldDef{
+		"cursor": prop(func(e edge) any { return e.Cursor }),
+		"node":   {Type: node, Resolve: func(_ context.Context, src any, _ Args) (any, error) { return src.(edge).Node, nil }},
+	}}
+	return &Object{Name: node.Name + "Connection", Fields: map[string]*FieldDef{
+		"edges": {Type: edgeType, Resolve: func(_ context.Context, src any, _ Args) (any, error) {
+			return src.(*connection).Edges, nil
+		}},
+		"nodes": {Type: node, Resolve: func(_ context.Context, src any, _ Args) (any, error) {
+			return src.(*connection).Nodes, nil
+		}},
+		"pageInfo": {Type: pageInfoType, Resolve: func(_ context.Context, src any, _ Args) (any, error) {
+			return src.(*connection).PageInfo, nil
+		}},
+		"totalCount": {Resolve: func(ctx context.Context, src any, _ Args) (any, error) {
+			return src.(*connection).count(ctx)
+		}},
+	}}
+}
+
+// prop is a scalar field read from a source of type T.
+func prop[T any](get func(T) any) *FieldDef {
+	return &FieldDef{Resolve: func(_ context.Context, src any, _ Args) (any, error) {
+		return get(src.(T)), nil
+	}}
+}
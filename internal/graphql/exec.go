@@ -0,0 +1,450 @@
+// Package graphql is a small GraphQL executor: it parses request
+// documents (operations, variables, fragments, aliases, @skip/@include)
+// and resolves them against a Schema of Go resolver functions. Only
+// queries are supported. Introspection (__schema, __type) describes the
+// schema's SDL.
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// DefaultMaxDepth bounds how deeply selections may nest.
+const DefaultMaxDepth = 12
+
+// DefaultMaxCost bounds the number of objects a query may ask for.
+const DefaultMaxCost = 20000
+
+type Schema struct {
+	Query *Object
+	// SDL describes the schema in the GraphQL schema language.
+	SDL      string
+	MaxDepth int
+	// MaxCost bounds the cost of queries, estimated before they run.
+	MaxCost int
+	// FormatError turns a resolver error into a response error; the
+	// executor fills in the path and location.
+	FormatError func(error) *Error
+
+	// meta holds the introspection fields of the query type, built from
+	// SDL on first use; none without SDL.
+	metaOnce sync.Once
+	meta     map[string]*FieldDef
+	metaErr  error
+}
+
+type Object struct {
+	Name   string
+	Fields map[string]*FieldDef
+}
+
+type ResolveFunc func(ctx context.Context, source any, args Args) (any, error)
+
+type FieldDef struct {
+	// Args lists the accepted argument names.
+	Args []string
+	// Type is the object type of the result, or of its elements when the
+	// resolver returns a slice. It is nil for scalar fields.
+	Type    *Object
+	Resolve ResolveFunc
+	// Size estimates, from the arguments, how many objects each object
+	// below the field stands for: the length of a list, or the page size
+	// of a connection. Nil counts one.
+	Size func(Args) int
+}
+
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+type Response struct {
+	Data   any      `json:"data,omitempty"`
+	Errors []*Error `json:"errors,omitempty"`
+}
+
+type Error struct {
+	Message    string         `json:"message"`
+	Locations  []Location     `json:"locations,omitempty"`
+	Path       []any          `json:"path,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+func (e *Error) Error() string { return e.Message }
+
+// Execute parses and runs req. Errors that prevent execution are returned
+// with no data; resolver errors null their field and are listed alongside
+// the data.
+func (s *Schema) Execute(ctx context.Context, req Request) *Response {
+	doc, err := Parse(req.Query)
+	if err != nil {
+		resp := &Response{Errors: []*Error{{Message: err.Error()}}}
+		if se, ok := err.(*SyntaxError); ok {
+			resp.Errors[0].Locations = []Location{se.Loc}
+		}
+		return resp
+	}
+	op, err := selectOperation(doc, req.OperationName)
+	if err != nil {
+		return &Response{Errors: []*Error{{Message: err.Error()}}}
+	}
+	vars, err := coerceVariables(op, req.Variables)
+	if err != nil {
+		return &Response{Errors: []*Error{{Message: err.Error()}}}
+	}
+	s.metaOnce.Do(func() {
+		if s.SDL != "" {
+			s.meta, s.metaErr = introspection(s.SDL)
+		}
+	})
+	if s.metaErr != nil {
+		return &Response{Errors: []*Error{{Message: "invalid schema: " + s.metaErr.Error()}}}
+	}
+	e := &executor{schema: s, doc: doc, vars: vars}
+	if max := s.MaxCost; max > 0 && e.cost(s.Query, op.Selections, 1, 1, max) > max {
+		return &Response{Errors: []*Error{{Message: fmt.Sprintf("query exceeds the maximum cost of %d", max)}}}
+	}
+	data := e.selectionSet(ctx, s.Query, nil, op.Selections, nil, 1)
+	return &Response{Data: data, Errors: e.errors}
+}
+
+func selectOperation(doc *Document, name string) (*Operation, error) {
+	var op *Operation
+	switch {
+	case name != "":
+		for _, o := range doc.Operations {
+			if o.Name == name {
+				op = o
+			}
+		}
+		if op == nil {
+			return nil, fmt.Errorf("unknown operation %q", name)
+		}
+	case len(doc.Operations) == 1:
+		op = doc.Operations[0]
+	default:
+		return nil, fmt.Errorf("operationName is required when the document has several operations")
+	}
+	if op.Kind != "query" {
+		return nil, fmt.Errorf("%s operations are not supported", op.Kind)
+	}
+	return op, nil
+}
+
+func coerceVariables(op *Operation, given map[string]any) (map[string]any, error) {
+	vars := make(map[string]any, len(op.Vars))
+	for _, v := range op.Vars {
+		val, ok := given[v.Name]
+		switch {
+		case ok:
+			vars[v.Name] = normalize(val)
+		case v.Default != nil:
+			vars[v.Name] = resolve(v.Default, nil)
+		case strings.HasSuffix(v.Type, "!"):
+			return nil, fmt.Errorf("variable $%s of required type %s was not provided", v.Name, v.Type)
+		}
+		if strings.HasSuffix(v.Type, "!") && vars[v.Name] == nil {
+			return nil, fmt.Errorf("variable $%s of required type %s must not be null", v.Name, v.Type)
+		}
+	}
+	return vars, nil
+}
+
+// normalize converts decoded JSON numbers to int64 where integral.
+func normalize(v any) any {
+	switch v := v.(type) {
+	case float64:
+		if v == float64(int64(v)) {
+			return int64(v)
+		}
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+	case []any:
+		for i := range v {
+			v[i] = normalize(v[i])
+		}
+	case map[string]any:
+		for k := range v {
+			v[k] = normalize(v[k])
+		}
+	}
+	return v
+}
+
+// resolve turns a literal into a plain Go value, substituting variables.
+// Enums become strings, lists []any and input objects map[string]any.
+func resolve(v Value, vars map[string]any) any {
+	switch v := v.(type) {
+	case Variable:
+		return vars[string(v)]
+	case Enum:
+		return string(v)
+	case []Value:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = resolve(item, vars)
+		}
+		return out
+	case ObjectValue:
+		out := make(map[string]any, len(v))
+		for _, f := range v {
+			out[f.Name] = resolve(f.Value, vars)
+		}
+		return out
+	}
+	return v
+}
+
+type executor struct {
+	schema *Schema
+	doc    *Document
+	vars   map[string]any
+	errors []*Error
+}
+
+// inputError is a problem with the request document or its arguments.
+type inputError struct{ msg string }
+
+func (e *inputError) Error() string { return e.msg }
+
+func inputErrorf(format string, args ...any) error {
+	return &inputError{fmt.Sprintf(format, args...)}
+}
+
+func (e *executor) fail(f *Field, path []any, err error) {
+	var ge *Error
+	var ie *inputError
+	switch {
+	case errors.As(err, &ie):
+		ge = &Error{Message: ie.msg, Extensions: map[string]any{"code": "bad_request"}}
+	case e.schema.FormatError != nil:
+		ge = e.schema.FormatError(err)
+	default:
+		ge = &Error{Message: err.Error()}
+	}
+	ge.Locations = []Location{f.Loc}
+	ge.Path = append([]any(nil), path...)
+	e.errors = append(e.errors, ge)
+}
+
+func (e *executor) selectionSet(ctx context.Context, obj *Object, source any, sels []Selection, path []any, depth int) object {
+	keys, groups := e.collect(obj, sels, nil, map[string]bool{})
+	out := make(object, 0, len(keys))
+	for _, key := range keys {
+		fields := groups[key]
+		f := fields[0]
+		fieldPath := append(path[:len(path):len(path)], key)
+		if f.Name == "__typename" {
+			out = append(out, member{key, obj.Name})
+			continue
+		}
+		def := e.field(obj, f.Name)
+		if def == nil {
+			e.fail(f, fieldPath, inputErrorf("cannot query field %q on type %q", f.Name, obj.Name))
+			out = append(out, member{key, nil})
+			continue
+		}
+		args, err := e.args(f, def)
+		var val any
+		if err == nil {
+			val, err = def.Resolve(ctx, source, args)
+		}
+		if err != nil {
+			e.fail(f, fieldPath, err)
+			out = append(out, member{key, nil})
+			continue
+		}
+		var sub []Selection
+		for _, fld := range fields {
+			sub = append(sub, fld.Selections...)
+		}
+		out = append(out, member{key, e.complete(ctx, f, def, val, sub, fieldPath, depth)})
+	}
+	return out
+}
+
+func (e *executor) complete(ctx context.Context, f *Field, def *FieldDef, val any, sels []Selection, path []any, depth int) any {
+	rv := reflect.ValueOf(val)
+	if val == nil || (rv.Kind() == reflect.Pointer && rv.IsNil()) {
+		return nil
+	}
+	if def.Type == nil {
+		if len(sels) > 0 {
+			e.fail(f, path, inputErrorf("field %q is a scalar and has no subfields", f.Name))
+			return nil
+		}
+		return val
+	}
+	if len(sels) == 0 {
+		e.fail(f, path, inputErrorf("field %q of type %q must have a selection of subfields", f.Name, def.Type.Name))
+		return nil
+	}
+	if max := e.schema.MaxDepth; max > 0 && depth >= max {
+		e.fail(f, path, inputErrorf("query exceeds the maximum depth of %d", max))
+		return nil
+	}
+	if rv.Kind() == reflect.Slice {
+		list := make([]any, rv.Len())
+		for i := range list {
+			list[i] = e.selectionSet(ctx, def.Type, rv.Index(i).Interface(), sels, append(path[:len(path):len(path)], i), depth+1)
+		}
+		return list
+	}
+	return e.selectionSet(ctx, def.Type, val, sels, path, depth+1)
+}
+
+// cost estimates how many objects sels ask for below n objects of type
+// obj: every object field counts once per parent, and the objects below
+// it count Size times as often. Counting stops once it passes max.
+func (e *executor) cost(obj *Object, sels []Selection, n, depth, max int) int {
+	keys, groups := e.collect(obj, sels, nil, map[string]bool{})
+	total := 0
+	for _, key := range keys {
+		fields := groups[key]
+		def := e.field(obj, fields[0].Name)
+		if def == nil || def.Type == nil {
+			continue
+		}
+		total += n
+		if total > max || (e.schema.MaxDepth > 0 && depth >= e.schema.MaxDepth) {
+			return total
+		}
+		below := n
+		if def.Size != nil {
+			args, _ := e.args(fields[0], def)
+			below = min(n*def.Size(args), max+1)
+		}
+		var sub []Selection
+		for _, f := range fields {
+			sub = append(sub, f.Selections...)
+		}
+		if total += e.cost(def.Type, sub, below, depth+1, max-total); total > max {
+			return total
+		}
+	}
+	return total
+}
+
+// field returns the definition of the field name of obj, which for the
+// query type includes __schema and __type.
+func (e *executor) field(obj *Object, name string) *FieldDef {
+	if def := e.schema.meta[name]; def != nil && obj == e.schema.Query {
+		return def
+	}
+	return obj.Fields[name]
+}
+
+// collect groups the fields selected on obj by response key, expanding
+// fragments and applying @skip and @include.
+func (e *executor) collect(obj *Object, sels []Selection, keys []string, visited map[string]bool) ([]string, map[string][]*Field) {
+	groups := map[string][]*Field{}
+	var walk func([]Selection)
+	walk = func(sels []Selection) {
+		for _, sel := range sels {
+			switch sel := sel.(type) {
+			case *Field:
+				if !e.included(sel.Directives) {
+					continue
+				}
+				key := sel.Key()
+				if _, ok := groups[key]; !ok {
+					keys = append(keys, key)
+				}
+				groups[key] = append(groups[key], sel)
+			case *InlineFragment:
+				if e.included(sel.Directives) && (sel.TypeCond == "" || sel.TypeCond == obj.Name) {
+					walk(sel.Selections)
+				}
+			case *FragmentSpread:
+				frag := e.doc.Fragments[sel.Name]
+				if frag == nil || visited[sel.Name] || !e.included(sel.Directives) || frag.TypeCond != obj.Name {
+					continue
+				}
+				visited[sel.Name] = true
+				walk(frag.Selections)
+				delete(visited, sel.Name)
+			}
+		}
+	}
+	walk(sels)
+	return keys, groups
+}
+
+func (e *executor) included(ds []Directive) bool {
+	for _, d := range ds {
+		var cond bool
+		for _, a := range d.Args {
+			if a.Name == "if" {
+				cond, _ = resolve(a.Value, e.vars).(bool)
+			}
+		}
+		switch d.Name {
+		case "skip":
+			if cond {
+				return false
+			}
+		case "include":
+			if !cond {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (e *executor) args(f *Field, def *FieldDef) (Args, error) {
+	args := make(Args, len(f.Args))
+	for _, a := range f.Args {
+		known := false
+		for _, name := range def.Args {
+			known = known || name == a.Name
+		}
+		if !known {
+			return nil, inputErrorf("unknown argument %q on field %q", a.Name, f.Name)
+		}
+		if v := resolve(a.Value, e.vars); v != nil {
+			args[a.Name] = v
+		}
+	}
+	return args, nil
+}
+
+// object is a response object that keeps its fields in selection order.
+type object []member
+
+type member struct {
+	key   string
+	value any
+}
+
+func (o object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, _ := json.Marshal(m.key)
+		buf.Write(k)
+		buf.WriteByte(':')
+		v, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
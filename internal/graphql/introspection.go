@@ -0,0 +1,480 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// introspectionSDL declares the built-in scalars, directives and
+// introspection types of the GraphQL specification, so that __schema lists
+// them along with the types of Schema.SDL.
+const introspectionSDL = `
+"The ` + "`ID`" + ` scalar type represents a unique identifier, serialized as a string."
+scalar ID
+"The ` + "`String`" + ` scalar type represents textual data, as UTF-8 character sequences."
+scalar String
+"The ` + "`Int`" + ` scalar type represents non-fractional signed whole numeric values between -2^31 and 2^31-1."
+scalar Int
+"The ` + "`Float`" + ` scalar type represents signed double-precision fractional values as specified by IEEE 754."
+scalar Float
+"The ` + "`Boolean`" + ` scalar type represents ` + "`true`" + ` or ` + "`false`" + `."
+scalar Boolean
+
+"Directs the executor to skip this field or fragment when the ` + "`if`" + ` argument is true."
+directive @skip("Skipped when true." if: Boolean!) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+"Directs the executor to include this field or fragment only when the ` + "`if`" + ` argument is true."
+directive @include("Included when true." if: Boolean!) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+
+type __Schema {
+  description: String
+  types: [__Type!]!
+  queryType: __Type!
+  mutationType: __Type
+  subscriptionType: __Type
+  directives: [__Directive!]!
+}
+
+type __Type {
+  kind: __TypeKind!
+  name: String
+  description: String
+  specifiedByURL: String
+  fields(includeDeprecated: Boolean = false): [__Field!]
+  interfaces: [__Type!]
+  possibleTypes: [__Type!]
+  enumValues(includeDeprecated: Boolean = false): [__EnumValue!]
+  inputFields(includeDeprecated: Boolean = false): [__InputValue!]
+  ofType: __Type
+  isOneOf: Boolean
+}
+
+enum __TypeKind { SCALAR OBJECT INTERFACE UNION ENUM INPUT_OBJECT LIST NON_NULL }
+
+type __Field {
+  name: String!
+  description: String
+  args(includeDeprecated: Boolean = false): [__InputValue!]!
+  type: __Type!
+  isDeprecated: Boolean!
+  deprecationReason: String
+}
+
+type __InputValue {
+  name: String!
+  description: String
+  type: __Type!
+  defaultValue: String
+  isDeprecated: Boolean!
+  deprecationReason: String
+}
+
+type __EnumValue {
+  name: String!
+  description: String
+  isDeprecated: Boolean!
+  deprecationReason: String
+}
+
+type __Directive {
+  name: String!
+  description: String
+  locations: [__DirectiveLocation!]!
+  args(includeDeprecated: Boolean = false): [__InputValue!]!
+  isRepeatable: Boolean!
+}
+
+enum __DirectiveLocation {
+  QUERY MUTATION SUBSCRIPTION FIELD FRAGMENT_DEFINITION FRAGMENT_SPREAD INLINE_FRAGMENT VARIABLE_DEFINITION
+  SCHEMA SCALAR OBJECT FIELD_DEFINITION ARGUMENTS_DEFINITION INTERFACE UNION ENUM ENUM_VALUE INPUT_OBJECT
+  INPUT_FIELD_DEFINITION
+}
+`
+
+// typeSystem is what introspection reports: the types and directives
+// declared in SDL, in declaration order.
+type typeSystem struct {
+	types      []*introType
+	byName     map[string]*introType
+	directives []*introDirective
+}
+
+// introType is a named type declared in SDL, or a LIST or NON_NULL
+// wrapper of another type.
+type introType struct {
+	kind        string
+	name        string
+	description string
+	fields      []*introField // OBJECT
+	inputFields []*introValue // INPUT_OBJECT
+	enumValues  []*introEnum  // ENUM
+	ofType      *introType    // LIST and NON_NULL
+}
+
+type introField struct {
+	name        string
+	description string
+	args        []*introValue
+	ref         string
+	typ         *introType
+}
+
+// introValue is an argument or an input object field.
+type introValue struct {
+	name         string
+	description  string
+	ref          string
+	typ          *introType
+	defaultValue *string
+}
+
+type introEnum struct {
+	name        string
+	description string
+}
+
+type introDirective struct {
+	name        string
+	description string
+	args        []*introValue
+	locations   []string
+}
+
+var typeKinds = map[string]string{"scalar": "SCALAR", "type": "OBJECT", "input": "INPUT_OBJECT", "enum": "ENUM"}
+
+// parseSDL reads the scalar, type, input, enum and directive definitions
+// of docs. Type references are resolved once every document is read.
+func parseSDL(docs ...string) (ts *typeSystem, err error) {
+	defer recoverSyntax(&err)
+	ts = &typeSystem{byName: map[string]*introType{}}
+	for _, src := range docs {
+		p := &parser{src: src, line: 1, col: 1}
+		p.next()
+		for p.tok.kind != tokEOF {
+			desc := p.description()
+			kw := p.name()
+			if kw == "directive" {
+				p.expect("@")
+				d := &introDirective{name: p.name(), description: desc, args: p.inputValues("(", ")")}
+				p.keyword("on")
+				p.skip("|")
+				d.locations = append(d.locations, p.name())
+				for p.skip("|") {
+					d.locations = append(d.locations, p.name())
+				}
+				ts.directives = append(ts.directives, d)
+				continue
+			}
+			kind, ok := typeKinds[kw]
+			if !ok {
+				p.fail("unexpected %q", kw)
+			}
+			t := &introType{kind: kind, name: p.name(), description: desc}
+			if ts.byName[t.name] != nil {
+				p.fail("type %q is defined more than once", t.name)
+			}
+			p.directives()
+			switch kind {
+			case "OBJECT":
+				p.expect("{")
+				for !p.skip("}") {
+					f := &introField{description: p.description(), name: p.name()}
+					f.args = p.inputValues("(", ")")
+					p.expect(":")
+					f.ref = p.typeRef()
+					p.directives()
+					t.fields = append(t.fields, f)
+				}
+			case "INPUT_OBJECT":
+				t.inputFields = p.inputValues("{", "}")
+			case "ENUM":
+				p.expect("{")
+				for !p.skip("}") {
+					t.enumValues = append(t.enumValues, &introEnum{description: p.description(), name: p.name()})
+					p.directives()
+				}
+			}
+			ts.types = append(ts.types, t)
+			ts.byName[t.name] = t
+		}
+	}
+
+	resolve := func(values []*introValue) error {
+		for _, v := range values {
+			typ, err := ts.ref(v.ref)
+			if err != nil {
+				return fmt.Errorf("%s: %w", v.name, err)
+			}
+			v.typ = typ
+		}
+		return nil
+	}
+	for _, t := range ts.types {
+		for _, f := range t.fields {
+			typ, err := ts.ref(f.ref)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", t.name, f.name, err)
+			}
+			f.typ = typ
+			if err := resolve(f.args); err != nil {
+				return nil, fmt.Errorf("%s.%s(%w)", t.name, f.name, err)
+			}
+		}
+		if err := resolve(t.inputFields); err != nil {
+			return nil, fmt.Errorf("%s.%w", t.name, err)
+		}
+	}
+	for _, d := range ts.directives {
+		if err := resolve(d.args); err != nil {
+			return nil, fmt.Errorf("@%s(%w)", d.name, err)
+		}
+	}
+	return ts, nil
+}
+
+// ref resolves a type reference such as "[Series!]!".
+func (ts *typeSystem) ref(ref string) (*introType, error) {
+	if inner, ok := strings.CutSuffix(ref, "!"); ok {
+		of, err := ts.ref(inner)
+		return &introType{kind: "NON_NULL", ofType: of}, err
+	}
+	if strings.HasPrefix(ref, "[") {
+		of, err := ts.ref(ref[1 : len(ref)-1])
+		return &introType{kind: "LIST", ofType: of}, err
+	}
+	if t := ts.byName[ref]; t != nil {
+		return t, nil
+	}
+	return nil, fmt.Errorf("unknown type %q", ref)
+}
+
+// description reads the string, if any, describing the next definition.
+func (p *parser) description() string {
+	if p.tok.kind != tokString {
+		return ""
+	}
+	s := p.tok.text
+	p.next()
+	return s
+}
+
+// inputValues reads argument or input field definitions between open and
+// close, if the next token is open.
+func (p *parser) inputValues(open, close string) []*introValue {
+	if !p.skip(open) {
+		return nil
+	}
+	var values []*introValue
+	for !p.skip(close) {
+		v := &introValue{description: p.description(), name: p.name()}
+		p.expect(":")
+		v.ref = p.typeRef()
+		if p.skip("=") {
+			lit := printValue(p.value(true))
+			v.defaultValue = &lit
+		}
+		p.directives()
+		values = append(values, v)
+	}
+	return values
+}
+
+// printValue writes a constant value back in GraphQL syntax, as
+// __InputValue.defaultValue reports it.
+func printValue(v Value) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return strconv.Quote(v)
+	case Enum:
+		return string(v)
+	case []Value:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = printValue(item)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case ObjectValue:
+		fields := make([]string, len(v))
+		for i, f := range v {
+			fields[i] = f.Name + ": " + printValue(f.Value)
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+	return fmt.Sprint(v)
+}
+
+// introspection returns the __schema and __type fields of the query type,
+// describing the types of sdl.
+func introspection(sdl string) (map[string]*FieldDef, error) {
+	ts, err := parseSDL(introspectionSDL, sdl)
+	if err != nil {
+		return nil, err
+	}
+	queryType := ts.byName["Query"]
+	if queryType == nil || queryType.kind != "OBJECT" {
+		return nil, fmt.Errorf("the schema has no Query type")
+	}
+
+	// Each list counts as many objects as its instances hold on average:
+	// with the longest, the standard introspection query would exceed
+	// DefaultMaxCost.
+	var objects, fields, args, argLists, inputs, inputFields, enums, enumValues int
+	for _, t := range ts.types {
+		switch t.kind {
+		case "OBJECT":
+			objects++
+			fields += len(t.fields)
+			for _, f := range t.fields {
+				argLists++
+				args += len(f.args)
+			}
+		case "INPUT_OBJECT":
+			inputs++
+			inputFields += len(t.inputFields)
+		case "ENUM":
+			enums++
+			enumValues += len(t.enumValues)
+		}
+	}
+	for _, d := range ts.directives {
+		argLists++
+		args += len(d.args)
+	}
+	mean := func(total, lists int) int { return (total + lists - 1) / max(lists, 1) }
+	fieldsEach, argsEach := mean(fields, objects), mean(args, argLists)
+	inputFieldsEach, enumValuesEach := mean(inputFields, inputs), mean(enumValues, enums)
+	deprecatable := []string{"includeDeprecated"}
+	list := func(args []string, typ *Object, size int, get func(src any) any) *FieldDef {
+		return &FieldDef{Args: args, Type: typ, Size: func(Args) int { return size },
+			Resolve: func(_ context.Context, src any, _ Args) (any, error) { return get(src), nil }}
+	}
+	object := func(typ *Object, get func(src any) any) *FieldDef {
+		return &FieldDef{Type: typ, Resolve: func(_ context.Context, src any, _ Args) (any, error) { return get(src), nil }}
+	}
+
+	schemaType := &Object{Name: "__Schema"}
+	typeType := &Object{Name: "__Type"}
+	fieldType := &Object{Name: "__Field"}
+	valueType := &Object{Name: "__InputValue"}
+	enumType := &Object{Name: "__EnumValue"}
+	directiveType := &Object{Name: "__Directive"}
+	notDeprecated := map[string]*FieldDef{
+		"isDeprecated":      {Resolve: func(context.Context, any, Args) (any, error) { return false, nil }},
+		"deprecationReason": {Resolve: func(context.Context, any, Args) (any, error) { return nil, nil }},
+	}
+
+	schemaType.Fields = map[string]*FieldDef{
+		"description":      {Resolve: func(context.Context, any, Args) (any, error) { return nil, nil }},
+		"types":            list(nil, typeType, len(ts.types), func(any) any { return ts.types }),
+		"queryType":        object(typeType, func(any) any { return queryType }),
+		"mutationType":     object(typeType, func(any) any { return nil }),
+		"subscriptionType": object(typeType, func(any) any { return nil }),
+		"directives":       list(nil, directiveType, len(ts.directives), func(any) any { return ts.directives }),
+	}
+
+	typeType.Fields = map[string]*FieldDef{
+		"kind":           prop(func(t *introType) any { return t.kind }),
+		"name":           prop(func(t *introType) any { return nullable(t.name) }),
+		"description":    prop(func(t *introType) any { return nullable(t.description) }),
+		"specifiedByURL": prop(func(t *introType) any { return nil }),
+		"fields": list(deprecatable, fieldType, fieldsEach, func(src any) any {
+			if t := src.(*introType); t.kind == "OBJECT" {
+				return t.fields
+			}
+			return nil
+		}),
+		"interfaces": list(nil, typeType, 0, func(src any) any {
+			if src.(*introType).kind == "OBJECT" {
+				return []*introType{}
+			}
+			return nil
+		}),
+		"possibleTypes": list(nil, typeType, 0, func(any) any { return nil }),
+		"enumValues": list(deprecatable, enumType, enumValuesEach, func(src any) any {
+			if t := src.(*introType); t.kind == "ENUM" {
+				return t.enumValues
+			}
+			return nil
+		}),
+		"inputFields": list(deprecatable, valueType, inputFieldsEach, func(src any) any {
+			if t := src.(*introType); t.kind == "INPUT_OBJECT" {
+				return t.inputFields
+			}
+			return nil
+		}),
+		"ofType": object(typeType, func(src any) any {
+			if t := src.(*introType); t.ofType != nil {
+				return t.ofType
+			}
+			return nil
+		}),
+		"isOneOf": prop(func(t *introType) any {
+			if t.kind == "INPUT_OBJECT" {
+				return false
+			}
+			return nil
+		}),
+	}
+
+	fieldType.Fields = map[string]*FieldDef{
+		"name":        prop(func(f *introField) any { return f.name }),
+		"description": prop(func(f *introField) any { return nullable(f.description) }),
+		"args":        list(deprecatable, valueType, argsEach, func(src any) any { return src.(*introField).args }),
+		"type":        object(typeType, func(src any) any { return src.(*introField).typ }),
+	}
+	valueType.Fields = map[string]*FieldDef{
+		"name":        prop(func(v *introValue) any { return v.name }),
+		"description": prop(func(v *introValue) any { return nullable(v.description) }),
+		"type":        object(typeType, func(src any) any { return src.(*introValue).typ }),
+		"defaultValue": prop(func(v *introValue) any {
+			if v.defaultValue == nil {
+				return nil
+			}
+			return *v.defaultValue
+		}),
+	}
+	enumType.Fields = map[string]*FieldDef{
+		"name":        prop(func(e *introEnum) any { return e.name }),
+		"description": prop(func(e *introEnum) any { return nullable(e.description) }),
+	}
+	for name, def := range notDeprecated {
+		fieldType.Fields[name] = def
+		valueType.Fields[name] = def
+		enumType.Fields[name] = def
+	}
+	directiveType.Fields = map[string]*FieldDef{
+		"name":         prop(func(d *introDirective) any { return d.name }),
+		"description":  prop(func(d *introDirective) any { return nullable(d.description) }),
+		"locations":    prop(func(d *introDirective) any { return d.locations }),
+		"args":         list(deprecatable, valueType, argsEach, func(src any) any { return src.(*introDirective).args }),
+		"isRepeatable": prop(func(*introDirective) any { return false }),
+	}
+
+	return map[string]*FieldDef{
+		"__schema": object(schemaType, func(any) any { return ts }),
+		"__type": {Args: []string{"name"}, Type: typeType, Resolve: func(_ context.Context, _ any, args Args) (any, error) {
+			name, ok, err := args.String("name")
+			if err == nil && !ok {
+				err = inputErrorf("argument \"name\" is required")
+			}
+			if err != nil {
+				return nil, err
+			}
+			if t := ts.byName[name]; t != nil {
+				return t, nil
+			}
+			return nil, nil
+		}},
+	}, nil
+}
+
+// nullable reports an empty string as null.
+func nullable(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
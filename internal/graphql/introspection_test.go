@@ -0,0 +1,259 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// introspectionQuery is getIntrospectionQuery of graphql-js 16 with every
+// option on, as GraphiQL and code generators send it.
+const introspectionQuery = `
+query IntrospectionQuery {
+  __schema {
+    description
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types { ...FullType }
+    directives {
+      name
+      description
+      isRepeatable
+      locations
+      args(includeDeprecated: true) { ...InputValue }
+    }
+  }
+}
+
+fragment FullType on __Type {
+  kind
+  name
+  description
+  specifiedByURL
+  fields(includeDeprecated: true) {
+    name
+    description
+    args(includeDeprecated: true) { ...InputValue }
+    type { ...TypeRef }
+    isDeprecated
+    deprecationReason
+  }
+  inputFields(includeDeprecated: true) { ...InputValue }
+  interfaces { ...TypeRef }
+  enumValues(includeDeprecated: true) {
+    name
+    description
+    isDeprecated
+    deprecationReason
+  }
+  possibleTypes { ...TypeRef }
+}
+
+fragment InputValue on __InputValue {
+  name
+  description
+  type { ...TypeRef }
+  defaultValue
+  isDeprecated
+  deprecationReason
+}
+
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType {
+    kind
+    name
+    ofType {
+      kind
+      name
+      ofType {
+        kind
+        name
+        ofType {
+          kind
+          name
+          ofType {
+            kind
+            name
+            ofType {
+              kind
+              name
+              ofType {
+                kind
+                name
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`
+
+type typeRef struct {
+	Kind   string   `json:"kind"`
+	Name   *string  `json:"name"`
+	OfType *typeRef `json:"ofType"`
+}
+
+func (t *typeRef) String() string {
+	switch t.Kind {
+	case "NON_NULL":
+		return t.OfType.String() + "!"
+	case "LIST":
+		return "[" + t.OfType.String() + "]"
+	}
+	return *t.Name
+}
+
+type inputValue struct {
+	Name         string  `json:"name"`
+	Type         typeRef `json:"type"`
+	DefaultValue *string `json:"defaultValue"`
+}
+
+type introspected struct {
+	Schema struct {
+		QueryType struct{ Name string } `json:"queryType"`
+		Types     []struct {
+			Kind   string `json:"kind"`
+			Name   string `json:"name"`
+			Fields []struct {
+				Name string       `json:"name"`
+				Args []inputValue `json:"args"`
+				Type typeRef      `json:"type"`
+			} `json:"fields"`
+			InputFields []inputValue            `json:"inputFields"`
+			EnumValues  []struct{ Name string } `json:"enumValues"`
+		} `json:"types"`
+		Directives []struct {
+			Name      string       `json:"name"`
+			Locations []string     `json:"locations"`
+			Args      []inputValue `json:"args"`
+		} `json:"directives"`
+	} `json:"__schema"`
+}
+
+func execute(t *testing.T, s *Schema, query string, out any) {
+	t.Helper()
+	resp := s.Execute(context.Background(), Request{Query: query})
+	if len(resp.Errors) > 0 {
+		t.Fatalf("errors: %v", resp.Errors[0])
+	}
+	b, err := json.Marshal(resp.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIntrospection(t *testing.T) {
+	s := NewCatalog(nil)
+	var got introspected
+	execute(t, s, introspectionQuery, &got)
+	schema := got.Schema
+	if schema.QueryType.Name != "Query" {
+		t.Errorf("queryType %q, want Query", schema.QueryType.Name)
+	}
+
+	// The object types are those of schema.graphql, plus the introspection
+	// types.
+	objects := map[string][]string{}
+	kinds := map[string]string{}
+	fieldTypes := map[string]string{}
+	for _, typ := range schema.Types {
+		kinds[typ.Name] = typ.Kind
+		if typ.Kind != "OBJECT" || typ.Name[:2] == "__" {
+			continue
+		}
+		for _, f := range typ.Fields {
+			objects[typ.Name] = append(objects[typ.Name], f.Name)
+			fieldTypes[typ.Name+"."+f.Name] = f.Type.String()
+		}
+		slices.Sort(objects[typ.Name])
+	}
+	sdl := sdlObjects(t)
+	if len(objects) != len(sdl) {
+		t.Errorf("introspection lists %d object types, schema.graphql has %d", len(objects), len(sdl))
+	}
+	for name, fields := range sdl {
+		if !slices.Equal(objects[name], fields) {
+			t.Errorf("type %s: introspection has fields %v, schema.graphql %v", name, objects[name], fields)
+		}
+	}
+	for name, kind := range map[string]string{
+		"String": "SCALAR", "ID": "SCALAR", "SeriesWhereInput": "INPUT_OBJECT", "OrderDirection": "ENUM",
+		"__Schema": "OBJECT", "__TypeKind": "ENUM",
+	} {
+		if kinds[name] != kind {
+			t.Errorf("type %s is %q, want %s", name, kinds[name], kind)
+		}
+	}
+	for field, want := range map[string]string{
+		"Query.series":            "Series",
+		"Query.search":            "[Series!]!",
+		"Series.seasons":          "SeasonConnection!",
+		"Series.ratingAverage":    "Float",
+		"SeriesConnection.edges":  "[SeriesEdge!]!",
+		"Episode.chapters":        "[Chapter!]!",
+		"PageInfo.endCursor":      "String",
+		"Season.seasonNumber":     "Int!",
+		"SeasonEdge.node":         "Season!",
+		"Query.seriesList":        "SeriesConnection!",
+		"EpisodeConnection.nodes": "[Episode!]!",
+	} {
+		if got := fieldTypes[field]; got != want {
+			t.Errorf("%s has type %s, want %s", field, got, want)
+		}
+	}
+	if len(schema.Directives) != 2 || schema.Directives[0].Name != "skip" || schema.Directives[1].Args[0].Type.String() != "Boolean!" {
+		t.Errorf("directives %+v, want @skip and @include", schema.Directives)
+	}
+
+	var order struct {
+		Type struct {
+			Kind        string       `json:"kind"`
+			InputFields []inputValue `json:"inputFields"`
+		} `json:"__type"`
+		Missing *struct{} `json:"missing"`
+	}
+	execute(t, s, `{ __type(name: "SeriesOrder") { kind inputFields { name type { kind name ofType { name } } defaultValue } }
+		missing: __type(name: "Nope") { name } }`, &order)
+	if f := order.Type.InputFields; order.Type.Kind != "INPUT_OBJECT" || len(f) != 2 ||
+		f[0].Type.String() != "SeriesOrderField!" || f[0].DefaultValue != nil || *f[1].DefaultValue != "ASC" {
+		t.Errorf("__type(name: \"SeriesOrder\") = %+v", order.Type)
+	}
+	if order.Missing != nil {
+		t.Errorf("__type of an unknown name = %+v, want null", order.Missing)
+	}
+
+	// Introspection counts towards the cost like any other query.
+	resp := s.Execute(context.Background(), Request{Query: `{ __schema { types { fields { type { fields { type { fields { type { fields { name } } } } } } } } } }`})
+	if resp.Data != nil || len(resp.Errors) != 1 || !strings.Contains(resp.Errors[0].Message, "maximum cost") {
+		t.Errorf("nested introspection: got data %v, errors %v, want it rejected", resp.Data, resp.Errors)
+	}
+}
+
+func TestParseSDL(t *testing.T) {
+	for _, sdl := range []string{
+		`type Query { a: Missing }`,
+		`type Query { a(x: [Nope!]): Int }`,
+		`input I { a: Int } type Query { a: Int } input I { b: Int }`,
+		`type Query { a: Int`,
+		`union U = A | B`,
+	} {
+		if _, err := parseSDL(introspectionSDL, sdl); err == nil {
+			t.Errorf("parseSDL(%q) accepted", sdl)
+		}
+	}
+	if _, err := introspection(`type Mutation { a: Int }`); err == nil {
+		t.Error("introspection accepted a schema without a Query type")
+	}
+}
@@ -0,0 +1,504 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Document is a parsed GraphQL request document.
+type Document struct {
+	Operations []*Operation
+	Fragments  map[string]*Fragment
+}
+
+type Operation struct {
+	Kind       string // query, mutation or subscription
+	Name       string
+	Vars       []VarDef
+	Selections []Selection
+}
+
+type VarDef struct {
+	Name    string
+	Type    string
+	Default Value
+}
+
+type Fragment struct {
+	Name       string
+	TypeCond   string
+	Selections []Selection
+}
+
+// Selection is a *Field, *FragmentSpread or *InlineFragment.
+type Selection interface{}
+
+type Field struct {
+	Alias      string
+	Name       string
+	Args       []Argument
+	Directives []Directive
+	Selections []Selection
+	Loc        Location
+}
+
+func (f *Field) Key() string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+	return f.Name
+}
+
+type FragmentSpread struct {
+	Name       string
+	Directives []Directive
+}
+
+type InlineFragment struct {
+	TypeCond   string
+	Directives []Directive
+	Selections []Selection
+}
+
+type Argument struct {
+	Name  string
+	Value Value
+}
+
+type Directive struct {
+	Name string
+	Args []Argument
+}
+
+// Value is a literal: nil, bool, int64, float64, string, Enum, Variable,
+// []Value or ObjectValue.
+type Value interface{}
+
+type (
+	Enum        string
+	Variable    string
+	ObjectValue []Argument
+)
+
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// SyntaxError reports where a document could not be parsed.
+type SyntaxError struct {
+	Loc     Location
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at %d:%d: %s", e.Loc.Line, e.Loc.Column, e.Message)
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokPunct
+	tokName
+	tokInt
+	tokFloat
+	tokString
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	loc  Location
+}
+
+// maxNesting bounds how deeply selection sets, list and object values and
+// list types may nest, so that parsing a hostile document cannot exhaust
+// the stack. Selections nested deeper than MaxDepth fail later anyway.
+const maxNesting = 2 * DefaultMaxDepth
+
+type parser struct {
+	src  string
+	pos  int
+	line int
+	col  int
+	tok  token
+	// depth is the current nesting, counted by enter and leave.
+	depth int
+}
+
+// Parse parses a request document.
+func Parse(src string) (doc *Document, err error) {
+	defer recoverSyntax(&err)
+	p := &parser{src: src, line: 1, col: 1}
+	p.next()
+	doc = &Document{Fragments: map[string]*Fragment{}}
+	for p.tok.kind != tokEOF {
+		switch {
+		case p.peek("{"):
+			doc.Operations = append(doc.Operations, &Operation{Kind: "query", Selections: p.selectionSet()})
+		case p.tok.kind == tokName && p.tok.text == "fragment":
+			p.next()
+			f := &Fragment{Name: p.name()}
+			p.keyword("on")
+			f.TypeCond = p.name()
+			p.directives()
+			f.Selections = p.selectionSet()
+			if _, dup := doc.Fragments[f.Name]; dup {
+				p.fail("fragment %q is defined more than once", f.Name)
+			}
+			doc.Fragments[f.Name] = f
+		case p.tok.kind == tokName:
+			doc.Operations = append(doc.Operations, p.operation())
+		default:
+			p.fail("unexpected %q", p.tok.text)
+		}
+	}
+	if len(doc.Operations) == 0 {
+		p.fail("document contains no operation")
+	}
+	return doc, nil
+}
+
+// recoverSyntax turns the panic of parser.fail into *err.
+func recoverSyntax(err *error) {
+	if r := recover(); r != nil {
+		se, ok := r.(*SyntaxError)
+		if !ok {
+			panic(r)
+		}
+		*err = se
+	}
+}
+
+func (p *parser) operation() *Operation {
+	op := &Operation{Kind: p.name()}
+	switch op.Kind {
+	case "query", "mutation", "subscription":
+	default:
+		p.fail("unexpected %q", op.Kind)
+	}
+	if p.tok.kind == tokName {
+		op.Name = p.name()
+	}
+	if p.skip("(") {
+		for !p.skip(")") {
+			p.expect("$")
+			v := VarDef{Name: p.name()}
+			p.expect(":")
+			v.Type = p.typeRef()
+			if p.skip("=") {
+				v.Default = p.value(true)
+			}
+			op.Vars = append(op.Vars, v)
+		}
+	}
+	p.directives()
+	op.Selections = p.selectionSet()
+	return op
+}
+
+func (p *parser) typeRef() string {
+	var t string
+	if p.skip("[") {
+		p.enter()
+		t = "[" + p.typeRef() + "]"
+		p.expect("]")
+		p.leave()
+	} else {
+		t = p.name()
+	}
+	if p.skip("!") {
+		t += "!"
+	}
+	return t
+}
+
+func (p *parser) selectionSet() []Selection {
+	p.expect("{")
+	p.enter()
+	var sels []Selection
+	for !p.skip("}") {
+		sels = append(sels, p.selection())
+	}
+	if len(sels) == 0 {
+		p.fail("empty selection set")
+	}
+	p.leave()
+	return sels
+}
+
+func (p *parser) selection() Selection {
+	if p.skip("...") {
+		if p.tok.kind == tokName && p.tok.text != "on" {
+			return &FragmentSpread{Name: p.name(), Directives: p.directives()}
+		}
+		f := &InlineFragment{}
+		if p.tok.kind == tokName {
+			p.keyword("on")
+			f.TypeCond = p.name()
+		}
+		f.Directives = p.directives()
+		f.Selections = p.selectionSet()
+		return f
+	}
+	f := &Field{Loc: p.tok.loc, Name: p.name()}
+	if p.skip(":") {
+		f.Alias, f.Name = f.Name, p.name()
+	}
+	f.Args = p.arguments(false)
+	f.Directives = p.directives()
+	if p.peek("{") {
+		f.Selections = p.selectionSet()
+	}
+	return f
+}
+
+func (p *parser) arguments(constant bool) []Argument {
+	var args []Argument
+	if !p.skip("(") {
+		return nil
+	}
+	for !p.skip(")") {
+		a := Argument{Name: p.name()}
+		p.expect(":")
+		a.Value = p.value(constant)
+		args = append(args, a)
+	}
+	return args
+}
+
+func (p *parser) directives() []Directive {
+	var ds []Directive
+	for p.skip("@") {
+		ds = append(ds, Directive{Name: p.name(), Args: p.arguments(false)})
+	}
+	return ds
+}
+
+func (p *parser) value(constant bool) Value {
+	t := p.tok
+	switch t.kind {
+	case tokPunct:
+		switch t.text {
+		case "$":
+			if constant {
+				p.fail("variables are not allowed here")
+			}
+			p.next()
+			return Variable(p.name())
+		case "[":
+			p.next()
+			p.enter()
+			list := []Value{}
+			for !p.skip("]") {
+				list = append(list, p.value(constant))
+			}
+			p.leave()
+			return list
+		case "{":
+			p.next()
+			p.enter()
+			obj := ObjectValue{}
+			for !p.skip("}") {
+				a := Argument{Name: p.name()}
+				p.expect(":")
+				a.Value = p.value(constant)
+				obj = append(obj, a)
+			}
+			p.leave()
+			return obj
+		}
+	case tokInt:
+		p.next()
+		n, err := strconv.ParseInt(t.text, 10, 64)
+		if err != nil {
+			p.fail("invalid integer %s", t.text)
+		}
+		return n
+	case tokFloat:
+		p.next()
+		f, _ := strconv.ParseFloat(t.text, 64)
+		return f
+	case tokString:
+		p.next()
+		return t.text
+	case tokName:
+		p.next()
+		switch t.text {
+		case "true":
+			return true
+		case "false":
+			return false
+		case "null":
+			return nil
+		}
+		return Enum(t.text)
+	}
+	p.fail("unexpected %q", t.text)
+	return nil
+}
+
+func (p *parser) name() string {
+	if p.tok.kind != tokName {
+		p.fail("expected name, found %q", p.tok.text)
+	}
+	s := p.tok.text
+	p.next()
+	return s
+}
+
+func (p *parser) keyword(kw string) {
+	if p.tok.kind != tokName || p.tok.text != kw {
+		p.fail("expected %q, found %q", kw, p.tok.text)
+	}
+	p.next()
+}
+
+func (p *parser) peek(punct string) bool {
+	return p.tok.kind == tokPunct && p.tok.text == punct
+}
+
+func (p *parser) skip(punct string) bool {
+	if p.peek(punct) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(punct string) {
+	if !p.skip(punct) {
+		p.fail("expected %q, found %q", punct, p.tok.text)
+	}
+}
+
+// enter descends one level of nesting, failing past maxNesting.
+func (p *parser) enter() {
+	if p.depth++; p.depth > maxNesting {
+		p.fail("document nests more than %d levels deep", maxNesting)
+	}
+}
+
+func (p *parser) leave() {
+	p.depth--
+}
+
+func (p *parser) fail(format string, args ...any) {
+	panic(&SyntaxError{Loc: p.tok.loc, Message: fmt.Sprintf(format, args...)})
+}
+
+// next advances to the following token, skipping whitespace, commas and
+// comments.
+func (p *parser) next() {
+skip:
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.advance(1)
+			}
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			p.advance(1)
+		case strings.HasPrefix(p.src[p.pos:], "\ufeff"):
+			p.pos += len("\ufeff")
+		default:
+			break skip
+		}
+	}
+	loc := Location{Line: p.line, Column: p.col}
+	if p.pos >= len(p.src) {
+		p.tok = token{kind: tokEOF, text: "<EOF>", loc: loc}
+		return
+	}
+	rest := p.src[p.pos:]
+	c := rest[0]
+	switch {
+	case strings.HasPrefix(rest, "..."):
+		p.tok = token{kind: tokPunct, text: "...", loc: loc}
+		p.advance(3)
+	case strings.ContainsRune("!$()=:@[]{}|&", rune(c)):
+		p.tok = token{kind: tokPunct, text: string(c), loc: loc}
+		p.advance(1)
+	case c == '_' || isLetter(c):
+		n := 1
+		for n < len(rest) && (rest[n] == '_' || isLetter(rest[n]) || isDigit(rest[n])) {
+			n++
+		}
+		p.tok = token{kind: tokName, text: rest[:n], loc: loc}
+		p.advance(n)
+	case c == '-' || isDigit(c):
+		p.number(loc)
+	case c == '"':
+		p.str(loc)
+	default:
+		p.tok = token{kind: tokPunct, text: string(c), loc: loc}
+		p.fail("unexpected character %q", c)
+	}
+}
+
+func (p *parser) number(loc Location) {
+	rest := p.src[p.pos:]
+	n := 0
+	if rest[n] == '-' {
+		n++
+	}
+	kind := tokInt
+	for n < len(rest) && (isDigit(rest[n]) || strings.IndexByte(".eE+-", rest[n]) >= 0) {
+		if !isDigit(rest[n]) {
+			kind = tokFloat
+		}
+		n++
+	}
+	p.tok = token{kind: kind, text: rest[:n], loc: loc}
+	p.advance(n)
+}
+
+func (p *parser) str(loc Location) {
+	rest := p.src[p.pos:]
+	if strings.HasPrefix(rest, `"""`) {
+		end := strings.Index(rest[3:], `"""`)
+		if end < 0 {
+			p.tok = token{loc: loc}
+			p.fail("unterminated block string")
+		}
+		p.tok = token{kind: tokString, text: strings.TrimSpace(rest[3 : 3+end]), loc: loc}
+		p.advance(end + 6)
+		return
+	}
+	n := 1
+	for n < len(rest) && rest[n] != '"' && rest[n] != '\n' {
+		if rest[n] == '\\' {
+			n++
+		}
+		n++
+	}
+	if n >= len(rest) || rest[n] != '"' {
+		p.tok = token{loc: loc}
+		p.fail("unterminated string")
+	}
+	s, err := strconv.Unquote(rest[:n+1])
+	if err != nil {
+		p.tok = token{loc: loc}
+		p.fail("invalid string %s", rest[:n+1])
+	}
+	p.tok = token{kind: tokString, text: s, loc: loc}
+	p.advance(n + 1)
+}
+
+func (p *parser) advance(n int) {
+	for i := 0; i < n && p.pos < len(p.src); i++ {
+		if p.src[p.pos] == '\n' {
+			p.line++
+			p.col = 1
+		} else {
+			p.col++
+		}
+		p.pos++
+	}
+}
+
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
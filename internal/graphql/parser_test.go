@@ -0,0 +1,36 @@
+package graphql
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// Deeply nested documents fail to parse instead of exhausting the stack,
+// which would crash the process rather than panic.
+func TestParseNesting(t *testing.T) {
+	const deep = 2_000_000
+	for _, tc := range []struct {
+		name       string
+		open, shut string
+		doc        func(open, shut string) string
+	}{
+		{"selections", "{a", "}", func(o, s string) string { return o + s }},
+		{"inline fragments", "...{", "}", func(o, s string) string { return "{" + o + "a" + s + "}" }},
+		{"list values", "[", "]", func(o, s string) string { return "{a(x: " + o + s + ")}" }},
+		{"object values", "{x:", "}", func(o, s string) string { return "{a(x: " + o + "1" + s + ")}" }},
+		{"list types", "[", "]", func(o, s string) string { return "query($v: " + o + "Int" + s + ") {a}" }},
+	} {
+		for _, n := range []int{maxNesting - 1, maxNesting + 1, deep} {
+			src := tc.doc(strings.Repeat(tc.open, n), strings.Repeat(tc.shut, n))
+			_, err := Parse(src)
+			var se *SyntaxError
+			switch {
+			case n < maxNesting && err != nil:
+				t.Errorf("%s nested %d deep: %v", tc.name, n, err)
+			case n > maxNesting && (!errors.As(err, &se) || !strings.Contains(se.Message, "levels deep")):
+				t.Errorf("%s nested %d deep: err = %v, want a syntax error on nesting", tc.name, n, err)
+			}
+		}
+	}
+}
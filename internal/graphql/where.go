@@ -0,0 +1,140 @@
+package graphql
+
+import (
+	"github.com/clustlight/animatrix-api/ent/episode"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/season"
+	"github.com/clustlight/animatrix-api/ent/series"
+)
+
+// The where builders translate the *WhereInput objects of schema.graphql
+// into predicates; every given condition must hold.
+
+func seriesWhere(args Args) ([]predicate.Series, error) {
+	w, err := args.Object("where", "seriesId", "seriesIdIn", "titleContains", "hasSeasons")
+	if err != nil {
+		return nil, err
+	}
+	var ps []predicate.Series
+	if v, ok, err := w.String("seriesId"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, series.SeriesIDEQ(v))
+	}
+	if v, ok, err := w.Strings("seriesIdIn"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, series.SeriesIDIn(v...))
+	}
+	if v, ok, err := w.String("titleContains"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, series.Or(
+			series.TitleContainsFold(v),
+			series.TitleYomiContainsFold(v),
+			series.TitleEnContainsFold(v),
+		))
+	}
+	if v, ok, err := w.Bool("hasSeasons"); err != nil {
+		return nil, err
+	} else if ok {
+		if v {
+			ps = append(ps, series.HasSeasons())
+		} else {
+			ps = append(ps, series.Not(series.HasSeasons()))
+		}
+	}
+	return ps, nil
+}
+
+func seasonWhere(args Args) ([]predicate.Season, error) {
+	w, err := args.Object("where", "seasonId", "seasonIdIn", "seriesId", "seasonTitleContains",
+		"seasonNumber", "firstYear", "firstYearGTE", "firstYearLTE")
+	if err != nil {
+		return nil, err
+	}
+	var ps []predicate.Season
+	if v, ok, err := w.String("seasonId"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, season.SeasonIDEQ(v))
+	}
+	if v, ok, err := w.Strings("seasonIdIn"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, season.SeasonIDIn(v...))
+	}
+	if v, ok, err := w.String("seriesId"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, season.HasSeriesWith(series.SeriesIDEQ(v)))
+	}
+	if v, ok, err := w.String("seasonTitleContains"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, season.Or(season.SeasonTitleContainsFold(v), season.SeasonTitleYomiContainsFold(v)))
+	}
+	for name, pred := range map[string]func(int) predicate.Season{
+		"seasonNumber": season.SeasonNumberEQ,
+		"firstYear":    season.FirstYearEQ,
+		"firstYearGTE": season.FirstYearGTE,
+		"firstYearLTE": season.FirstYearLTE,
+	} {
+		if v, ok, err := w.Int(name); err != nil {
+			return nil, err
+		} else if ok {
+			ps = append(ps, pred(v))
+		}
+	}
+	return ps, nil
+}
+
+func episodeWhere(args Args) ([]predicate.Episode, error) {
+	w, err := args.Object("where", "episodeId", "episodeIdIn", "seasonId", "titleContains",
+		"episodeNumber", "dynamicRange", "timestampGTE", "timestampLTE")
+	if err != nil {
+		return nil, err
+	}
+	var ps []predicate.Episode
+	if v, ok, err := w.String("episodeId"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, episode.EpisodeIDEQ(v))
+	}
+	if v, ok, err := w.Strings("episodeIdIn"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, episode.EpisodeIDIn(v...))
+	}
+	if v, ok, err := w.String("seasonId"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, episode.HasSeasonWith(season.SeasonIDEQ(v)))
+	}
+	if v, ok, err := w.String("titleContains"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, episode.TitleContainsFold(v))
+	}
+	if v, ok, err := w.Int("episodeNumber"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, episode.EpisodeNumberEQ(v))
+	}
+	if v, ok, err := w.String("dynamicRange"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, episode.DynamicRangeEQ(v))
+	}
+	if v, ok, err := w.Time("timestampGTE"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, episode.TimestampGTE(v))
+	}
+	if v, ok, err := w.Time("timestampLTE"); err != nil {
+		return nil, err
+	} else if ok {
+		ps = append(ps, episode.TimestampLTE(v))
+	}
+	return ps, nil
+}
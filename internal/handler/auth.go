@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/auth"
+	"github.com/clustlight/animatrix-api/internal/controller"
+)
+
+// Authenticate returns middleware that, when auth.Config.APIKeys is set,
+// admits requests presenting an API key or token (see
+// controller.Authenticate) with the scope scopeOf returns for them: 401
+// without valid credentials, 403 when they lack the scope. Routes needing
+// no scope ("") are public; credentials sent to them are still checked,
+// so they can name the user.
+func Authenticate(client *ent.Client, scopeOf func(*http.Request) auth.Scope) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !auth.Current().APIKeys {
+				next.ServeHTTP(w, r)
+				return
+			}
+			need, key := scopeOf(r), auth.KeyFrom(r)
+			if key == "" {
+				if need == "" {
+					next.ServeHTTP(w, r)
+					return
+				}
+				w.Header().Set("WWW-Authenticate", `Bearer realm="animatrix"`)
+				writeError(w, r, controller.Unauthorized("an API key or token is required; send it as a bearer token or an API key in X-API-Key"))
+				return
+			}
+			p, err := controller.Authenticate(r.Context(), client, key)
+			var ce *controller.Error
+			if errors.As(err, &ce) && ce.Code == controller.CodeUnauthorized {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="animatrix", error="invalid_token"`)
+			}
+			if err != nil {
+				writeError(w, r, err)
+				return
+			}
+			if need != "" && !p.Allows(need) {
+				writeError(w, r, controller.Forbidden("the API key or token lacks the "+string(need)+" scope"))
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), p)))
+		})
+	}
+}
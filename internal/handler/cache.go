@@ -0,0 +1,16 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/clustlight/animatrix-api/internal/respcache"
+)
+
+// CacheStats reports the response cache hit and miss counters.
+func CacheStats(cache *respcache.Cache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(cache.Stats())
+	}
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/httpcache"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/validate"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func GetChapters(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.ChapterResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		chapters, err := controller.GetChapters(r.Context(), client, chi.URLParam(r, "episode_id"))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, chapters, types.Latest(chapters))
+	}
+}
+
+// ReplaceChapters sets the chapters of an episode to the JSON array in the
+// body.
+func ReplaceChapters(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		episodeID := chi.URLParam(r, "episode_id")
+		var chapters []types.ChapterRequest
+		if err := json.NewDecoder(r.Body).Decode(&chapters); err != nil {
+			writeError(w, r, controller.BadRequest("invalid request"))
+			return
+		}
+		if err := validate.Slice(chapters); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+		var saved []types.ChapterResponse
+		if err := ifMatch(r, client, controller.LockEpisode(episodeID), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetChapters(ctx, client, episodeID)
+		}, func(ctx context.Context, client *ent.Client) (err error) {
+			saved, err = controller.ReplaceChapters(ctx, client, episodeID, chapters)
+			return err
+		}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(saved)
+	}
+}
+
+func DeleteChapters(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		episodeID := chi.URLParam(r, "episode_id")
+		if err := ifMatch(r, client, controller.LockEpisode(episodeID), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetChapters(ctx, client, episodeID)
+		}, func(ctx context.Context, client *ent.Client) error {
+			_, err := controller.ReplaceChapters(ctx, client, episodeID, nil)
+			return err
+		}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// GetChapterVTT answers with the chapters of the episode as a WebVTT
+// chapters file.
+func GetChapterVTT(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vtt, modified, err := controller.EpisodeChapterVTT(r.Context(), client, chi.URLParam(r, "episode_id"))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		httpcache.SetLastModified(w, modified)
+		w.Header().Set("Content-Type", "text/vtt; charset=utf-8")
+		io.WriteString(w, vtt)
+	}
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+// deleteOptions reads the ?cascade= and ?dry_run= flags.
+func deleteOptions(r *http.Request) (types.DeleteOptions, error) {
+	var opts types.DeleteOptions
+	q := r.URL.Query()
+	for name, dst := range map[string]*bool{"cascade": &opts.Cascade, "dry_run": &opts.DryRun} {
+		if raw := q.Get(name); raw != "" {
+			v, err := strconv.ParseBool(raw)
+			if err != nil {
+				return opts, controller.ValidationFailed(types.FieldError{Field: name, Message: "must be a boolean"})
+			}
+			*dst = v
+		}
+	}
+	return opts, nil
+}
+
+func writeDeletionPlan(w http.ResponseWriter, plan *types.DeletionPlan) {
+	if !plan.DryRun {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(plan)
+}
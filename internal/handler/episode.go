@@ -1,12 +1,17 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 
 	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/codec"
 	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/httpcache"
 	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/validate"
 
 	"github.com/go-chi/chi/v5"
 )
@@ -14,13 +19,17 @@ import (
 func GetAllEpisodes(client *ent.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
+		v, err := readView[types.EpisodeResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
 		episodes, err := controller.GetAllEpisodes(ctx, client)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(episodes)
+		v.write(w, episodes, types.Latest(*episodes))
 	}
 }
 
@@ -29,18 +38,17 @@ func GetEpisodeDetail(client *ent.Client) http.HandlerFunc {
 		episodeID := chi.URLParam(r, "episode_id")
 		ctx := r.Context()
 
+		v, err := readView[types.EpisodeResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
 		episode, err := controller.GetEpisode(ctx, client, episodeID)
 		if err != nil {
-			switch err.(type) {
-			case *ent.NotFoundError:
-				http.Error(w, "Episode not found", http.StatusNotFound)
-			default:
-				http.Error(w, err.Error(), http.StatusInternalServerError)
-			}
+			writeError(w, r, err)
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(episode)
+		v.write(w, episode, episode.LastModified())
 	}
 }
 
@@ -48,19 +56,19 @@ func CreateEpisode(client *ent.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var episodeData types.CreateEpisodeRequest
 		if err := json.NewDecoder(r.Body).Decode(&episodeData); err != nil {
-			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
 			return
 		}
 
-		if err := episodeData.ValidateRequired(); err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+		if err := validate.Struct(&episodeData); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
 			return
 		}
 
 		ctx := r.Context()
 		newEpisode, err := controller.CreateEpisode(ctx, client, &episodeData)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
 		w.Header().Set("Content-Type", "application/json")
@@ -73,14 +81,23 @@ func UpdateEpisode(client *ent.Client) http.HandlerFunc {
 		episodeID := chi.URLParam(r, "episode_id")
 		var episodeData types.UpdateEpisodeRequest
 		if err := json.NewDecoder(r.Body).Decode(&episodeData); err != nil {
-			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
 			return
 		}
 
-		ctx := r.Context()
-		updatedEpisode, err := controller.UpdateEpisode(ctx, client, episodeID, &episodeData)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if err := validate.Struct(&episodeData); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+
+		var updatedEpisode *types.EpisodeResponse
+		if err := ifMatch(r, client, controller.LockEpisode(episodeID), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetEpisode(ctx, client, episodeID)
+		}, func(ctx context.Context, client *ent.Client) (err error) {
+			updatedEpisode, err = controller.UpdateEpisode(ctx, client, episodeID, &episodeData)
+			return err
+		}); err != nil {
+			writeError(w, r, err)
 			return
 		}
 		w.Header().Set("Content-Type", "application/json")
@@ -90,14 +107,24 @@ func UpdateEpisode(client *ent.Client) http.HandlerFunc {
 
 func BulkCreateEpisodeHandler(client *ent.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if codec.IsStreaming(r.Header.Get("Content-Type")) {
+			streamImport(w, r, func(ctx context.Context, dec codec.Decoder, batchSize int) (*types.ImportSummary, error) {
+				return controller.ImportEpisodes(ctx, client, dec, batchSize)
+			})
+			return
+		}
 		var EpisodeList []types.CreateEpisodeRequest
 		if err := json.NewDecoder(r.Body).Decode(&EpisodeList); err != nil {
-			http.Error(w, "invalid request", http.StatusBadRequest)
+			writeError(w, r, controller.BadRequest("invalid request"))
+			return
+		}
+		if err := validate.Slice(EpisodeList); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
 			return
 		}
 		newEpisodeList, err := controller.BulkCreateEpisode(r.Context(), client, EpisodeList)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			writeError(w, r, err)
 			return
 		}
 
@@ -111,18 +138,32 @@ func DeleteEpisode(client *ent.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		episodeID := chi.URLParam(r, "episode_id")
 		if episodeID == "" {
-			http.Error(w, "episode_id required", http.StatusBadRequest)
+			writeError(w, r, controller.BadRequest("episode_id required"))
 			return
 		}
-		if err := controller.DeleteEpisode(r.Context(), client, episodeID); err != nil {
-			switch _, ok := err.(*ent.NotFoundError); {
-			case ok:
-				http.Error(w, "Episode not found", http.StatusNotFound)
-			default:
-				http.Error(w, err.Error(), http.StatusInternalServerError)
-			}
+		if err := ifMatch(r, client, controller.LockEpisode(episodeID), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetEpisode(ctx, client, episodeID)
+		}, func(ctx context.Context, client *ent.Client) error {
+			return controller.DeleteEpisode(ctx, client, episodeID)
+		}); err != nil {
+			writeError(w, r, err)
 			return
 		}
 		w.WriteHeader(http.StatusNoContent)
 	}
 }
+
+// GetEpisodeMasterPlaylist answers with the HLS master playlist listing
+// the episode's HLS renditions.
+func GetEpisodeMasterPlaylist(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		playlist, modified, err := controller.EpisodeMasterPlaylist(r.Context(), client, chi.URLParam(r, "episode_id"))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		httpcache.SetLastModified(w, modified)
+		w.Header().Set("Content-Type", "application/vnd.apple.mpegurl")
+		io.WriteString(w, playlist)
+	}
+}
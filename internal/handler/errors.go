@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/clustlight/animatrix-api/internal/controller"
+)
+
+const problemContentType = "application/problem+json"
+
+// writeError answers with the problem details for err.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	p := controller.ToProblem(err)
+	p.Instance = r.URL.Path
+	w.Header().Set("Content-Type", problemContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
+
+func NotFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, controller.NotFound("no route for "+r.URL.Path))
+}
+
+func MethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, &controller.Error{Code: controller.CodeMethodNotAllowed, Message: r.Method + " is not allowed on " + r.URL.Path})
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/codec"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+// flushingEncoder pushes each exported page to the client as it is written.
+type flushingEncoder struct {
+	codec.Encoder
+	rc *http.ResponseController
+}
+
+func (e flushingEncoder) Flush() error {
+	if err := e.Encoder.Flush(); err != nil {
+		return err
+	}
+	_ = e.rc.Flush()
+	return nil
+}
+
+func ExportHandler(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		opts, err := types.ParseExportOptions(q.Get("format"), q.Get("include"))
+		if err != nil {
+			writeError(w, r, controller.BadRequest(err.Error()))
+			return
+		}
+
+		w.Header().Set("Content-Type", codec.ContentType(opts.Format))
+		w.Header().Set("Content-Disposition", `attachment; filename="animatrix-export.`+opts.Format+`"`)
+		enc, err := codec.NewEncoder(opts.Format, w, &types.CatalogRecord{})
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		if err := controller.ExportCatalog(r.Context(), client, flushingEncoder{enc, http.NewResponseController(w)}, opts); err != nil {
+			// headers are already sent; the truncated body is all we can signal
+			log.Printf("export aborted: %v", err)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/graphql"
+)
+
+// maxGraphQLBytes bounds the body of a POST and the query string of a GET.
+const maxGraphQLBytes = 256 << 10
+
+// GraphQL executes queries against the catalog schema. POST takes a JSON
+// body {query, operationName, variables}; GET takes the same as query
+// parameters, with variables JSON-encoded.
+func GraphQL(client *ent.Client) http.HandlerFunc {
+	schema := graphql.NewCatalog(client)
+	tooLarge := controller.PayloadTooLarge("the request is larger than 256 KiB")
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req graphql.Request
+		if r.Method == http.MethodGet {
+			if len(r.URL.RawQuery) > maxGraphQLBytes {
+				writeError(w, r, tooLarge)
+				return
+			}
+			q := r.URL.Query()
+			req.Query = q.Get("query")
+			req.OperationName = q.Get("operationName")
+			if v := q.Get("variables"); v != "" {
+				if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+					writeError(w, r, controller.BadRequest("variables must be a JSON object"))
+					return
+				}
+			}
+		} else {
+			dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGraphQLBytes))
+			dec.UseNumber()
+			if err := dec.Decode(&req); err != nil {
+				var mbe *http.MaxBytesError
+				if errors.As(err, &mbe) {
+					writeError(w, r, tooLarge)
+				} else {
+					writeError(w, r, controller.BadRequest("Invalid request payload"))
+				}
+				return
+			}
+		}
+		if req.Query == "" {
+			writeError(w, r, controller.BadRequest("query is required"))
+			return
+		}
+
+		resp := schema.Execute(r.Context(), req)
+		w.Header().Set("Content-Type", "application/json")
+		if resp.Data == nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// GraphQLSchema serves the schema in the GraphQL schema language.
+func GraphQLSchema(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write([]byte(graphql.CatalogSDL))
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/codec"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+type importFunc func(ctx context.Context, dec codec.Decoder, batchSize int) (*types.ImportSummary, error)
+
+// importBatchSize reads ?batch_size=, falling back to IMPORT_BATCH_SIZE.
+func importBatchSize(r *http.Request) (int, bool) {
+	raw := r.URL.Query().Get("batch_size")
+	if raw == "" {
+		raw = os.Getenv("IMPORT_BATCH_SIZE")
+	}
+	if raw == "" {
+		return controller.DefaultImportBatchSize, true
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n <= 0 || n > controller.MaxImportBatchSize {
+		return 0, false
+	}
+	return n, true
+}
+
+// streamImport decodes the body record by record according to its Content-Type.
+func streamImport(w http.ResponseWriter, r *http.Request, run importFunc) {
+	batchSize, ok := importBatchSize(r)
+	if !ok {
+		writeError(w, r, controller.ValidationFailed(types.FieldError{
+			Field:   "batch_size",
+			Message: "must be between 1 and " + strconv.Itoa(controller.MaxImportBatchSize),
+		}))
+		return
+	}
+	dec, err := codec.NewDecoder(r.Header.Get("Content-Type"), r.Body)
+	if err == codec.ErrUnsupportedMediaType {
+		writeError(w, r, err)
+		return
+	}
+	if err != nil {
+		writeError(w, r, controller.BadRequest(err.Error()))
+		return
+	}
+	summary, err := run(r.Context(), dec, batchSize)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(summary)
+}
+
+func ImportHandler(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		streamImport(w, r, func(ctx context.Context, dec codec.Decoder, batchSize int) (*types.ImportSummary, error) {
+			return controller.ImportCatalog(ctx, client, dec, batchSize)
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/validate"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// itemID returns the catalog ID of the series or season an item handler
+// serves: the series_id or season_id path parameter, by kind
+// (types.ItemSeries or types.ItemSeason).
+func itemID(r *http.Request, kind string) string {
+	return chi.URLParam(r, kind+"_id")
+}
+
+// writeAdded answers 201 with body when an item was added, 200 when it
+// already was there.
+func writeAdded(w http.ResponseWriter, body any, created bool) {
+	w.Header().Set("Content-Type", "application/json")
+	if created {
+		w.WriteHeader(http.StatusCreated)
+	}
+	json.NewEncoder(w).Encode(body)
+}
+
+func GetFavorites(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.FavoriteResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		favorites, err := controller.GetFavorites(r.Context(), client, currentUser(r))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, favorites, types.Latest(favorites))
+	}
+}
+
+func GetFavorite(client *ent.Client, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.FavoriteResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		favorite, err := controller.GetFavorite(r.Context(), client, currentUser(r), kind, itemID(r, kind))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, favorite, favorite.LastModified())
+	}
+}
+
+func AddFavorite(client *ent.Client, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		favorite, created, err := controller.AddFavorite(r.Context(), client, currentUser(r), kind, itemID(r, kind))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		writeAdded(w, favorite, created)
+	}
+}
+
+func RemoveFavorite(client *ent.Client, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		u, id := currentUser(r), itemID(r, kind)
+		if err := ifMatch(r, client, controller.LockUser(u), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetFavorite(ctx, client, u, kind, id)
+		}, func(ctx context.Context, client *ent.Client) error {
+			return controller.RemoveFavorite(ctx, client, u, kind, id)
+		}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func GetRatings(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.RatingResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		ratings, err := controller.GetRatings(r.Context(), client, currentUser(r))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, ratings, types.Latest(ratings))
+	}
+}
+
+func GetRating(client *ent.Client, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.RatingResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		rating, err := controller.GetRating(r.Context(), client, currentUser(r), kind, itemID(r, kind))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, rating, rating.LastModified())
+	}
+}
+
+func RateItem(client *ent.Client, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		u, id := currentUser(r), itemID(r, kind)
+		var req types.RatingRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
+			return
+		}
+		if err := validate.Struct(&req); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+		var rating *types.RatingResponse
+		if err := ifMatch(r, client, controller.LockUser(u), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetRating(ctx, client, u, kind, id)
+		}, func(ctx context.Context, client *ent.Client) (err error) {
+			rating, err = controller.RateItem(ctx, client, u, kind, id, &req)
+			return err
+		}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(rating)
+	}
+}
+
+func RemoveRating(client *ent.Client, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		u, id := currentUser(r), itemID(r, kind)
+		if err := ifMatch(r, client, controller.LockUser(u), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetRating(ctx, client, u, kind, id)
+		}, func(ctx context.Context, client *ent.Client) error {
+			return controller.RemoveRating(ctx, client, u, kind, id)
+		}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func GetWatchlists(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.WatchlistResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		lists, err := controller.GetWatchlists(r.Context(), client, currentUser(r))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, lists, types.Latest(lists))
+	}
+}
+
+func GetWatchlist(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.WatchlistResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		list, err := controller.GetWatchlist(r.Context(), client, currentUser(r), chi.URLParam(r, "watchlist_id"))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, list, list.LastModified())
+	}
+}
+
+func CreateWatchlist(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.WatchlistRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
+			return
+		}
+		if err := validate.Struct(&req); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+		list, err := controller.CreateWatchlist(r.Context(), client, currentUser(r), &req)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(list)
+	}
+}
+
+func RenameWatchlist(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		u, id := currentUser(r), chi.URLParam(r, "watchlist_id")
+		var req types.WatchlistRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
+			return
+		}
+		if err := validate.Struct(&req); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+		var list *types.WatchlistResponse
+		if err := ifMatch(r, client, controller.LockUser(u), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetWatchlist(ctx, client, u, id)
+		}, func(ctx context.Context, client *ent.Client) (err error) {
+			list, err = controller.RenameWatchlist(ctx, client, u, id, &req)
+			return err
+		}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(list)
+	}
+}
+
+func DeleteWatchlist(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		u, id := currentUser(r), chi.URLParam(r, "watchlist_id")
+		if err := ifMatch(r, client, controller.LockUser(u), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetWatchlist(ctx, client, u, id)
+		}, func(ctx context.Context, client *ent.Client) error {
+			return controller.DeleteWatchlist(ctx, client, u, id)
+		}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func GetWatchlistItem(client *ent.Client, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.WatchlistItemResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		item, err := controller.GetWatchlistItem(r.Context(), client, currentUser(r), chi.URLParam(r, "watchlist_id"), kind, itemID(r, kind))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, item, item.LastModified())
+	}
+}
+
+func AddWatchlistItem(client *ent.Client, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		item, created, err := controller.AddWatchlistItem(r.Context(), client, currentUser(r), chi.URLParam(r, "watchlist_id"), kind, itemID(r, kind))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		writeAdded(w, item, created)
+	}
+}
+
+func RemoveWatchlistItem(client *ent.Client, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		u, id, item := currentUser(r), chi.URLParam(r, "watchlist_id"), itemID(r, kind)
+		if err := ifMatch(r, client, controller.LockUser(u), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetWatchlistItem(ctx, client, u, id, kind, item)
+		}, func(ctx context.Context, client *ent.Client) error {
+			return controller.RemoveWatchlistItem(ctx, client, u, id, kind, item)
+		}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/auth"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+	"github.com/clustlight/animatrix-api/internal/validate"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type userKey struct{}
+
+// RequireUser returns middleware that answers 401 unless the request acts
+// for a user, the one its API key is bound to or else the one it names
+// (see auth.Config.User), whose account it creates on first use. Tokens
+// act only for the user of their claim; they and keys not bound to a user
+// get 403, except admin keys, which may name one.
+func RequireUser(client *ent.Client) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c := auth.Current()
+			if c.UserHeader != "" {
+				w.Header().Add("Vary", c.UserHeader)
+			}
+			var name string
+			switch p := auth.PrincipalFrom(r.Context()); {
+			case p == nil:
+				name = c.User(r)
+			case p.User != "":
+				name = p.User
+			case p.KeyID == 0:
+				claim := auth.DefaultUserClaim
+				if c.JWT != nil {
+					claim = c.JWT.UserClaim
+				}
+				writeError(w, r, controller.Forbidden("the token has no "+claim+" claim naming a user"))
+				return
+			case p.Allows(auth.ScopeAdmin):
+				name = c.User(r)
+			default:
+				writeError(w, r, controller.Forbidden("the API key is not bound to a user; create one with -user"))
+				return
+			}
+			if name == "" {
+				msg := "user identification is disabled"
+				if c.UserHeader != "" {
+					msg = "no user; send the " + c.UserHeader + " header"
+				}
+				writeError(w, r, controller.Unauthorized(msg))
+				return
+			}
+			u, err := controller.EnsureUser(r.Context(), client, name)
+			if err != nil {
+				writeError(w, r, err)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), userKey{}, u)))
+		})
+	}
+}
+
+// currentUser returns the user set by RequireUser.
+func currentUser(r *http.Request) *ent.User {
+	return r.Context().Value(userKey{}).(*ent.User)
+}
+
+// watchListLimit reads ?limit=.
+func watchListLimit(r *http.Request) (int, error) {
+	raw := r.URL.Query().Get("limit")
+	if raw == "" {
+		return controller.DefaultWatchListLimit, nil
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n <= 0 || n > controller.MaxWatchListLimit {
+		return 0, controller.ValidationFailed(types.FieldError{
+			Field:   "limit",
+			Message: "must be between 1 and " + strconv.Itoa(controller.MaxWatchListLimit),
+		})
+	}
+	return n, nil
+}
+
+func GetMe(w http.ResponseWriter, r *http.Request) {
+	v, err := readView[types.UserResponse](r, nil)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	me := utils.BuildUserResponse(currentUser(r))
+	v.write(w, me, me.CreatedAt)
+}
+
+func GetProgress(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.ProgressResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		progress, err := controller.GetProgress(r.Context(), client, currentUser(r), chi.URLParam(r, "episode_id"))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, progress, progress.LastModified())
+	}
+}
+
+func SaveProgress(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		u, episodeID := currentUser(r), chi.URLParam(r, "episode_id")
+		var req types.ProgressRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
+			return
+		}
+		if err := validate.Struct(&req); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+		var progress *types.ProgressResponse
+		if err := ifMatch(r, client, controller.LockUser(u), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetProgress(ctx, client, u, episodeID)
+		}, func(ctx context.Context, client *ent.Client) (err error) {
+			progress, err = controller.SaveProgress(ctx, client, u, episodeID, &req)
+			return err
+		}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(progress)
+	}
+}
+
+func GetHistory(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.WatchEntry](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		limit, err := watchListLimit(r)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		entries, err := controller.History(r.Context(), client, currentUser(r), limit)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, entries, types.Latest(entries))
+	}
+}
+
+func GetContinueWatching(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.WatchEntry](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		limit, err := watchListLimit(r)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		entries, err := controller.ContinueWatching(r.Context(), client, currentUser(r), limit)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, entries, types.Latest(entries))
+	}
+}
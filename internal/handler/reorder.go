@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/validate"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func MoveEpisodes(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		seasonID := chi.URLParam(r, "season_id")
+		var req types.MoveEpisodesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
+			return
+		}
+		if err := validate.Struct(&req); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+
+		moved, err := controller.MoveEpisodes(r.Context(), client, seasonID, &req)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(moved)
+	}
+}
+
+func RenumberEpisodes(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		seasonID := chi.URLParam(r, "season_id")
+		var req types.RenumberRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
+			return
+		}
+		if err := validate.Struct(&req); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+
+		season, err := controller.RenumberEpisodes(r.Context(), client, seasonID, &req)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(season)
+	}
+}
+
+func RenumberSeasons(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		seriesID := chi.URLParam(r, "series_id")
+		var req types.RenumberRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
+			return
+		}
+		if err := validate.Struct(&req); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+
+		series, err := controller.RenumberSeasons(r.Context(), client, seriesID, &req)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(series)
+	}
+}
@@ -1,27 +1,31 @@
 package handler
 
 import (
-	"encoding/json"
 	"net/http"
 
 	"github.com/clustlight/animatrix-api/ent"
 	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/types"
 )
 
 func SearchHandler(client *ent.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		query := r.URL.Query().Get("q")
 		if query == "" {
-			http.Error(w, "query parameter 'q' is required", http.StatusBadRequest)
+			writeError(w, r, controller.ValidationFailed(types.FieldError{Field: "q", Message: "is required"}))
 			return
 		}
 		ctx := r.Context()
-		result, err := controller.SearchSeries(ctx, client, query)
+		v, err := readView[types.SeriesResponse](r, nil, types.IncludeSeasons, types.IncludeSeasonEpisodes)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(result)
+		result, err := controller.SearchSeries(ctx, client, query, v.include)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, result, types.Latest(result))
 	}
 }
@@ -1,25 +1,32 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
 	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/codec"
 	"github.com/clustlight/animatrix-api/internal/controller"
 	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/validate"
 	"github.com/go-chi/chi/v5"
 )
 
 func GetAllSeasons(client *ent.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		seasons, err := controller.GetAllSeasons(ctx, client)
+		v, err := readView[types.SeasonResponse](r, nil, types.IncludeEpisodes)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(seasons)
+		seasons, err := controller.GetAllSeasons(ctx, client, v.include)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, seasons, types.Latest(*seasons))
 	}
 }
 
@@ -28,18 +35,17 @@ func GetSeasonDetail(client *ent.Client) http.HandlerFunc {
 		seasonID := chi.URLParam(r, "season_id")
 		ctx := r.Context()
 
-		season, err := controller.GetSeason(ctx, client, seasonID)
+		v, err := readView[types.SeasonResponse](r, controller.SeasonDetailInclude, types.IncludeEpisodes)
 		if err != nil {
-			switch err.(type) {
-			case *ent.NotFoundError:
-				http.Error(w, "Season not found", http.StatusNotFound)
-			default:
-				http.Error(w, err.Error(), http.StatusInternalServerError)
-			}
+			writeError(w, r, err)
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(season)
+		season, err := controller.GetSeason(ctx, client, seasonID, v.include)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, season, season.LastModified())
 	}
 }
 
@@ -47,19 +53,19 @@ func CreateSeason(client *ent.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var seasonData types.CreateSeasonRequest
 		if err := json.NewDecoder(r.Body).Decode(&seasonData); err != nil {
-			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
 			return
 		}
 
-		if err := seasonData.ValidateRequired(); err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+		if err := validate.Struct(&seasonData); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
 			return
 		}
 
 		ctx := r.Context()
 		newSeason, err := controller.CreateSeason(ctx, client, &seasonData)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
 		w.Header().Set("Content-Type", "application/json")
@@ -72,14 +78,23 @@ func UpdateSeason(client *ent.Client) http.HandlerFunc {
 		seasonID := chi.URLParam(r, "season_id")
 		var seasonData types.UpdateSeasonRequest
 		if err := json.NewDecoder(r.Body).Decode(&seasonData); err != nil {
-			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
 			return
 		}
 
-		ctx := r.Context()
-		updatedSeason, err := controller.UpdateSeason(ctx, client, seasonID, &seasonData)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if err := validate.Struct(&seasonData); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+
+		var updatedSeason *types.SeasonResponse
+		if err := ifMatch(r, client, controller.LockSeason(seasonID), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetSeason(ctx, client, seasonID, controller.SeasonDetailInclude)
+		}, func(ctx context.Context, client *ent.Client) (err error) {
+			updatedSeason, err = controller.UpdateSeason(ctx, client, seasonID, &seasonData)
+			return err
+		}); err != nil {
+			writeError(w, r, err)
 			return
 		}
 		w.Header().Set("Content-Type", "application/json")
@@ -89,14 +104,24 @@ func UpdateSeason(client *ent.Client) http.HandlerFunc {
 
 func BulkCreateSeasonHandler(client *ent.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if codec.IsStreaming(r.Header.Get("Content-Type")) {
+			streamImport(w, r, func(ctx context.Context, dec codec.Decoder, batchSize int) (*types.ImportSummary, error) {
+				return controller.ImportSeasons(ctx, client, dec, batchSize)
+			})
+			return
+		}
 		var seasonList []types.CreateSeasonRequest
 		if err := json.NewDecoder(r.Body).Decode(&seasonList); err != nil {
-			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
+			return
+		}
+		if err := validate.Slice(seasonList); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
 			return
 		}
 		newSeasonList, err := controller.BulkCreateSeason(r.Context(), client, seasonList)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
 		w.Header().Set("Content-Type", "application/json")
@@ -108,19 +133,24 @@ func DeleteSeason(client *ent.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		seasonID := chi.URLParam(r, "season_id")
 		if seasonID == "" {
-			http.Error(w, "season_id required", http.StatusBadRequest)
+			writeError(w, r, controller.BadRequest("season_id required"))
+			return
+		}
+		opts, err := deleteOptions(r)
+		if err != nil {
+			writeError(w, r, err)
 			return
 		}
-		if err := controller.DeleteSeason(r.Context(), client, seasonID); err != nil {
-			if err == controller.ErrHasChildren {
-				http.Error(w, "Season has episodes; cannot delete", http.StatusConflict)
-			} else if _, ok := err.(*ent.NotFoundError); ok {
-				http.Error(w, "Season not found", http.StatusNotFound)
-			} else {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
-			}
+		var plan *types.DeletionPlan
+		if err := ifMatch(r, client, controller.LockSeason(seasonID), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetSeason(ctx, client, seasonID, controller.SeasonDetailInclude)
+		}, func(ctx context.Context, client *ent.Client) (err error) {
+			plan, err = controller.DeleteSeason(ctx, client, seasonID, opts)
+			return err
+		}); err != nil {
+			writeError(w, r, err)
 			return
 		}
-		w.WriteHeader(http.StatusNoContent)
+		writeDeletionPlan(w, plan)
 	}
 }
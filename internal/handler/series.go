@@ -1,12 +1,15 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
 	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/codec"
 	"github.com/clustlight/animatrix-api/internal/controller"
 	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/validate"
 
 	"github.com/go-chi/chi/v5"
 )
@@ -14,13 +17,22 @@ import (
 func GetAllSeries(client *ent.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		series, err := controller.GetAllSeries(ctx, client)
+		v, err := readView[types.SeriesResponse](r, nil, types.IncludeSeasons, types.IncludeSeasonEpisodes)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(series)
+		order, err := types.ParseSeriesSort(r.URL.Query().Get("sort"))
+		if err != nil {
+			writeError(w, r, controller.ValidationFailed(types.FieldError{Field: "sort", Message: err.Error()}))
+			return
+		}
+		series, err := controller.GetAllSeries(ctx, client, v.include, order)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, series, types.Latest(*series))
 	}
 }
 
@@ -29,18 +41,17 @@ func GetSeriesDetail(client *ent.Client) http.HandlerFunc {
 		seriesID := chi.URLParam(r, "series_id")
 		ctx := r.Context()
 
-		series, err := controller.GetSeries(ctx, client, seriesID)
+		v, err := readView[types.SeriesResponse](r, controller.SeriesDetailInclude, types.IncludeSeasons, types.IncludeSeasonEpisodes)
 		if err != nil {
-			switch err.(type) {
-			case *ent.NotFoundError:
-				http.Error(w, "Series not found", http.StatusNotFound)
-			default:
-				http.Error(w, err.Error(), http.StatusInternalServerError)
-			}
+			writeError(w, r, err)
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(series)
+		series, err := controller.GetSeries(ctx, client, seriesID, v.include)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, series, series.LastModified())
 	}
 }
 
@@ -49,19 +60,19 @@ func CreateSeries(client *ent.Client) http.HandlerFunc {
 
 		var seriesData types.CreateSeriesRequest
 		if err := json.NewDecoder(r.Body).Decode(&seriesData); err != nil {
-			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
 			return
 		}
 
-		if err := seriesData.ValidateRequired(); err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+		if err := validate.Struct(&seriesData); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
 			return
 		}
 
 		ctx := r.Context()
 		newSeries, err := controller.CreateSeries(ctx, client, &seriesData)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
 
@@ -76,19 +87,23 @@ func UpdateSeries(client *ent.Client) http.HandlerFunc {
 		seriesID := chi.URLParam(r, "series_id")
 		var seriesData types.UpdateSeriesRequest
 		if err := json.NewDecoder(r.Body).Decode(&seriesData); err != nil {
-			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
 			return
 		}
 
-		ctx := r.Context()
-		updatedSeries, err := controller.UpdateSeries(ctx, client, seriesID, &seriesData)
-		if err != nil {
-			switch err.(type) {
-			case *ent.NotFoundError:
-				http.Error(w, "Series not found", http.StatusNotFound)
-			default:
-				http.Error(w, err.Error(), http.StatusInternalServerError)
-			}
+		if err := validate.Struct(&seriesData); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+
+		var updatedSeries *types.SeriesResponse
+		if err := ifMatch(r, client, controller.LockSeries(seriesID), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetSeries(ctx, client, seriesID, controller.SeriesDetailInclude)
+		}, func(ctx context.Context, client *ent.Client) (err error) {
+			updatedSeries, err = controller.UpdateSeries(ctx, client, seriesID, &seriesData)
+			return err
+		}); err != nil {
+			writeError(w, r, err)
 			return
 		}
 
@@ -99,14 +114,24 @@ func UpdateSeries(client *ent.Client) http.HandlerFunc {
 
 func BulkCreateSeriesHandler(client *ent.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if codec.IsStreaming(r.Header.Get("Content-Type")) {
+			streamImport(w, r, func(ctx context.Context, dec codec.Decoder, batchSize int) (*types.ImportSummary, error) {
+				return controller.ImportSeries(ctx, client, dec, batchSize)
+			})
+			return
+		}
 		var seriesList []types.CreateSeriesRequest
 		if err := json.NewDecoder(r.Body).Decode(&seriesList); err != nil {
-			http.Error(w, "invalid request", http.StatusBadRequest)
+			writeError(w, r, controller.BadRequest("invalid request"))
+			return
+		}
+		if err := validate.Slice(seriesList); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
 			return
 		}
 		newSeriesList, err := controller.BulkCreateSeries(r.Context(), client, seriesList)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			writeError(w, r, err)
 			return
 		}
 
@@ -119,13 +144,17 @@ func BulkCreateSeriesHandler(client *ent.Client) http.HandlerFunc {
 func GetRecentlyUpdatedSeriesHandler(client *ent.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		series, err := controller.GetRecentlyUpdatedSeries(ctx, client)
+		v, err := readView[types.SeriesResponse](r, nil, types.IncludeSeasons, types.IncludeSeasonEpisodes)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(series)
+		series, err := controller.GetRecentlyUpdatedSeries(ctx, client, v.include)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, series, types.Latest(series))
 	}
 }
 
@@ -133,22 +162,24 @@ func DeleteSeries(client *ent.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		seriesID := chi.URLParam(r, "series_id")
 		if seriesID == "" {
-			http.Error(w, "series_id required", http.StatusBadRequest)
+			writeError(w, r, controller.BadRequest("series_id required"))
+			return
+		}
+		opts, err := deleteOptions(r)
+		if err != nil {
+			writeError(w, r, err)
 			return
 		}
-		if err := controller.DeleteSeries(r.Context(), client, seriesID); err != nil {
-			switch {
-			case err == controller.ErrHasChildren:
-				http.Error(w, "Series has seasons; cannot delete", http.StatusConflict)
-			default:
-				if _, ok := err.(*ent.NotFoundError); ok {
-					http.Error(w, "Series not found", http.StatusNotFound)
-				} else {
-					http.Error(w, err.Error(), http.StatusInternalServerError)
-				}
-			}
+		var plan *types.DeletionPlan
+		if err := ifMatch(r, client, controller.LockSeries(seriesID), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetSeries(ctx, client, seriesID, controller.SeriesDetailInclude)
+		}, func(ctx context.Context, client *ent.Client) (err error) {
+			plan, err = controller.DeleteSeries(ctx, client, seriesID, opts)
+			return err
+		}); err != nil {
+			writeError(w, r, err)
 			return
 		}
-		w.WriteHeader(http.StatusNoContent)
+		writeDeletionPlan(w, plan)
 	}
 }
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/controller"
+)
+
+// CheckStorage reports media missing from the object storage and storage
+// prefixes that belong to no catalog entry.
+func CheckStorage(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bucket, err := openBucket()
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		q := r.URL.Query()
+		report, err := controller.CheckStorage(r.Context(), client, bucket, q.Get("method"), q.Get("prefix"))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(report)
+	}
+}
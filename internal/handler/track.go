@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/httpcache"
+	"github.com/clustlight/animatrix-api/internal/storage"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/validate"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func GetSubtitles(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.SubtitleResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		subtitles, err := controller.GetSubtitles(r.Context(), client, chi.URLParam(r, "episode_id"))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, subtitles, types.Latest(subtitles))
+	}
+}
+
+func GetSubtitle(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.SubtitleResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		subtitle, err := controller.GetSubtitle(r.Context(), client, chi.URLParam(r, "episode_id"), chi.URLParam(r, "subtitle_id"))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, subtitle, subtitle.LastModified())
+	}
+}
+
+func CreateSubtitle(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SubtitleRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
+			return
+		}
+		if err := validate.Struct(&req); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+		subtitle, err := controller.CreateSubtitle(r.Context(), client, chi.URLParam(r, "episode_id"), &req)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(subtitle)
+	}
+}
+
+func UpdateSubtitle(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		episodeID, id := chi.URLParam(r, "episode_id"), chi.URLParam(r, "subtitle_id")
+		var req types.UpdateSubtitleRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
+			return
+		}
+		if err := validate.Struct(&req); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+		var subtitle *types.SubtitleResponse
+		if err := ifMatch(r, client, controller.LockEpisode(episodeID), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetSubtitle(ctx, client, episodeID, id)
+		}, func(ctx context.Context, client *ent.Client) (err error) {
+			subtitle, err = controller.UpdateSubtitle(ctx, client, episodeID, id, &req)
+			return err
+		}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(subtitle)
+	}
+}
+
+func DeleteSubtitle(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		episodeID, id := chi.URLParam(r, "episode_id"), chi.URLParam(r, "subtitle_id")
+		if err := ifMatch(r, client, controller.LockEpisode(episodeID), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetSubtitle(ctx, client, episodeID, id)
+		}, func(ctx context.Context, client *ent.Client) error {
+			return controller.DeleteSubtitle(ctx, client, episodeID, id)
+		}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// GetSubtitleWebVTT answers with the subtitle converted to WebVTT, or
+// redirects to a WebVTT file stored at an absolute URL.
+func GetSubtitleWebVTT(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vtt, location, modified, err := controller.SubtitleWebVTT(r.Context(), client, storage.Current(), chi.URLParam(r, "episode_id"), chi.URLParam(r, "subtitle_id"))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		if location != "" {
+			http.Redirect(w, r, location, http.StatusFound)
+			return
+		}
+		httpcache.SetLastModified(w, modified)
+		w.Header().Set("Content-Type", "text/vtt; charset=utf-8")
+		io.WriteString(w, vtt)
+	}
+}
+
+func GetAudioTracks(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.AudioTrackResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		tracks, err := controller.GetAudioTracks(r.Context(), client, chi.URLParam(r, "episode_id"))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, tracks, types.Latest(tracks))
+	}
+}
+
+func GetAudioTrack(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := readView[types.AudioTrackResponse](r, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		track, err := controller.GetAudioTrack(r.Context(), client, chi.URLParam(r, "episode_id"), chi.URLParam(r, "track_id"))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		v.write(w, track, track.LastModified())
+	}
+}
+
+func CreateAudioTrack(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AudioTrackRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
+			return
+		}
+		if err := validate.Struct(&req); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+		track, err := controller.CreateAudioTrack(r.Context(), client, chi.URLParam(r, "episode_id"), &req)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(track)
+	}
+}
+
+func UpdateAudioTrack(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		episodeID, id := chi.URLParam(r, "episode_id"), chi.URLParam(r, "track_id")
+		var req types.UpdateAudioTrackRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, controller.BadRequest("Invalid request payload"))
+			return
+		}
+		if err := validate.Struct(&req); err != nil {
+			writeError(w, r, controller.ValidationFailed(err))
+			return
+		}
+		var track *types.AudioTrackResponse
+		if err := ifMatch(r, client, controller.LockEpisode(episodeID), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetAudioTrack(ctx, client, episodeID, id)
+		}, func(ctx context.Context, client *ent.Client) (err error) {
+			track, err = controller.UpdateAudioTrack(ctx, client, episodeID, id, &req)
+			return err
+		}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(track)
+	}
+}
+
+func DeleteAudioTrack(client *ent.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		episodeID, id := chi.URLParam(r, "episode_id"), chi.URLParam(r, "track_id")
+		if err := ifMatch(r, client, controller.LockEpisode(episodeID), func(ctx context.Context, client *ent.Client) (any, error) {
+			return controller.GetAudioTrack(ctx, client, episodeID, id)
+		}, func(ctx context.Context, client *ent.Client) error {
+			return controller.DeleteAudioTrack(ctx, client, episodeID, id)
+		}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
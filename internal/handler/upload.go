@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/media"
+	"github.com/clustlight/animatrix-api/internal/storage"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// openBucket connects to the bucket configured by OBJECT_STORAGE_*.
+func openBucket() (*storage.Bucket, error) {
+	p, err := storage.S3FromEnv()
+	if err != nil {
+		return nil, controller.Unavailable("object storage is not configured", err)
+	}
+	return storage.NewBucket(p), nil
+}
+
+// UploadImage stores the request body as image asset a of the entity of
+// the given kind named by the path parameter param.
+func UploadImage(client *ent.Client, kind media.Kind, param string, a media.Asset) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "" {
+			mt, _, err := mime.ParseMediaType(ct)
+			if err != nil || (mt != "application/octet-stream" && !strings.HasPrefix(mt, "image/")) {
+				writeError(w, r, &controller.Error{Code: controller.CodeUnsupportedMediaType, Message: "body must be an image, not " + ct})
+				return
+			}
+		}
+		bucket, err := openBucket()
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		resp, err := controller.UploadImage(r.Context(), client, bucket, kind, chi.URLParam(r, param), a, r.Body)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}
+}
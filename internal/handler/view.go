@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/httpcache"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/utils"
+)
+
+// view is the ?fields= and ?include= selection of a read request.
+type view struct {
+	include types.Include
+	fields  types.Fields
+}
+
+// readView parses the selection for an endpoint answering with T. def
+// applies when ?include= is absent; allowed lists the accepted paths.
+func readView[T any](r *http.Request, def types.Include, allowed ...string) (view, error) {
+	q := r.URL.Query()
+	v := view{include: def, fields: types.ParseFields(q.Get("fields"))}
+	if err := utils.CheckFields(reflect.TypeFor[T](), v.fields); err != nil {
+		return v, controller.ValidationFailed(types.FieldError{Field: "fields", Message: err.Error()})
+	}
+	if q.Has("include") {
+		in, err := types.ParseInclude(q.Get("include"), allowed...)
+		if err != nil {
+			return v, controller.ValidationFailed(types.FieldError{Field: "include", Message: err.Error()})
+		}
+		v.include = in
+	}
+	v.include = v.include.Within(v.fields)
+	return v, nil
+}
+
+// write sends body reduced to the selected fields; modified becomes its
+// Last-Modified.
+func (v view) write(w http.ResponseWriter, body any, modified time.Time) {
+	httpcache.SetLastModified(w, modified)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(utils.SelectFields(body, v.fields))
+}
+
+// ifMatch runs write under an If-Match precondition. The listed tags are
+// compared with the ETag that GET on the resource, without query
+// parameters, currently returns; current loads that representation. Both
+// run in one transaction holding lock, so the resource cannot be changed
+// by another write between the comparison and write.
+func ifMatch(r *http.Request, client *ent.Client, lock controller.Lock, current func(context.Context, *ent.Client) (any, error), write func(context.Context, *ent.Client) error) error {
+	header := r.Header.Get("If-Match")
+	if header == "" {
+		return write(r.Context(), client)
+	}
+	return controller.Locked(r.Context(), client, lock, func(ctx context.Context, client *ent.Client) error {
+		v, err := current(ctx, client)
+		if ent.IsNotFound(err) {
+			return controller.PreconditionFailed("the resource does not exist")
+		}
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err := json.NewEncoder(&buf).Encode(v); err != nil {
+			return err
+		}
+		if !httpcache.Match(header, httpcache.ETag(buf.Bytes())) {
+			return controller.PreconditionFailed("the resource has changed since it was read")
+		}
+		return write(ctx, client)
+	})
+}
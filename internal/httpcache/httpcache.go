@@ -0,0 +1,122 @@
+// Package httpcache adds validators (ETag, Last-Modified) and Cache-Control
+// to GET responses, answers conditional requests with 304 Not Modified and
+// evaluates If-Match preconditions for writes.
+package httpcache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// ETag returns the strong entity tag of a response body.
+func ETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + base64.RawURLEncoding.EncodeToString(sum[:16]) + `"`
+}
+
+// Match reports whether an If-Match or If-None-Match header value lists
+// etag, using the strong comparison.
+func Match(header, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// SetLastModified records when the resource in the response last changed.
+// The zero time is ignored.
+func SetLastModified(w http.ResponseWriter, t time.Time) {
+	if !t.IsZero() {
+		w.Header().Set("Last-Modified", t.UTC().Format(http.TimeFormat))
+	}
+}
+
+// Policy maps route patterns, as registered with chi, to the
+// Cache-Control directive that replaces a route's default.
+type Policy map[string]string
+
+// PolicyFromEnv reads CACHE_CONTROL, a ';' separated list of
+// pattern=directive entries such as
+// "/v1/series/recent=public, max-age=30;/v1/search=no-cache".
+func PolicyFromEnv() Policy {
+	p := Policy{}
+	for _, entry := range strings.Split(os.Getenv("CACHE_CONTROL"), ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		pattern, directive, ok := strings.Cut(entry, "=")
+		if !ok {
+			log.Printf("CACHE_CONTROL: ignoring %q, want pattern=directive", entry)
+			continue
+		}
+		p[strings.TrimSpace(pattern)] = strings.TrimSpace(directive)
+	}
+	return p
+}
+
+// Handler returns middleware that buffers successful GET responses, tags
+// them with an ETag and the Cache-Control directive (def unless the policy
+// overrides it for the route) and serves them with http.ServeContent, which
+// answers If-None-Match and If-Modified-Since. Other responses pass through
+// unchanged.
+func (p Policy) Handler(def string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+			rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+			if rec.status != http.StatusOK {
+				w.WriteHeader(rec.status)
+				w.Write(rec.body.Bytes())
+				return
+			}
+
+			directive := def
+			if rc := chi.RouteContext(r.Context()); rc != nil {
+				if d, ok := p[rc.RoutePattern()]; ok {
+					directive = d
+				}
+			}
+			h := w.Header()
+			if directive != "" {
+				h.Set("Cache-Control", directive)
+			}
+			h.Set("ETag", ETag(rec.body.Bytes()))
+			var modified time.Time
+			if lm := h.Get("Last-Modified"); lm != "" {
+				modified, _ = http.ParseTime(lm)
+			}
+			http.ServeContent(w, r, "", modified, bytes.NewReader(rec.body.Bytes()))
+		})
+	}
+}
+
+// recorder holds back the status and body of a response; headers go
+// straight to the underlying writer.
+type recorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *recorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
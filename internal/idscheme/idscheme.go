@@ -0,0 +1,200 @@
+// Package idscheme describes how season and episode IDs are derived from
+// their parent's ID.
+//
+// A scheme is a set of templates, one per ID kind. A template may hold
+// alternatives separated by |. Within an alternative, {n} matches digits,
+// {*} any non-empty text and {name} the value of the named variable
+// (series_id for seasons, season_id for episodes).
+package idscheme
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type Scheme struct {
+	// Season is the template for season_id. Variables: series_id.
+	Season string
+	// Episode is the template for episode_id. Variables: season_id.
+	Episode string
+}
+
+// Default matches the storage layout the API has always assumed: seasons
+// are "<series_id>_s<N>" (or the series_id itself for a single-season
+// series) and episodes are "<season_id>_<suffix>".
+var Default = Scheme{
+	Season:  "{series_id}_s{n}|{series_id}",
+	Episode: "{season_id}_{*}",
+}
+
+var (
+	mu      sync.RWMutex
+	current *Scheme
+)
+
+// FromEnv returns Default overridden by ID_SEASON_FORMAT and
+// ID_EPISODE_FORMAT.
+func FromEnv() Scheme {
+	s := Default
+	if v := os.Getenv("ID_SEASON_FORMAT"); v != "" {
+		s.Season = v
+	}
+	if v := os.Getenv("ID_EPISODE_FORMAT"); v != "" {
+		s.Episode = v
+	}
+	return s
+}
+
+// Current returns the configured scheme, reading the environment on first
+// use unless Configure was called.
+func Current() Scheme {
+	mu.RLock()
+	s := current
+	mu.RUnlock()
+	if s != nil {
+		return *s
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if current == nil {
+		env := FromEnv()
+		current = &env
+	}
+	return *current
+}
+
+func Configure(s Scheme) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = &s
+}
+
+// Template returns the template for a field name ("season_id" or
+// "episode_id").
+func (s Scheme) Template(field string) (string, bool) {
+	switch field {
+	case "season_id":
+		return s.Season, true
+	case "episode_id":
+		return s.Episode, true
+	}
+	return "", false
+}
+
+func (s Scheme) ValidSeasonID(seasonID, seriesID string) bool {
+	return Match(s.Season, seasonID, map[string]string{"series_id": seriesID})
+}
+
+func (s Scheme) ValidEpisodeID(episodeID, seasonID string) bool {
+	return Match(s.Episode, episodeID, map[string]string{"season_id": seasonID})
+}
+
+// SeasonID generates a season_id from the first alternative of the
+// template, with {n} set to the season number.
+func (s Scheme) SeasonID(seriesID string, seasonNumber int) (string, error) {
+	return generate(s.Season, map[string]string{"series_id": seriesID}, strconv.Itoa(seasonNumber))
+}
+
+// EpisodeID generates an episode_id from the first alternative of the
+// template, with {n} and {*} set to the two-digit episode number.
+func (s Scheme) EpisodeID(seasonID string, episodeNumber int) (string, error) {
+	return generate(s.Episode, map[string]string{"season_id": seasonID}, fmt.Sprintf("%02d", episodeNumber))
+}
+
+var placeholder = regexp.MustCompile(`\{[a-z_*]+\}`)
+
+// Match reports whether id matches any alternative of template. An
+// alternative referring to a variable that is empty or missing is
+// skipped; if every alternative is skipped, id is accepted.
+func Match(template, id string, vars map[string]string) bool {
+	checked := false
+	for _, alt := range strings.Split(template, "|") {
+		re, ok := compile(alt, vars)
+		if !ok {
+			continue
+		}
+		checked = true
+		if re.MatchString(id) {
+			return true
+		}
+	}
+	return !checked
+}
+
+func compile(alt string, vars map[string]string) (*regexp.Regexp, bool) {
+	var b strings.Builder
+	b.WriteString("^")
+	ok := expand(alt, &b, regexp.QuoteMeta, func(key string) (string, bool) {
+		switch key {
+		case "n":
+			return `\d+`, true
+		case "*":
+			return `.+`, true
+		}
+		v := vars[key]
+		return regexp.QuoteMeta(v), v != ""
+	})
+	b.WriteString("$")
+	if !ok {
+		return nil, false
+	}
+	return regexp.MustCompile(b.String()), true
+}
+
+func generate(template string, vars map[string]string, number string) (string, error) {
+	for _, alt := range strings.Split(template, "|") {
+		var b strings.Builder
+		used := false
+		ok := expand(alt, &b, func(s string) string { return s }, func(key string) (string, bool) {
+			switch key {
+			case "n", "*":
+				used = true
+				return number, true
+			}
+			v := vars[key]
+			return v, v != ""
+		})
+		if ok && used {
+			return b.String(), nil
+		}
+	}
+	return "", fmt.Errorf("id template %q has no numbered alternative", template)
+}
+
+// Render returns the first alternative of template whose variables are
+// all set, with the variables substituted. It reports false when every
+// alternative refers to an empty or missing variable.
+func Render(template string, vars map[string]string) (string, bool) {
+	for _, alt := range strings.Split(template, "|") {
+		var b strings.Builder
+		ok := expand(alt, &b, func(s string) string { return s }, func(key string) (string, bool) {
+			v := vars[key]
+			return v, v != ""
+		})
+		if ok {
+			return b.String(), true
+		}
+	}
+	return "", false
+}
+
+// expand writes alt to b, passing literal text through lit and replacing
+// each placeholder with sub. It reports false when sub rejects a key.
+func expand(alt string, b *strings.Builder, lit func(string) string, sub func(key string) (string, bool)) bool {
+	last := 0
+	for _, loc := range placeholder.FindAllStringIndex(alt, -1) {
+		b.WriteString(lit(alt[last:loc[0]]))
+		v, ok := sub(alt[loc[0]+1 : loc[1]-1])
+		if !ok {
+			return false
+		}
+		b.WriteString(v)
+		last = loc[1]
+	}
+	b.WriteString(lit(alt[last:]))
+	return true
+}
@@ -0,0 +1,206 @@
+// Package imgproxy builds imgproxy URLs for the catalog images.
+//
+// Every image kind has an ordered list of named presets, each a string of
+// imgproxy processing options such as "h:360" or "rs:fit:0:720/q:80". The
+// first preset is the primary one, returned as thumbnail_url and friends;
+// all of them are returned as a srcset-style map. URLs are signed when a
+// key and salt are configured.
+package imgproxy
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Image names an image kind, e.g. "series.thumbnail".
+type Image string
+
+const (
+	SeriesThumbnail  Image = "series.thumbnail"
+	SeriesPortrait   Image = "series.portrait"
+	SeasonThumbnail  Image = "season.thumbnail"
+	EpisodeThumbnail Image = "episode.thumbnail"
+)
+
+type Preset struct {
+	Name    string
+	Options string
+}
+
+type Config struct {
+	// BaseURL of the imgproxy server; images are served unprocessed when
+	// it is empty.
+	BaseURL string
+	Key     []byte
+	Salt    []byte
+	// Format is the output format (webp, avif, ...) for presets that do
+	// not set one; empty keeps the source format.
+	Format  string
+	Presets map[Image][]Preset
+}
+
+// DefaultPresets are the sizes the API has always used, plus a 2x variant.
+var DefaultPresets = map[Image][]Preset{
+	SeriesThumbnail:  {{"1x", "h:360"}, {"2x", "h:720"}},
+	SeriesPortrait:   {{"1x", "w:360"}, {"2x", "w:720"}},
+	SeasonThumbnail:  {{"1x", "h:240"}, {"2x", "h:480"}},
+	EpisodeThumbnail: {{"1x", "h:240"}, {"2x", "h:480"}},
+}
+
+var presetEnv = map[Image]string{
+	SeriesThumbnail:  "IMGPROXY_SERIES_THUMBNAIL",
+	SeriesPortrait:   "IMGPROXY_SERIES_PORTRAIT",
+	SeasonThumbnail:  "IMGPROXY_SEASON_THUMBNAIL",
+	EpisodeThumbnail: "IMGPROXY_EPISODE_THUMBNAIL",
+}
+
+// FromEnv reads IMGPROXY_URL, IMGPROXY_KEY and IMGPROXY_SALT (hex, both or
+// neither), IMGPROXY_FORMAT and the presets of each image kind from
+// IMGPROXY_SERIES_THUMBNAIL, IMGPROXY_SERIES_PORTRAIT,
+// IMGPROXY_SEASON_THUMBNAIL and IMGPROXY_EPISODE_THUMBNAIL, each a comma
+// separated list of name=options.
+func FromEnv() (Config, error) {
+	c := Config{
+		BaseURL: strings.TrimSuffix(os.Getenv("IMGPROXY_URL"), "/"),
+		Format:  os.Getenv("IMGPROXY_FORMAT"),
+		Presets: map[Image][]Preset{},
+	}
+	key, salt := os.Getenv("IMGPROXY_KEY"), os.Getenv("IMGPROXY_SALT")
+	if (key == "") != (salt == "") {
+		return c, fmt.Errorf("IMGPROXY_KEY and IMGPROXY_SALT must be set together")
+	}
+	var err error
+	if c.Key, err = hex.DecodeString(key); err != nil {
+		return c, fmt.Errorf("IMGPROXY_KEY must be hex encoded")
+	}
+	if c.Salt, err = hex.DecodeString(salt); err != nil {
+		return c, fmt.Errorf("IMGPROXY_SALT must be hex encoded")
+	}
+	for img, env := range presetEnv {
+		c.Presets[img] = DefaultPresets[img]
+		raw := os.Getenv(env)
+		if raw == "" {
+			continue
+		}
+		if c.Presets[img], err = ParsePresets(raw); err != nil {
+			return c, fmt.Errorf("%s: %w", env, err)
+		}
+	}
+	return c, nil
+}
+
+// ParsePresets reads a comma separated list of name=options.
+func ParsePresets(raw string) ([]Preset, error) {
+	var presets []Preset
+	seen := map[string]bool{}
+	for _, entry := range strings.Split(raw, ",") {
+		name, options, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || name == "" || options == "" {
+			return nil, fmt.Errorf("invalid preset %q, want name=options", entry)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate preset %q", name)
+		}
+		seen[name] = true
+		presets = append(presets, Preset{Name: name, Options: strings.Trim(options, "/")})
+	}
+	return presets, nil
+}
+
+var (
+	mu      sync.RWMutex
+	current *Config
+)
+
+// Current returns the configured Config, reading the environment on first
+// use unless Configure was called. An invalid environment is logged and
+// leaves images unprocessed.
+func Current() Config {
+	mu.RLock()
+	c := current
+	mu.RUnlock()
+	if c != nil {
+		return *c
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if current == nil {
+		env, err := FromEnv()
+		if err != nil {
+			log.Printf("imgproxy: %v", err)
+			env = Config{}
+		}
+		current = &env
+	}
+	return *current
+}
+
+func Configure(c Config) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = &c
+}
+
+// URLs returns the primary URL of source for img and the URL of every
+// preset by name. Without a server, the primary URL is source itself and
+// the map is nil.
+func (c Config) URLs(source string, img Image) (string, map[string]string) {
+	presets := c.Presets[img]
+	if c.BaseURL == "" || source == "" || len(presets) == 0 {
+		return source, nil
+	}
+	urls := make(map[string]string, len(presets))
+	for _, p := range presets {
+		urls[p.Name] = c.URL(source, p.Options)
+	}
+	return urls[presets[0].Name], urls
+}
+
+// URL returns the imgproxy URL processing source with options.
+func (c Config) URL(source, options string) string {
+	u, err := url.Parse(source)
+	if err != nil {
+		return source
+	}
+	if c.Format != "" && !hasOption(options, "f", "format", "ext") {
+		options += "/f:" + c.Format
+	}
+	encoded := u.Scheme + "://" + url.PathEscape(u.Host+u.Path)
+	if u.RawQuery != "" {
+		encoded += "%3F" + url.PathEscape(u.RawQuery)
+	}
+	path := "/" + options + "/plain/" + encoded
+	return c.BaseURL + "/" + c.signature(path) + path
+}
+
+// signature signs path with HMAC-SHA256 over salt and path, or returns
+// "unsafe" when no key is configured.
+func (c Config) signature(path string) string {
+	if len(c.Key) == 0 {
+		return "unsafe"
+	}
+	mac := hmac.New(sha256.New, c.Key)
+	mac.Write(c.Salt)
+	mac.Write([]byte(path))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func hasOption(options string, names ...string) bool {
+	for _, opt := range strings.Split(options, "/") {
+		name, _, _ := strings.Cut(opt, ":")
+		for _, n := range names {
+			if name == n {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package imgproxy
+
+import (
+	"encoding/hex"
+	"maps"
+	"testing"
+)
+
+// exampleConfig holds the key and salt of the example in the imgproxy
+// documentation ("Signing a URL"), hex encoded as IMGPROXY_KEY and
+// IMGPROXY_SALT take them.
+func exampleConfig(t *testing.T) Config {
+	t.Helper()
+	key, err := hex.DecodeString("736563726574")
+	if err != nil {
+		t.Fatal(err)
+	}
+	salt, err := hex.DecodeString("68656C6C6F")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Config{BaseURL: "https://img.example.com", Key: key, Salt: salt, Presets: DefaultPresets}
+}
+
+func TestSignatureExample(t *testing.T) {
+	c := exampleConfig(t)
+	got := c.signature("/rs:fill:300:400:0/g:sm/aHR0cDovL2V4YW1w/bGUuY29tL2ltYWdl/cy9jdXJpb3NpdHku/anBn.png")
+	if want := "oKfUtW34Dvo2BGQehJFR4Nr0_rIjOtdtzJ3QFsUcXH8"; got != want {
+		t.Errorf("signature = %s, want %s", got, want)
+	}
+	if got := (Config{}).signature("/h:360/plain/x"); got != "unsafe" {
+		t.Errorf("signature without a key = %s, want unsafe", got)
+	}
+}
+
+func TestURLs(t *testing.T) {
+	const source = "https://cdn.example.com/foo/thumbnail.jpg"
+	c := exampleConfig(t)
+
+	primary, urls := c.URLs(source, SeriesThumbnail)
+	want := map[string]string{
+		"1x": "https://img.example.com/_eVYpKM6jfoxDTuk4Rt5n8kL5JZc6nbYxH2WsnUNUN4/h:360/plain/https://cdn.example.com%2Ffoo%2Fthumbnail.jpg",
+		"2x": "https://img.example.com/uVd5S8PXZNsUIsx6ksfJXIKa94ZeefwsPjhMr5ozmF0/h:720/plain/https://cdn.example.com%2Ffoo%2Fthumbnail.jpg",
+	}
+	if primary != want["1x"] || !maps.Equal(urls, want) {
+		t.Errorf("URLs = %s, %v\nwant %s, %v", primary, urls, want["1x"], want)
+	}
+
+	// The format is appended to presets that do not choose one.
+	c.Format = "webp"
+	c.Presets = map[Image][]Preset{EpisodeThumbnail: {{"1x", "h:360"}, {"png", "h:360/f:png"}}}
+	_, urls = c.URLs(source, EpisodeThumbnail)
+	want = map[string]string{
+		"1x":  "https://img.example.com/L7gNUkBMzEgD_qHV3yoGkPbq2hjTg_IyjbIHhJdcNbU/h:360/f:webp/plain/https://cdn.example.com%2Ffoo%2Fthumbnail.jpg",
+		"png": "https://img.example.com/7FclwvdBlHDtHaf8ujv2w8FQeNYsX1tzu4s4vUKcriA/h:360/f:png/plain/https://cdn.example.com%2Ffoo%2Fthumbnail.jpg",
+	}
+	if !maps.Equal(urls, want) {
+		t.Errorf("URLs with a format = %v\nwant %v", urls, want)
+	}
+
+	// Without a server or presets for the kind, the source is served as is.
+	for _, c := range []Config{{Presets: DefaultPresets}, {BaseURL: "https://img.example.com"}} {
+		if primary, urls := c.URLs(source, SeriesThumbnail); primary != source || urls != nil {
+			t.Errorf("URLs of %+v = %s, %v, want the source and no presets", c, primary, urls)
+		}
+	}
+}
+
+func TestParsePresets(t *testing.T) {
+	got, err := ParsePresets(" 1x=rs:fit:0:720/q:80/ , small=/h:120")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Preset{{"1x", "rs:fit:0:720/q:80"}, {"small", "h:120"}}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("ParsePresets = %v, want %v", got, want)
+	}
+	for _, raw := range []string{"1x", "=h:120", "1x=", "1x=h:1,1x=h:2"} {
+		if _, err := ParsePresets(raw); err == nil {
+			t.Errorf("ParsePresets(%q) accepted", raw)
+		}
+	}
+}
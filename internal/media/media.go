@@ -0,0 +1,231 @@
+// Package media maps the media files of series, seasons and episodes to
+// object keys in the storage bucket.
+//
+// Keys come from a Resolver. The default one renders a path template per
+// asset; see Layout. An entity may also store its own key for an asset,
+// which takes precedence over the template.
+package media
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/clustlight/animatrix-api/internal/idscheme"
+)
+
+type Kind string
+
+const (
+	Series  Kind = "series"
+	Season  Kind = "season"
+	Episode Kind = "episode"
+)
+
+// Asset names a media file of an entity.
+type Asset string
+
+const (
+	Thumbnail Asset = "thumbnail"
+	Portrait  Asset = "portrait"
+	Video     Asset = "video"
+)
+
+// Assets lists the assets of each kind of entity.
+var Assets = map[Kind][]Asset{
+	Series:  {Thumbnail, Portrait},
+	Season:  {Thumbnail},
+	Episode: {Video, Thumbnail},
+}
+
+// Ref identifies the entity an asset belongs to. Parent IDs may be empty
+// when the parent was not loaded.
+type Ref struct {
+	Kind          Kind
+	SeriesID      string
+	SeasonID      string
+	EpisodeID     string
+	SeasonNumber  int
+	EpisodeNumber int
+	// Overrides maps asset names to keys stored on the entity.
+	Overrides map[string]string
+}
+
+// Resolver returns the object key of an asset, or "" when there is none.
+// A key may also be an absolute URL.
+type Resolver interface {
+	Path(r Ref, a Asset) string
+}
+
+// Layout is a Resolver rendering one template per asset. Templates follow
+// idscheme: alternatives are separated by | and the first one whose
+// variables are all set is used. Variables:
+//
+//	{series_id}, {season_id}, {episode_id}
+//	{id_prefix}       the entity's ID up to its first '_', or all of it
+//	{id_rest}         the entity's ID after its first '_'
+//	{season_tag}      the s<N> that season_id's {id_rest} starts with
+//	{season_suffix}   season_id without the "<series_id>_" prefix
+//	{episode}         episode_id without the "<season_id>_" prefix
+//	{episode_suffix}  episode_id without the "<series_id>_" prefix
+//	{season_number}, {episode_number}
+//	{ext}             VideoExt for videos, ImageExt otherwise
+type Layout struct {
+	SeriesThumbnail  string
+	SeriesPortrait   string
+	SeasonThumbnail  string
+	EpisodeVideo     string
+	EpisodeThumbnail string
+	VideoExt         string
+	ImageExt         string
+}
+
+// Default is the bucket layout the API has always used. Seasons and
+// episodes are split at the first '_' of their own ID, not at the end of
+// the series_id, which differs for series IDs containing '_'.
+var Default = Layout{
+	SeriesThumbnail:  "{series_id}/thumbnail.{ext}",
+	SeriesPortrait:   "{series_id}/portrait.{ext}",
+	SeasonThumbnail:  "{id_prefix}/thumbnail_{season_tag}.{ext}|{id_prefix}/thumbnail.{ext}",
+	EpisodeVideo:     "{id_prefix}/{id_rest}/video.{ext}|{episode_id}/video.{ext}",
+	EpisodeThumbnail: "{id_prefix}/{id_rest}/thumbnail.{ext}|{episode_id}/thumbnail.{ext}",
+	VideoExt:         "mp4",
+	ImageExt:         "png",
+}
+
+var (
+	mu      sync.RWMutex
+	current Resolver
+)
+
+// FromEnv returns Default overridden by MEDIA_SERIES_THUMBNAIL,
+// MEDIA_SERIES_PORTRAIT, MEDIA_SEASON_THUMBNAIL, MEDIA_EPISODE_VIDEO,
+// MEDIA_EPISODE_THUMBNAIL, MEDIA_VIDEO_EXT and MEDIA_IMAGE_EXT.
+func FromEnv() Layout {
+	l := Default
+	for env, field := range map[string]*string{
+		"MEDIA_SERIES_THUMBNAIL":  &l.SeriesThumbnail,
+		"MEDIA_SERIES_PORTRAIT":   &l.SeriesPortrait,
+		"MEDIA_SEASON_THUMBNAIL":  &l.SeasonThumbnail,
+		"MEDIA_EPISODE_VIDEO":     &l.EpisodeVideo,
+		"MEDIA_EPISODE_THUMBNAIL": &l.EpisodeThumbnail,
+		"MEDIA_VIDEO_EXT":         &l.VideoExt,
+		"MEDIA_IMAGE_EXT":         &l.ImageExt,
+	} {
+		if v := os.Getenv(env); v != "" {
+			*field = v
+		}
+	}
+	return l
+}
+
+// Current returns the configured resolver, reading the environment on
+// first use unless Configure was called.
+func Current() Resolver {
+	mu.RLock()
+	r := current
+	mu.RUnlock()
+	if r != nil {
+		return r
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if current == nil {
+		current = FromEnv()
+	}
+	return current
+}
+
+func Configure(r Resolver) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = r
+}
+
+func (l Layout) template(k Kind, a Asset) string {
+	switch {
+	case k == Series && a == Thumbnail:
+		return l.SeriesThumbnail
+	case k == Series && a == Portrait:
+		return l.SeriesPortrait
+	case k == Season && a == Thumbnail:
+		return l.SeasonThumbnail
+	case k == Episode && a == Video:
+		return l.EpisodeVideo
+	case k == Episode && a == Thumbnail:
+		return l.EpisodeThumbnail
+	}
+	return ""
+}
+
+// Path returns the override stored on r for a, or renders its template.
+func (l Layout) Path(r Ref, a Asset) string {
+	if p := r.Overrides[string(a)]; p != "" {
+		return p
+	}
+	template := l.template(r.Kind, a)
+	if template == "" {
+		return ""
+	}
+	vars := r.Vars()
+	vars["ext"] = l.ImageExt
+	if a == Video {
+		vars["ext"] = l.VideoExt
+	}
+	p, _ := idscheme.Render(template, vars)
+	return p
+}
+
+var seasonTag = regexp.MustCompile(`^s\d+`)
+
+// Vars returns the template variables of r. A missing series_id is taken
+// from the text before the first '_' of the season or episode ID.
+func (r Ref) Vars() map[string]string {
+	id := r.SeriesID
+	switch r.Kind {
+	case Season:
+		id = r.SeasonID
+	case Episode:
+		id = r.EpisodeID
+	}
+	prefix, rest, _ := strings.Cut(id, "_")
+	seriesID := r.SeriesID
+	if seriesID == "" {
+		seriesID = prefix
+	}
+	vars := map[string]string{
+		"series_id":      seriesID,
+		"season_id":      r.SeasonID,
+		"episode_id":     r.EpisodeID,
+		"id_prefix":      prefix,
+		"id_rest":        rest,
+		"season_suffix":  suffix(r.SeasonID, seriesID),
+		"episode":        suffix(r.EpisodeID, r.SeasonID),
+		"episode_suffix": suffix(r.EpisodeID, seriesID),
+	}
+	if r.Kind == Season {
+		vars["season_tag"] = seasonTag.FindString(rest)
+	}
+	if r.Kind == Season || (r.Kind == Episode && r.SeasonID != "") {
+		vars["season_number"] = strconv.Itoa(r.SeasonNumber)
+	}
+	if r.Kind == Episode {
+		vars["episode_number"] = strconv.Itoa(r.EpisodeNumber)
+	}
+	return vars
+}
+
+// suffix returns id without the "<parent>_" prefix, or "" when id does not
+// start with it.
+func suffix(id, parent string) string {
+	if parent == "" {
+		return ""
+	}
+	s, ok := strings.CutPrefix(id, parent+"_")
+	if !ok {
+		return ""
+	}
+	return s
+}
@@ -0,0 +1,109 @@
+package media
+
+import "testing"
+
+// The wanted keys are those the API served before the layout became
+// configurable, when they were cut from the IDs in code.
+func TestDefaultLayout(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		ref  Ref
+		want map[Asset]string
+	}{
+		{
+			name: "series",
+			ref:  Ref{Kind: Series, SeriesID: "foo"},
+			want: map[Asset]string{Thumbnail: "foo/thumbnail.png", Portrait: "foo/portrait.png"},
+		},
+		{
+			name: "single-season season",
+			ref:  Ref{Kind: Season, SeriesID: "foo", SeasonID: "foo", SeasonNumber: 1},
+			want: map[Asset]string{Thumbnail: "foo/thumbnail.png"},
+		},
+		{
+			name: "single-season episode",
+			ref:  Ref{Kind: Episode, SeriesID: "foo", SeasonID: "foo", EpisodeID: "foo_01", SeasonNumber: 1, EpisodeNumber: 1},
+			want: map[Asset]string{Video: "foo/01/video.mp4", Thumbnail: "foo/01/thumbnail.png"},
+		},
+		{
+			name: "season _sN",
+			ref:  Ref{Kind: Season, SeriesID: "foo", SeasonID: "foo_s2", SeasonNumber: 2},
+			want: map[Asset]string{Thumbnail: "foo/thumbnail_s2.png"},
+		},
+		{
+			name: "episode of season _sN",
+			ref:  Ref{Kind: Episode, SeriesID: "foo", SeasonID: "foo_s2", EpisodeID: "foo_s2_05", SeasonNumber: 2, EpisodeNumber: 5},
+			want: map[Asset]string{Video: "foo/s2_05/video.mp4", Thumbnail: "foo/s2_05/thumbnail.png"},
+		},
+		{
+			name: "series ID with underscores",
+			ref:  Ref{Kind: Series, SeriesID: "my_show"},
+			want: map[Asset]string{Thumbnail: "my_show/thumbnail.png", Portrait: "my_show/portrait.png"},
+		},
+		{
+			name: "single season of a series ID with underscores",
+			ref:  Ref{Kind: Season, SeriesID: "my_show", SeasonID: "my_show", SeasonNumber: 1},
+			want: map[Asset]string{Thumbnail: "my/thumbnail.png"},
+		},
+		{
+			name: "season _sN of a series ID with underscores",
+			ref:  Ref{Kind: Season, SeriesID: "my_show", SeasonID: "my_show_s1", SeasonNumber: 1},
+			want: map[Asset]string{Thumbnail: "my/thumbnail.png"},
+		},
+		{
+			name: "episode of a series ID with underscores",
+			ref:  Ref{Kind: Episode, SeriesID: "my_show", SeasonID: "my_show_s1", EpisodeID: "my_show_s1_01", SeasonNumber: 1, EpisodeNumber: 1},
+			want: map[Asset]string{Video: "my/show_s1_01/video.mp4", Thumbnail: "my/show_s1_01/thumbnail.png"},
+		},
+		{
+			name: "override",
+			ref:  Ref{Kind: Episode, SeriesID: "foo", SeasonID: "foo_s2", EpisodeID: "foo_s2_05", Overrides: map[string]string{"video": "legacy/foo-05.mkv"}},
+			want: map[Asset]string{Video: "legacy/foo-05.mkv", Thumbnail: "foo/s2_05/thumbnail.png"},
+		},
+		{
+			name: "override with an absolute URL",
+			ref:  Ref{Kind: Series, SeriesID: "my_show", Overrides: map[string]string{"portrait": "https://img.example.com/my_show.jpg"}},
+			want: map[Asset]string{Thumbnail: "my_show/thumbnail.png", Portrait: "https://img.example.com/my_show.jpg"},
+		},
+	} {
+		// Parents are not always loaded; the keys must not depend on it.
+		bare := tc.ref
+		if bare.Kind != Series {
+			bare.SeriesID = ""
+		}
+		if bare.Kind == Episode {
+			bare.SeasonID, bare.SeasonNumber = "", 0
+		}
+		for _, ref := range []Ref{tc.ref, bare} {
+			for _, a := range Assets[ref.Kind] {
+				if got := Default.Path(ref, a); got != tc.want[a] {
+					t.Errorf("%s (series_id %q): %s = %q, want %q", tc.name, ref.SeriesID, a, got, tc.want[a])
+				}
+			}
+		}
+	}
+}
+
+func TestLayoutVars(t *testing.T) {
+	l := Default
+	l.EpisodeVideo = "{series_id}/{season_suffix}/{episode}/video.{ext}"
+	l.SeasonThumbnail = "{series_id}/{season_number}/thumbnail.{ext}"
+	l.EpisodeThumbnail = "{series_id}/{episode_suffix}/{episode_number}.{ext}"
+	l.ImageExt = "webp"
+	for _, tc := range []struct {
+		ref  Ref
+		a    Asset
+		want string
+	}{
+		{Ref{Kind: Episode, SeriesID: "foo", SeasonID: "foo_s1", EpisodeID: "foo_s1_01"}, Video, "foo/s1/01/video.mp4"},
+		{Ref{Kind: Episode, SeriesID: "my_show", SeasonID: "my_show_s1", EpisodeID: "my_show_s1_01"}, Video, "my_show/s1/01/video.mp4"},
+		{Ref{Kind: Episode, SeriesID: "my_show", SeasonID: "my_show_s1", EpisodeID: "my_show_s1_01", EpisodeNumber: 1}, Thumbnail, "my_show/s1_01/1.webp"},
+		{Ref{Kind: Season, SeriesID: "foo", SeasonID: "foo_s3", SeasonNumber: 3}, Thumbnail, "foo/3/thumbnail.webp"},
+		// Without its season, an episode has no {season_suffix} or {episode}.
+		{Ref{Kind: Episode, EpisodeID: "foo_s1_01"}, Video, ""},
+	} {
+		if got := l.Path(tc.ref, tc.a); got != tc.want {
+			t.Errorf("%+v %s = %q, want %q", tc.ref, tc.a, got, tc.want)
+		}
+	}
+}
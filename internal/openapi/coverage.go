@@ -0,0 +1,46 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Check compares the route table with the routes registered on r and
+// returns an error listing routes missing from the document and
+// documented operations that no longer exist.
+func Check(r chi.Routes) error {
+	registered := map[string]bool{}
+	err := chi.Walk(r, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		registered[method+" "+strings.TrimSuffix(route, "/")] = true
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	documented := map[string]bool{}
+	for _, op := range Operations() {
+		documented[op] = true
+	}
+
+	var problems []string
+	for op := range registered {
+		if !documented[op] {
+			problems = append(problems, "undocumented route: "+op)
+		}
+	}
+	for op := range documented {
+		if !registered[op] {
+			problems = append(problems, "documented but not routed: "+op)
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return fmt.Errorf("openapi: %d route(s) out of sync:\n  %s", len(problems), strings.Join(problems, "\n  "))
+}
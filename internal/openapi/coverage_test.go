@@ -0,0 +1,14 @@
+package openapi_test
+
+import (
+	"testing"
+
+	"github.com/clustlight/animatrix-api/internal"
+	"github.com/clustlight/animatrix-api/internal/openapi"
+)
+
+func TestRoutesDocumented(t *testing.T) {
+	if err := openapi.Check(internal.NewRouter(nil, nil)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,262 @@
+// Package openapi builds the OpenAPI 3.1 description of the /v1 API from
+// the route table in routes.go and the request and response types in
+// internal/types, and serves it together with a small docs page.
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+const (
+	mediaJSON    = "application/json"
+	mediaProblem = "application/problem+json"
+	mediaNDJSON  = "application/x-ndjson"
+	mediaCSV     = "text/csv"
+)
+
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Servers    []Server            `json:"servers,omitempty"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+	Security   []Requirement       `json:"security,omitempty"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+// PathItem maps lower-case HTTP methods to operations.
+type PathItem map[string]*Operation
+
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary"`
+	Tags        []string            `json:"tags,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+	// Security overrides that of the document; empty for public routes.
+	Security *[]Requirement `json:"security,omitempty"`
+}
+
+// Requirement maps security scheme names to the scopes needed.
+type Requirement map[string][]string
+
+type SecurityScheme struct {
+	Type        string `json:"type"`
+	Scheme      string `json:"scheme,omitempty"`
+	In          string `json:"in,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Ref         string               `json:"$ref,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	Responses       map[string]Response       `json:"responses"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes"`
+}
+
+// errorResponses names the shared problem+json responses by status.
+var errorResponses = map[int]string{
+	http.StatusBadRequest:            "BadRequest",
+	http.StatusUnauthorized:          "Unauthorized",
+	http.StatusForbidden:             "Forbidden",
+	http.StatusNotFound:              "NotFound",
+	http.StatusConflict:              "Conflict",
+	http.StatusPreconditionFailed:    "PreconditionFailed",
+	http.StatusRequestEntityTooLarge: "PayloadTooLarge",
+	http.StatusUnsupportedMediaType:  "UnsupportedMediaType",
+	http.StatusUnprocessableEntity:   "Unprocessable",
+	http.StatusInternalServerError:   "InternalError",
+	http.StatusServiceUnavailable:    "Unavailable",
+}
+
+// keyScopes describes the scopes of API keys and tokens; see
+// internal/auth.
+const keyScopes = "API key, or identity provider token whose roles grant scopes: read for reads and /v1/me, " +
+	"write for other changes, admin for /v1/admin. " +
+	"Catalog reads need no credentials when AUTH_PUBLIC_READ is set, and none are needed when AUTH_API_KEYS is off."
+
+// Conditional request headers, see internal/httpcache.
+var (
+	ifNoneMatch     = Parameter{Name: "If-None-Match", In: "header", Description: "Answer 304 when the response still has one of these ETags.", Schema: &Schema{Type: "string"}}
+	ifModifiedSince = Parameter{Name: "If-Modified-Since", In: "header", Description: "Answer 304 when nothing changed since this HTTP date.", Schema: &Schema{Type: "string"}}
+	ifMatch         = Parameter{Name: "If-Match", In: "header", Description: "Only apply the change while GET on the resource returns one of these ETags; 412 otherwise.", Schema: &Schema{Type: "string"}}
+)
+
+var pathParam = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+// Build returns the document for every operation in the route table.
+func Build() *Document {
+	reg := schemas{}
+	problem := reg.of(types.Problem{})
+	doc := &Document{
+		OpenAPI: "3.1.0",
+		Info:    Info{Title: "animatrix-api", Version: "1"},
+		Paths:   map[string]PathItem{},
+		Components: Components{
+			Schemas:   reg,
+			Responses: map[string]Response{},
+			SecuritySchemes: map[string]SecurityScheme{
+				"bearer": {Type: "http", Scheme: "bearer", Description: keyScopes},
+				"apiKey": {Type: "apiKey", In: "header", Name: "X-API-Key", Description: keyScopes},
+			},
+		},
+		Security: []Requirement{{"bearer": {}}, {"apiKey": {}}},
+	}
+	for status, name := range errorResponses {
+		doc.Components.Responses[name] = Response{
+			Description: http.StatusText(status),
+			Content:     map[string]MediaType{mediaProblem: {Schema: problem}},
+		}
+	}
+
+	for _, rt := range routes {
+		op := &Operation{
+			OperationID: rt.id,
+			Summary:     rt.summary,
+			Tags:        []string{rt.tag},
+			Responses:   map[string]Response{},
+		}
+		for _, m := range pathParam.FindAllStringSubmatch(rt.path, -1) {
+			op.Parameters = append(op.Parameters, Parameter{
+				Name: m[1], In: "path", Required: true, Schema: &Schema{Type: "string"},
+			})
+		}
+		for _, q := range rt.query {
+			op.Parameters = append(op.Parameters, Parameter{
+				Name: q.name, In: "query", Description: q.desc, Required: q.required, Schema: q.schema,
+			})
+		}
+		if rt.body != nil {
+			op.RequestBody = &RequestBody{Required: true, Content: map[string]MediaType{}}
+			schema := reg.of(rt.body)
+			if rt.bulk {
+				schema = &Schema{Type: "array", Items: schema}
+			}
+			op.RequestBody.Content[mediaJSON] = MediaType{Schema: schema}
+			if rt.stream {
+				item := reg.of(rt.body)
+				op.RequestBody.Content[mediaNDJSON] = MediaType{Schema: item}
+				op.RequestBody.Content[mediaCSV] = MediaType{Schema: item}
+			}
+		}
+		if rt.image {
+			op.RequestBody = &RequestBody{Required: true, Content: map[string]MediaType{}}
+			for _, ct := range []string{"image/png", "image/jpeg", "image/gif"} {
+				op.RequestBody.Content[ct] = MediaType{Schema: &Schema{Type: "string", Format: "binary"}}
+			}
+		}
+		for _, res := range rt.responses {
+			r := Response{Description: res.desc}
+			if res.body != nil {
+				schema := reg.of(res.body)
+				if res.list {
+					schema = &Schema{Type: "array", Items: schema}
+				}
+				r.Content = map[string]MediaType{}
+				for _, ct := range res.mediaTypes() {
+					r.Content[ct] = MediaType{Schema: schema}
+				}
+			}
+			op.Responses[strconv.Itoa(res.status)] = r
+		}
+		switch {
+		case rt.method == http.MethodGet && !rt.uncached:
+			op.Parameters = append(op.Parameters, ifNoneMatch, ifModifiedSince)
+			op.Responses[strconv.Itoa(http.StatusNotModified)] = Response{Description: "Not modified since the ETag or date sent"}
+		case rt.method == http.MethodPatch || rt.method == http.MethodDelete || (rt.method == http.MethodPut && rt.body != nil):
+			op.Parameters = append(op.Parameters, ifMatch)
+			op.Responses[strconv.Itoa(http.StatusPreconditionFailed)] = Response{Ref: "#/components/responses/" + errorResponses[http.StatusPreconditionFailed]}
+		}
+		if rt.public {
+			op.Security = &[]Requirement{}
+		} else {
+			rt.errors = append(rt.errors, http.StatusUnauthorized, http.StatusForbidden)
+		}
+		for _, status := range append(rt.errors, http.StatusInternalServerError) {
+			op.Responses[strconv.Itoa(status)] = Response{Ref: "#/components/responses/" + errorResponses[status]}
+		}
+
+		item := doc.Paths[rt.path]
+		if item == nil {
+			item = PathItem{}
+			doc.Paths[rt.path] = item
+		}
+		item[strings.ToLower(rt.method)] = op
+	}
+	return doc
+}
+
+// Operations lists "METHOD /path" for every documented operation, sorted.
+func Operations() []string {
+	ops := make([]string, 0, len(routes))
+	for _, rt := range routes {
+		ops = append(ops, rt.method+" "+rt.path)
+	}
+	sort.Strings(ops)
+	return ops
+}
+
+var document = sync.OnceValues(func() ([]byte, error) {
+	return json.MarshalIndent(Build(), "", "  ")
+})
+
+// Handler serves the document as JSON.
+func Handler(w http.ResponseWriter, r *http.Request) {
+	b, err := document()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", mediaJSON)
+	w.Write(b)
+}
+
+//go:embed docs.html
+var docsPage []byte
+
+// Docs serves a self-contained page that renders the document.
+func Docs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(docsPage)
+}
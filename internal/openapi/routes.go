@@ -0,0 +1,404 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/clustlight/animatrix-api/internal/graphql"
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+type route struct {
+	method, path string
+	id, summary  string
+	tag          string
+	query        []param
+	// body is a value of the request body type. bulk bodies are JSON
+	// arrays of it; stream bodies also accept NDJSON and CSV records.
+	body         any
+	bulk, stream bool
+	// image bodies are the raw bytes of a PNG, JPEG or GIF.
+	image bool
+	// uncached GET routes are streamed and answer no conditional requests.
+	uncached bool
+	// public routes need no API key.
+	public    bool
+	responses []response
+	// errors lists the problem+json statuses besides 500.
+	errors []int
+}
+
+type param struct {
+	name     string
+	desc     string
+	required bool
+	schema   *Schema
+}
+
+type response struct {
+	status int
+	desc   string
+	body   any
+	list   bool
+	// media overrides the default application/json content type.
+	media []string
+}
+
+func (r response) mediaTypes() []string {
+	if len(r.media) > 0 {
+		return r.media
+	}
+	return []string{mediaJSON}
+}
+
+var (
+	boolParam = &Schema{Type: "boolean"}
+
+	batchSize = param{name: "batch_size", desc: "Records per insert batch for streamed bodies (max 5000).", schema: &Schema{Type: "integer"}}
+	cascade   = param{name: "cascade", desc: "Delete the whole subtree.", schema: boolParam}
+	dryRun    = param{name: "dry_run", desc: "Report what would be deleted without deleting.", schema: boolParam}
+
+	fields        = param{name: "fields", desc: "Comma separated members to return; nested members as dotted paths, e.g. seasons.season_id.", schema: &Schema{Type: "string"}}
+	seriesInclude = param{name: "include", desc: "Related objects to embed: seasons, seasons.episodes. Empty for none.", schema: &Schema{Type: "string"}}
+	seasonInclude = param{name: "include", desc: "Related objects to embed: episodes. Empty for none.", schema: &Schema{Type: "string"}}
+
+	importSummary = response{status: http.StatusOK, desc: "Import summary for NDJSON and CSV bodies", body: types.ImportSummary{}}
+	deleted       = response{status: http.StatusNoContent, desc: "Deleted"}
+	deletionPlan  = response{status: http.StatusOK, desc: "Deletion plan (dry_run)", body: types.DeletionPlan{}}
+
+	createErrors = []int{http.StatusBadRequest, http.StatusConflict}
+	updateErrors = []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}
+	bulkErrors   = []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnsupportedMediaType}
+	deleteErrors = []int{http.StatusNotFound, http.StatusConflict}
+	uploadErrors = []int{http.StatusBadRequest, http.StatusNotFound, http.StatusUnsupportedMediaType, http.StatusServiceUnavailable}
+
+	seriesSort = param{name: "sort", desc: "series_id, title, title_yomi, updated_at, rating or favorites; prefix - for descending. Unrated series sort last by rating.", schema: &Schema{Type: "string"}}
+	watchLimit = param{name: "limit", desc: "Maximum number of entries (default 50, max 200).", schema: &Schema{Type: "integer"}}
+	userErrors = []int{http.StatusBadRequest, http.StatusUnauthorized}
+	itemErrors = []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusNotFound}
+
+	uploaded = response{status: http.StatusOK, desc: "Stored image", body: types.ImageUploadResponse{}}
+)
+
+// routes documents every route registered by internal.NewRouter. Check
+// reports any drift between the two.
+var routes = []route{
+	{method: "GET", path: "/v1/series", id: "listSeries", summary: "List all series", tag: "series",
+		query:     []param{fields, seriesInclude, seriesSort},
+		responses: []response{{status: 200, desc: "Series", body: types.SeriesResponse{}, list: true}},
+		errors:    []int{http.StatusBadRequest}},
+	{method: "POST", path: "/v1/series", id: "createSeries", summary: "Create a series", tag: "series",
+		body:      types.CreateSeriesRequest{},
+		responses: []response{{status: 201, desc: "Created series", body: types.SeriesResponse{}}},
+		errors:    createErrors},
+	{method: "GET", path: "/v1/series/{series_id}", id: "getSeries", summary: "Get a series with its seasons and episodes", tag: "series",
+		query:     []param{fields, seriesInclude},
+		responses: []response{{status: 200, desc: "Series", body: types.SeriesResponse{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusNotFound}},
+	{method: "PATCH", path: "/v1/series/{series_id}", id: "updateSeries", summary: "Update a series", tag: "series",
+		body:      types.UpdateSeriesRequest{},
+		responses: []response{{status: 200, desc: "Updated series", body: types.SeriesResponse{}}},
+		errors:    updateErrors},
+	{method: "DELETE", path: "/v1/series/{series_id}", id: "deleteSeries", summary: "Delete a series", tag: "series",
+		query:     []param{cascade, dryRun},
+		responses: []response{deleted, deletionPlan},
+		errors:    deleteErrors},
+	{method: "POST", path: "/v1/series/{series_id}/seasons:renumber", id: "renumberSeasons", summary: "Resequence season numbers", tag: "series",
+		body:      types.RenumberRequest{},
+		responses: []response{{status: 200, desc: "Renumbered series", body: types.SeriesResponse{}}},
+		errors:    updateErrors},
+	{method: "PUT", path: "/v1/series/{series_id}/thumbnail", id: "uploadSeriesThumbnail", summary: "Upload the thumbnail of a series", tag: "series",
+		image: true, responses: []response{uploaded}, errors: uploadErrors},
+	{method: "PUT", path: "/v1/series/{series_id}/portrait", id: "uploadSeriesPortrait", summary: "Upload the portrait of a series", tag: "series",
+		image: true, responses: []response{uploaded}, errors: uploadErrors},
+	{method: "POST", path: "/v1/series/bulk", id: "bulkCreateSeries", summary: "Bulk create series", tag: "series",
+		query: []param{batchSize}, body: types.CreateSeriesRequest{}, bulk: true, stream: true,
+		responses: []response{{status: 201, desc: "Created series (JSON body)", body: types.SeriesResponse{}, list: true}, importSummary},
+		errors:    bulkErrors},
+	{method: "GET", path: "/v1/series/recent", id: "listRecentSeries", summary: "List recently updated series", tag: "series",
+		query:     []param{fields, seriesInclude},
+		responses: []response{{status: 200, desc: "Series", body: types.SeriesResponse{}, list: true}},
+		errors:    []int{http.StatusBadRequest}},
+
+	{method: "GET", path: "/v1/season", id: "listSeasons", summary: "List all seasons", tag: "season",
+		query:     []param{fields, seasonInclude},
+		responses: []response{{status: 200, desc: "Seasons", body: types.SeasonResponse{}, list: true}},
+		errors:    []int{http.StatusBadRequest}},
+	{method: "POST", path: "/v1/season", id: "createSeason", summary: "Create a season", tag: "season",
+		body:      types.CreateSeasonRequest{},
+		responses: []response{{status: 200, desc: "Created season", body: types.SeasonResponse{}}},
+		errors:    createErrors},
+	{method: "GET", path: "/v1/season/{season_id}", id: "getSeason", summary: "Get a season with its episodes", tag: "season",
+		query:     []param{fields, seasonInclude},
+		responses: []response{{status: 200, desc: "Season", body: types.SeasonResponse{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusNotFound}},
+	{method: "PATCH", path: "/v1/season/{season_id}", id: "updateSeason", summary: "Update a season", tag: "season",
+		body:      types.UpdateSeasonRequest{},
+		responses: []response{{status: 200, desc: "Updated season", body: types.SeasonResponse{}}},
+		errors:    updateErrors},
+	{method: "DELETE", path: "/v1/season/{season_id}", id: "deleteSeason", summary: "Delete a season", tag: "season",
+		query:     []param{cascade, dryRun},
+		responses: []response{deleted, deletionPlan},
+		errors:    deleteErrors},
+	{method: "POST", path: "/v1/season/{season_id}/episodes:move", id: "moveEpisodes", summary: "Move episodes to another season", tag: "season",
+		body:      types.MoveEpisodesRequest{},
+		responses: []response{{status: 200, desc: "Moved episodes", body: types.EpisodeResponse{}, list: true}},
+		errors:    updateErrors},
+	{method: "POST", path: "/v1/season/{season_id}/episodes:renumber", id: "renumberEpisodes", summary: "Resequence episode numbers", tag: "season",
+		body:      types.RenumberRequest{},
+		responses: []response{{status: 200, desc: "Renumbered season", body: types.SeasonResponse{}}},
+		errors:    updateErrors},
+	{method: "PUT", path: "/v1/season/{season_id}/thumbnail", id: "uploadSeasonThumbnail", summary: "Upload the thumbnail of a season", tag: "season",
+		image: true, responses: []response{uploaded}, errors: uploadErrors},
+	{method: "POST", path: "/v1/season/bulk", id: "bulkCreateSeasons", summary: "Bulk create seasons", tag: "season",
+		query: []param{batchSize}, body: types.CreateSeasonRequest{}, bulk: true, stream: true,
+		responses: []response{{status: 201, desc: "Created seasons (JSON body)", body: types.SeasonResponse{}, list: true}, importSummary},
+		errors:    bulkErrors},
+
+	{method: "GET", path: "/v1/episode", id: "listEpisodes", summary: "List all episodes", tag: "episode",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Episodes", body: types.EpisodeResponse{}, list: true}},
+		errors:    []int{http.StatusBadRequest}},
+	{method: "POST", path: "/v1/episode", id: "createEpisode", summary: "Create an episode", tag: "episode",
+		body:      types.CreateEpisodeRequest{},
+		responses: []response{{status: 200, desc: "Created episode", body: types.EpisodeResponse{}}},
+		errors:    createErrors},
+	{method: "GET", path: "/v1/episode/{episode_id}", id: "getEpisode", summary: "Get an episode", tag: "episode",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Episode", body: types.EpisodeResponse{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusNotFound}},
+	{method: "GET", path: "/v1/episode/{episode_id}/master.m3u8", id: "getEpisodeMasterPlaylist", summary: "HLS master playlist of the episode's renditions", tag: "episode",
+		responses: []response{{status: 200, desc: "Master playlist", body: "", media: []string{"application/vnd.apple.mpegurl"}}},
+		errors:    []int{http.StatusNotFound}},
+	{method: "PATCH", path: "/v1/episode/{episode_id}", id: "updateEpisode", summary: "Update an episode", tag: "episode",
+		body:      types.UpdateEpisodeRequest{},
+		responses: []response{{status: 200, desc: "Updated episode", body: types.EpisodeResponse{}}},
+		errors:    updateErrors},
+	{method: "DELETE", path: "/v1/episode/{episode_id}", id: "deleteEpisode", summary: "Delete an episode", tag: "episode",
+		responses: []response{deleted},
+		errors:    []int{http.StatusNotFound}},
+	{method: "PUT", path: "/v1/episode/{episode_id}/thumbnail", id: "uploadEpisodeThumbnail", summary: "Upload the thumbnail of an episode", tag: "episode",
+		image: true, responses: []response{uploaded}, errors: uploadErrors},
+	{method: "GET", path: "/v1/episode/{episode_id}/subtitles", id: "listSubtitles", summary: "List the subtitles of an episode", tag: "episode",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Subtitles", body: types.SubtitleResponse{}, list: true}},
+		errors:    []int{http.StatusBadRequest, http.StatusNotFound}},
+	{method: "POST", path: "/v1/episode/{episode_id}/subtitles", id: "createSubtitle", summary: "Add a subtitle to an episode", tag: "episode",
+		body:      types.SubtitleRequest{},
+		responses: []response{{status: 201, desc: "Created subtitle", body: types.SubtitleResponse{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusNotFound}},
+	{method: "GET", path: "/v1/episode/{episode_id}/subtitles/{subtitle_id}", id: "getSubtitle", summary: "Get a subtitle", tag: "episode",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Subtitle", body: types.SubtitleResponse{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusNotFound}},
+	{method: "GET", path: "/v1/episode/{episode_id}/subtitles/{subtitle_id}.vtt", id: "getSubtitleWebVTT", summary: "Subtitle converted to WebVTT", tag: "episode",
+		responses: []response{
+			{status: 200, desc: "WebVTT file", body: "", media: []string{"text/vtt"}},
+			{status: 302, desc: "Redirect to a WebVTT file stored at an absolute URL"},
+		},
+		errors: []int{http.StatusNotFound, http.StatusUnprocessableEntity, http.StatusServiceUnavailable}},
+	{method: "PATCH", path: "/v1/episode/{episode_id}/subtitles/{subtitle_id}", id: "updateSubtitle", summary: "Update a subtitle", tag: "episode",
+		body:      types.UpdateSubtitleRequest{},
+		responses: []response{{status: 200, desc: "Updated subtitle", body: types.SubtitleResponse{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusNotFound}},
+	{method: "DELETE", path: "/v1/episode/{episode_id}/subtitles/{subtitle_id}", id: "deleteSubtitle", summary: "Delete a subtitle", tag: "episode",
+		responses: []response{deleted},
+		errors:    []int{http.StatusNotFound}},
+	{method: "GET", path: "/v1/episode/{episode_id}/audio-tracks", id: "listAudioTracks", summary: "List the audio tracks of an episode", tag: "episode",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Audio tracks", body: types.AudioTrackResponse{}, list: true}},
+		errors:    []int{http.StatusBadRequest, http.StatusNotFound}},
+	{method: "POST", path: "/v1/episode/{episode_id}/audio-tracks", id: "createAudioTrack", summary: "Add an audio track to an episode", tag: "episode",
+		body:      types.AudioTrackRequest{},
+		responses: []response{{status: 201, desc: "Created audio track", body: types.AudioTrackResponse{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusNotFound}},
+	{method: "GET", path: "/v1/episode/{episode_id}/audio-tracks/{track_id}", id: "getAudioTrack", summary: "Get an audio track", tag: "episode",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Audio track", body: types.AudioTrackResponse{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusNotFound}},
+	{method: "PATCH", path: "/v1/episode/{episode_id}/audio-tracks/{track_id}", id: "updateAudioTrack", summary: "Update an audio track", tag: "episode",
+		body:      types.UpdateAudioTrackRequest{},
+		responses: []response{{status: 200, desc: "Updated audio track", body: types.AudioTrackResponse{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusNotFound}},
+	{method: "DELETE", path: "/v1/episode/{episode_id}/audio-tracks/{track_id}", id: "deleteAudioTrack", summary: "Delete an audio track", tag: "episode",
+		responses: []response{deleted},
+		errors:    []int{http.StatusNotFound}},
+	{method: "GET", path: "/v1/episode/{episode_id}/chapters", id: "listChapters", summary: "List the chapters of an episode", tag: "episode",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Chapters by start", body: types.ChapterResponse{}, list: true}},
+		errors:    []int{http.StatusBadRequest, http.StatusNotFound}},
+	{method: "GET", path: "/v1/episode/{episode_id}/chapters.vtt", id: "getChapterWebVTT", summary: "Chapters as a WebVTT chapters file", tag: "episode",
+		responses: []response{{status: 200, desc: "WebVTT file", body: "", media: []string{"text/vtt"}}},
+		errors:    []int{http.StatusNotFound}},
+	{method: "PUT", path: "/v1/episode/{episode_id}/chapters", id: "replaceChapters", summary: "Replace the chapters of an episode", tag: "episode",
+		body: types.ChapterRequest{}, bulk: true,
+		responses: []response{{status: 200, desc: "Chapters by start", body: types.ChapterResponse{}, list: true}},
+		errors:    []int{http.StatusBadRequest, http.StatusNotFound}},
+	{method: "DELETE", path: "/v1/episode/{episode_id}/chapters", id: "deleteChapters", summary: "Remove the chapters of an episode", tag: "episode",
+		responses: []response{deleted},
+		errors:    []int{http.StatusNotFound}},
+	{method: "POST", path: "/v1/episode/bulk", id: "bulkCreateEpisodes", summary: "Bulk create episodes", tag: "episode",
+		query: []param{batchSize}, body: types.CreateEpisodeRequest{}, bulk: true, stream: true,
+		responses: []response{{status: 201, desc: "Created episodes (JSON body)", body: types.EpisodeResponse{}, list: true}, importSummary},
+		errors:    bulkErrors},
+
+	{method: "GET", path: "/v1/search", id: "search", summary: "Search series and seasons", tag: "search",
+		query:     []param{{name: "q", desc: "Search text", required: true, schema: &Schema{Type: "string"}}, fields, seriesInclude},
+		responses: []response{{status: 200, desc: "Matching series", body: types.SeriesResponse{}, list: true}},
+		errors:    []int{http.StatusBadRequest}},
+
+	{method: "GET", path: "/v1/me", id: "getMe", summary: "The user the request acts for", tag: "me",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "User", body: types.UserResponse{}}},
+		errors:    userErrors},
+	{method: "GET", path: "/v1/me/progress/{episode_id}", id: "getProgress", summary: "Watch progress on an episode", tag: "me",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Progress", body: types.ProgressResponse{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusNotFound}},
+	{method: "PUT", path: "/v1/me/progress/{episode_id}", id: "saveProgress", summary: "Record watch progress on an episode", tag: "me",
+		body:      types.ProgressRequest{},
+		responses: []response{{status: 200, desc: "Progress", body: types.ProgressResponse{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusNotFound}},
+	{method: "GET", path: "/v1/me/continue-watching", id: "continueWatching", summary: "The episode to play next per series, most recent first", tag: "me",
+		query:     []param{fields, watchLimit},
+		responses: []response{{status: 200, desc: "Episodes up next", body: types.WatchEntry{}, list: true}},
+		errors:    userErrors},
+	{method: "GET", path: "/v1/me/history", id: "listHistory", summary: "Watched episodes, most recent first", tag: "me",
+		query:     []param{fields, watchLimit},
+		responses: []response{{status: 200, desc: "Watched episodes", body: types.WatchEntry{}, list: true}},
+		errors:    userErrors},
+	{method: "GET", path: "/v1/me/favorites", id: "listFavorites", summary: "Favorite series and seasons, most recently added first", tag: "me",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Favorites", body: types.FavoriteResponse{}, list: true}},
+		errors:    userErrors},
+	{method: "GET", path: "/v1/me/favorites/series/{series_id}", id: "getFavoriteSeries", summary: "Whether a series is a favorite", tag: "me",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Favorite", body: types.FavoriteResponse{}}},
+		errors:    itemErrors},
+	{method: "PUT", path: "/v1/me/favorites/series/{series_id}", id: "addFavoriteSeries", summary: "Mark a series as favorite", tag: "me",
+		responses: []response{{status: 200, desc: "Already there", body: types.FavoriteResponse{}}, {status: 201, desc: "Added", body: types.FavoriteResponse{}}},
+		errors:    itemErrors},
+	{method: "DELETE", path: "/v1/me/favorites/series/{series_id}", id: "removeFavoriteSeries", summary: "Unmark a favorite series", tag: "me",
+		responses: []response{deleted},
+		errors:    itemErrors},
+	{method: "GET", path: "/v1/me/favorites/season/{season_id}", id: "getFavoriteSeason", summary: "Whether a season is a favorite", tag: "me",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Favorite", body: types.FavoriteResponse{}}},
+		errors:    itemErrors},
+	{method: "PUT", path: "/v1/me/favorites/season/{season_id}", id: "addFavoriteSeason", summary: "Mark a season as favorite", tag: "me",
+		responses: []response{{status: 200, desc: "Already there", body: types.FavoriteResponse{}}, {status: 201, desc: "Added", body: types.FavoriteResponse{}}},
+		errors:    itemErrors},
+	{method: "DELETE", path: "/v1/me/favorites/season/{season_id}", id: "removeFavoriteSeason", summary: "Unmark a favorite season", tag: "me",
+		responses: []response{deleted},
+		errors:    itemErrors},
+	{method: "GET", path: "/v1/me/ratings", id: "listRatings", summary: "Ratings, most recently changed first", tag: "me",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Ratings", body: types.RatingResponse{}, list: true}},
+		errors:    userErrors},
+	{method: "GET", path: "/v1/me/ratings/series/{series_id}", id: "getRatingSeries", summary: "The rating of a series", tag: "me",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Rating", body: types.RatingResponse{}}},
+		errors:    itemErrors},
+	{method: "PUT", path: "/v1/me/ratings/series/{series_id}", id: "rateSeries", summary: "Rate a series from 1 to 10", tag: "me",
+		body:      types.RatingRequest{},
+		responses: []response{{status: 200, desc: "Rating", body: types.RatingResponse{}}},
+		errors:    itemErrors},
+	{method: "DELETE", path: "/v1/me/ratings/series/{series_id}", id: "removeRatingSeries", summary: "Remove the rating of a series", tag: "me",
+		responses: []response{deleted},
+		errors:    itemErrors},
+	{method: "GET", path: "/v1/me/ratings/season/{season_id}", id: "getRatingSeason", summary: "The rating of a season", tag: "me",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Rating", body: types.RatingResponse{}}},
+		errors:    itemErrors},
+	{method: "PUT", path: "/v1/me/ratings/season/{season_id}", id: "rateSeason", summary: "Rate a season from 1 to 10", tag: "me",
+		body:      types.RatingRequest{},
+		responses: []response{{status: 200, desc: "Rating", body: types.RatingResponse{}}},
+		errors:    itemErrors},
+	{method: "DELETE", path: "/v1/me/ratings/season/{season_id}", id: "removeRatingSeason", summary: "Remove the rating of a season", tag: "me",
+		responses: []response{deleted},
+		errors:    itemErrors},
+	{method: "GET", path: "/v1/me/watchlists", id: "listWatchlists", summary: "Watchlists by name", tag: "me",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Watchlists", body: types.WatchlistResponse{}, list: true}},
+		errors:    userErrors},
+	{method: "POST", path: "/v1/me/watchlists", id: "createWatchlist", summary: "Create a watchlist", tag: "me",
+		body:      types.WatchlistRequest{},
+		responses: []response{{status: 201, desc: "Created watchlist", body: types.WatchlistResponse{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusConflict}},
+	{method: "GET", path: "/v1/me/watchlists/{watchlist_id}", id: "getWatchlist", summary: "Get a watchlist", tag: "me",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Watchlist", body: types.WatchlistResponse{}}},
+		errors:    itemErrors},
+	{method: "PATCH", path: "/v1/me/watchlists/{watchlist_id}", id: "renameWatchlist", summary: "Rename a watchlist", tag: "me",
+		body:      types.WatchlistRequest{},
+		responses: []response{{status: 200, desc: "Renamed watchlist", body: types.WatchlistResponse{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusNotFound, http.StatusConflict}},
+	{method: "DELETE", path: "/v1/me/watchlists/{watchlist_id}", id: "deleteWatchlist", summary: "Delete a watchlist", tag: "me",
+		responses: []response{deleted},
+		errors:    itemErrors},
+	{method: "GET", path: "/v1/me/watchlists/{watchlist_id}/series/{series_id}", id: "getWatchlistSeries", summary: "Whether a series is on a watchlist", tag: "me",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Watchlist item", body: types.WatchlistItemResponse{}}},
+		errors:    itemErrors},
+	{method: "PUT", path: "/v1/me/watchlists/{watchlist_id}/series/{series_id}", id: "addWatchlistSeries", summary: "Put a series on a watchlist", tag: "me",
+		responses: []response{{status: 200, desc: "Already there", body: types.WatchlistItemResponse{}}, {status: 201, desc: "Added", body: types.WatchlistItemResponse{}}},
+		errors:    itemErrors},
+	{method: "DELETE", path: "/v1/me/watchlists/{watchlist_id}/series/{series_id}", id: "removeWatchlistSeries", summary: "Take a series off a watchlist", tag: "me",
+		responses: []response{deleted},
+		errors:    itemErrors},
+	{method: "GET", path: "/v1/me/watchlists/{watchlist_id}/season/{season_id}", id: "getWatchlistSeason", summary: "Whether a season is on a watchlist", tag: "me",
+		query:     []param{fields},
+		responses: []response{{status: 200, desc: "Watchlist item", body: types.WatchlistItemResponse{}}},
+		errors:    itemErrors},
+	{method: "PUT", path: "/v1/me/watchlists/{watchlist_id}/season/{season_id}", id: "addWatchlistSeason", summary: "Put a season on a watchlist", tag: "me",
+		responses: []response{{status: 200, desc: "Already there", body: types.WatchlistItemResponse{}}, {status: 201, desc: "Added", body: types.WatchlistItemResponse{}}},
+		errors:    itemErrors},
+	{method: "DELETE", path: "/v1/me/watchlists/{watchlist_id}/season/{season_id}", id: "removeWatchlistSeason", summary: "Take a season off a watchlist", tag: "me",
+		responses: []response{deleted},
+		errors:    itemErrors},
+
+	{method: "GET", path: "/v1/export", id: "exportCatalog", summary: "Stream the catalog", tag: "catalog",
+		query: []param{
+			{name: "format", schema: &Schema{Type: "string", Enum: []string{"json", "ndjson", "csv"}}},
+			{name: "include", desc: "Comma-separated: seasons, episodes", schema: &Schema{Type: "string"}},
+		},
+		responses: []response{{status: 200, desc: "Catalog records, parents before children", body: types.CatalogRecord{}, list: true,
+			media: []string{mediaJSON, mediaNDJSON, mediaCSV}}},
+		errors:   []int{http.StatusBadRequest},
+		uncached: true},
+	{method: "POST", path: "/v1/import", id: "importCatalog", summary: "Re-ingest an export", tag: "catalog",
+		query: []param{batchSize}, body: types.CatalogRecord{}, bulk: true, stream: true,
+		responses: []response{importSummary},
+		errors:    []int{http.StatusBadRequest, http.StatusUnsupportedMediaType}},
+
+	{method: "GET", path: "/v1/admin/id-check", id: "checkIDs", summary: "List IDs that do not match their parent", tag: "admin",
+		responses: []response{{status: 200, desc: "Report", body: types.IDCheckReport{}}}},
+	{method: "GET", path: "/v1/admin/cache", id: "cacheStats", summary: "Response cache hits and misses per route", tag: "admin",
+		responses: []response{{status: 200, desc: "Cache statistics", body: types.CacheStats{}}}},
+	{method: "GET", path: "/v1/admin/storage-check", id: "checkStorage", summary: "Compare catalog media with the object storage", tag: "admin",
+		query: []param{
+			{name: "method", desc: "list (default) also finds orphaned prefixes; head checks each expected object", schema: &Schema{Type: "string", Enum: []string{"list", "head"}}},
+			{name: "prefix", desc: "Only check keys under this prefix", schema: &Schema{Type: "string"}},
+		},
+		responses: []response{{status: 200, desc: "Report", body: types.StorageReport{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusServiceUnavailable}},
+
+	{method: "GET", path: "/v1/graphql", id: "graphqlGet", summary: "Run a GraphQL query given as query parameters", tag: "graphql",
+		query: []param{
+			{name: "query", required: true, schema: &Schema{Type: "string"}},
+			{name: "operationName", schema: &Schema{Type: "string"}},
+			{name: "variables", desc: "JSON object", schema: &Schema{Type: "string"}},
+		},
+		responses: []response{{status: 200, desc: "GraphQL response", body: graphql.Response{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusRequestEntityTooLarge}},
+	{method: "POST", path: "/v1/graphql", id: "graphqlPost", summary: "Run a GraphQL query", tag: "graphql",
+		body:      graphql.Request{},
+		responses: []response{{status: 200, desc: "GraphQL response", body: graphql.Response{}}},
+		errors:    []int{http.StatusBadRequest, http.StatusRequestEntityTooLarge}},
+	{method: "GET", path: "/v1/graphql/schema", id: "graphqlSchema", summary: "GraphQL schema (SDL)", tag: "graphql", public: true,
+		responses: []response{{status: 200, desc: "Schema", body: "", media: []string{"text/plain"}}}},
+
+	{method: "GET", path: "/v1/openapi.json", id: "getOpenAPI", summary: "This document", tag: "meta", public: true,
+		responses: []response{{status: 200, desc: "OpenAPI 3.1 document", body: map[string]any{}}}},
+	{method: "GET", path: "/v1/docs", id: "getDocs", summary: "API documentation page", tag: "meta", public: true,
+		responses: []response{{status: 200, desc: "HTML page", body: "", media: []string{"text/html"}}}},
+}
@@ -0,0 +1,199 @@
+package openapi
+
+import (
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schema is the subset of JSON Schema 2020-12 used by the document.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	PropertyNames        *Schema            `json:"propertyNames,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	ExclusiveMinimum     *float64           `json:"exclusiveMinimum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemas collects named struct schemas into components while building
+// references to them.
+type schemas map[string]*Schema
+
+// of returns the schema for the type of v; structs are added to the
+// registry and referenced by name.
+func (s schemas) of(v any) *Schema {
+	return s.typ(reflect.TypeOf(v))
+}
+
+func (s schemas) typ(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.Kind() == reflect.Struct:
+		name := schemaName(t)
+		if _, ok := s[name]; !ok {
+			s[name] = nil // placeholder for recursive types
+			s[name] = s.object(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + name}
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: s.typ(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.typ(t.Elem())}
+	}
+	return &Schema{}
+}
+
+// object describes a struct by its json tags. Fields with validate tags
+// are required when the rules say so; untagged fields of response types
+// are required unless omitempty.
+func (s schemas) object(t reflect.Type) *Schema {
+	obj := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	s.fields(t, obj)
+	return obj
+}
+
+func (s schemas) fields(t reflect.Type, obj *Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			s.fields(f.Type, obj)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		prop := s.typ(f.Type)
+		rules, hasRules := f.Tag.Lookup("validate")
+		if hasRules {
+			prop = constrain(prop, f.Type, rules)
+		}
+		obj.Properties[name] = prop
+
+		optional := f.Type.Kind() == reflect.Pointer || strings.Contains(opts, "omitempty")
+		if hasRules {
+			optional = optional || !hasRule(rules, "required")
+		}
+		if !optional {
+			obj.Required = append(obj.Required, name)
+		}
+	}
+}
+
+func hasRule(rules, name string) bool {
+	for _, r := range strings.Split(rules, ",") {
+		if n, _, _ := strings.Cut(r, "="); n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// constrain translates validate rules into JSON Schema keywords.
+func constrain(prop *Schema, t reflect.Type, rules string) *Schema {
+	if prop.Ref != "" {
+		return prop
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	for _, rule := range strings.Split(rules, ",") {
+		name, arg, _ := strings.Cut(rule, "=")
+		n, _ := strconv.ParseFloat(arg, 64)
+		switch name {
+		case "required":
+			switch t.Kind() {
+			case reflect.String:
+				prop.MinLength = intPtr(1)
+			case reflect.Slice:
+				prop.MinItems = intPtr(1)
+			}
+		case "min", "max":
+			switch t.Kind() {
+			case reflect.String:
+				if name == "min" {
+					prop.MinLength = intPtr(int(n))
+				} else {
+					prop.MaxLength = intPtr(int(n))
+				}
+			case reflect.Slice:
+				if name == "min" {
+					prop.MinItems = intPtr(int(n))
+				} else {
+					prop.MaxItems = intPtr(int(n))
+				}
+			default:
+				if name == "min" {
+					prop.Minimum = &n
+				} else {
+					prop.Maximum = &n
+				}
+			}
+		case "gt":
+			prop.ExclusiveMinimum = &n
+		case "month":
+			lo, hi := 1.0, 12.0
+			prop.Minimum, prop.Maximum = &lo, &hi
+		case "oneof":
+			prop.Enum = strings.Split(arg, "|")
+		case "keys":
+			prop.PropertyNames = &Schema{Type: "string", Enum: strings.Split(arg, "|")}
+		case "format":
+			if tmpl, ok := strings.CutPrefix(arg, "@"); ok {
+				prop.Description = "Must follow the configured " + tmpl + " template; generated when omitted."
+			} else {
+				prop.Description = "Must match " + strings.ReplaceAll(arg, "|", " or ") + "."
+			}
+		}
+	}
+	return prop
+}
+
+// schemaName names components after their type, prefixed with the
+// package name for types outside internal/types.
+func schemaName(t reflect.Type) string {
+	pkg := path.Base(t.PkgPath())
+	if pkg == "types" {
+		return t.Name()
+	}
+	return strings.ToUpper(pkg[:1]) + pkg[1:] + t.Name()
+}
+
+func intPtr(n int) *int { return &n }
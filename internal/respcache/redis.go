@@ -0,0 +1,76 @@
+package respcache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis is a store on a Redis-compatible server, shared by every instance
+// of the API.
+type Redis struct {
+	client *redis.Client
+	prefix string
+}
+
+const redisPoolSize = 16
+
+// NewRedis parses redis://[user:password@]host[:port][/db]. Nothing is
+// dialed until the first command.
+func NewRedis(rawURL string) (*Redis, error) {
+	opts, err := redis.ParseURL(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis URL %q: %w", rawURL, err)
+	}
+	opts.PoolSize = redisPoolSize
+	opts.DialTimeout = 2 * time.Second
+	opts.ReadTimeout = 2 * time.Second
+	opts.WriteTimeout = 2 * time.Second
+	return &Redis{client: redis.NewClient(opts), prefix: "animatrix:respcache:"}, nil
+}
+
+func (r *Redis) genKey(scope string) string {
+	if scope == "" {
+		return r.prefix + "gen"
+	}
+	return r.prefix + "gen:" + scope
+}
+
+// Get reads the generations and the entry with a single MGET.
+func (r *Redis) Get(ctx context.Context, key string, scopes []string) ([]byte, bool, []uint64, error) {
+	keys := make([]string, 0, len(scopes)+1)
+	for _, scope := range scopes {
+		keys = append(keys, r.genKey(scope))
+	}
+	values, err := r.client.MGet(ctx, append(keys, r.prefix+key)...).Result()
+	if err != nil {
+		return nil, false, nil, err
+	}
+	if len(values) != len(keys)+1 {
+		return nil, false, nil, fmt.Errorf("redis: MGET of %d keys returned %d values", len(keys)+1, len(values))
+	}
+	gens := make([]uint64, len(scopes))
+	for i := range scopes {
+		if s, ok := values[i].(string); ok {
+			if gens[i], err = strconv.ParseUint(s, 10, 64); err != nil {
+				return nil, false, nil, err
+			}
+		}
+	}
+	s, ok := values[len(scopes)].(string)
+	if !ok {
+		return nil, false, gens, nil
+	}
+	return []byte(s), true, gens, nil
+}
+
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return r.client.Set(ctx, r.prefix+key, value, ttl).Err()
+}
+
+func (r *Redis) Invalidate(ctx context.Context, scope string) error {
+	return r.client.Incr(ctx, r.genKey(scope)).Err()
+}
@@ -0,0 +1,382 @@
+// Package respcache caches whole GET responses of the read endpoints and
+// drops them whenever a series, season or episode changes, or only those
+// of a series and the lists of series when its favorites or ratings do.
+package respcache
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/favorite"
+	"github.com/clustlight/animatrix-api/ent/predicate"
+	"github.com/clustlight/animatrix-api/ent/rating"
+	"github.com/clustlight/animatrix-api/ent/series"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/go-chi/chi/v5"
+)
+
+const DefaultSize = 1000
+
+// AggregatesScope holds the cached responses showing the favorite and
+// rating aggregates of many series, such as lists sorted by them; routes
+// join it through Handler.
+const AggregatesScope = "aggregates"
+
+// cachedHeaders are the response headers stored with a body.
+var cachedHeaders = []string{"Content-Type", "Last-Modified"}
+
+// Cache serves repeated GET requests from a Store. A nil *Cache caches
+// nothing.
+type Cache struct {
+	store   Store
+	backend string
+	// ttls overrides the TTL given to Handler, by route pattern.
+	ttls map[string]time.Duration
+	// maxTTL caps every TTL when set; see Limit.
+	maxTTL time.Duration
+
+	mu            sync.Mutex
+	routes        map[string]*routeStats
+	invalidations atomic.Int64
+	// pending holds the transactions and scopes that already invalidate
+	// on commit.
+	pending sync.Map
+}
+
+type routeStats struct {
+	hits, misses, errors atomic.Int64
+}
+
+func New(store Store, backend string) *Cache {
+	return &Cache{store: store, backend: backend, ttls: map[string]time.Duration{}, routes: map[string]*routeStats{}}
+}
+
+// FromEnv builds the cache selected by RESPONSE_CACHE: "memory" (the
+// default, RESPONSE_CACHE_SIZE entries), a redis:// URL, or "off", for
+// which it returns nil. RESPONSE_CACHE_TTL overrides route TTLs with a ';'
+// separated list of pattern=duration entries.
+func FromEnv() (*Cache, error) {
+	var c *Cache
+	switch spec := cmp.Or(os.Getenv("RESPONSE_CACHE"), "memory"); {
+	case spec == "off":
+		return nil, nil
+	case spec == "memory":
+		size := DefaultSize
+		if raw := os.Getenv("RESPONSE_CACHE_SIZE"); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("RESPONSE_CACHE_SIZE must be a positive integer")
+			}
+			size = n
+		}
+		c = New(NewMemory(size), "memory")
+	case strings.HasPrefix(spec, "redis://"):
+		store, err := NewRedis(spec)
+		if err != nil {
+			return nil, err
+		}
+		c = New(store, "redis")
+	default:
+		return nil, fmt.Errorf("RESPONSE_CACHE must be memory, off or a redis:// URL")
+	}
+
+	for _, entry := range strings.Split(os.Getenv("RESPONSE_CACHE_TTL"), ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		pattern, raw, ok := strings.Cut(entry, "=")
+		ttl, err := time.ParseDuration(strings.TrimSpace(raw))
+		if !ok || err != nil {
+			return nil, fmt.Errorf("RESPONSE_CACHE_TTL: invalid entry %q, want pattern=duration", entry)
+		}
+		c.ttls[strings.TrimSpace(pattern)] = ttl
+	}
+	return c, nil
+}
+
+// Limit caps the TTL of every route at max, so responses embedding
+// expiring URLs are not served once the URLs are about to expire.
+func (c *Cache) Limit(max time.Duration) {
+	if c != nil {
+		c.maxTTL = max
+	}
+}
+
+func (c *Cache) route(pattern string) *routeStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.routes[pattern]
+	if !ok {
+		s = &routeStats{}
+		c.routes[pattern] = s
+	}
+	return s
+}
+
+// entry is a cached response with the generations of its scopes at the
+// time it was read.
+type entry struct {
+	Gens   []uint64          `json:"gens"`
+	Header map[string]string `json:"header"`
+	Body   []byte            `json:"body"`
+}
+
+// Handler returns middleware caching successful GET responses for ttl,
+// or for the route's RESPONSE_CACHE_TTL override, at most the Limit; a TTL
+// of 0 disables it. Besides the global scope and that of the route's
+// {series_id}, the responses belong to the given scopes.
+// Responses are keyed by path and query and carry X-Cache: HIT or MISS.
+// Backend errors are counted and the request is served uncached.
+func (c *Cache) Handler(ttl time.Duration, scopes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if c == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				next.ServeHTTP(w, r)
+				return
+			}
+			pattern := r.URL.Path
+			if rc := chi.RouteContext(r.Context()); rc != nil {
+				pattern = rc.RoutePattern()
+			}
+			ttl := ttl
+			if d, ok := c.ttls[pattern]; ok {
+				ttl = d
+			}
+			if c.maxTTL > 0 {
+				ttl = min(ttl, c.maxTTL)
+			}
+			if ttl <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+			stats := c.route(pattern)
+			ctx := r.Context()
+
+			scopes := append([]string{""}, scopes...)
+			if rc := chi.RouteContext(ctx); rc != nil {
+				if id := rc.URLParam("series_id"); id != "" {
+					scopes = append(scopes, seriesScope(id))
+				}
+			}
+			key := r.URL.Path + "?" + r.URL.Query().Encode()
+			b, ok, gens, err := c.store.Get(ctx, key, scopes)
+			if err != nil {
+				stats.errors.Add(1)
+				log.Printf("response cache: %v", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+			var e entry
+			if ok && json.Unmarshal(b, &e) == nil && slices.Equal(e.Gens, gens) {
+				stats.hits.Add(1)
+				for k, v := range e.Header {
+					w.Header().Set(k, v)
+				}
+				w.Header().Set("X-Cache", "HIT")
+				w.Write(e.Body)
+				return
+			}
+
+			stats.misses.Add(1)
+			w.Header().Set("X-Cache", "MISS")
+			rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+			if rec.status != http.StatusOK {
+				return
+			}
+			e = entry{Gens: gens, Header: map[string]string{}, Body: rec.body.Bytes()}
+			for _, k := range cachedHeaders {
+				if v := w.Header().Get(k); v != "" {
+					e.Header[k] = v
+				}
+			}
+			b, _ = json.Marshal(e)
+			if err := c.store.Set(ctx, key, b, ttl); err != nil {
+				stats.errors.Add(1)
+				log.Printf("response cache: %v", err)
+			}
+		})
+	}
+}
+
+// recorder copies the response body while passing it through.
+type recorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *recorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// Invalidate drops every cached response.
+func (c *Cache) Invalidate(ctx context.Context) {
+	c.invalidate(ctx, "")
+}
+
+func (c *Cache) invalidate(ctx context.Context, scope string) {
+	if err := c.store.Invalidate(ctx, scope); err != nil {
+		log.Printf("response cache: invalidate: %v", err)
+		return
+	}
+	c.invalidations.Add(1)
+}
+
+// seriesScope holds the cached responses of the routes of a series, i.e.
+// those with a {series_id} parameter.
+func seriesScope(seriesID string) string {
+	return "series:" + seriesID
+}
+
+// Hook invalidates the cache after every successful mutation, or when
+// the transaction it ran in commits. Mutations of the ignored entity types
+// (e.g. ent.TypeUser), which no cached response shows, are left out.
+// Favorites and ratings only show in the aggregates of series, so they
+// drop just the responses of their series' routes and AggregatesScope.
+func (c *Cache) Hook(ignore ...string) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if slices.Contains(ignore, m.Type()) {
+				return next.Mutate(ctx, m)
+			}
+			scopes := []string{""}
+			if m.Type() == ent.TypeFavorite || m.Type() == ent.TypeRating {
+				// Read before the mutation, which may delete the rows.
+				ids, err := seriesOf(ctx, m)
+				if err != nil {
+					log.Printf("response cache: finding the series of a %s: %v", m.Type(), err)
+				} else {
+					scopes = []string{AggregatesScope}
+					for _, id := range ids {
+						scopes = append(scopes, seriesScope(id))
+					}
+				}
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			if txm, ok := m.(interface{ Tx() (*ent.Tx, error) }); ok {
+				if tx, err := txm.Tx(); err == nil {
+					for _, scope := range scopes {
+						c.invalidateOnCommit(tx, scope)
+					}
+					return v, nil
+				}
+			}
+			for _, scope := range scopes {
+				c.invalidate(ctx, scope)
+			}
+			return v, nil
+		})
+	}
+}
+
+// seriesOf returns the series_id of the series whose favorites or ratings
+// m changes. Items that are seasons have none.
+func seriesOf(ctx context.Context, m ent.Mutation) ([]string, error) {
+	var where predicate.Series
+	switch m := m.(type) {
+	case *ent.FavoriteMutation:
+		if id, ok := m.SeriesID(); ok {
+			where = series.ID(id)
+		} else if m.Op().Is(ent.OpCreate) {
+			return nil, nil
+		} else {
+			ids, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			where = series.HasFavoritesWith(favorite.IDIn(ids...))
+		}
+		return m.Client().Series.Query().Where(where).Select(series.FieldSeriesID).Strings(ctx)
+	case *ent.RatingMutation:
+		if id, ok := m.SeriesID(); ok {
+			where = series.ID(id)
+		} else if m.Op().Is(ent.OpCreate) {
+			return nil, nil
+		} else {
+			ids, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			where = series.HasRatingsWith(rating.IDIn(ids...))
+		}
+		return m.Client().Series.Query().Where(where).Select(series.FieldSeriesID).Strings(ctx)
+	}
+	return nil, fmt.Errorf("unexpected mutation %T", m)
+}
+
+func (c *Cache) invalidateOnCommit(tx *ent.Tx, scope string) {
+	key := pendingKey{tx, scope}
+	if _, seen := c.pending.LoadOrStore(key, struct{}{}); seen {
+		return
+	}
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			err := next.Commit(ctx, tx)
+			c.pending.Delete(key)
+			if err == nil {
+				c.invalidate(ctx, scope)
+			}
+			return err
+		})
+	})
+	tx.OnRollback(func(next ent.Rollbacker) ent.Rollbacker {
+		return ent.RollbackFunc(func(ctx context.Context, tx *ent.Tx) error {
+			c.pending.Delete(key)
+			return next.Rollback(ctx, tx)
+		})
+	})
+}
+
+type pendingKey struct {
+	tx    *ent.Tx
+	scope string
+}
+
+// Stats reports the backend, the number of invalidations and the hits and
+// misses of every cached route.
+func (c *Cache) Stats() types.CacheStats {
+	if c == nil {
+		return types.CacheStats{Backend: "off", Routes: map[string]types.CacheRouteStats{}}
+	}
+	s := types.CacheStats{
+		Backend:       c.backend,
+		Invalidations: c.invalidations.Load(),
+		Routes:        map[string]types.CacheRouteStats{},
+	}
+	if m, ok := c.store.(*Memory); ok {
+		n := m.Len()
+		s.Entries = &n
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for pattern, rs := range c.routes {
+		s.Routes[pattern] = types.CacheRouteStats{Hits: rs.hits.Load(), Misses: rs.misses.Load(), Errors: rs.errors.Load()}
+	}
+	return s
+}
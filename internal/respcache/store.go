@@ -0,0 +1,98 @@
+package respcache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// Store is a cache backend. Every entry belongs to the global scope, ""
+// and possibly to narrower ones such as "series:<series_id>". Invalidate
+// advances the generation of a scope; the cache stores the generations an
+// entry was written under with it and ignores the entry once they differ.
+type Store interface {
+	// Get returns the entry under key and the current generation of each
+	// scope, in one round trip.
+	Get(ctx context.Context, key string, scopes []string) (value []byte, ok bool, gens []uint64, err error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Invalidate(ctx context.Context, scope string) error
+}
+
+// Memory is an in-process LRU store holding up to size entries.
+type Memory struct {
+	mu    sync.Mutex
+	size  int
+	gens  map[string]uint64 // by scope
+	order *list.List        // front is most recently used
+	items map[string]*list.Element
+}
+
+type memoryItem struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+func NewMemory(size int) *Memory {
+	return &Memory{size: size, gens: map[string]uint64{}, order: list.New(), items: map[string]*list.Element{}}
+}
+
+func (m *Memory) Get(_ context.Context, key string, scopes []string) ([]byte, bool, []uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	gens := make([]uint64, len(scopes))
+	for i, scope := range scopes {
+		gens[i] = m.gens[scope]
+	}
+	el, ok := m.items[key]
+	if !ok {
+		return nil, false, gens, nil
+	}
+	it := el.Value.(*memoryItem)
+	if time.Now().After(it.expires) {
+		m.order.Remove(el)
+		delete(m.items, key)
+		return nil, false, gens, nil
+	}
+	m.order.MoveToFront(el)
+	return it.value, true, gens, nil
+}
+
+func (m *Memory) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	it := &memoryItem{key: key, value: value, expires: time.Now().Add(ttl)}
+	if el, ok := m.items[key]; ok {
+		el.Value = it
+		m.order.MoveToFront(el)
+		return nil
+	}
+	m.items[key] = m.order.PushFront(it)
+	for m.order.Len() > m.size {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.items, oldest.Value.(*memoryItem).key)
+	}
+	return nil
+}
+
+// Invalidate advances the generation of scope. The global scope also
+// drops every entry, since none of them can be read again; entries of a
+// narrower scope are replaced when next written, or age out.
+func (m *Memory) Invalidate(_ context.Context, scope string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.gens[scope]++
+	if scope == "" {
+		m.order.Init()
+		m.items = map[string]*list.Element{}
+	}
+	return nil
+}
+
+func (m *Memory) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.order.Len()
+}
@@ -1,53 +1,197 @@
 package internal
 
 import (
+	"net/http"
+	"strings"
+	"time"
+
 	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/auth"
 	"github.com/clustlight/animatrix-api/internal/handler"
+	"github.com/clustlight/animatrix-api/internal/httpcache"
+	"github.com/clustlight/animatrix-api/internal/media"
+	"github.com/clustlight/animatrix-api/internal/openapi"
+	"github.com/clustlight/animatrix-api/internal/respcache"
+	"github.com/clustlight/animatrix-api/internal/types"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/cors"
 )
 
-func NewRouter(client *ent.Client) *chi.Mux {
+// Default Cache-Control directives of the GET routes. CACHE_CONTROL
+// overrides them per route (see httpcache.PolicyFromEnv).
+const (
+	catalogCache = "public, max-age=60"
+	docsCache    = "public, max-age=3600"
+	revalidate   = "no-cache"
+	private      = "private, no-cache"
+)
+
+// Default TTLs of cached responses. Mutations invalidate the cache, so
+// these only bound staleness after changes made outside the API;
+// RESPONSE_CACHE_TTL overrides them per route.
+const (
+	catalogTTL = 10 * time.Minute
+	searchTTL  = 5 * time.Minute
+)
+
+// publicPaths are served without an API key.
+var publicPaths = map[string]bool{
+	"/v1/openapi.json":   true,
+	"/v1/docs":           true,
+	"/v1/graphql/schema": true,
+}
+
+// scopeOf returns the API key scope a request needs: admin for the admin
+// routes, read for reads and for the caller's own data under /me, write
+// for other changes. Documentation, and catalog reads when
+// auth.Config.PublicRead is set, need none.
+func scopeOf(r *http.Request) auth.Scope {
+	p := r.URL.Path
+	read := r.Method == http.MethodGet || r.Method == http.MethodHead ||
+		r.Method == http.MethodPost && p == "/v1/graphql"
+	switch {
+	case publicPaths[p]:
+		return ""
+	case strings.HasPrefix(p, "/v1/admin/"):
+		return auth.ScopeAdmin
+	case p == "/v1/me" || strings.HasPrefix(p, "/v1/me/"):
+		return auth.ScopeRead
+	case read && auth.Current().PublicRead:
+		return ""
+	case read:
+		return auth.ScopeRead
+	}
+	return auth.ScopeWrite
+}
+
+// NewRouter builds the HTTP API. cache may be nil to disable response
+// caching.
+func NewRouter(client *ent.Client, cache *respcache.Cache) *chi.Mux {
 	r := chi.NewRouter()
 
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"*"},
-		AllowedMethods:   []string{"GET", "POST", "PATCH", "DELETE"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-User", "X-API-Key", "If-Match", "If-None-Match", "If-Modified-Since"},
+		ExposedHeaders:   []string{"Link", "ETag"},
 		AllowCredentials: false,
 		MaxAge:           300,
 	}))
 
+	r.NotFound(handler.NotFound)
+	r.MethodNotAllowed(handler.MethodNotAllowed)
+
+	policy := httpcache.PolicyFromEnv()
+	catalog := chi.Middlewares{policy.Handler(catalogCache), cache.Handler(catalogTTL)}
+	// Lists of series show and sort by their favorite and rating
+	// aggregates.
+	seriesList := chi.Middlewares{policy.Handler(catalogCache), cache.Handler(catalogTTL, respcache.AggregatesScope)}
+	search := chi.Middlewares{policy.Handler(catalogCache), cache.Handler(searchTTL, respcache.AggregatesScope)}
+	docs := policy.Handler(docsCache)
+	dynamic := policy.Handler(revalidate)
+	user := chi.Middlewares{policy.Handler(private), handler.RequireUser(client)}
+
 	r.Route("/v1", func(api chi.Router) {
-		api.Get("/series", handler.GetAllSeries(client))
+		api.Use(handler.Authenticate(client, scopeOf))
+
+		api.With(seriesList...).Get("/series", handler.GetAllSeries(client))
 		api.Post("/series", handler.CreateSeries(client))
-		api.Get("/series/{series_id}", handler.GetSeriesDetail(client))
+		api.With(catalog...).Get("/series/{series_id}", handler.GetSeriesDetail(client))
 		api.Patch("/series/{series_id}", handler.UpdateSeries(client))
 		api.Delete("/series/{series_id}", handler.DeleteSeries(client))
+		api.Post("/series/{series_id}/seasons:renumber", handler.RenumberSeasons(client))
+		api.Put("/series/{series_id}/thumbnail", handler.UploadImage(client, media.Series, "series_id", media.Thumbnail))
+		api.Put("/series/{series_id}/portrait", handler.UploadImage(client, media.Series, "series_id", media.Portrait))
 
 		api.Post("/series/bulk", handler.BulkCreateSeriesHandler(client))
 
-		api.Get("/series/recent", handler.GetRecentlyUpdatedSeriesHandler(client))
+		api.With(seriesList...).Get("/series/recent", handler.GetRecentlyUpdatedSeriesHandler(client))
 
-		api.Get("/season", handler.GetAllSeasons(client))
+		api.With(catalog...).Get("/season", handler.GetAllSeasons(client))
 		api.Post("/season", handler.CreateSeason(client))
-		api.Get("/season/{season_id}", handler.GetSeasonDetail(client))
+		api.With(catalog...).Get("/season/{season_id}", handler.GetSeasonDetail(client))
 		api.Patch("/season/{season_id}", handler.UpdateSeason(client))
 		api.Delete("/season/{season_id}", handler.DeleteSeason(client))
+		api.Post("/season/{season_id}/episodes:move", handler.MoveEpisodes(client))
+		api.Post("/season/{season_id}/episodes:renumber", handler.RenumberEpisodes(client))
+		api.Put("/season/{season_id}/thumbnail", handler.UploadImage(client, media.Season, "season_id", media.Thumbnail))
 
 		api.Post("/season/bulk", handler.BulkCreateSeasonHandler(client))
 
-		api.Get("/episode", handler.GetAllEpisodes(client))
+		api.With(catalog...).Get("/episode", handler.GetAllEpisodes(client))
 		api.Post("/episode", handler.CreateEpisode(client))
-		api.Get("/episode/{episode_id}", handler.GetEpisodeDetail(client))
+		api.With(catalog...).Get("/episode/{episode_id}", handler.GetEpisodeDetail(client))
+		api.With(catalog...).Get("/episode/{episode_id}/master.m3u8", handler.GetEpisodeMasterPlaylist(client))
 		api.Patch("/episode/{episode_id}", handler.UpdateEpisode(client))
 		api.Delete("/episode/{episode_id}", handler.DeleteEpisode(client))
+		api.Put("/episode/{episode_id}/thumbnail", handler.UploadImage(client, media.Episode, "episode_id", media.Thumbnail))
+		api.With(catalog...).Get("/episode/{episode_id}/subtitles", handler.GetSubtitles(client))
+		api.Post("/episode/{episode_id}/subtitles", handler.CreateSubtitle(client))
+		api.With(catalog...).Get("/episode/{episode_id}/subtitles/{subtitle_id}", handler.GetSubtitle(client))
+		api.With(catalog...).Get("/episode/{episode_id}/subtitles/{subtitle_id}.vtt", handler.GetSubtitleWebVTT(client))
+		api.Patch("/episode/{episode_id}/subtitles/{subtitle_id}", handler.UpdateSubtitle(client))
+		api.Delete("/episode/{episode_id}/subtitles/{subtitle_id}", handler.DeleteSubtitle(client))
+		api.With(catalog...).Get("/episode/{episode_id}/audio-tracks", handler.GetAudioTracks(client))
+		api.Post("/episode/{episode_id}/audio-tracks", handler.CreateAudioTrack(client))
+		api.With(catalog...).Get("/episode/{episode_id}/audio-tracks/{track_id}", handler.GetAudioTrack(client))
+		api.Patch("/episode/{episode_id}/audio-tracks/{track_id}", handler.UpdateAudioTrack(client))
+		api.Delete("/episode/{episode_id}/audio-tracks/{track_id}", handler.DeleteAudioTrack(client))
+		api.With(catalog...).Get("/episode/{episode_id}/chapters", handler.GetChapters(client))
+		api.With(catalog...).Get("/episode/{episode_id}/chapters.vtt", handler.GetChapterVTT(client))
+		api.Put("/episode/{episode_id}/chapters", handler.ReplaceChapters(client))
+		api.Delete("/episode/{episode_id}/chapters", handler.DeleteChapters(client))
 
 		api.Post("/episode/bulk", handler.BulkCreateEpisodeHandler(client))
 
-		api.Get("/search", handler.SearchHandler(client))
+		api.With(search...).Get("/search", handler.SearchHandler(client))
+
+		api.With(user...).Get("/me", handler.GetMe)
+		api.With(user...).Get("/me/progress/{episode_id}", handler.GetProgress(client))
+		api.With(user...).Put("/me/progress/{episode_id}", handler.SaveProgress(client))
+		api.With(user...).Get("/me/continue-watching", handler.GetContinueWatching(client))
+		api.With(user...).Get("/me/history", handler.GetHistory(client))
+
+		api.With(user...).Get("/me/favorites", handler.GetFavorites(client))
+		api.With(user...).Get("/me/favorites/series/{series_id}", handler.GetFavorite(client, types.ItemSeries))
+		api.With(user...).Put("/me/favorites/series/{series_id}", handler.AddFavorite(client, types.ItemSeries))
+		api.With(user...).Delete("/me/favorites/series/{series_id}", handler.RemoveFavorite(client, types.ItemSeries))
+		api.With(user...).Get("/me/favorites/season/{season_id}", handler.GetFavorite(client, types.ItemSeason))
+		api.With(user...).Put("/me/favorites/season/{season_id}", handler.AddFavorite(client, types.ItemSeason))
+		api.With(user...).Delete("/me/favorites/season/{season_id}", handler.RemoveFavorite(client, types.ItemSeason))
+		api.With(user...).Get("/me/ratings", handler.GetRatings(client))
+		api.With(user...).Get("/me/ratings/series/{series_id}", handler.GetRating(client, types.ItemSeries))
+		api.With(user...).Put("/me/ratings/series/{series_id}", handler.RateItem(client, types.ItemSeries))
+		api.With(user...).Delete("/me/ratings/series/{series_id}", handler.RemoveRating(client, types.ItemSeries))
+		api.With(user...).Get("/me/ratings/season/{season_id}", handler.GetRating(client, types.ItemSeason))
+		api.With(user...).Put("/me/ratings/season/{season_id}", handler.RateItem(client, types.ItemSeason))
+		api.With(user...).Delete("/me/ratings/season/{season_id}", handler.RemoveRating(client, types.ItemSeason))
+		api.With(user...).Get("/me/watchlists", handler.GetWatchlists(client))
+		api.With(user...).Post("/me/watchlists", handler.CreateWatchlist(client))
+		api.With(user...).Get("/me/watchlists/{watchlist_id}", handler.GetWatchlist(client))
+		api.With(user...).Patch("/me/watchlists/{watchlist_id}", handler.RenameWatchlist(client))
+		api.With(user...).Delete("/me/watchlists/{watchlist_id}", handler.DeleteWatchlist(client))
+		api.With(user...).Get("/me/watchlists/{watchlist_id}/series/{series_id}", handler.GetWatchlistItem(client, types.ItemSeries))
+		api.With(user...).Put("/me/watchlists/{watchlist_id}/series/{series_id}", handler.AddWatchlistItem(client, types.ItemSeries))
+		api.With(user...).Delete("/me/watchlists/{watchlist_id}/series/{series_id}", handler.RemoveWatchlistItem(client, types.ItemSeries))
+		api.With(user...).Get("/me/watchlists/{watchlist_id}/season/{season_id}", handler.GetWatchlistItem(client, types.ItemSeason))
+		api.With(user...).Put("/me/watchlists/{watchlist_id}/season/{season_id}", handler.AddWatchlistItem(client, types.ItemSeason))
+		api.With(user...).Delete("/me/watchlists/{watchlist_id}/season/{season_id}", handler.RemoveWatchlistItem(client, types.ItemSeason))
+
+		api.Get("/export", handler.ExportHandler(client))
+		api.Post("/import", handler.ImportHandler(client))
+
+		api.With(dynamic).Get("/admin/id-check", handler.CheckIDs(client))
+		api.With(dynamic).Get("/admin/cache", handler.CacheStats(cache))
+		api.With(dynamic).Get("/admin/storage-check", handler.CheckStorage(client))
+
+		graphQL := handler.GraphQL(client)
+		api.With(dynamic).Get("/graphql", graphQL)
+		api.Post("/graphql", graphQL)
+		api.With(docs).Get("/graphql/schema", handler.GraphQLSchema)
+
+		api.With(docs).Get("/openapi.json", openapi.Handler)
+		api.With(docs).Get("/docs", openapi.Docs)
 	})
 	return r
 }
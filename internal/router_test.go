@@ -0,0 +1,122 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/enttest"
+	"github.com/clustlight/animatrix-api/internal/auth"
+	"github.com/clustlight/animatrix-api/internal/respcache"
+	"github.com/clustlight/animatrix-api/internal/types"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// A favorite drops the cached lists sorted by favorites along with the
+// responses of its series, and leaves the rest of the cache alone.
+func TestFavoriteInvalidatesSeriesLists(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:router?mode=memory&_fk=1")
+	defer client.Close()
+	cache := respcache.New(respcache.NewMemory(100), "memory")
+	client.Use(cache.Hook(ent.TypeAPIKey, ent.TypeUser, ent.TypeWatchProgress, ent.TypeWatchlist, ent.TypeWatchlistItem))
+	auth.Configure(auth.Config{UserHeader: auth.DefaultUserHeader})
+	t.Cleanup(func() { auth.Configure(auth.Config{}) })
+	srv := httptest.NewServer(NewRouter(client, cache))
+	defer srv.Close()
+
+	do := func(method, path, body string) (*http.Response, []byte) {
+		t.Helper()
+		req, _ := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(auth.DefaultUserHeader, "alice")
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		b, _ := io.ReadAll(res.Body)
+		if res.StatusCode >= 300 {
+			t.Fatalf("%s %s: %d %s", method, path, res.StatusCode, b)
+		}
+		return res, b
+	}
+	for _, id := range []string{"aa", "bb"} {
+		do("POST", "/v1/series", fmt.Sprintf(`{"series_id":%q,"title":%q}`, id, strings.ToUpper(id)))
+	}
+	// ranking returns the series IDs and favorite counts of the list and
+	// whether it came from the cache.
+	ranking := func() (string, string) {
+		res, b := do("GET", "/v1/series?sort=-favorites", "")
+		var list []types.SeriesResponse
+		if err := json.Unmarshal(b, &list); err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, s := range list {
+			got = append(got, fmt.Sprintf("%s:%d", s.SeriesID, s.FavoriteCount))
+		}
+		return strings.Join(got, " "), res.Header.Get("X-Cache")
+	}
+
+	ranking()
+	do("GET", "/v1/season", "")
+	if got, cached := ranking(); got != "aa:0 bb:0" || cached != "HIT" {
+		t.Fatalf("before the favorite: %s (%s), want aa:0 bb:0 (HIT)", got, cached)
+	}
+
+	do("PUT", "/v1/me/favorites/series/bb", "")
+	if got, cached := ranking(); got != "bb:1 aa:0" || cached != "MISS" {
+		t.Errorf("after the favorite: %s (%s), want bb:1 aa:0 (MISS)", got, cached)
+	}
+	if res, _ := do("GET", "/v1/season", ""); res.Header.Get("X-Cache") != "HIT" {
+		t.Errorf("/v1/season was dropped by a favorite")
+	}
+}
+
+// Catalog reads carry the newest updated_at of the rows they return, and
+// answer If-Modified-Since with 304 once nothing changed.
+func TestLastModified(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:lastmodified?mode=memory&_fk=1")
+	defer client.Close()
+	srv := httptest.NewServer(NewRouter(client, nil))
+	defer srv.Close()
+	ctx := context.Background()
+
+	s := client.Series.Create().SetSeriesID("aa").SetTitle("A").SetUpdatedAt(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).SaveX(ctx)
+	client.Season.Create().SetSeries(s).SetSeasonID("aa_s1").SetSeasonTitle("S").SetSeasonNumber(1).
+		SetUpdatedAt(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)).SaveX(ctx)
+	want := "Fri, 01 Mar 2024 00:00:00 GMT"
+
+	get := func(path, since string) *http.Response {
+		t.Helper()
+		req, _ := http.NewRequest("GET", srv.URL+path, nil)
+		if since != "" {
+			req.Header.Set("If-Modified-Since", since)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		return res
+	}
+	for _, path := range []string{"/v1/series/aa", "/v1/season", "/v1/season/aa_s1", "/v1/series?include=seasons"} {
+		if got := get(path, "").Header.Get("Last-Modified"); got != want {
+			t.Errorf("%s: Last-Modified %q, want %q", path, got, want)
+		}
+		if res := get(path, want); res.StatusCode != http.StatusNotModified {
+			t.Errorf("%s: If-Modified-Since its Last-Modified answered %d, want 304", path, res.StatusCode)
+		}
+		if res := get(path, "Thu, 29 Feb 2024 00:00:00 GMT"); res.StatusCode != http.StatusOK {
+			t.Errorf("%s: If-Modified-Since an earlier time answered %d, want 200", path, res.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package rpc
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/clustlight/animatrix-api/internal/types"
+	animatrixv1 "github.com/clustlight/animatrix-api/proto/animatrix/v1"
+)
+
+// Conversions between the messages of catalog.proto and the
+// internal/types structs the controllers take and return. Fields without
+// a counterpart in the proto, such as preset URLs and media overrides,
+// are left out.
+
+func messages[T, M any](items []T, convert func(*T) *M) []*M {
+	out := make([]*M, len(items))
+	for i := range items {
+		out[i] = convert(&items[i])
+	}
+	return out
+}
+
+func requests[M, T any](msgs []*M, convert func(*M) *T) []T {
+	out := make([]T, len(msgs))
+	for i, m := range msgs {
+		out[i] = *convert(m)
+	}
+	return out
+}
+
+// timestamp leaves zero times unset.
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// timeOf returns the zero time for an unset timestamp.
+func timeOf(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func timePtr(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func intPtr(n *int32) *int {
+	if n == nil {
+		return nil
+	}
+	v := int(*n)
+	return &v
+}
+
+func seriesMessage(s *types.SeriesResponse) *animatrixv1.Series {
+	return &animatrixv1.Series{
+		SeriesId:     s.SeriesID,
+		Title:        s.Title,
+		TitleYomi:    s.TitleYomi,
+		TitleEn:      s.TitleEn,
+		ThumbnailUrl: s.ThumbnailURL,
+		PortraitUrl:  s.PortraitURL,
+		Description:  s.Description,
+		Seasons:      messages(s.Seasons, seasonMessage),
+		UpdatedAt:    timestamp(s.UpdatedAt),
+	}
+}
+
+func seasonMessage(s *types.SeasonResponse) *animatrixv1.Season {
+	return &animatrixv1.Season{
+		SeriesId:        s.SeriesID,
+		SeasonId:        s.SeasonID,
+		SeasonTitle:     s.SeasonTitle,
+		SeasonTitleYomi: s.SeasonTitleYomi,
+		SeasonNumber:    int32(s.SeasonNumber),
+		ShoboiTid:       int32(s.ShoboiTID),
+		Description:     s.Description,
+		FirstYear:       int32(s.FirstYear),
+		FirstMonth:      int32(s.FirstMonth),
+		FirstEndYear:    int32(s.FirstEndYear),
+		FirstEndMonth:   int32(s.FirstEndMonth),
+		ThumbnailUrl:    s.ThumbnailURL,
+		Episodes:        messages(s.Episodes, episodeMessage),
+		UpdatedAt:       timestamp(s.UpdatedAt),
+	}
+}
+
+func episodeMessage(e *types.EpisodeResponse) *animatrixv1.Episode {
+	return &animatrixv1.Episode{
+		EpisodeId:      e.EpisodeID,
+		Title:          e.Title,
+		EpisodeNumber:  int32(e.EpisodeNumber),
+		Duration:       e.Duration,
+		DurationString: e.DurationString,
+		Timestamp:      timestamp(e.Timestamp),
+		FormatId:       e.FormatID,
+		Width:          int32(e.Width),
+		Height:         int32(e.Height),
+		DynamicRange:   e.DynamicRange,
+		VideoUrl:       e.VideoURL,
+		ThumbnailUrl:   e.ThumbnailURL,
+		Description:    e.Description,
+		UpdatedAt:      timestamp(e.UpdatedAt),
+	}
+}
+
+func importSummaryMessage(s *types.ImportSummary) *animatrixv1.ImportSummary {
+	return &animatrixv1.ImportSummary{
+		Total:   int32(s.Total),
+		Created: int32(s.Created),
+		Failed:  int32(s.Failed),
+		Batches: int32(s.Batches),
+		Errors: messages(s.Errors, func(e *types.ImportError) *animatrixv1.ImportError {
+			return &animatrixv1.ImportError{Line: int32(e.Line), Id: e.ID, Code: e.Code, Error: e.Error}
+		}),
+	}
+}
+
+func createSeriesRequest(m *animatrixv1.CreateSeriesRequest) *types.CreateSeriesRequest {
+	return &types.CreateSeriesRequest{
+		SeriesID:    m.SeriesId,
+		Title:       m.Title,
+		TitleYomi:   m.TitleYomi,
+		TitleEn:     m.TitleEn,
+		Description: m.Description,
+	}
+}
+
+func updateSeriesRequest(m *animatrixv1.UpdateSeriesRequest) *types.UpdateSeriesRequest {
+	return &types.UpdateSeriesRequest{
+		Title:       m.Title,
+		TitleYomi:   m.TitleYomi,
+		TitleEn:     m.TitleEn,
+		Description: m.Description,
+	}
+}
+
+func createSeasonRequest(m *animatrixv1.CreateSeasonRequest) *types.CreateSeasonRequest {
+	return &types.CreateSeasonRequest{
+		SeriesID:        m.SeriesId,
+		SeasonID:        m.SeasonId,
+		SeasonTitle:     m.SeasonTitle,
+		SeasonTitleYomi: m.SeasonTitleYomi,
+		SeasonNumber:    int(m.SeasonNumber),
+		ShoboiTID:       intPtr(m.ShoboiTid),
+		Description:     m.Description,
+		FirstYear:       intPtr(m.FirstYear),
+		FirstMonth:      intPtr(m.FirstMonth),
+		FirstEndYear:    intPtr(m.FirstEndYear),
+		FirstEndMonth:   intPtr(m.FirstEndMonth),
+	}
+}
+
+func updateSeasonRequest(m *animatrixv1.UpdateSeasonRequest) *types.UpdateSeasonRequest {
+	return &types.UpdateSeasonRequest{
+		SeasonTitle:     m.SeasonTitle,
+		SeasonTitleYomi: m.SeasonTitleYomi,
+		SeasonNumber:    intPtr(m.SeasonNumber),
+		ShoboiTID:       intPtr(m.ShoboiTid),
+		Description:     m.Description,
+		FirstYear:       intPtr(m.FirstYear),
+		FirstMonth:      intPtr(m.FirstMonth),
+		FirstEndYear:    intPtr(m.FirstEndYear),
+		FirstEndMonth:   intPtr(m.FirstEndMonth),
+		SeriesID:        m.SeriesId,
+	}
+}
+
+func createEpisodeRequest(m *animatrixv1.CreateEpisodeRequest) *types.CreateEpisodeRequest {
+	return &types.CreateEpisodeRequest{
+		SeasonID:       m.SeasonId,
+		EpisodeID:      m.EpisodeId,
+		Title:          m.Title,
+		EpisodeNumber:  int(m.EpisodeNumber),
+		Duration:       m.Duration,
+		DurationString: m.DurationString,
+		Timestamp:      timeOf(m.Timestamp),
+		FormatID:       m.FormatId,
+		Width:          int(m.Width),
+		Height:         int(m.Height),
+		DynamicRange:   m.DynamicRange,
+		Metadata:       m.Metadata,
+		Description:    m.Description,
+	}
+}
+
+func updateEpisodeRequest(m *animatrixv1.UpdateEpisodeRequest) *types.UpdateEpisodeRequest {
+	return &types.UpdateEpisodeRequest{
+		Title:          m.Title,
+		EpisodeNumber:  intPtr(m.EpisodeNumber),
+		Duration:       m.Duration,
+		DurationString: m.DurationString,
+		Timestamp:      timePtr(m.Timestamp),
+		FormatID:       m.FormatId,
+		Width:          intPtr(m.Width),
+		Height:         intPtr(m.Height),
+		DynamicRange:   m.DynamicRange,
+		Metadata:       m.Metadata,
+		Description:    m.Description,
+	}
+}
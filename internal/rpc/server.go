@@ -0,0 +1,178 @@
+// Package rpc serves the Catalog gRPC service declared in
+// proto/animatrix/v1/catalog.proto with grpc-go, calling the same
+// controller functions as the REST handlers.
+package rpc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/auth"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	animatrixv1 "github.com/clustlight/animatrix-api/proto/animatrix/v1"
+)
+
+// controllerCodes maps the REST problem codes to gRPC status codes.
+var controllerCodes = map[controller.Code]codes.Code{
+	controller.CodeBadRequest:           codes.InvalidArgument,
+	controller.CodeValidationFailed:     codes.InvalidArgument,
+	controller.CodeUnauthorized:         codes.Unauthenticated,
+	controller.CodeForbidden:            codes.PermissionDenied,
+	controller.CodeNotFound:             codes.NotFound,
+	controller.CodeConflict:             codes.AlreadyExists,
+	controller.CodeHasChildren:          codes.FailedPrecondition,
+	controller.CodePreconditionFailed:   codes.FailedPrecondition,
+	controller.CodeUnsupportedMediaType: codes.InvalidArgument,
+	controller.CodeInternal:             codes.Internal,
+}
+
+// statusOf converts any error returned by a method into a gRPC status,
+// using the same classification as the REST problem responses.
+func statusOf(err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.New(codes.DeadlineExceeded, err.Error())
+	}
+	p := controller.ToProblem(err)
+	msg := p.Detail
+	if msg == "" {
+		msg = p.Title
+	}
+	for _, fe := range p.Errors {
+		msg += "; " + fe.Error()
+	}
+	for _, c := range p.Conflicts {
+		msg += "; " + c.ID + ": " + c.Message
+	}
+	code, ok := controllerCodes[controller.Code(p.Code)]
+	if !ok {
+		code = codes.Unknown
+	}
+	if errors.Is(err, context.Canceled) {
+		code = codes.Canceled
+	}
+	return status.New(code, msg)
+}
+
+// toStatus returns err as a status error, logging the ones that are not
+// the client's fault.
+func toStatus(method string, err error) error {
+	if err == nil {
+		return nil
+	}
+	st := statusOf(err)
+	if st.Code() == codes.Internal || st.Code() == codes.Unknown {
+		log.Printf("rpc %s: %v", method, err)
+	}
+	return st.Err()
+}
+
+// methodScope returns the API key scope a method needs: read for lookups,
+// write for changes.
+func methodScope(fullMethod string) auth.Scope {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range []string{"Get", "List", "Search"} {
+		if strings.HasPrefix(name, prefix) {
+			return auth.ScopeRead
+		}
+	}
+	return auth.ScopeWrite
+}
+
+// keyFrom returns the API key of the call, sent as "authorization: Bearer
+// <key>" or in x-api-key metadata, or "" when there is none.
+func keyFrom(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if k := first(md, "x-api-key"); k != "" {
+		return k
+	}
+	scheme, token, ok := strings.Cut(first(md, "authorization"), " ")
+	if ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+	return ""
+}
+
+func first(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return strings.TrimSpace(v[0])
+	}
+	return ""
+}
+
+// authorizer checks API keys like the REST routes when
+// auth.Config.APIKeys is set.
+type authorizer struct {
+	// authenticate returns the principal presenting an API key.
+	authenticate func(ctx context.Context, key string) (*auth.Principal, error)
+}
+
+// authorize checks the API key of the call for the scope of fullMethod.
+func (a *authorizer) authorize(ctx context.Context, fullMethod string) error {
+	c := auth.Current()
+	need := methodScope(fullMethod)
+	if !c.APIKeys || need == auth.ScopeRead && c.PublicRead {
+		return nil
+	}
+	key := keyFrom(ctx)
+	if key == "" {
+		return controller.Unauthorized("an API key or token is required; send it as a bearer token or an API key in x-api-key")
+	}
+	p, err := a.authenticate(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !p.Allows(need) {
+		return controller.Forbidden("the API key or token lacks the " + string(need) + " scope")
+	}
+	return nil
+}
+
+// unary authorizes a unary call and converts the error of its handler.
+func (a *authorizer) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := a.authorize(ctx, info.FullMethod); err != nil {
+		return nil, toStatus(info.FullMethod, err)
+	}
+	resp, err := handler(ctx, req)
+	return resp, toStatus(info.FullMethod, err)
+}
+
+// stream authorizes a streaming call and converts the error of its
+// handler.
+func (a *authorizer) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+		return toStatus(info.FullMethod, err)
+	}
+	return toStatus(info.FullMethod, handler(srv, ss))
+}
+
+// NewServer returns a gRPC server with the Catalog service backed by
+// client.
+func NewServer(client *ent.Client) *grpc.Server {
+	a := &authorizer{authenticate: func(ctx context.Context, key string) (*auth.Principal, error) {
+		return controller.Authenticate(ctx, client, key)
+	}}
+	srv := grpc.NewServer(grpc.UnaryInterceptor(a.unary), grpc.StreamInterceptor(a.stream))
+	animatrixv1.RegisterCatalogServer(srv, &catalog{client: client})
+	return srv
+}
+
+// ListenAndServe serves srv on addr without TLS.
+func ListenAndServe(addr string, srv *grpc.Server) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return srv.Serve(lis)
+}
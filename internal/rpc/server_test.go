@@ -0,0 +1,177 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/ent/enttest"
+	"github.com/clustlight/animatrix-api/internal/auth"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	animatrixv1 "github.com/clustlight/animatrix-api/proto/animatrix/v1"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// dial serves the Catalog service on an in-memory listener and returns a
+// client of it, with API keys off.
+func dial(t *testing.T, name string) (*ent.Client, animatrixv1.CatalogClient) {
+	t.Helper()
+	auth.Configure(auth.Config{})
+	t.Cleanup(func() { auth.Configure(auth.Config{}) })
+	client := enttest.Open(t, "sqlite3", "file:"+name+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	lis := bufconn.Listen(1 << 20)
+	srv := NewServer(client)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return client, animatrixv1.NewCatalogClient(conn)
+}
+
+func wantCode(t *testing.T, call string, err error, want codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != want {
+		t.Errorf("%s: code %v (%v), want %v", call, got, err, want)
+	}
+}
+
+func episode(seasonID string, number int32) *animatrixv1.CreateEpisodeRequest {
+	return &animatrixv1.CreateEpisodeRequest{
+		SeasonId: seasonID, Title: "E", EpisodeNumber: number, Duration: 1440, DurationString: "24:00",
+		Timestamp: timestamppb.New(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)), FormatId: "hls",
+		Width: 1920, Height: 1080, DynamicRange: "SDR",
+	}
+}
+
+func TestCatalog(t *testing.T) {
+	_, c := dial(t, "rpccatalog")
+	ctx := context.Background()
+
+	if _, err := c.CreateSeries(ctx, &animatrixv1.CreateSeriesRequest{SeriesId: "aa", Title: "A"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := c.CreateSeries(ctx, &animatrixv1.CreateSeriesRequest{SeriesId: "aa", Title: "A"})
+	wantCode(t, "CreateSeries of an existing ID", err, codes.AlreadyExists)
+	_, err = c.CreateSeries(ctx, &animatrixv1.CreateSeriesRequest{SeriesId: "bb"})
+	wantCode(t, "CreateSeries without a title", err, codes.InvalidArgument)
+
+	season, err := c.CreateSeason(ctx, &animatrixv1.CreateSeasonRequest{
+		SeriesId: "aa", SeasonTitle: "S", SeasonNumber: 1, FirstYear: proto.Int32(2024),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if season.SeasonId != "aa_s1" || season.FirstYear != 2024 || season.UpdatedAt == nil {
+		t.Errorf("CreateSeason = %v", season)
+	}
+	if _, err := c.CreateEpisode(ctx, episode(season.SeasonId, 1)); err != nil {
+		t.Fatal(err)
+	}
+
+	series, err := c.GetSeries(ctx, &animatrixv1.GetSeriesRequest{SeriesId: "aa"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(series.Seasons) != 1 || len(series.Seasons[0].Episodes) != 1 || series.Seasons[0].Episodes[0].DurationString != "24:00" {
+		t.Errorf("GetSeries = %v, want the season and its episode", series)
+	}
+
+	// Fields left out of an update keep their value; the ones sent are
+	// set even to their zero value.
+	updated, err := c.UpdateSeason(ctx, &animatrixv1.UpdateSeasonRequest{SeasonId: "aa_s1", Description: proto.String("")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.SeasonTitle != "S" || updated.FirstYear != 2024 {
+		t.Errorf("UpdateSeason = %v", updated)
+	}
+
+	_, err = c.GetSeries(ctx, &animatrixv1.GetSeriesRequest{SeriesId: "zz"})
+	wantCode(t, "GetSeries of a missing ID", err, codes.NotFound)
+	_, err = c.Search(ctx, &animatrixv1.SearchRequest{})
+	wantCode(t, "Search without a query", err, codes.InvalidArgument)
+}
+
+func TestIngestEpisodes(t *testing.T) {
+	client, c := dial(t, "rpcingest")
+	ctx := context.Background()
+	s := client.Series.Create().SetSeriesID("aa").SetTitle("A").SaveX(ctx)
+	client.Season.Create().SetSeries(s).SetSeasonID("aa_s1").SetSeasonTitle("S").SetSeasonNumber(1).SaveX(ctx)
+
+	stream, err := c.IngestEpisodes(metadata.AppendToOutgoingContext(ctx, "batch-size", "2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bad := episode("aa_s1", 3)
+	bad.Width = 0
+	for _, e := range []*animatrixv1.CreateEpisodeRequest{episode("aa_s1", 1), episode("aa_s1", 2), bad} {
+		if err := stream.Send(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	summary, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Total != 3 || summary.Created != 2 || summary.Failed != 1 || summary.Batches != 1 ||
+		len(summary.Errors) != 1 || summary.Errors[0].Line != 3 {
+		t.Errorf("summary = %v, want 2 of 3 created in one batch and line 3 failed", summary)
+	}
+
+	stream, err = c.IngestEpisodes(metadata.AppendToOutgoingContext(ctx, "batch-size", "0"))
+	if err == nil {
+		_, err = stream.CloseAndRecv()
+	}
+	wantCode(t, "IngestEpisodes with batch-size 0", err, codes.InvalidArgument)
+}
+
+func TestAuthorize(t *testing.T) {
+	client, c := dial(t, "rpcauth")
+	ctx := context.Background()
+	auth.Configure(auth.Config{APIKeys: true, PublicRead: true})
+	read, err := controller.CreateAPIKey(ctx, client, "reader", []auth.Scope{auth.ScopeRead}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	write, err := controller.CreateAPIKey(ctx, client, "writer", []auth.Scope{auth.ScopeWrite}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	create := &animatrixv1.CreateSeriesRequest{SeriesId: "aa", Title: "A"}
+
+	_, err = c.ListSeries(ctx, &animatrixv1.ListSeriesRequest{})
+	wantCode(t, "ListSeries without a key", err, codes.OK)
+	_, err = c.CreateSeries(ctx, create)
+	wantCode(t, "CreateSeries without a key", err, codes.Unauthenticated)
+	_, err = c.CreateSeries(metadata.AppendToOutgoingContext(ctx, "x-api-key", "nope"), create)
+	wantCode(t, "CreateSeries with an unknown key", err, codes.Unauthenticated)
+	_, err = c.CreateSeries(metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+read.Key), create)
+	wantCode(t, "CreateSeries with a read key", err, codes.PermissionDenied)
+	_, err = c.CreateSeries(metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+write.Key), create)
+	wantCode(t, "CreateSeries with a write key", err, codes.OK)
+
+	// The stream interceptor checks streaming calls the same way.
+	stream, err := c.IngestEpisodes(metadata.AppendToOutgoingContext(ctx, "x-api-key", read.Key))
+	if err == nil {
+		_, err = stream.CloseAndRecv()
+	}
+	wantCode(t, "IngestEpisodes with a read key", err, codes.PermissionDenied)
+}
@@ -0,0 +1,249 @@
+package rpc
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/controller"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/validate"
+	animatrixv1 "github.com/clustlight/animatrix-api/proto/animatrix/v1"
+)
+
+// catalog implements the Catalog service on the controllers.
+type catalog struct {
+	animatrixv1.UnimplementedCatalogServer
+	client *ent.Client
+}
+
+func valid[T any](req *T) (*T, error) {
+	if err := validate.Struct(req); err != nil {
+		return nil, controller.ValidationFailed(err)
+	}
+	return req, nil
+}
+
+func validAll[T any](items []T) ([]T, error) {
+	if err := validate.Slice(items); err != nil {
+		return nil, controller.ValidationFailed(err)
+	}
+	return items, nil
+}
+
+func (c *catalog) ListSeries(ctx context.Context, _ *animatrixv1.ListSeriesRequest) (*animatrixv1.ListSeriesResponse, error) {
+	all, err := controller.GetAllSeries(ctx, c.client, nil, types.SeriesSort{})
+	if err != nil {
+		return nil, err
+	}
+	return &animatrixv1.ListSeriesResponse{Series: messages(*all, seriesMessage)}, nil
+}
+
+func (c *catalog) GetSeries(ctx context.Context, req *animatrixv1.GetSeriesRequest) (*animatrixv1.Series, error) {
+	s, err := controller.GetSeries(ctx, c.client, req.SeriesId, controller.SeriesDetailInclude)
+	if err != nil {
+		return nil, err
+	}
+	return seriesMessage(s), nil
+}
+
+func (c *catalog) CreateSeries(ctx context.Context, req *animatrixv1.CreateSeriesRequest) (*animatrixv1.Series, error) {
+	in, err := valid(createSeriesRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	s, err := controller.CreateSeries(ctx, c.client, in)
+	if err != nil {
+		return nil, err
+	}
+	return seriesMessage(s), nil
+}
+
+func (c *catalog) UpdateSeries(ctx context.Context, req *animatrixv1.UpdateSeriesRequest) (*animatrixv1.Series, error) {
+	in, err := valid(updateSeriesRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	s, err := controller.UpdateSeries(ctx, c.client, req.SeriesId, in)
+	if err != nil {
+		return nil, err
+	}
+	return seriesMessage(s), nil
+}
+
+func (c *catalog) BulkCreateSeries(ctx context.Context, req *animatrixv1.BulkCreateSeriesRequest) (*animatrixv1.ListSeriesResponse, error) {
+	in, err := validAll(requests(req.Series, createSeriesRequest))
+	if err != nil {
+		return nil, err
+	}
+	created, err := controller.BulkCreateSeries(ctx, c.client, in)
+	if err != nil {
+		return nil, err
+	}
+	return &animatrixv1.ListSeriesResponse{Series: messages(created, seriesMessage)}, nil
+}
+
+func (c *catalog) ListSeasons(ctx context.Context, _ *animatrixv1.ListSeasonsRequest) (*animatrixv1.ListSeasonsResponse, error) {
+	all, err := controller.GetAllSeasons(ctx, c.client, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &animatrixv1.ListSeasonsResponse{Seasons: messages(*all, seasonMessage)}, nil
+}
+
+func (c *catalog) GetSeason(ctx context.Context, req *animatrixv1.GetSeasonRequest) (*animatrixv1.Season, error) {
+	s, err := controller.GetSeason(ctx, c.client, req.SeasonId, controller.SeasonDetailInclude)
+	if err != nil {
+		return nil, err
+	}
+	return seasonMessage(s), nil
+}
+
+func (c *catalog) CreateSeason(ctx context.Context, req *animatrixv1.CreateSeasonRequest) (*animatrixv1.Season, error) {
+	in, err := valid(createSeasonRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	s, err := controller.CreateSeason(ctx, c.client, in)
+	if err != nil {
+		return nil, err
+	}
+	return seasonMessage(s), nil
+}
+
+func (c *catalog) UpdateSeason(ctx context.Context, req *animatrixv1.UpdateSeasonRequest) (*animatrixv1.Season, error) {
+	in, err := valid(updateSeasonRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	s, err := controller.UpdateSeason(ctx, c.client, req.SeasonId, in)
+	if err != nil {
+		return nil, err
+	}
+	return seasonMessage(s), nil
+}
+
+func (c *catalog) BulkCreateSeasons(ctx context.Context, req *animatrixv1.BulkCreateSeasonsRequest) (*animatrixv1.ListSeasonsResponse, error) {
+	in, err := validAll(requests(req.Seasons, createSeasonRequest))
+	if err != nil {
+		return nil, err
+	}
+	created, err := controller.BulkCreateSeason(ctx, c.client, in)
+	if err != nil {
+		return nil, err
+	}
+	return &animatrixv1.ListSeasonsResponse{Seasons: messages(created, seasonMessage)}, nil
+}
+
+func (c *catalog) ListEpisodes(ctx context.Context, _ *animatrixv1.ListEpisodesRequest) (*animatrixv1.ListEpisodesResponse, error) {
+	all, err := controller.GetAllEpisodes(ctx, c.client)
+	if err != nil {
+		return nil, err
+	}
+	return &animatrixv1.ListEpisodesResponse{Episodes: messages(*all, episodeMessage)}, nil
+}
+
+func (c *catalog) GetEpisode(ctx context.Context, req *animatrixv1.GetEpisodeRequest) (*animatrixv1.Episode, error) {
+	e, err := controller.GetEpisode(ctx, c.client, req.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+	return episodeMessage(e), nil
+}
+
+func (c *catalog) CreateEpisode(ctx context.Context, req *animatrixv1.CreateEpisodeRequest) (*animatrixv1.Episode, error) {
+	in, err := valid(createEpisodeRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	e, err := controller.CreateEpisode(ctx, c.client, in)
+	if err != nil {
+		return nil, err
+	}
+	return episodeMessage(e), nil
+}
+
+func (c *catalog) UpdateEpisode(ctx context.Context, req *animatrixv1.UpdateEpisodeRequest) (*animatrixv1.Episode, error) {
+	in, err := valid(updateEpisodeRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	e, err := controller.UpdateEpisode(ctx, c.client, req.EpisodeId, in)
+	if err != nil {
+		return nil, err
+	}
+	return episodeMessage(e), nil
+}
+
+func (c *catalog) BulkCreateEpisodes(ctx context.Context, req *animatrixv1.BulkCreateEpisodesRequest) (*animatrixv1.ListEpisodesResponse, error) {
+	in, err := validAll(requests(req.Episodes, createEpisodeRequest))
+	if err != nil {
+		return nil, err
+	}
+	created, err := controller.BulkCreateEpisode(ctx, c.client, in)
+	if err != nil {
+		return nil, err
+	}
+	return &animatrixv1.ListEpisodesResponse{Episodes: messages(created, episodeMessage)}, nil
+}
+
+// episodeStream lets a request stream feed the controller importers.
+type episodeStream struct {
+	stream animatrixv1.Catalog_IngestEpisodesServer
+	count  int
+}
+
+// Decode receives the next message into v, a *types.CreateEpisodeRequest,
+// returning io.EOF after the last.
+func (s *episodeStream) Decode(v any) error {
+	msg, err := s.stream.Recv()
+	if err != nil {
+		return err
+	}
+	s.count++
+	*v.(*types.CreateEpisodeRequest) = *createEpisodeRequest(msg)
+	return nil
+}
+
+func (s *episodeStream) Line() int { return s.count }
+
+func (c *catalog) IngestEpisodes(stream animatrixv1.Catalog_IngestEpisodesServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	batchSize, err := parseBatchSize(first(md, "batch-size"))
+	if err != nil {
+		return err
+	}
+	summary, err := controller.ImportEpisodes(stream.Context(), c.client, &episodeStream{stream: stream}, batchSize)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(importSummaryMessage(summary))
+}
+
+func (c *catalog) Search(ctx context.Context, req *animatrixv1.SearchRequest) (*animatrixv1.ListSeriesResponse, error) {
+	if req.Query == "" {
+		return nil, controller.ValidationFailed(types.FieldError{Field: "query", Message: "is required"})
+	}
+	found, err := controller.SearchSeries(ctx, c.client, req.Query, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &animatrixv1.ListSeriesResponse{Series: messages(found, seriesMessage)}, nil
+}
+
+// parseBatchSize validates the batch-size metadata of a streaming
+// request; 0 selects the importer's default.
+func parseBatchSize(raw string) (int, error) {
+	if raw == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n <= 0 || n > controller.MaxImportBatchSize {
+		return 0, status.Error(codes.InvalidArgument, "batch-size must be between 1 and "+strconv.Itoa(controller.MaxImportBatchSize))
+	}
+	return n, nil
+}
@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Bucket reads and writes objects of an S3-compatible bucket with
+// presigned requests.
+type Bucket struct {
+	Signer *Presigner
+	Client *http.Client
+}
+
+func NewBucket(p *Presigner) *Bucket {
+	return &Bucket{Signer: p, Client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// Exists reports whether an object is stored at key.
+func (b *Bucket) Exists(ctx context.Context, key string) (bool, error) {
+	resp, err := b.do(ctx, http.MethodHead, key, nil)
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return false, nil
+	case resp.StatusCode >= 300:
+		return false, fmt.Errorf("HEAD %s: %s", key, resp.Status)
+	}
+	return true, nil
+}
+
+type listResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// List calls fn with the key of every object under prefix.
+func (b *Bucket) List(ctx context.Context, prefix string, fn func(key string) error) error {
+	token := ""
+	for {
+		params := url.Values{"list-type": {"2"}}
+		if prefix != "" {
+			params.Set("prefix", prefix)
+		}
+		if token != "" {
+			params.Set("continuation-token", token)
+		}
+		resp, err := b.do(ctx, http.MethodGet, "", params)
+		if err != nil {
+			return err
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode >= 300 {
+			return fmt.Errorf("list %q: %s", prefix, resp.Status)
+		}
+		var page listResult
+		if err := xml.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("list %q: %w", prefix, err)
+		}
+		for _, c := range page.Contents {
+			if err := fn(c.Key); err != nil {
+				return err
+			}
+		}
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			return nil
+		}
+		token = page.NextContinuationToken
+	}
+}
+
+func (b *Bucket) do(ctx context.Context, method, key string, params url.Values) (*http.Response, error) {
+	u := b.Signer.SignURL(method, key, params, time.Now(), time.Minute)
+	req, err := http.NewRequestWithContext(ctx, method, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return b.Client.Do(req)
+}
+
+// Put stores body at key, replacing any object there.
+func (b *Bucket) Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error {
+	u := b.Signer.SignURL(http.MethodPut, key, nil, time.Now(), time.Minute)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+	resp, err := b.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("PUT %s: %s", key, resp.Status)
+	}
+	return nil
+}
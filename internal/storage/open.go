@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/clustlight/animatrix-api/internal/media"
+)
+
+var fetchClient = &http.Client{Timeout: 30 * time.Second}
+
+// Open fetches asset a stored at key through the URL clients would use.
+// Keys that are absolute URLs are refused: they may point anywhere,
+// including hosts only the server can reach. The caller closes the body.
+func (c Config) Open(ctx context.Context, key string, a media.Asset) (io.ReadCloser, error) {
+	if u, err := url.Parse(key); err == nil && u.IsAbs() {
+		return nil, fmt.Errorf("%s is a URL, not an object key", key)
+	}
+	u := c.URL(key, a)
+	if u == "" {
+		return nil, fmt.Errorf("%s has no URL", key)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := fetchClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("GET %s: %s", key, resp.Status)
+	}
+	return resp.Body, nil
+}
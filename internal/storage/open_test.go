@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/clustlight/animatrix-api/internal/media"
+)
+
+// Open reads keys under the storage and never fetches a key that is a URL
+// of its own, which could point at hosts only the server can reach.
+func TestOpen(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		io.WriteString(w, "WEBVTT\n")
+	}))
+	defer srv.Close()
+	c := Config{BaseURL: srv.URL + "/media/"}
+
+	body, err := c.Open(context.Background(), "foo/01/ja.vtt", media.Video)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := io.ReadAll(body)
+	body.Close()
+	if string(b) != "WEBVTT\n" {
+		t.Errorf("body = %q", b)
+	}
+
+	for _, key := range []string{srv.URL + "/media/foo/01/ja.vtt", "http://169.254.169.254/latest/meta-data/", "file:///etc/passwd"} {
+		if body, err := c.Open(context.Background(), key, media.Video); err == nil {
+			body.Close()
+			t.Errorf("Open(%q) fetched it", key)
+		}
+	}
+	if len(requests) != 1 || requests[0] != "/media/foo/01/ja.vtt" {
+		t.Errorf("requests = %v, want only /media/foo/01/ja.vtt", requests)
+	}
+}
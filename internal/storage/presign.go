@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxExpiry is the longest lifetime SigV4 allows for a presigned URL.
+const MaxExpiry = 7 * 24 * time.Hour
+
+// Presigner signs GET URLs for an S3-compatible bucket with AWS Signature
+// Version 4 in the query string.
+type Presigner struct {
+	Endpoint     *url.URL
+	Bucket       string
+	Region       string
+	AccessKey    string
+	SecretKey    string
+	SessionToken string
+	// PathStyle addresses the bucket as a path (endpoint/bucket/key), as
+	// MinIO and most stand-ins expect, instead of as a subdomain.
+	PathStyle bool
+	Expiry    time.Duration
+	// Now returns the current time; nil means time.Now.
+	Now func() time.Time
+}
+
+// Presign returns a URL for key valid for at least half of Expiry. The
+// signing time is rounded down to a multiple of Expiry/2, so the URL stays
+// the same within that window and responses embedding it keep their ETag.
+func (p *Presigner) Presign(key string) (string, error) {
+	now := time.Now
+	if p.Now != nil {
+		now = p.Now
+	}
+	return p.PresignAt(key, now().UTC().Truncate(p.Expiry/2), p.Expiry), nil
+}
+
+// PresignAt signs a GET of key as of t for the given lifetime.
+func (p *Presigner) PresignAt(key string, t time.Time, expires time.Duration) string {
+	return p.SignURL("GET", key, nil, t, expires)
+}
+
+// SignURL signs a request for key with extra query parameters. An empty
+// key addresses the bucket itself.
+func (p *Presigner) SignURL(method, key string, params url.Values, t time.Time, expires time.Duration) string {
+	host := p.Endpoint.Host
+	path := ""
+	if key != "" {
+		path = "/" + strings.TrimPrefix(key, "/")
+	}
+	if p.PathStyle {
+		path = "/" + p.Bucket + path
+	} else {
+		host = p.Bucket + "." + host
+	}
+	path = strings.TrimSuffix(p.Endpoint.Path, "/") + path
+	if path == "" {
+		path = "/"
+	}
+
+	t = t.UTC()
+	date := t.Format("20060102")
+	stamp := t.Format("20060102T150405Z")
+	scope := date + "/" + p.Region + "/s3/aws4_request"
+
+	query := map[string]string{
+		"X-Amz-Algorithm":     "AWS4-HMAC-SHA256",
+		"X-Amz-Credential":    p.AccessKey + "/" + scope,
+		"X-Amz-Date":          stamp,
+		"X-Amz-Expires":       strconv.Itoa(int(expires.Seconds())),
+		"X-Amz-SignedHeaders": "host",
+	}
+	if p.SessionToken != "" {
+		query["X-Amz-Security-Token"] = p.SessionToken
+	}
+	for k := range params {
+		query[k] = params.Get(k)
+	}
+	canonicalQuery := canonicalQueryString(query)
+	canonicalRequest := strings.Join([]string{
+		method,
+		uriEncode(path, false),
+		canonicalQuery,
+		"host:" + host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	digest := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", stamp, scope, hex.EncodeToString(digest[:])}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+p.SecretKey), date)
+	for _, part := range []string{p.Region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	return fmt.Sprintf("%s://%s%s?%s&X-Amz-Signature=%s",
+		p.Endpoint.Scheme, host, uriEncode(path, false), canonicalQuery, signature)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func canonicalQueryString(query map[string]string) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, uriEncode(k, true)+"="+uriEncode(query[k], true))
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode percent-encodes everything but unreserved characters, as
+// SigV4 requires; slashes are kept unless encodeSlash is set.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
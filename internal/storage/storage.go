@@ -0,0 +1,174 @@
+// Package storage turns object keys into URLs clients can fetch, either
+// public ones under OBJECT_STORAGE_URL or presigned, expiring ones.
+package storage
+
+import (
+	"cmp"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/clustlight/animatrix-api/internal/media"
+)
+
+const DefaultExpiry = time.Hour
+
+// Signer returns a URL granting temporary access to an object.
+type Signer interface {
+	Presign(key string) (string, error)
+}
+
+type Config struct {
+	// BaseURL serves objects that are not signed.
+	BaseURL string
+	Signer  Signer
+	// Signed lists the assets served through Signer.
+	Signed map[media.Asset]bool
+}
+
+// FromEnv reads OBJECT_STORAGE_URL and, unless OBJECT_STORAGE_PRESIGN is
+// "off" (the default), the S3 settings used to presign "video" or "all"
+// assets.
+func FromEnv() (Config, error) {
+	c := Config{BaseURL: os.Getenv("OBJECT_STORAGE_URL")}
+	switch mode := cmp.Or(os.Getenv("OBJECT_STORAGE_PRESIGN"), "off"); mode {
+	case "off":
+		return c, nil
+	case "video":
+		c.Signed = map[media.Asset]bool{media.Video: true}
+	case "all":
+		c.Signed = map[media.Asset]bool{media.Video: true, media.Thumbnail: true, media.Portrait: true}
+	default:
+		return c, fmt.Errorf("OBJECT_STORAGE_PRESIGN must be off, video or all")
+	}
+
+	p, err := S3FromEnv()
+	if err != nil {
+		return c, err
+	}
+	c.Signer = p
+	return c, nil
+}
+
+// S3FromEnv reads the bucket settings:
+//
+//	OBJECT_STORAGE_ENDPOINT    e.g. https://s3.us-east-1.amazonaws.com or http://minio:9000
+//	OBJECT_STORAGE_BUCKET
+//	OBJECT_STORAGE_REGION      default us-east-1
+//	OBJECT_STORAGE_ACCESS_KEY, OBJECT_STORAGE_SECRET_KEY, OBJECT_STORAGE_SESSION_TOKEN
+//	OBJECT_STORAGE_PATH_STYLE  default true
+//	OBJECT_STORAGE_URL_EXPIRY  default 1h, at most 7 days
+func S3FromEnv() (*Presigner, error) {
+	endpoint, err := url.Parse(os.Getenv("OBJECT_STORAGE_ENDPOINT"))
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("OBJECT_STORAGE_ENDPOINT must be an http(s) URL")
+	}
+	p := &Presigner{
+		Endpoint:     endpoint,
+		Bucket:       os.Getenv("OBJECT_STORAGE_BUCKET"),
+		Region:       cmp.Or(os.Getenv("OBJECT_STORAGE_REGION"), "us-east-1"),
+		AccessKey:    os.Getenv("OBJECT_STORAGE_ACCESS_KEY"),
+		SecretKey:    os.Getenv("OBJECT_STORAGE_SECRET_KEY"),
+		SessionToken: os.Getenv("OBJECT_STORAGE_SESSION_TOKEN"),
+		PathStyle:    true,
+		Expiry:       DefaultExpiry,
+	}
+	if p.Bucket == "" || p.AccessKey == "" || p.SecretKey == "" {
+		return nil, fmt.Errorf("OBJECT_STORAGE_BUCKET, OBJECT_STORAGE_ACCESS_KEY and OBJECT_STORAGE_SECRET_KEY are required")
+	}
+	if raw := os.Getenv("OBJECT_STORAGE_PATH_STYLE"); raw != "" {
+		if p.PathStyle, err = strconv.ParseBool(raw); err != nil {
+			return nil, fmt.Errorf("OBJECT_STORAGE_PATH_STYLE must be true or false")
+		}
+	}
+	if raw := os.Getenv("OBJECT_STORAGE_URL_EXPIRY"); raw != "" {
+		if p.Expiry, err = time.ParseDuration(raw); err != nil || p.Expiry < 2*time.Second || p.Expiry > MaxExpiry {
+			return nil, fmt.Errorf("OBJECT_STORAGE_URL_EXPIRY must be a duration between 2s and %s", MaxExpiry)
+		}
+	}
+	return p, nil
+}
+
+var (
+	mu      sync.RWMutex
+	current *Config
+)
+
+// Current returns the configured Config, reading the environment on first
+// use unless Configure was called. An invalid environment is logged and
+// leaves the assets meant to be signed without URLs.
+func Current() Config {
+	mu.RLock()
+	c := current
+	mu.RUnlock()
+	if c != nil {
+		return *c
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if current == nil {
+		env, err := FromEnv()
+		if err != nil {
+			log.Printf("storage: %v", err)
+			env.Signer = nil
+		}
+		current = &env
+	}
+	return *current
+}
+
+func Configure(c Config) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = &c
+}
+
+// MinLifetime is how long a URL returned by URL stays valid at least, or 0
+// when none of them expire. Presigned URLs last between Expiry/2 and
+// Expiry; see Presigner.Presign.
+func (c Config) MinLifetime() time.Duration {
+	if p, ok := c.Signer.(*Presigner); ok && len(c.Signed) > 0 {
+		return p.Expiry / 2
+	}
+	return 0
+}
+
+// URL returns the URL of asset a stored at key. Keys that are already
+// absolute URLs are returned as is. A signed asset that cannot be signed
+// has no URL rather than a public one.
+func (c Config) URL(key string, a media.Asset) string {
+	if key == "" {
+		return ""
+	}
+	if u, err := url.Parse(key); err == nil && u.IsAbs() {
+		return key
+	}
+	if c.Signed[a] {
+		if c.Signer == nil {
+			return ""
+		}
+		signed, err := c.Signer.Presign(key)
+		if err != nil {
+			log.Printf("storage: presign %s: %v", key, err)
+			return ""
+		}
+		return signed
+	}
+	return JoinURL(c.BaseURL, key)
+}
+
+func JoinURL(base, relPath string) string {
+	u, err := url.Parse(base)
+	if err != nil {
+		return ""
+	}
+	ref, err := url.Parse(relPath)
+	if err != nil {
+		return ""
+	}
+	return u.ResolveReference(ref).String()
+}
@@ -0,0 +1,15 @@
+package types
+
+import "time"
+
+type APIKeyResponse struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Prefix string `json:"prefix"`
+	// Key is the secret itself, only known when the key is created.
+	Key        string     `json:"key,omitempty"`
+	Scopes     []string   `json:"scopes"`
+	User       *string    `json:"user"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
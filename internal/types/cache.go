@@ -0,0 +1,16 @@
+package types
+
+type CacheRouteStats struct {
+	Hits   int64 `json:"hits"`
+	Misses int64 `json:"misses"`
+	Errors int64 `json:"errors"`
+}
+
+// CacheStats describes the response cache; Entries is only known for the
+// in-memory backend.
+type CacheStats struct {
+	Backend       string                     `json:"backend"`
+	Entries       *int                       `json:"entries,omitempty"`
+	Invalidations int64                      `json:"invalidations"`
+	Routes        map[string]CacheRouteStats `json:"routes"`
+}
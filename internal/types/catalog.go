@@ -0,0 +1,154 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	KindSeries  = "series"
+	KindSeason  = "season"
+	KindEpisode = "episode"
+)
+
+// CatalogRecord is one row of a catalog export. The fields used depend on
+// Kind; the same shape is read back by the import endpoint.
+type CatalogRecord struct {
+	Kind            string              `json:"kind"`
+	SeriesID        string              `json:"series_id,omitempty"`
+	SeasonID        string              `json:"season_id,omitempty"`
+	EpisodeID       string              `json:"episode_id,omitempty"`
+	Title           string              `json:"title,omitempty"`
+	TitleYomi       string              `json:"title_yomi,omitempty"`
+	TitleEn         string              `json:"title_en,omitempty"`
+	SeasonTitle     string              `json:"season_title,omitempty"`
+	SeasonTitleYomi string              `json:"season_title_yomi,omitempty"`
+	SeasonNumber    int                 `json:"season_number,omitempty"`
+	ShoboiTID       int                 `json:"shoboi_tid,omitempty"`
+	FirstYear       int                 `json:"first_year,omitempty"`
+	FirstMonth      int                 `json:"first_month,omitempty"`
+	FirstEndYear    int                 `json:"first_end_year,omitempty"`
+	FirstEndMonth   int                 `json:"first_end_month,omitempty"`
+	EpisodeNumber   int                 `json:"episode_number,omitempty"`
+	Duration        float64             `json:"duration,omitempty"`
+	DurationString  string              `json:"duration_string,omitempty"`
+	Timestamp       *time.Time          `json:"timestamp,omitempty"`
+	FormatID        string              `json:"format_id,omitempty"`
+	Width           int                 `json:"width,omitempty"`
+	Height          int                 `json:"height,omitempty"`
+	DynamicRange    string              `json:"dynamic_range,omitempty"`
+	Description     string              `json:"description,omitempty"`
+	Metadata        string              `json:"metadata,omitempty"`
+	Media           map[string]string   `json:"media,omitempty"`
+	Renditions      []RenditionRequest  `json:"renditions,omitempty"`
+	Subtitles       []SubtitleRequest   `json:"subtitles,omitempty"`
+	AudioTracks     []AudioTrackRequest `json:"audio_tracks,omitempty"`
+	Chapters        []ChapterRequest    `json:"chapters,omitempty"`
+}
+
+type ExportOptions struct {
+	Format          string
+	IncludeSeasons  bool
+	IncludeEpisodes bool
+}
+
+func (r *CatalogRecord) ValidateKind() error {
+	switch r.Kind {
+	case KindSeries, KindSeason, KindEpisode:
+		return nil
+	case "":
+		return FieldError{Field: "kind", Message: "is required"}
+	}
+	return FieldError{Field: "kind", Message: fmt.Sprintf("must be series, season or episode, got %q", r.Kind)}
+}
+
+func (r *CatalogRecord) SeriesRequest() CreateSeriesRequest {
+	return CreateSeriesRequest{
+		SeriesID:    r.SeriesID,
+		Title:       r.Title,
+		TitleYomi:   r.TitleYomi,
+		TitleEn:     r.TitleEn,
+		Description: r.Description,
+		Media:       r.Media,
+	}
+}
+
+func (r *CatalogRecord) SeasonRequest() CreateSeasonRequest {
+	return CreateSeasonRequest{
+		SeriesID:        r.SeriesID,
+		SeasonID:        r.SeasonID,
+		SeasonTitle:     r.SeasonTitle,
+		SeasonTitleYomi: optional(r.SeasonTitleYomi),
+		SeasonNumber:    r.SeasonNumber,
+		ShoboiTID:       optional(r.ShoboiTID),
+		Description:     optional(r.Description),
+		FirstYear:       optional(r.FirstYear),
+		FirstMonth:      optional(r.FirstMonth),
+		FirstEndYear:    optional(r.FirstEndYear),
+		FirstEndMonth:   optional(r.FirstEndMonth),
+		Media:           r.Media,
+	}
+}
+
+func (r *CatalogRecord) EpisodeRequest() CreateEpisodeRequest {
+	req := CreateEpisodeRequest{
+		SeasonID:       r.SeasonID,
+		EpisodeID:      r.EpisodeID,
+		Title:          r.Title,
+		EpisodeNumber:  r.EpisodeNumber,
+		Duration:       r.Duration,
+		DurationString: r.DurationString,
+		FormatID:       r.FormatID,
+		Width:          r.Width,
+		Height:         r.Height,
+		DynamicRange:   r.DynamicRange,
+		Metadata:       r.Metadata,
+		Description:    r.Description,
+		Media:          r.Media,
+		Renditions:     r.Renditions,
+		Subtitles:      r.Subtitles,
+		AudioTracks:    r.AudioTracks,
+		Chapters:       r.Chapters,
+	}
+	if r.Timestamp != nil {
+		req.Timestamp = *r.Timestamp
+	}
+	return req
+}
+
+// optional returns nil for the zero value, matching how the create
+// requests mark fields that were not supplied.
+func optional[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+// ParseExportOptions reads the format and a comma separated include list
+// (seasons, episodes).
+func ParseExportOptions(format, include string) (ExportOptions, error) {
+	opts := ExportOptions{Format: format}
+	if opts.Format == "" {
+		opts.Format = "json"
+	}
+	switch opts.Format {
+	case "json", "ndjson", "csv":
+	default:
+		return opts, fmt.Errorf("format must be one of json, ndjson, csv")
+	}
+	for _, inc := range strings.Split(include, ",") {
+		switch strings.TrimSpace(inc) {
+		case "":
+		case "seasons":
+			opts.IncludeSeasons = true
+		case "episodes":
+			opts.IncludeEpisodes = true
+		default:
+			return opts, fmt.Errorf("unknown include %q", inc)
+		}
+	}
+	return opts, nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Kinds of chapters.
+const (
+	ChapterOpening = "opening"
+	ChapterEnding  = "ending"
+	ChapterRecap   = "recap"
+	ChapterPreview = "preview"
+	ChapterMain    = "main"
+)
+
+type ChapterRequest struct {
+	Start float64 `json:"start" validate:"min=0"` // seconds from the start of the episode
+	End   float64 `json:"end" validate:"gt=0"`
+	Kind  string  `json:"kind" validate:"oneof=opening|ending|recap|preview|main"`
+	Title string  `json:"title,omitempty"`
+}
+
+type ChapterResponse struct {
+	Start     float64   `json:"start"`
+	End       float64   `json:"end"`
+	Kind      string    `json:"kind"`
+	Title     string    `json:"title,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (r ChapterResponse) LastModified() time.Time {
+	return r.UpdatedAt
+}
+
+// ValidateChapters checks that every chapter ends after it starts and
+// within duration, and that chapters do not overlap. Fields are named
+// prefix[i].start or prefix[i].end.
+func ValidateChapters(prefix string, list []ChapterRequest, duration float64) error {
+	var errs ValidationErrors
+	order := make([]int, 0, len(list))
+	for i, c := range list {
+		switch {
+		case c.End <= c.Start:
+			errs = append(errs, FieldError{Field: fmt.Sprintf("%s[%d].end", prefix, i), Message: "must be after start"})
+		case c.End > duration:
+			errs = append(errs, FieldError{Field: fmt.Sprintf("%s[%d].end", prefix, i), Message: fmt.Sprintf("must not exceed the duration of %g", duration)})
+		default:
+			order = append(order, i)
+		}
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(list[a].Start, list[b].Start)
+	})
+	for k := 1; k < len(order); k++ {
+		prev, cur := order[k-1], order[k]
+		if list[cur].Start < list[prev].End {
+			errs = append(errs, FieldError{Field: fmt.Sprintf("%s[%d].start", prefix, cur), Message: fmt.Sprintf("overlaps %s[%d]", prefix, prev)})
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
@@ -0,0 +1,30 @@
+package types
+
+type DeleteOptions struct {
+	Cascade bool
+	DryRun  bool
+}
+
+type DeletionCounts struct {
+	Series   int `json:"series"`
+	Seasons  int `json:"seasons"`
+	Episodes int `json:"episodes"`
+}
+
+// DeletionPlan lists everything removed by a delete, or that would be
+// removed when DryRun is set.
+type DeletionPlan struct {
+	DryRun   bool           `json:"dry_run"`
+	Counts   DeletionCounts `json:"counts"`
+	Series   []string       `json:"series"`
+	Seasons  []string       `json:"seasons"`
+	Episodes []string       `json:"episodes"`
+}
+
+func (p *DeletionPlan) Count() {
+	p.Counts = DeletionCounts{
+		Series:   len(p.Series),
+		Seasons:  len(p.Seasons),
+		Episodes: len(p.Episodes),
+	}
+}
@@ -1,89 +1,81 @@
 package types
 
 import (
-	"fmt"
 	"time"
 )
 
 type EpisodeResponse struct {
-	EpisodeID      string    `json:"episode_id"`
-	Title          string    `json:"title"`
-	EpisodeNumber  int       `json:"episode_number"`
-	Duration       float64   `json:"duration"`
-	DurationString string    `json:"duration_string"`
-	Timestamp      time.Time `json:"timestamp"`
-	FormatID       string    `json:"format_id"`
-	Width          int       `json:"width"`
-	Height         int       `json:"height"`
-	DynamicRange   string    `json:"dynamic_range"`
-	VideoURL       string    `json:"video_url"`
-	ThumbnailURL   string    `json:"thumbnail_url"`
-	Description    string    `json:"description"`
+	EpisodeID      string               `json:"episode_id"`
+	Title          string               `json:"title"`
+	EpisodeNumber  int                  `json:"episode_number"`
+	Duration       float64              `json:"duration"`
+	DurationString string               `json:"duration_string"`
+	Timestamp      time.Time            `json:"timestamp"`
+	FormatID       string               `json:"format_id"`
+	Width          int                  `json:"width"`
+	Height         int                  `json:"height"`
+	DynamicRange   string               `json:"dynamic_range"`
+	VideoURL       string               `json:"video_url"`
+	ThumbnailURL   string               `json:"thumbnail_url"`
+	ThumbnailURLs  map[string]string    `json:"thumbnail_urls,omitempty"` // by imgproxy preset
+	Renditions     []RenditionResponse  `json:"renditions,omitempty"`     // by bitrate
+	Subtitles      []SubtitleResponse   `json:"subtitles,omitempty"`
+	AudioTracks    []AudioTrackResponse `json:"audio_tracks,omitempty"`
+	Chapters       []ChapterResponse    `json:"chapters,omitempty"` // by start
+	Description    string               `json:"description"`
+	UpdatedAt      time.Time            `json:"updated_at"`
 }
 
 type CreateEpisodeRequest struct {
-	SeasonID       string    `json:"season_id" validate:"required"`
-	EpisodeID      string    `json:"episode_id" validate:"required"`
-	Title          string    `json:"title" validate:"required"`
-	EpisodeNumber  int       `json:"episode_number" validate:"required"`
-	Duration       float64   `json:"duration" validate:"required"`
-	DurationString string    `json:"duration_string" validate:"required"`
-	Timestamp      time.Time `json:"timestamp" validate:"required"` // ISO 8601 format
-	FormatID       string    `json:"format_id" validate:"required"`
-	Width          int       `json:"width" validate:"required"`
-	Height         int       `json:"height" validate:"required"`
-	DynamicRange   string    `json:"dynamic_range" validate:"required"`
-	Metadata       string    `json:"metadata,omitempty"` // Optional field for additional metadata
-	Description    string    `json:"description,omitempty"`
+	SeasonID       string              `json:"season_id" validate:"required"`
+	EpisodeID      string              `json:"episode_id" validate:"format=@episode_id"` // generated when empty
+	Title          string              `json:"title" validate:"required"`
+	EpisodeNumber  int                 `json:"episode_number" validate:"min=0"`
+	Duration       float64             `json:"duration" validate:"gt=0"`
+	DurationString string              `json:"duration_string" validate:"required"`
+	Timestamp      time.Time           `json:"timestamp" validate:"required"` // ISO 8601 format
+	FormatID       string              `json:"format_id" validate:"required"`
+	Width          int                 `json:"width" validate:"gt=0"`
+	Height         int                 `json:"height" validate:"gt=0"`
+	DynamicRange   string              `json:"dynamic_range" validate:"required"`
+	Metadata       string              `json:"metadata,omitempty"` // Optional field for additional metadata
+	Description    string              `json:"description,omitempty"`
+	Media          map[string]string   `json:"media,omitempty" validate:"keys=video|thumbnail"` // object keys overriding the media layout
+	Renditions     []RenditionRequest  `json:"renditions,omitempty" validate:"dive"`
+	Subtitles      []SubtitleRequest   `json:"subtitles,omitempty" validate:"dive"`
+	AudioTracks    []AudioTrackRequest `json:"audio_tracks,omitempty" validate:"dive"`
+	Chapters       []ChapterRequest    `json:"chapters,omitempty" validate:"dive"`
 }
 
 type UpdateEpisodeRequest struct {
-	Title          *string    `json:"title,omitempty"`
-	EpisodeNumber  *int       `json:"episode_number,omitempty"`
-	Duration       *float64   `json:"duration,omitempty"`
-	DurationString *string    `json:"duration_string,omitempty"`
-	Timestamp      *time.Time `json:"timestamp,omitempty"` // ISO 8601 format`
-	FormatID       *string    `json:"format_id,omitempty"`
-	Width          *int       `json:"width,omitempty"`
-	Height         *int       `json:"height,omitempty"`
-	DynamicRange   *string    `json:"dynamic_range,omitempty"`
-	Metadata       *string    `json:"metadata,omitempty"`
-	Description    *string    `json:"description,omitempty"`
+	Title          *string            `json:"title,omitempty" validate:"required"`
+	EpisodeNumber  *int               `json:"episode_number,omitempty" validate:"min=0"`
+	Duration       *float64           `json:"duration,omitempty" validate:"gt=0"`
+	DurationString *string            `json:"duration_string,omitempty" validate:"required"`
+	Timestamp      *time.Time         `json:"timestamp,omitempty" validate:"required"` // ISO 8601 format`
+	FormatID       *string            `json:"format_id,omitempty" validate:"required"`
+	Width          *int               `json:"width,omitempty" validate:"gt=0"`
+	Height         *int               `json:"height,omitempty" validate:"gt=0"`
+	DynamicRange   *string            `json:"dynamic_range,omitempty" validate:"required"`
+	Metadata       *string            `json:"metadata,omitempty"`
+	Description    *string            `json:"description,omitempty"`
+	Media          map[string]string  `json:"media,omitempty" validate:"keys=video|thumbnail"` // replaces the stored overrides; {} clears them
+	Renditions     []RenditionRequest `json:"renditions,omitempty" validate:"dive"`            // replaces the stored renditions; [] clears them
 }
 
-func (r *CreateEpisodeRequest) ValidateRequired() error {
-	if r.SeasonID == "" {
-		return fmt.Errorf("season_id is required")
-	}
-	if r.EpisodeID == "" {
-		return fmt.Errorf("episode_id is required")
-	}
-	if r.Title == "" {
-		return fmt.Errorf("title is required")
-	}
-	if r.EpisodeNumber < 0 {
-		return fmt.Errorf("episode_number must be greater than -1")
-	}
-	if r.Duration <= 0 {
-		return fmt.Errorf("duration must be greater than 0")
-	}
-	if r.DurationString == "" {
-		return fmt.Errorf("duration_string is required")
-	}
-	if r.Timestamp.IsZero() {
-		return fmt.Errorf("timestamp is required")
-	}
-	if r.FormatID == "" {
-		return fmt.Errorf("format_id is required")
-	}
-	if r.Width <= 0 {
-		return fmt.Errorf("width must be greater than 0")
-	}
-	if r.Height <= 0 {
-		return fmt.Errorf("height must be greater than 0")
-	}
-	if r.DynamicRange == "" {
-		return fmt.Errorf("dynamic_range is required")
-	}
-	return nil
+// Validate checks that rendition names are unique, that at most one
+// subtitle and one audio track are the default and that chapters fit the
+// duration.
+func (r *CreateEpisodeRequest) Validate() error {
+	return joinFieldErrors(
+		renditionNamesUnique(r.Renditions),
+		singleDefault("subtitles", r.Subtitles),
+		singleDefault("audio_tracks", r.AudioTracks),
+		ValidateChapters("chapters", r.Chapters, r.Duration),
+	)
+}
+
+// Validate checks that rendition names are unique.
+func (r *UpdateEpisodeRequest) Validate() error {
+	return renditionNamesUnique(r.Renditions)
 }
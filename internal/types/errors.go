@@ -0,0 +1,56 @@
+package types
+
+import "strings"
+
+// Problem is an RFC 7807 problem details body. Code is a stable,
+// machine-readable identifier; the remaining members are set per code.
+type Problem struct {
+	Type      string              `json:"type"`
+	Title     string              `json:"title"`
+	Status    int                 `json:"status"`
+	Detail    string              `json:"detail,omitempty"`
+	Instance  string              `json:"instance,omitempty"`
+	Code      string              `json:"code"`
+	Errors    []FieldError        `json:"errors,omitempty"`
+	Conflicts []Conflict          `json:"conflicts,omitempty"`
+	Children  map[string][]string `json:"children,omitempty"`
+}
+
+// FieldError describes a problem with a single request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	return e.Field + " " + e.Message
+}
+
+// ValidationErrors collects every field error found in a request.
+type ValidationErrors []FieldError
+
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(v))
+	for _, e := range v {
+		msgs = append(msgs, e.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// joinFieldErrors collects the FieldErrors and ValidationErrors among
+// errs, or returns nil.
+func joinFieldErrors(errs ...error) error {
+	var out ValidationErrors
+	for _, err := range errs {
+		switch e := err.(type) {
+		case FieldError:
+			out = append(out, e)
+		case ValidationErrors:
+			out = append(out, e...)
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
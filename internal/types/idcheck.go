@@ -0,0 +1,22 @@
+package types
+
+// IDMismatch is a season or episode whose ID does not follow the ID
+// scheme for its parent.
+type IDMismatch struct {
+	Kind     string `json:"kind"`
+	ID       string `json:"id"`
+	ParentID string `json:"parent_id"`
+	Expected string `json:"expected"`
+}
+
+type IDCheckCounts struct {
+	Seasons  int `json:"seasons"`
+	Episodes int `json:"episodes"`
+}
+
+// IDCheckReport is the result of checking every stored ID against the
+// configured scheme.
+type IDCheckReport struct {
+	Checked    IDCheckCounts `json:"checked"`
+	Mismatches []IDMismatch  `json:"mismatches"`
+}
@@ -0,0 +1,16 @@
+package types
+
+type ImportError struct {
+	Line  int    `json:"line"`
+	ID    string `json:"id,omitempty"`
+	Code  string `json:"code"`
+	Error string `json:"error"`
+}
+
+type ImportSummary struct {
+	Total   int           `json:"total"`
+	Created int           `json:"created"`
+	Failed  int           `json:"failed"`
+	Batches int           `json:"batches"`
+	Errors  []ImportError `json:"errors"`
+}
@@ -0,0 +1,68 @@
+package types
+
+import "time"
+
+// Kinds of catalog items users can favorite, rate and put on watchlists.
+const (
+	ItemSeries = "series"
+	ItemSeason = "season"
+)
+
+// FavoriteResponse, RatingResponse and WatchlistItemResponse name their
+// item by kind, series_id, season_id (seasons only) and title.
+type FavoriteResponse struct {
+	Kind      string    `json:"kind"`
+	SeriesID  string    `json:"series_id"`
+	SeasonID  string    `json:"season_id,omitempty"`
+	Title     string    `json:"title"` // of the series or season
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type RatingRequest struct {
+	Score int `json:"score" validate:"min=1,max=10"`
+}
+
+type RatingResponse struct {
+	Kind      string    `json:"kind"`
+	SeriesID  string    `json:"series_id"`
+	SeasonID  string    `json:"season_id,omitempty"`
+	Title     string    `json:"title"`
+	Score     int       `json:"score"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type WatchlistRequest struct {
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+type WatchlistResponse struct {
+	ID        int                     `json:"id"`
+	Name      string                  `json:"name"`
+	Items     []WatchlistItemResponse `json:"items"` // most recently added first
+	CreatedAt time.Time               `json:"created_at"`
+	UpdatedAt time.Time               `json:"updated_at"`
+}
+
+type WatchlistItemResponse struct {
+	Kind     string    `json:"kind"`
+	SeriesID string    `json:"series_id"`
+	SeasonID string    `json:"season_id,omitempty"`
+	Title    string    `json:"title"`
+	AddedAt  time.Time `json:"added_at"`
+}
+
+func (r FavoriteResponse) LastModified() time.Time {
+	return r.CreatedAt
+}
+
+func (r RatingResponse) LastModified() time.Time {
+	return r.UpdatedAt
+}
+
+func (r WatchlistResponse) LastModified() time.Time {
+	return r.UpdatedAt
+}
+
+func (r WatchlistItemResponse) LastModified() time.Time {
+	return r.AddedAt
+}
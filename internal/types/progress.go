@@ -0,0 +1,41 @@
+package types
+
+import "time"
+
+type UserResponse struct {
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ProgressRequest struct {
+	Position  float64 `json:"position" validate:"min=0"` // seconds
+	Completed *bool   `json:"completed,omitempty"`       // derived from position when omitted
+}
+
+type ProgressResponse struct {
+	EpisodeID string    `json:"episode_id"`
+	Position  float64   `json:"position"`
+	Duration  float64   `json:"duration"`
+	Completed bool      `json:"completed"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// WatchEntry is an episode in a user's history or continue watching list,
+// with how far it was watched. UpdatedAt is when the user last watched
+// the episode, or for an episode up next, its series.
+type WatchEntry struct {
+	SeriesID    string          `json:"series_id"`
+	SeriesTitle string          `json:"series_title"`
+	Episode     EpisodeResponse `json:"episode"`
+	Position    float64         `json:"position"`
+	Completed   bool            `json:"completed"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+func (r ProgressResponse) LastModified() time.Time {
+	return r.UpdatedAt
+}
+
+func (r WatchEntry) LastModified() time.Time {
+	return later(r.UpdatedAt, r.Episode.LastModified())
+}
@@ -0,0 +1,48 @@
+package types
+
+import "fmt"
+
+// Containers of renditions. HLS renditions (ts and fmp4) store the key of
+// their media playlist as path and are listed in the master playlist; mp4
+// renditions are progressive files.
+const (
+	ContainerMP4  = "mp4"
+	ContainerTS   = "ts"
+	ContainerFMP4 = "fmp4"
+)
+
+type RenditionRequest struct {
+	Name         string  `json:"name" validate:"required"`
+	Width        int     `json:"width" validate:"gt=0"`
+	Height       int     `json:"height" validate:"gt=0"`
+	Codecs       string  `json:"codecs" validate:"required"` // RFC 6381, e.g. avc1.640028,mp4a.40.2
+	Bitrate      int     `json:"bitrate" validate:"gt=0"`    // peak bits per second
+	FrameRate    float64 `json:"frame_rate,omitempty" validate:"min=0"`
+	DynamicRange string  `json:"dynamic_range" validate:"oneof=SDR|HDR10|HDR10+|HLG|DV"`
+	Container    string  `json:"container" validate:"oneof=mp4|ts|fmp4"`
+	Path         string  `json:"path" validate:"required"` // object key or absolute URL
+}
+
+type RenditionResponse struct {
+	Name         string  `json:"name"`
+	Width        int     `json:"width"`
+	Height       int     `json:"height"`
+	Codecs       string  `json:"codecs"`
+	Bitrate      int     `json:"bitrate"`
+	FrameRate    float64 `json:"frame_rate,omitempty"`
+	DynamicRange string  `json:"dynamic_range"`
+	Container    string  `json:"container"`
+	URL          string  `json:"url"`
+}
+
+// renditionNamesUnique reports the first rendition reusing a name.
+func renditionNamesUnique(list []RenditionRequest) error {
+	seen := make(map[string]bool, len(list))
+	for i, r := range list {
+		if seen[r.Name] {
+			return FieldError{Field: fmt.Sprintf("renditions[%d].name", i), Message: fmt.Sprintf("%q is used twice", r.Name)}
+		}
+		seen[r.Name] = true
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import "fmt"
+
+type MoveEpisodesRequest struct {
+	EpisodeIDs     []string `json:"episode_ids" validate:"required"`
+	TargetSeasonID string   `json:"target_season_id" validate:"required"`
+	// RewriteIDs replaces the source season_id prefix of each episode_id
+	// with the target season_id.
+	RewriteIDs bool `json:"rewrite_ids,omitempty"`
+	// NumberFrom renumbers the moved episodes from this value, in their
+	// current order, instead of keeping their episode_number.
+	NumberFrom *int `json:"number_from,omitempty" validate:"min=0"`
+}
+
+// RenumberRequest resequences the children of a season or series. Either
+// Start (assign Start, Start+1, ...) or Offset (shift every number) must
+// be set. Order optionally lists every child ID in the desired sequence.
+type RenumberRequest struct {
+	Start  *int     `json:"start,omitempty" validate:"min=0"`
+	Offset *int     `json:"offset,omitempty"`
+	Order  []string `json:"order,omitempty"`
+}
+
+type Conflict struct {
+	ID      string `json:"id"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Validate rejects episode IDs listed more than once.
+func (r *MoveEpisodesRequest) Validate() error {
+	seen := make(map[string]struct{}, len(r.EpisodeIDs))
+	for _, id := range r.EpisodeIDs {
+		if _, dup := seen[id]; dup {
+			return FieldError{Field: "episode_ids", Message: fmt.Sprintf("contains %q more than once", id)}
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
+
+// Validate checks the combination of start, offset and order.
+func (r *RenumberRequest) Validate() error {
+	if (r.Start == nil) == (r.Offset == nil) {
+		return FieldError{Field: "start", Message: "exactly one of start or offset is required"}
+	}
+	if r.Offset != nil && len(r.Order) > 0 {
+		return FieldError{Field: "order", Message: "can only be used with start"}
+	}
+	return nil
+}
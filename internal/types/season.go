@@ -1,8 +1,6 @@
 package types
 
-import (
-	"fmt"
-)
+import "time"
 
 type SeasonResponse struct {
 	SeriesID        string            `json:"series_id"`
@@ -17,48 +15,52 @@ type SeasonResponse struct {
 	FirstEndYear    int               `json:"first_end_year"`
 	FirstEndMonth   int               `json:"first_end_month"`
 	ThumbnailURL    string            `json:"thumbnail_url"`
+	ThumbnailURLs   map[string]string `json:"thumbnail_urls,omitempty"` // by imgproxy preset
+	UpdatedAt       time.Time         `json:"updated_at"`
 	Episodes        []EpisodeResponse `json:"episodes,omitempty"`
 }
 
 type CreateSeasonRequest struct {
-	SeriesID        string  `json:"series_id" validate:"required"`
-	SeasonID        string  `json:"season_id" validate:"required"`
-	SeasonTitle     string  `json:"season_title" validate:"required"`
-	SeasonTitleYomi *string `json:"season_title_yomi,omitempty"`
-	SeasonNumber    int     `json:"season_number" validate:"required"`
-	ShoboiTID       *int    `json:"shoboi_tid,omitempty"`
-	Description     *string `json:"description,omitempty"`
-	FirstYear       *int    `json:"first_year,omitempty"`
-	FirstMonth      *int    `json:"first_month,omitempty"`
-	FirstEndYear    *int    `json:"first_end_year,omitempty"`
-	FirstEndMonth   *int    `json:"first_end_month,omitempty"`
+	SeriesID        string            `json:"series_id" validate:"required"`
+	SeasonID        string            `json:"season_id" validate:"format=@season_id"` // generated when empty
+	SeasonTitle     string            `json:"season_title" validate:"required"`
+	SeasonTitleYomi *string           `json:"season_title_yomi,omitempty"`
+	SeasonNumber    int               `json:"season_number" validate:"min=0"`
+	ShoboiTID       *int              `json:"shoboi_tid,omitempty" validate:"min=1"`
+	Description     *string           `json:"description,omitempty"`
+	FirstYear       *int              `json:"first_year,omitempty" validate:"min=1900"`
+	FirstMonth      *int              `json:"first_month,omitempty" validate:"month"`
+	FirstEndYear    *int              `json:"first_end_year,omitempty" validate:"min=1900"`
+	FirstEndMonth   *int              `json:"first_end_month,omitempty" validate:"month"`
+	Media           map[string]string `json:"media,omitempty" validate:"keys=thumbnail"` // object keys overriding the media layout
 }
 
 type UpdateSeasonRequest struct {
-	SeasonTitle     *string `json:"season_title,omitempty"`
-	SeasonTitleYomi *string `json:"season_title_yomi,omitempty"`
-	SeasonNumber    *int    `json:"season_number,omitempty"`
-	ShoboiTID       *int    `json:"shoboi_tid,omitempty"`
-	Description     *string `json:"description,omitempty"`
-	FirstYear       *int    `json:"first_year,omitempty"`
-	FirstMonth      *int    `json:"first_month,omitempty"`
-	FirstEndYear    *int    `json:"first_end_year,omitempty"`
-	FirstEndMonth   *int    `json:"first_end_month,omitempty"`
-	SeriesID        *string `json:"series_id,omitempty"`
+	SeasonTitle     *string           `json:"season_title,omitempty" validate:"required"`
+	SeasonTitleYomi *string           `json:"season_title_yomi,omitempty"`
+	SeasonNumber    *int              `json:"season_number,omitempty" validate:"min=0"`
+	ShoboiTID       *int              `json:"shoboi_tid,omitempty" validate:"min=1"`
+	Description     *string           `json:"description,omitempty"`
+	FirstYear       *int              `json:"first_year,omitempty" validate:"min=1900"`
+	FirstMonth      *int              `json:"first_month,omitempty" validate:"month"`
+	FirstEndYear    *int              `json:"first_end_year,omitempty" validate:"min=1900"`
+	FirstEndMonth   *int              `json:"first_end_month,omitempty" validate:"month"`
+	SeriesID        *string           `json:"series_id,omitempty" validate:"required"`
+	Media           map[string]string `json:"media,omitempty" validate:"keys=thumbnail"` // replaces the stored overrides; {} clears them
 }
 
-func (r *CreateSeasonRequest) ValidateRequired() error {
-	if r.SeriesID == "" {
-		return fmt.Errorf("series_id")
+// Validate checks that the broadcast end is not before its start.
+func (r *CreateSeasonRequest) Validate() error {
+	if r.FirstYear == nil || r.FirstEndYear == nil {
+		return nil
 	}
-	if r.SeasonID == "" {
-		return fmt.Errorf("season_id")
+	start, end := *r.FirstYear*12, *r.FirstEndYear*12
+	if r.FirstMonth != nil && r.FirstEndMonth != nil {
+		start += *r.FirstMonth
+		end += *r.FirstEndMonth
 	}
-	if r.SeasonTitle == "" {
-		return fmt.Errorf("season_title")
-	}
-	if r.SeasonNumber < 0 {
-		return fmt.Errorf("season_number must be greater than -1")
+	if end < start {
+		return FieldError{Field: "first_end_year", Message: "must not be before first_year"}
 	}
 	return nil
 }
@@ -1,39 +1,72 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
 
 type SeriesResponse struct {
-	SeriesID     string           `json:"series_id"`
-	Title        string           `json:"title"`
-	TitleYomi    string           `json:"title_yomi"`
-	TitleEn      string           `json:"title_en"`
-	ThumbnailURL string           `json:"thumbnail_url"`
-	PortraitURL  string           `json:"portrait_url"`
-	Description  string           `json:"description"`
-	Seasons      []SeasonResponse `json:"seasons,omitempty"`
+	SeriesID      string            `json:"series_id"`
+	Title         string            `json:"title"`
+	TitleYomi     string            `json:"title_yomi"`
+	TitleEn       string            `json:"title_en"`
+	ThumbnailURL  string            `json:"thumbnail_url"`
+	ThumbnailURLs map[string]string `json:"thumbnail_urls,omitempty"` // by imgproxy preset
+	PortraitURL   string            `json:"portrait_url"`
+	PortraitURLs  map[string]string `json:"portrait_urls,omitempty"`
+	Description   string            `json:"description"`
+	RatingAverage *float64          `json:"rating_average"` // of the users' scores; null when unrated
+	RatingCount   int               `json:"rating_count"`
+	FavoriteCount int               `json:"favorite_count"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+	Seasons       []SeasonResponse  `json:"seasons,omitempty"`
 }
 
 type CreateSeriesRequest struct {
-	SeriesID    string `json:"series_id" validate:"required"`
-	Title       string `json:"title" validate:"required"`
-	TitleYomi   string `json:"title_yomi,omitempty"`
-	TitleEn     string `json:"title_en,omitempty"`
-	Description string `json:"description,omitempty"`
+	SeriesID    string            `json:"series_id" validate:"required"`
+	Title       string            `json:"title" validate:"required"`
+	TitleYomi   string            `json:"title_yomi,omitempty"`
+	TitleEn     string            `json:"title_en,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Media       map[string]string `json:"media,omitempty" validate:"keys=thumbnail|portrait"` // object keys overriding the media layout
 }
 
 type UpdateSeriesRequest struct {
-	Title       *string `json:"title,omitempty"`
-	TitleYomi   *string `json:"title_yomi,omitempty"`
-	TitleEn     *string `json:"title_en,omitempty"`
-	Description *string `json:"description,omitempty"`
+	Title       *string           `json:"title,omitempty" validate:"required"`
+	TitleYomi   *string           `json:"title_yomi,omitempty"`
+	TitleEn     *string           `json:"title_en,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	Media       map[string]string `json:"media,omitempty" validate:"keys=thumbnail|portrait"` // replaces the stored overrides; {} clears them
 }
 
-func (r *CreateSeriesRequest) ValidateRequired() error {
-	if r.SeriesID == "" {
-		return fmt.Errorf("series_id is required")
+// Sort keys of GET /v1/series.
+const (
+	SortSeriesID  = "series_id"
+	SortTitle     = "title"
+	SortTitleYomi = "title_yomi"
+	SortUpdatedAt = "updated_at"
+	SortRating    = "rating"    // by rating_average, then rating_count; unrated last
+	SortFavorites = "favorites" // by favorite_count
+)
+
+var seriesSortKeys = []string{SortSeriesID, SortTitle, SortTitleYomi, SortUpdatedAt, SortRating, SortFavorites}
+
+// SeriesSort orders a list of series; the zero value keeps storage order.
+type SeriesSort struct {
+	Key  string
+	Desc bool
+}
+
+// ParseSeriesSort reads a sort key, prefixed with - for descending order.
+func ParseSeriesSort(raw string) (SeriesSort, error) {
+	if raw == "" {
+		return SeriesSort{}, nil
 	}
-	if r.Title == "" {
-		return fmt.Errorf("title is required")
+	key, desc := strings.CutPrefix(raw, "-")
+	if !slices.Contains(seriesSortKeys, key) {
+		return SeriesSort{}, fmt.Errorf("unknown key %q (allowed: %s)", key, strings.Join(seriesSortKeys, ", "))
 	}
-	return nil
+	return SeriesSort{Key: key, Desc: desc}, nil
 }
@@ -0,0 +1,38 @@
+package types
+
+// MissingObject is an expected object the bucket does not have. Asset is
+// "rendition:<name>" for the file or media playlist of a rendition.
+type MissingObject struct {
+	Kind  string `json:"kind"`
+	ID    string `json:"id"`
+	Asset string `json:"asset"`
+	Key   string `json:"key"`
+}
+
+type StorageCheckCounts struct {
+	Series   int `json:"series"`
+	Seasons  int `json:"seasons"`
+	Episodes int `json:"episodes"`
+	// Objects is the number of keys looked up or listed.
+	Objects int `json:"objects"`
+	// External counts media overrides pointing outside the bucket, which
+	// are not checked.
+	External int `json:"external"`
+}
+
+// StorageReport is the result of comparing the catalog with the object
+// storage. Orphans are the shallowest prefixes (ending in /) or keys that
+// hold objects but belong to no series, season or episode; they are only
+// looked for with the list method.
+type StorageReport struct {
+	Method  string             `json:"method"`
+	Prefix  string             `json:"prefix,omitempty"`
+	Checked StorageCheckCounts `json:"checked"`
+	Missing []MissingObject    `json:"missing"`
+	Orphans []string           `json:"orphans"`
+}
+
+// OK reports whether nothing is missing or orphaned.
+func (r *StorageReport) OK() bool {
+	return len(r.Missing) == 0 && len(r.Orphans) == 0
+}
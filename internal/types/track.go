@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// Formats of subtitle files. Each of them can be served as WebVTT.
+const (
+	SubtitleSRT = "srt"
+	SubtitleASS = "ass"
+	SubtitleSSA = "ssa"
+	SubtitleVTT = "vtt"
+)
+
+type SubtitleRequest struct {
+	Language string `json:"language" validate:"required,max=35"` // BCP 47, e.g. ja or pt-BR
+	Format   string `json:"format" validate:"oneof=srt|ass|ssa|vtt"`
+	Label    string `json:"label,omitempty"`
+	Default  bool   `json:"default,omitempty"`
+	Path     string `json:"path" validate:"required"` // object key or absolute URL
+}
+
+type UpdateSubtitleRequest struct {
+	Language *string `json:"language,omitempty" validate:"required,max=35"`
+	Format   *string `json:"format,omitempty" validate:"oneof=srt|ass|ssa|vtt"`
+	Label    *string `json:"label,omitempty"` // "" removes the label
+	Default  *bool   `json:"default,omitempty"`
+	Path     *string `json:"path,omitempty" validate:"required"`
+}
+
+type SubtitleResponse struct {
+	ID        int       `json:"id"`
+	Language  string    `json:"language"`
+	Format    string    `json:"format"`
+	Label     string    `json:"label,omitempty"`
+	Default   bool      `json:"default"`
+	URL       string    `json:"url"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type AudioTrackRequest struct {
+	Language string `json:"language" validate:"required,max=35"` // BCP 47, e.g. ja or pt-BR
+	Format   string `json:"format" validate:"oneof=aac|ac3|eac3|alac|flac|mp3|opus|dts|truehd|pcm"`
+	Label    string `json:"label,omitempty"`
+	Default  bool   `json:"default,omitempty"`
+	Path     string `json:"path" validate:"required"` // object key or absolute URL
+}
+
+type UpdateAudioTrackRequest struct {
+	Language *string `json:"language,omitempty" validate:"required,max=35"`
+	Format   *string `json:"format,omitempty" validate:"oneof=aac|ac3|eac3|alac|flac|mp3|opus|dts|truehd|pcm"`
+	Label    *string `json:"label,omitempty"` // "" removes the label
+	Default  *bool   `json:"default,omitempty"`
+	Path     *string `json:"path,omitempty" validate:"required"`
+}
+
+type AudioTrackResponse struct {
+	ID        int       `json:"id"`
+	Language  string    `json:"language"`
+	Format    string    `json:"format"`
+	Label     string    `json:"label,omitempty"`
+	Default   bool      `json:"default"`
+	URL       string    `json:"url"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (r SubtitleResponse) LastModified() time.Time {
+	return r.UpdatedAt
+}
+
+func (r AudioTrackResponse) LastModified() time.Time {
+	return r.UpdatedAt
+}
+
+func (r SubtitleRequest) isDefault() bool   { return r.Default }
+func (r AudioTrackRequest) isDefault() bool { return r.Default }
+
+// singleDefault reports the second track of a list marked as default.
+func singleDefault[T interface{ isDefault() bool }](field string, list []T) error {
+	first := -1
+	for i, t := range list {
+		if !t.isDefault() {
+			continue
+		}
+		if first >= 0 {
+			return FieldError{Field: fmt.Sprintf("%s[%d].default", field, i), Message: fmt.Sprintf("%s[%d] is already the default", field, first)}
+		}
+		first = i
+	}
+	return nil
+}
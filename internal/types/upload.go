@@ -0,0 +1,13 @@
+package types
+
+// ImageUploadResponse describes an image stored by an upload endpoint.
+type ImageUploadResponse struct {
+	Key         string `json:"key"`
+	ContentType string `json:"content_type"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	// URL is the imgproxy URL of the default preset (or the object URL
+	// without imgproxy); URLs lists every preset.
+	URL  string            `json:"url"`
+	URLs map[string]string `json:"urls,omitempty"`
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Include paths accepted by the read endpoints.
+const (
+	IncludeSeasons        = "seasons"
+	IncludeSeasonEpisodes = "seasons.episodes"
+	IncludeEpisodes       = "episodes"
+)
+
+// Include is the set of related objects embedded in a read response, by
+// dotted path. A path implies its parents.
+type Include map[string]bool
+
+func NewInclude(paths ...string) Include {
+	in := Include{}
+	for _, p := range paths {
+		for p != "" {
+			in[p] = true
+			i := strings.LastIndexByte(p, '.')
+			if i < 0 {
+				break
+			}
+			p = p[:i]
+		}
+	}
+	return in
+}
+
+func (in Include) Has(path string) bool {
+	return in[path]
+}
+
+// ParseInclude reads a comma separated list of paths, each of which must
+// be one of allowed.
+func ParseInclude(raw string, allowed ...string) (Include, error) {
+	var paths []string
+	for _, p := range strings.Split(raw, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		ok := false
+		for _, a := range allowed {
+			ok = ok || a == p
+		}
+		if !ok {
+			if len(allowed) == 0 {
+				return nil, fmt.Errorf("nothing can be included here")
+			}
+			return nil, fmt.Errorf("unknown path %q (allowed: %s)", p, strings.Join(allowed, ", "))
+		}
+		paths = append(paths, p)
+	}
+	return NewInclude(paths...), nil
+}
+
+// Fields is a sparse fieldset: the JSON members to keep, keyed by name.
+// A nil subset keeps the whole member; nil Fields keeps everything.
+type Fields map[string]Fields
+
+// ParseFields reads a comma separated list of member names. Nested
+// members are selected with dotted paths, e.g. "seasons.season_id".
+func ParseFields(raw string) Fields {
+	var f Fields
+	for _, p := range strings.Split(raw, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if f == nil {
+			f = Fields{}
+		}
+		f.add(strings.Split(p, "."))
+	}
+	return f
+}
+
+func (f Fields) add(path []string) {
+	sub, seen := f[path[0]]
+	if len(path) == 1 {
+		f[path[0]] = nil
+		return
+	}
+	if seen && sub == nil {
+		return // already selected whole
+	}
+	if sub == nil {
+		sub = Fields{}
+		f[path[0]] = sub
+	}
+	sub.add(path[1:])
+}
+
+// Allows reports whether the member at the dotted path is kept.
+func (f Fields) Allows(path string) bool {
+	for _, name := range strings.Split(path, ".") {
+		if f == nil {
+			return true
+		}
+		sub, ok := f[name]
+		if !ok {
+			return false
+		}
+		f = sub
+	}
+	return true
+}
+
+// Within drops the paths whose members are not kept by f, so nothing is
+// loaded only to be filtered out.
+func (in Include) Within(f Fields) Include {
+	out := Include{}
+	for p := range in {
+		if f.Allows(p) {
+			out[p] = true
+		}
+	}
+	return out
+}
+
+// LastModified is the latest UpdatedAt of the series and everything
+// embedded in it.
+func (r SeriesResponse) LastModified() time.Time {
+	return later(r.UpdatedAt, Latest(r.Seasons))
+}
+
+func (r SeasonResponse) LastModified() time.Time {
+	return later(r.UpdatedAt, Latest(r.Episodes))
+}
+
+func (r EpisodeResponse) LastModified() time.Time {
+	return r.UpdatedAt
+}
+
+// Latest returns the greatest LastModified of items, or the zero time.
+func Latest[T interface{ LastModified() time.Time }](items []T) time.Time {
+	var latest time.Time
+	for _, it := range items {
+		if t := it.LastModified(); t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
+
+func later(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
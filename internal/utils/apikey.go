@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+// BuildAPIKeyResponse describes k, loaded with its user.
+func BuildAPIKeyResponse(k *ent.APIKey) types.APIKeyResponse {
+	resp := types.APIKeyResponse{
+		ID:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		LastUsedAt: k.LastUsedAt,
+		CreatedAt:  k.CreatedAt,
+	}
+	if u := k.Edges.User; u != nil {
+		resp.User = &u.Name
+	}
+	return resp
+}
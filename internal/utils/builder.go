@@ -1,75 +1,61 @@
 package utils
 
 import (
-	"net/url"
-	"os"
-	"regexp"
-	"strconv"
-	"strings"
-
 	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/imgproxy"
+	"github.com/clustlight/animatrix-api/internal/media"
+	"github.com/clustlight/animatrix-api/internal/storage"
 	"github.com/clustlight/animatrix-api/internal/types"
 )
 
-func JoinURL(base, relPath string) string {
-	u, err := url.Parse(base)
-	if err != nil {
-		return ""
-	}
-	ref, err := url.Parse(relPath)
-	if err != nil {
-		return ""
-	}
-	return u.ResolveReference(ref).String()
+// mediaURL returns the URL of asset a of r in the object storage.
+func mediaURL(r media.Ref, a media.Asset) string {
+	return storage.Current().URL(media.Current().Path(r, a), a)
 }
 
-func getObjectStorageURL() string {
-	return os.Getenv("OBJECT_STORAGE_URL")
+// ImageURLs returns the imgproxy URL of image asset a of r and its presets.
+func ImageURLs(r media.Ref, a media.Asset) (string, map[string]string) {
+	return imgproxy.Current().URLs(mediaURL(r, a), imgproxy.Image(string(r.Kind)+"."+string(a)))
 }
 
-func buildThumbnailURL(baseURL, id, suffix, ext string) string {
-	if suffix != "" {
-		return JoinURL(baseURL, id+"/thumbnail_"+suffix+"."+ext)
-	}
-	return JoinURL(baseURL, id+"/thumbnail."+ext)
+func SeriesMediaRef(s *ent.Series) media.Ref {
+	return media.Ref{Kind: media.Series, SeriesID: s.SeriesID, Overrides: s.Media}
 }
 
-func getImgproxyURL(originalURL string, sizeType string, size int) string {
-	imgproxyBase := os.Getenv("IMGPROXY_URL")
-	if imgproxyBase == "" {
-		// fallback: return original if not set
-		return originalURL
+// SeasonMediaRef uses the series edge when loaded; otherwise the series ID is
+// derived from the season ID.
+func SeasonMediaRef(s *ent.Season) media.Ref {
+	r := media.Ref{Kind: media.Season, SeasonID: s.SeasonID, SeasonNumber: s.SeasonNumber, Overrides: s.Media}
+	if s.Edges.Series != nil {
+		r.SeriesID = s.Edges.Series.SeriesID
 	}
-	// sizeType: "w" or "h"
-	u, err := url.Parse(originalURL)
-	if err != nil {
-		return originalURL
-	}
-	encoded := u.Scheme + "://" + url.PathEscape(u.Host+u.Path)
-	if u.RawQuery != "" {
-		encoded += "%3F" + url.PathEscape(u.RawQuery)
-	}
-	var sizeParam string
-	if sizeType == "h" {
-		sizeParam = "h:" + strconv.Itoa(size)
-	} else {
-		sizeParam = "w:" + strconv.Itoa(size)
+	return r
+}
+
+func EpisodeMediaRef(ep *ent.Episode, season *ent.Season) media.Ref {
+	r := media.Ref{Kind: media.Episode, EpisodeID: ep.EpisodeID, EpisodeNumber: ep.EpisodeNumber, Overrides: ep.Media}
+	if season != nil {
+		parent := SeasonMediaRef(season)
+		r.SeriesID, r.SeasonID, r.SeasonNumber = parent.SeriesID, parent.SeasonID, parent.SeasonNumber
 	}
-	return imgproxyBase + "/unsafe/" + sizeParam + "/plain/" + encoded
+	return r
 }
 
 func BuildSeriesResponse(series *ent.Series, withSeasons, withEpisodes bool) types.SeriesResponse {
-	baseURL := getObjectStorageURL()
-	origThumb := JoinURL(baseURL, series.SeriesID+"/thumbnail.png")
-	origPortrait := JoinURL(baseURL, series.SeriesID+"/portrait.png")
+	ref := SeriesMediaRef(series)
+	thumb, thumbs := ImageURLs(ref, media.Thumbnail)
+	portrait, portraits := ImageURLs(ref, media.Portrait)
 	resp := types.SeriesResponse{
-		SeriesID:     series.SeriesID,
-		Title:        series.Title,
-		TitleYomi:    series.TitleYomi,
-		TitleEn:      series.TitleEn,
-		Description:  series.Description,
-		ThumbnailURL: getImgproxyURL(origThumb, "h", 360),
-		PortraitURL:  getImgproxyURL(origPortrait, "w", 360),
+		SeriesID:      series.SeriesID,
+		Title:         series.Title,
+		TitleYomi:     series.TitleYomi,
+		TitleEn:       series.TitleEn,
+		Description:   series.Description,
+		ThumbnailURL:  thumb,
+		ThumbnailURLs: thumbs,
+		PortraitURL:   portrait,
+		PortraitURLs:  portraits,
+		UpdatedAt:     series.UpdatedAt,
 	}
 
 	if withSeasons && series.Edges.Seasons != nil {
@@ -82,30 +68,8 @@ func BuildSeriesResponse(series *ent.Series, withSeasons, withEpisodes bool) typ
 	return resp
 }
 
-func extractSeriesIDAndSuffix(seasonID string) (seriesID, suffix string) {
-	idx := strings.Index(seasonID, "_")
-	if idx == -1 {
-		return seasonID, ""
-	}
-	seriesID = seasonID[:idx]
-	rest := seasonID[idx+1:]
-	re := regexp.MustCompile(`^s\d+`)
-	suffix = re.FindString(rest)
-	return
-}
-
 func BuildSeasonResponse(season *ent.Season, withEpisodes bool) types.SeasonResponse {
-	baseURL := getObjectStorageURL()
-	seriesID, suffix := extractSeriesIDAndSuffix(season.SeasonID)
-
-	thumbURL := ""
-	if suffix != "" {
-		thumbURL = buildThumbnailURL(baseURL, seriesID, suffix, "png")
-	} else {
-		thumbURL = JoinURL(baseURL, seriesID+"/thumbnail.png")
-	}
-	thumbURL = getImgproxyURL(thumbURL, "h", 240)
-
+	thumb, thumbs := ImageURLs(SeasonMediaRef(season), media.Thumbnail)
 	seriesIDVal := ""
 	if season.Edges.Series != nil {
 		seriesIDVal = season.Edges.Series.SeriesID
@@ -122,26 +86,30 @@ func BuildSeasonResponse(season *ent.Season, withEpisodes bool) types.SeasonResp
 		FirstMonth:      season.FirstMonth,
 		FirstEndYear:    season.FirstEndYear,
 		FirstEndMonth:   season.FirstEndMonth,
-		ThumbnailURL:    thumbURL,
+		ThumbnailURL:    thumb,
+		ThumbnailURLs:   thumbs,
+		UpdatedAt:       season.UpdatedAt,
 	}
 
 	if withEpisodes && season.Edges.Episodes != nil {
 		episodes := make([]types.EpisodeResponse, 0, len(season.Edges.Episodes))
 		for _, ep := range season.Edges.Episodes {
-			episodes = append(episodes, BuildEpisodeResponse(ep))
+			episodes = append(episodes, buildEpisodeResponse(ep, season))
 		}
 		resp.Episodes = episodes
 	}
 	return resp
 }
 
+// BuildEpisodeResponse resolves media paths with the season edge (and its
+// series) when loaded.
 func BuildEpisodeResponse(ep *ent.Episode) types.EpisodeResponse {
-	baseURL := getObjectStorageURL()
-	epPath := strings.Replace(ep.EpisodeID, "_", "/", 1)
-	VideoUrl := JoinURL(baseURL, epPath+"/video.mp4")
-	ThumbnailUrl := JoinURL(baseURL, epPath+"/thumbnail.png")
-	ThumbnailUrl = getImgproxyURL(ThumbnailUrl, "h", 240)
+	return buildEpisodeResponse(ep, ep.Edges.Season)
+}
 
+func buildEpisodeResponse(ep *ent.Episode, season *ent.Season) types.EpisodeResponse {
+	ref := EpisodeMediaRef(ep, season)
+	thumb, thumbs := ImageURLs(ref, media.Thumbnail)
 	return types.EpisodeResponse{
 		Title:          ep.Title,
 		Description:    ep.Description,
@@ -154,7 +122,13 @@ func BuildEpisodeResponse(ep *ent.Episode) types.EpisodeResponse {
 		Width:          ep.Width,
 		Height:         ep.Height,
 		DynamicRange:   ep.DynamicRange,
-		VideoURL:       VideoUrl,
-		ThumbnailURL:   ThumbnailUrl,
+		VideoURL:       mediaURL(ref, media.Video),
+		ThumbnailURL:   thumb,
+		ThumbnailURLs:  thumbs,
+		Renditions:     buildRenditionResponses(ep.Edges.Renditions),
+		Subtitles:      BuildSubtitleResponses(ep.Edges.Subtitles),
+		AudioTracks:    BuildAudioTrackResponses(ep.Edges.AudioTracks),
+		Chapters:       BuildChapterResponses(ep.Edges.Chapters),
+		UpdatedAt:      ep.UpdatedAt,
 	}
 }
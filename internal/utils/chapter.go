@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/types"
+	"github.com/clustlight/animatrix-api/internal/webvtt"
+)
+
+// chapterTitles name chapters without a title in WebVTT.
+var chapterTitles = map[string]string{
+	types.ChapterOpening: "Opening",
+	types.ChapterEnding:  "Ending",
+	types.ChapterRecap:   "Recap",
+	types.ChapterPreview: "Preview",
+	types.ChapterMain:    "Main",
+}
+
+// sortChapters orders chapters by start.
+func sortChapters(list []*ent.Chapter) []*ent.Chapter {
+	list = slices.Clone(list)
+	slices.SortFunc(list, func(a, b *ent.Chapter) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+	return list
+}
+
+// BuildChapterResponses lists chapters by start; it returns nil for an
+// empty list.
+func BuildChapterResponses(list []*ent.Chapter) []types.ChapterResponse {
+	if len(list) == 0 {
+		return nil
+	}
+	resps := make([]types.ChapterResponse, 0, len(list))
+	for _, c := range sortChapters(list) {
+		resps = append(resps, types.ChapterResponse{
+			Start:     c.Start,
+			End:       c.End,
+			Kind:      c.Kind,
+			Title:     c.Title,
+			UpdatedAt: c.UpdatedAt,
+		})
+	}
+	return resps
+}
+
+func buildChapterRequests(list []*ent.Chapter) []types.ChapterRequest {
+	if len(list) == 0 {
+		return nil
+	}
+	reqs := make([]types.ChapterRequest, 0, len(list))
+	for _, c := range sortChapters(list) {
+		reqs = append(reqs, types.ChapterRequest{
+			Start: c.Start,
+			End:   c.End,
+			Kind:  c.Kind,
+			Title: c.Title,
+		})
+	}
+	return reqs
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// BuildChapterVTT renders chapters as a WebVTT chapters file, titled by
+// their kind when they have no title.
+func BuildChapterVTT(list []*ent.Chapter) string {
+	cues := make([]webvtt.Cue, 0, len(list))
+	for _, c := range list {
+		cues = append(cues, webvtt.Cue{
+			Start: seconds(c.Start),
+			End:   seconds(c.End),
+			Text:  webvtt.Escape(cmp.Or(c.Title, chapterTitles[c.Kind])),
+		})
+	}
+	return webvtt.Render(cues)
+}
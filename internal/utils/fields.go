@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// jsonName returns the member name of a struct field and whether it is
+// omitted when empty; name is "" for fields not serialized.
+func jsonName(f reflect.StructField) (name string, omitempty bool) {
+	if !f.IsExported() {
+		return "", false
+	}
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = f.Name
+	}
+	return name, strings.Contains(opts, "omitempty")
+}
+
+func objectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == timeType {
+		return nil
+	}
+	return t
+}
+
+// CheckFields reports the first member in f that responses of type t do
+// not have.
+func CheckFields(t reflect.Type, f types.Fields) error {
+	return checkFields(t, f, "")
+}
+
+func checkFields(t reflect.Type, f types.Fields, prefix string) error {
+	st := objectType(t)
+	for name, sub := range f {
+		if st == nil {
+			return fmt.Errorf("%q has no members", strings.TrimSuffix(prefix, "."))
+		}
+		field, ok := fieldByJSONName(st, name)
+		if !ok {
+			return fmt.Errorf("unknown field %q", prefix+name)
+		}
+		if sub != nil {
+			if err := checkFields(field.Type, sub, prefix+name+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := range t.NumField() {
+		if n, _ := jsonName(t.Field(i)); n == name {
+			return t.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// SelectFields returns v reduced to the members selected by f, keeping
+// struct field order. Slices are reduced element by element.
+func SelectFields(v any, f types.Fields) any {
+	if f == nil {
+		return v
+	}
+	return project(reflect.ValueOf(v), f)
+}
+
+func project(v reflect.Value, f types.Fields) any {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return project(v.Elem(), f)
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		out := make([]any, v.Len())
+		for i := range out {
+			out[i] = project(v.Index(i), f)
+		}
+		return out
+	case reflect.Struct:
+		if f == nil || v.Type() == timeType {
+			return v.Interface()
+		}
+		obj := projection{}
+		for i := range v.NumField() {
+			name, omitempty := jsonName(v.Type().Field(i))
+			sub, ok := f[name]
+			if name == "" || !ok {
+				continue
+			}
+			fv := v.Field(i)
+			if omitempty && isEmpty(fv) {
+				continue
+			}
+			obj = append(obj, member{name, project(fv, sub)})
+		}
+		return obj
+	default:
+		return v.Interface()
+	}
+}
+
+// isEmpty matches the values encoding/json drops for omitempty.
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String, reflect.Array:
+		return v.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	case reflect.Struct:
+		return false
+	}
+	return v.IsZero()
+}
+
+type member struct {
+	name  string
+	value any
+}
+
+// projection is a JSON object whose members keep their order.
+type projection []member
+
+func (p projection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range p {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(m.name)
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+// itemRef names the series or season a favorite, rating or watchlist item
+// is about.
+type itemRef struct {
+	kind, seriesID, seasonID, title string
+}
+
+// refOf describes s, or sn loaded with its series when s is nil.
+func refOf(s *ent.Series, sn *ent.Season) itemRef {
+	if s != nil {
+		return itemRef{kind: types.ItemSeries, seriesID: s.SeriesID, title: s.Title}
+	}
+	ref := itemRef{kind: types.ItemSeason, seasonID: sn.SeasonID, title: sn.SeasonTitle}
+	if sn.Edges.Series != nil {
+		ref.seriesID = sn.Edges.Series.SeriesID
+	}
+	return ref
+}
+
+// BuildFavoriteResponse expects f loaded with its series, or its season
+// and the season's series.
+func BuildFavoriteResponse(f *ent.Favorite) types.FavoriteResponse {
+	ref := refOf(f.Edges.Series, f.Edges.Season)
+	return types.FavoriteResponse{
+		Kind:      ref.kind,
+		SeriesID:  ref.seriesID,
+		SeasonID:  ref.seasonID,
+		Title:     ref.title,
+		CreatedAt: f.CreatedAt,
+	}
+}
+
+// BuildRatingResponse expects r loaded like BuildFavoriteResponse.
+func BuildRatingResponse(r *ent.Rating) types.RatingResponse {
+	ref := refOf(r.Edges.Series, r.Edges.Season)
+	return types.RatingResponse{
+		Kind:      ref.kind,
+		SeriesID:  ref.seriesID,
+		SeasonID:  ref.seasonID,
+		Title:     ref.title,
+		Score:     r.Score,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+// BuildWatchlistItemResponse expects it loaded like BuildFavoriteResponse.
+func BuildWatchlistItemResponse(it *ent.WatchlistItem) types.WatchlistItemResponse {
+	ref := refOf(it.Edges.Series, it.Edges.Season)
+	return types.WatchlistItemResponse{
+		Kind:     ref.kind,
+		SeriesID: ref.seriesID,
+		SeasonID: ref.seasonID,
+		Title:    ref.title,
+		AddedAt:  it.CreatedAt,
+	}
+}
+
+// BuildWatchlistResponse expects w loaded with its items, in order.
+func BuildWatchlistResponse(w *ent.Watchlist) types.WatchlistResponse {
+	items := make([]types.WatchlistItemResponse, 0, len(w.Edges.Items))
+	for _, it := range w.Edges.Items {
+		items = append(items, BuildWatchlistItemResponse(it))
+	}
+	return types.WatchlistResponse{
+		ID:        w.ID,
+		Name:      w.Name,
+		Items:     items,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
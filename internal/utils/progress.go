@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+func BuildUserResponse(u *ent.User) types.UserResponse {
+	return types.UserResponse{Name: u.Name, CreatedAt: u.CreatedAt}
+}
+
+func BuildProgressResponse(e *ent.Episode, p *ent.WatchProgress) *types.ProgressResponse {
+	return &types.ProgressResponse{
+		EpisodeID: e.EpisodeID,
+		Position:  p.Position,
+		Duration:  e.Duration,
+		Completed: p.Completed,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+// BuildWatchEntry describes episode e, loaded with its season and series,
+// as watched up to position; p is the progress that put it on the list.
+func BuildWatchEntry(e *ent.Episode, position float64, completed bool, p *ent.WatchProgress) types.WatchEntry {
+	s := e.Edges.Season.Edges.Series
+	return types.WatchEntry{
+		SeriesID:    s.SeriesID,
+		SeriesTitle: s.Title,
+		Episode:     BuildEpisodeResponse(e),
+		Position:    position,
+		Completed:   completed,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
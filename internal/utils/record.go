@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+func BuildSeriesRecord(s *ent.Series) types.CatalogRecord {
+	return types.CatalogRecord{
+		Kind:        types.KindSeries,
+		SeriesID:    s.SeriesID,
+		Title:       s.Title,
+		TitleYomi:   s.TitleYomi,
+		TitleEn:     s.TitleEn,
+		Description: s.Description,
+		Media:       s.Media,
+	}
+}
+
+func BuildSeasonRecord(seriesID string, s *ent.Season) types.CatalogRecord {
+	return types.CatalogRecord{
+		Kind:            types.KindSeason,
+		SeriesID:        seriesID,
+		SeasonID:        s.SeasonID,
+		SeasonTitle:     s.SeasonTitle,
+		SeasonTitleYomi: s.SeasonTitleYomi,
+		SeasonNumber:    s.SeasonNumber,
+		ShoboiTID:       s.ShoboiTid,
+		FirstYear:       s.FirstYear,
+		FirstMonth:      s.FirstMonth,
+		FirstEndYear:    s.FirstEndYear,
+		FirstEndMonth:   s.FirstEndMonth,
+		Description:     s.Description,
+		Media:           s.Media,
+	}
+}
+
+func BuildEpisodeRecord(seasonID string, e *ent.Episode) types.CatalogRecord {
+	ts := e.Timestamp
+	return types.CatalogRecord{
+		Kind:           types.KindEpisode,
+		SeasonID:       seasonID,
+		EpisodeID:      e.EpisodeID,
+		Title:          e.Title,
+		EpisodeNumber:  e.EpisodeNumber,
+		Duration:       e.Duration,
+		DurationString: e.DurationString,
+		Timestamp:      &ts,
+		FormatID:       e.FormatID,
+		Width:          e.Width,
+		Height:         e.Height,
+		DynamicRange:   e.DynamicRange,
+		Description:    e.Description,
+		Metadata:       e.Metadata,
+		Media:          e.Media,
+		Renditions:     buildRenditionRequests(e.Edges.Renditions),
+		Subtitles:      buildSubtitleRequests(e.Edges.Subtitles),
+		AudioTracks:    buildAudioTrackRequests(e.Edges.AudioTracks),
+		Chapters:       buildChapterRequests(e.Edges.Chapters),
+	}
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/media"
+	"github.com/clustlight/animatrix-api/internal/storage"
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+// sortRenditions orders renditions by bitrate, then name.
+func sortRenditions(list []*ent.Rendition) []*ent.Rendition {
+	list = slices.Clone(list)
+	slices.SortFunc(list, func(a, b *ent.Rendition) int {
+		return cmp.Or(cmp.Compare(a.Bitrate, b.Bitrate), strings.Compare(a.Name, b.Name))
+	})
+	return list
+}
+
+// renditionURL serves renditions like the episode video, signed when
+// videos are.
+func renditionURL(r *ent.Rendition) string {
+	return storage.Current().URL(r.Path, media.Video)
+}
+
+func buildRenditionResponses(list []*ent.Rendition) []types.RenditionResponse {
+	if len(list) == 0 {
+		return nil
+	}
+	resps := make([]types.RenditionResponse, 0, len(list))
+	for _, r := range sortRenditions(list) {
+		resps = append(resps, types.RenditionResponse{
+			Name:         r.Name,
+			Width:        r.Width,
+			Height:       r.Height,
+			Codecs:       r.Codecs,
+			Bitrate:      r.Bitrate,
+			FrameRate:    r.FrameRate,
+			DynamicRange: r.DynamicRange,
+			Container:    r.Container,
+			URL:          renditionURL(r),
+		})
+	}
+	return resps
+}
+
+func buildRenditionRequests(list []*ent.Rendition) []types.RenditionRequest {
+	if len(list) == 0 {
+		return nil
+	}
+	reqs := make([]types.RenditionRequest, 0, len(list))
+	for _, r := range sortRenditions(list) {
+		reqs = append(reqs, types.RenditionRequest{
+			Name:         r.Name,
+			Width:        r.Width,
+			Height:       r.Height,
+			Codecs:       r.Codecs,
+			Bitrate:      r.Bitrate,
+			FrameRate:    r.FrameRate,
+			DynamicRange: r.DynamicRange,
+			Container:    r.Container,
+			Path:         r.Path,
+		})
+	}
+	return reqs
+}
+
+// IsHLS reports whether r is listed in master playlists.
+func IsHLS(r *ent.Rendition) bool {
+	return r.Container == types.ContainerTS || r.Container == types.ContainerFMP4
+}
+
+// videoRange maps a dynamic range to its HLS VIDEO-RANGE.
+func videoRange(dynamicRange string) string {
+	switch dynamicRange {
+	case "SDR", "HLG":
+		return dynamicRange
+	}
+	return "PQ"
+}
+
+// BuildMasterPlaylist renders an HLS master playlist with a variant
+// stream per HLS rendition, lowest bitrate first.
+func BuildMasterPlaylist(list []*ent.Rendition) string {
+	var b strings.Builder
+	b.WriteString("#EXTM3U\n")
+	for _, r := range sortRenditions(list) {
+		url := renditionURL(r)
+		if !IsHLS(r) || url == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "#EXT-X-STREAM-INF:BANDWIDTH=%d,RESOLUTION=%dx%d,CODECS=%q", r.Bitrate, r.Width, r.Height, r.Codecs)
+		if r.FrameRate > 0 {
+			b.WriteString(",FRAME-RATE=" + strconv.FormatFloat(r.FrameRate, 'f', 3, 64))
+		}
+		b.WriteString(",VIDEO-RANGE=" + videoRange(r.DynamicRange) + "\n")
+		b.WriteString(url + "\n")
+	}
+	return b.String()
+}
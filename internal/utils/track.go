@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/clustlight/animatrix-api/ent"
+	"github.com/clustlight/animatrix-api/internal/media"
+	"github.com/clustlight/animatrix-api/internal/storage"
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+// trackURL serves subtitle and audio files like the episode video, signed
+// when videos are.
+func trackURL(path string) string {
+	return storage.Current().URL(path, media.Video)
+}
+
+// byID orders tracks as they were added.
+func byID[T any](list []T, id func(T) int) []T {
+	list = slices.Clone(list)
+	slices.SortFunc(list, func(a, b T) int { return cmp.Compare(id(a), id(b)) })
+	return list
+}
+
+func subtitleID(s *ent.Subtitle) int     { return s.ID }
+func audioTrackID(a *ent.AudioTrack) int { return a.ID }
+
+func BuildSubtitleResponse(s *ent.Subtitle) types.SubtitleResponse {
+	return types.SubtitleResponse{
+		ID:        s.ID,
+		Language:  s.Language,
+		Format:    s.Format,
+		Label:     s.Label,
+		Default:   s.IsDefault,
+		URL:       trackURL(s.Path),
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+func BuildAudioTrackResponse(a *ent.AudioTrack) types.AudioTrackResponse {
+	return types.AudioTrackResponse{
+		ID:        a.ID,
+		Language:  a.Language,
+		Format:    a.Format,
+		Label:     a.Label,
+		Default:   a.IsDefault,
+		URL:       trackURL(a.Path),
+		UpdatedAt: a.UpdatedAt,
+	}
+}
+
+// BuildSubtitleResponses lists subtitles as they were added; it returns
+// nil for an empty list.
+func BuildSubtitleResponses(list []*ent.Subtitle) []types.SubtitleResponse {
+	if len(list) == 0 {
+		return nil
+	}
+	resps := make([]types.SubtitleResponse, 0, len(list))
+	for _, s := range byID(list, subtitleID) {
+		resps = append(resps, BuildSubtitleResponse(s))
+	}
+	return resps
+}
+
+// BuildAudioTrackResponses lists audio tracks as they were added; it
+// returns nil for an empty list.
+func BuildAudioTrackResponses(list []*ent.AudioTrack) []types.AudioTrackResponse {
+	if len(list) == 0 {
+		return nil
+	}
+	resps := make([]types.AudioTrackResponse, 0, len(list))
+	for _, a := range byID(list, audioTrackID) {
+		resps = append(resps, BuildAudioTrackResponse(a))
+	}
+	return resps
+}
+
+func buildSubtitleRequests(list []*ent.Subtitle) []types.SubtitleRequest {
+	if len(list) == 0 {
+		return nil
+	}
+	reqs := make([]types.SubtitleRequest, 0, len(list))
+	for _, s := range byID(list, subtitleID) {
+		reqs = append(reqs, types.SubtitleRequest{
+			Language: s.Language,
+			Format:   s.Format,
+			Label:    s.Label,
+			Default:  s.IsDefault,
+			Path:     s.Path,
+		})
+	}
+	return reqs
+}
+
+func buildAudioTrackRequests(list []*ent.AudioTrack) []types.AudioTrackRequest {
+	if len(list) == 0 {
+		return nil
+	}
+	reqs := make([]types.AudioTrackRequest, 0, len(list))
+	for _, a := range byID(list, audioTrackID) {
+		reqs = append(reqs, types.AudioTrackRequest{
+			Language: a.Language,
+			Format:   a.Format,
+			Label:    a.Label,
+			Default:  a.IsDefault,
+			Path:     a.Path,
+		})
+	}
+	return reqs
+}
@@ -0,0 +1,250 @@
+// Package validate checks request structs against their `validate` tags.
+//
+// Rules are comma separated:
+//
+//	required     value must be non-zero (non-empty string, slice, time)
+//	min=N        number >= N, or string/slice length >= N
+//	max=N        number <= N, or string/slice length <= N
+//	gt=N         number > N
+//	month        number between 1 and 12
+//	oneof=a|b    value is one of the listed strings
+//	keys=a|b     every key of a map is one of the listed strings
+//	format=T     string matches template T; alternatives are separated by |
+//	format=@name string matches the configured ID template name, e.g. @season_id
+//	dive         validates each struct of a slice, e.g. "renditions[1].width"
+//
+// Templates may reference sibling fields by json name ({series_id}),
+// digits ({n}) or any non-empty text ({*}); see idscheme.Match.
+//
+// Pointer fields are optional: a nil pointer is skipped, otherwise the
+// rules apply to the pointed-to value. Every failing field is reported.
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/clustlight/animatrix-api/internal/idscheme"
+	"github.com/clustlight/animatrix-api/internal/types"
+)
+
+// Validator is implemented by requests with rules that tags cannot express.
+// Its errors are reported alongside the tag failures.
+type Validator interface {
+	Validate() error
+}
+
+// Struct validates v, which must be a struct or a pointer to one. It
+// returns nil or types.ValidationErrors.
+func Struct(v any) error {
+	return collect(structErrors(v, ""))
+}
+
+// Slice validates every element, prefixing field names with the index,
+// e.g. "[2].title".
+func Slice[T any](items []T) error {
+	var errs types.ValidationErrors
+	for i := range items {
+		errs = append(errs, structErrors(&items[i], "["+strconv.Itoa(i)+"].")...)
+	}
+	return collect(errs)
+}
+
+func collect(errs types.ValidationErrors) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func structErrors(v any, prefix string) types.ValidationErrors {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	var errs types.ValidationErrors
+	siblings := jsonValues(rv)
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		tag := f.Tag.Get("validate")
+		if tag == "" || !f.IsExported() {
+			continue
+		}
+		name := jsonName(f)
+		fv := rv.Field(i)
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		for _, rule := range strings.Split(tag, ",") {
+			if rule == "dive" {
+				for j := 0; j < fv.Len(); j++ {
+					errs = append(errs, structErrors(fv.Index(j).Addr().Interface(), prefix+name+"["+strconv.Itoa(j)+"].")...)
+				}
+				continue
+			}
+			if msg := check(fv, rule, siblings); msg != "" {
+				errs = append(errs, types.FieldError{Field: prefix + name, Message: msg})
+				break
+			}
+		}
+	}
+
+	if vr, ok := v.(Validator); ok {
+		if err := vr.Validate(); err != nil {
+			errs = append(errs, prefixed(err, prefix)...)
+		}
+	}
+	return errs
+}
+
+func prefixed(err error, prefix string) types.ValidationErrors {
+	var out types.ValidationErrors
+	switch e := err.(type) {
+	case types.ValidationErrors:
+		out = append(out, e...)
+	case types.FieldError:
+		out = append(out, e)
+	default:
+		out = append(out, types.FieldError{Field: strings.TrimSuffix(prefix, "."), Message: err.Error()})
+	}
+	for i := range out {
+		out[i].Field = prefix + out[i].Field
+	}
+	return out
+}
+
+func check(v reflect.Value, rule string, siblings map[string]string) string {
+	name, arg, _ := strings.Cut(rule, "=")
+	switch name {
+	case "required":
+		if v.IsZero() || (v.Kind() == reflect.Slice && v.Len() == 0) {
+			return "is required"
+		}
+	case "min":
+		if n, ok := measure(v); ok && n < parseArg(arg) {
+			return "must be at least " + arg + unit(v)
+		}
+	case "max":
+		if n, ok := measure(v); ok && n > parseArg(arg) {
+			return "must be at most " + arg + unit(v)
+		}
+	case "gt":
+		if n, ok := measure(v); ok && n <= parseArg(arg) {
+			return "must be greater than " + arg
+		}
+	case "month":
+		if n, ok := measure(v); ok && (n < 1 || n > 12) {
+			return "must be between 1 and 12"
+		}
+	case "oneof":
+		options := strings.Split(arg, "|")
+		for _, o := range options {
+			if v.Kind() == reflect.String && v.String() == o {
+				return ""
+			}
+		}
+		return "must be one of " + strings.Join(options, ", ")
+	case "keys":
+		options := strings.Split(arg, "|")
+		if v.Kind() != reflect.Map {
+			return ""
+		}
+		keys := make([]string, 0, v.Len())
+		for _, k := range v.MapKeys() {
+			keys = append(keys, k.String())
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			if !slices.Contains(options, k) {
+				return fmt.Sprintf("has unknown key %q (allowed: %s)", k, strings.Join(options, ", "))
+			}
+		}
+	case "format":
+		if v.Kind() != reflect.String || v.String() == "" {
+			return ""
+		}
+		template := arg
+		if name, ok := strings.CutPrefix(arg, "@"); ok {
+			if template, ok = idscheme.Current().Template(name); !ok {
+				panic(fmt.Sprintf("validate: unknown id template %q", arg))
+			}
+		}
+		if !idscheme.Match(template, v.String(), siblings) {
+			return "must match " + strings.ReplaceAll(template, "|", " or ")
+		}
+	default:
+		panic(fmt.Sprintf("validate: unknown rule %q", rule))
+	}
+	return ""
+}
+
+// measure returns the number a min/max rule compares against: the value
+// of numbers and the length of strings and slices.
+func measure(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.String:
+		return float64(utf8.RuneCountInString(v.String())), true
+	case reflect.Slice:
+		return float64(v.Len()), true
+	}
+	return 0, false
+}
+
+func unit(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return " characters"
+	case reflect.Slice:
+		return " items"
+	}
+	return ""
+}
+
+func parseArg(s string) float64 {
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		panic(fmt.Sprintf("validate: bad rule argument %q", s))
+	}
+	return n
+}
+
+// jsonValues returns the string fields of a struct by json name, for use
+// in format templates.
+func jsonValues(rv reflect.Value) map[string]string {
+	m := make(map[string]string)
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		fv := rv.Field(i)
+		if fv.Kind() == reflect.Pointer && !fv.IsNil() {
+			fv = fv.Elem()
+		}
+		if fv.Kind() == reflect.String {
+			m[jsonName(f)] = fv.String()
+		}
+	}
+	return m
+}
+
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return f.Name
+	}
+	return name
+}
@@ -0,0 +1,195 @@
+package webvtt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultEventFormat applies when the [Events] section has no Format line.
+var defaultEventFormat = []string{"layer", "start", "end", "style", "name", "marginl", "marginr", "marginv", "effect", "text"}
+
+// ParseASS reads the Dialogue lines of the [Events] section of an ASS or
+// SSA script. Dialogue without visible text, such as drawings, is skipped.
+func ParseASS(text string) ([]Cue, error) {
+	var cues []Cue
+	format := defaultEventFormat
+	inEvents := false
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "[") {
+			inEvents = strings.EqualFold(line, "[Events]")
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !inEvents || !ok {
+			continue
+		}
+		switch strings.ToLower(key) {
+		case "format":
+			format = format[:0:0]
+			for _, f := range strings.Split(value, ",") {
+				format = append(format, strings.ToLower(strings.TrimSpace(f)))
+			}
+		case "dialogue":
+			fields := strings.SplitN(strings.TrimSpace(value), ",", len(format))
+			if len(fields) < len(format) {
+				return nil, fmt.Errorf("line %d: expected %d fields", i+1, len(format))
+			}
+			var cue Cue
+			var err1, err2 error
+			for j, name := range format {
+				switch name {
+				case "start":
+					cue.Start, err1 = assTime(fields[j])
+				case "end":
+					cue.End, err2 = assTime(fields[j])
+				case "text":
+					cue.Text = assText(fields[j])
+				}
+			}
+			if err1 != nil || err2 != nil {
+				return nil, fmt.Errorf("line %d: invalid timing", i+1)
+			}
+			if cue.Text != "" {
+				cues = append(cues, cue)
+			}
+		}
+	}
+	return cues, nil
+}
+
+// assTime reads h:mm:ss.cc.
+func assTime(s string) (time.Duration, error) {
+	clock, frac, _ := strings.Cut(strings.TrimSpace(s), ".")
+	d, err := srtTime(clock)
+	if err != nil || len(strings.Split(clock, ":")) != 3 {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	if frac != "" {
+		if len(frac) > 2 {
+			frac = frac[:2]
+		}
+		n, err := strconv.Atoi(frac + strings.Repeat("0", 2-len(frac)))
+		if err != nil {
+			return 0, fmt.Errorf("invalid time %q", s)
+		}
+		d += time.Duration(n) * 10 * time.Millisecond
+	}
+	return d, nil
+}
+
+// assStyle tracks the bold, italic and underline overrides of a line.
+type assStyle struct {
+	bold, italic, underline bool
+}
+
+// tags returns the WebVTT tags opening s.
+func (s assStyle) tags() []string {
+	var tags []string
+	if s.bold {
+		tags = append(tags, "b")
+	}
+	if s.italic {
+		tags = append(tags, "i")
+	}
+	if s.underline {
+		tags = append(tags, "u")
+	}
+	return tags
+}
+
+// apply changes s by the tags of an override block, e.g. `\i1\fs20`.
+// changed reports whether the block sets drawing mode, and drawing whether
+// it turns it on.
+func (s *assStyle) apply(block string) (drawing, changed bool) {
+	for _, tag := range strings.Split(block, `\`) {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		switch name, arg := tag[:1], tag[1:]; {
+		case name == "r":
+			*s = assStyle{}
+		case name == "p" && isNumber(arg):
+			drawing, changed = arg != "0", true
+		case name == "b" && (arg == "" || isNumber(arg)):
+			s.bold = arg != "" && arg != "0"
+		case name == "i" && (arg == "" || isNumber(arg)):
+			s.italic = arg == "1"
+		case name == "u" && (arg == "" || isNumber(arg)):
+			s.underline = arg == "1"
+		}
+	}
+	return drawing, changed
+}
+
+// assText converts the text field of a Dialogue line. Style changes close
+// every open tag and reopen the new set, so tags always nest.
+func assText(field string) string {
+	var (
+		b       strings.Builder
+		style   assStyle
+		open    []string
+		drawing bool
+	)
+	write := func(text string) {
+		if drawing || text == "" {
+			return
+		}
+		if want := style.tags(); strings.Join(want, ",") != strings.Join(open, ",") {
+			for k := len(open) - 1; k >= 0; k-- {
+				b.WriteString("</" + open[k] + ">")
+			}
+			for _, t := range want {
+				b.WriteString("<" + t + ">")
+			}
+			open = want
+		}
+		b.WriteString(text)
+	}
+	text := field
+	for text != "" {
+		if text[0] == '{' {
+			if end := strings.IndexByte(text, '}'); end >= 0 {
+				if d, ok := style.apply(text[1:end]); ok {
+					drawing = d
+				}
+				text = text[end+1:]
+				continue
+			}
+		}
+		if text[0] == '\\' && len(text) > 1 {
+			switch text[1] {
+			case 'N':
+				write("\n")
+				text = text[2:]
+				continue
+			case 'n':
+				write(" ")
+				text = text[2:]
+				continue
+			case 'h':
+				write("\u00a0")
+				text = text[2:]
+				continue
+			}
+		}
+		next := strings.IndexAny(text[1:], `{\`) + 1
+		if next == 0 {
+			next = len(text)
+		}
+		write(escaper.Replace(text[:next]))
+		text = text[next:]
+	}
+	for k := len(open) - 1; k >= 0; k-- {
+		b.WriteString("</" + open[k] + ">")
+	}
+	return joinLines(strings.Split(b.String(), "\n"))
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
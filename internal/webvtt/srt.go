@@ -0,0 +1,122 @@
+package webvtt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseSRT reads the cues of a SubRip file. Blocks without a timing line
+// are skipped; a timing line that cannot be read is an error.
+func ParseSRT(text string) ([]Cue, error) {
+	var cues []Cue
+	lines := strings.Split(text, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if !strings.Contains(line, "-->") {
+			continue
+		}
+		start, end, err := srtTiming(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		var body []string
+		for i+1 < len(lines) && strings.TrimSpace(lines[i+1]) != "" {
+			i++
+			body = append(body, srtText(strings.TrimRight(lines[i], " \t")))
+		}
+		if text := joinLines(body); text != "" {
+			cues = append(cues, Cue{Start: start, End: end, Text: text})
+		}
+	}
+	return cues, nil
+}
+
+// srtTiming reads "00:00:01,000 --> 00:00:02,500", ignoring the
+// coordinates some files append.
+func srtTiming(line string) (time.Duration, time.Duration, error) {
+	from, to, _ := strings.Cut(line, "-->")
+	fields := strings.Fields(to)
+	if len(fields) == 0 {
+		return 0, 0, fmt.Errorf("invalid timing %q", line)
+	}
+	start, err1 := srtTime(strings.TrimSpace(from))
+	end, err2 := srtTime(fields[0])
+	if err1 != nil || err2 != nil {
+		return 0, 0, fmt.Errorf("invalid timing %q", line)
+	}
+	return start, end, nil
+}
+
+// srtTime reads [hh:]mm:ss[,mmm]; a period may replace the comma.
+func srtTime(s string) (time.Duration, error) {
+	clock, frac, _ := strings.Cut(strings.Replace(s, ",", ".", 1), ".")
+	parts := strings.Split(clock, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	var d time.Duration
+	for _, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid time %q", s)
+		}
+		d = d*60 + time.Duration(n)*time.Second
+	}
+	if frac != "" {
+		if len(frac) > 3 {
+			frac = frac[:3]
+		}
+		n, err := strconv.Atoi(frac + strings.Repeat("0", 3-len(frac)))
+		if err != nil {
+			return 0, fmt.Errorf("invalid time %q", s)
+		}
+		d += time.Duration(n) * time.Millisecond
+	}
+	return d, nil
+}
+
+// srtText keeps the <b>, <i> and <u> tags of a line of SubRip text and
+// drops other tags, such as <font>, and ASS override blocks like {\an8}.
+func srtText(line string) string {
+	var b strings.Builder
+	for line != "" {
+		switch {
+		case line[0] == '<':
+			end := strings.IndexByte(line, '>')
+			if end < 0 {
+				break
+			}
+			tag := strings.ToLower(strings.TrimSpace(line[1:end]))
+			name := strings.TrimPrefix(tag, "/")
+			switch {
+			case name == "b" || name == "i" || name == "u":
+				b.WriteString("<" + tag + ">")
+			case name != "" && isLetter(name[0]):
+			default:
+				b.WriteString(escaper.Replace(line[:end+1]))
+			}
+			line = line[end+1:]
+			continue
+		case strings.HasPrefix(line, `{\`):
+			end := strings.IndexByte(line, '}')
+			if end < 0 {
+				break
+			}
+			line = line[end+1:]
+			continue
+		}
+		next := strings.IndexAny(line[1:], "<{") + 1
+		if next == 0 {
+			next = len(line)
+		}
+		b.WriteString(escaper.Replace(line[:next]))
+		line = line[next:]
+	}
+	return b.String()
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
@@ -0,0 +1,101 @@
+// Package webvtt converts SubRip (SRT) and Advanced SubStation Alpha
+// (ASS/SSA) subtitles to WebVTT, which browsers play natively.
+//
+// Only timing, text, line breaks and bold, italic and underline survive
+// the conversion; positioning, colors, fonts and karaoke effects of the
+// source are dropped.
+package webvtt
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ErrNoCues is returned for sources without a single cue.
+var ErrNoCues = errors.New("no subtitles found")
+
+// Cue is one subtitle with its WebVTT payload.
+type Cue struct {
+	Start, End time.Duration
+	Text       string
+}
+
+// Convert renders src, a subtitle file in format "srt", "ass", "ssa" or
+// "vtt", as WebVTT. WebVTT sources are returned as they are.
+func Convert(format string, src []byte) (string, error) {
+	if !utf8.Valid(src) {
+		return "", errors.New("subtitles must be UTF-8")
+	}
+	src = bytes.TrimPrefix(src, []byte("\ufeff"))
+	text := strings.ReplaceAll(string(src), "\r\n", "\n")
+	var (
+		cues []Cue
+		err  error
+	)
+	switch format {
+	case "vtt":
+		if !strings.HasPrefix(text, "WEBVTT") {
+			return "", errors.New("missing WEBVTT header")
+		}
+		return text, nil
+	case "srt":
+		cues, err = ParseSRT(text)
+	case "ass", "ssa":
+		cues, err = ParseASS(text)
+	default:
+		return "", fmt.Errorf("unsupported subtitle format %q", format)
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(cues) == 0 {
+		return "", ErrNoCues
+	}
+	return Render(cues), nil
+}
+
+// Render writes cues as a WebVTT file, ordered by start time as WebVTT
+// requires.
+func Render(cues []Cue) string {
+	cues = slices.Clone(cues)
+	slices.SortStableFunc(cues, func(a, b Cue) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+	var b strings.Builder
+	b.WriteString("WEBVTT\n")
+	for _, c := range cues {
+		fmt.Fprintf(&b, "\n%s --> %s\n%s\n", timestamp(c.Start), timestamp(c.End), c.Text)
+	}
+	return b.String()
+}
+
+// timestamp formats d as hh:mm:ss.ttt.
+func timestamp(d time.Duration) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
+
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// Escape makes plain text safe as a cue payload.
+func Escape(s string) string {
+	return joinLines(strings.Split(escaper.Replace(s), "\n"))
+}
+
+// joinLines joins the lines of a cue, dropping blank ones, which would end
+// the cue early.
+func joinLines(lines []string) string {
+	kept := lines[:0:0]
+	for _, l := range lines {
+		if strings.TrimSpace(l) != "" {
+			kept = append(kept, l)
+		}
+	}
+	return strings.Join(kept, "\n")
+}
@@ -1,16 +1,67 @@
 package main
 
 import (
+	"cmp"
 	"log"
 	"net/http"
+	"os"
 
+	"github.com/clustlight/animatrix-api/ent"
 	"github.com/clustlight/animatrix-api/internal"
+	"github.com/clustlight/animatrix-api/internal/auth"
+	"github.com/clustlight/animatrix-api/internal/cli"
+	"github.com/clustlight/animatrix-api/internal/imgproxy"
+	"github.com/clustlight/animatrix-api/internal/respcache"
+	"github.com/clustlight/animatrix-api/internal/rpc"
+	"github.com/clustlight/animatrix-api/internal/storage"
 	"github.com/clustlight/animatrix-api/internal/utils"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		if err := cli.Run(os.Args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	client := utils.NewDBClient()
 	defer client.Close()
+
+	images, err := imgproxy.FromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	imgproxy.Configure(images)
+	objects, err := storage.FromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	storage.Configure(objects)
+	access, err := auth.FromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	auth.Configure(access)
+
+	cache, err := respcache.FromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if lifetime := objects.MinLifetime(); lifetime > 0 {
+		// Cached responses keep at least half of their URLs' lifetime.
+		cache.Limit(lifetime / 2)
+	}
+	if cache != nil {
+		client.Use(cache.Hook(ent.TypeAPIKey, ent.TypeUser, ent.TypeWatchProgress, ent.TypeWatchlist, ent.TypeWatchlistItem))
+	}
+
+	grpcAddr := cmp.Or(os.Getenv("GRPC_ADDR"), ":9090")
+	go func() {
+		log.Println("gRPC server started at " + grpcAddr)
+		log.Fatal(rpc.ListenAndServe(grpcAddr, rpc.NewServer(client)))
+	}()
+
 	log.Println("server started at :8080")
-	http.ListenAndServe(":8080", internal.NewRouter(client))
+	http.ListenAndServe(":8080", internal.NewRouter(client, cache))
 }
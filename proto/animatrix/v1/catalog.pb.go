@@ -0,0 +1,2146 @@
+// Catalog service for ingest workers. Mirrors the /v1 REST API; see
+// internal/rpc for the server. Served without TLS on GRPC_ADDR (default
+// :9090).
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: animatrix/v1/catalog.proto
+
+package animatrixv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Series struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId      string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TitleYomi     string                 `protobuf:"bytes,3,opt,name=title_yomi,json=titleYomi,proto3" json:"title_yomi,omitempty"`
+	TitleEn       string                 `protobuf:"bytes,4,opt,name=title_en,json=titleEn,proto3" json:"title_en,omitempty"`
+	ThumbnailUrl  string                 `protobuf:"bytes,5,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	PortraitUrl   string                 `protobuf:"bytes,6,opt,name=portrait_url,json=portraitUrl,proto3" json:"portrait_url,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Seasons       []*Season              `protobuf:"bytes,8,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Series) Reset() {
+	*x = Series{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Series) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Series) ProtoMessage() {}
+
+func (x *Series) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Series.ProtoReflect.Descriptor instead.
+func (*Series) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Series) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *Series) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Series) GetTitleYomi() string {
+	if x != nil {
+		return x.TitleYomi
+	}
+	return ""
+}
+
+func (x *Series) GetTitleEn() string {
+	if x != nil {
+		return x.TitleEn
+	}
+	return ""
+}
+
+func (x *Series) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Series) GetPortraitUrl() string {
+	if x != nil {
+		return x.PortraitUrl
+	}
+	return ""
+}
+
+func (x *Series) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Series) GetSeasons() []*Season {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+func (x *Series) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Season struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId        string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	SeasonId        string                 `protobuf:"bytes,2,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	SeasonTitle     string                 `protobuf:"bytes,3,opt,name=season_title,json=seasonTitle,proto3" json:"season_title,omitempty"`
+	SeasonTitleYomi string                 `protobuf:"bytes,4,opt,name=season_title_yomi,json=seasonTitleYomi,proto3" json:"season_title_yomi,omitempty"`
+	SeasonNumber    int32                  `protobuf:"varint,5,opt,name=season_number,json=seasonNumber,proto3" json:"season_number,omitempty"`
+	ShoboiTid       int32                  `protobuf:"varint,6,opt,name=shoboi_tid,json=shoboiTid,proto3" json:"shoboi_tid,omitempty"`
+	Description     string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	FirstYear       int32                  `protobuf:"varint,8,opt,name=first_year,json=firstYear,proto3" json:"first_year,omitempty"`
+	FirstMonth      int32                  `protobuf:"varint,9,opt,name=first_month,json=firstMonth,proto3" json:"first_month,omitempty"`
+	FirstEndYear    int32                  `protobuf:"varint,10,opt,name=first_end_year,json=firstEndYear,proto3" json:"first_end_year,omitempty"`
+	FirstEndMonth   int32                  `protobuf:"varint,11,opt,name=first_end_month,json=firstEndMonth,proto3" json:"first_end_month,omitempty"`
+	ThumbnailUrl    string                 `protobuf:"bytes,12,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Episodes        []*Episode             `protobuf:"bytes,13,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Season) Reset() {
+	*x = Season{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Season) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Season) ProtoMessage() {}
+
+func (x *Season) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Season.ProtoReflect.Descriptor instead.
+func (*Season) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Season) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *Season) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *Season) GetSeasonTitle() string {
+	if x != nil {
+		return x.SeasonTitle
+	}
+	return ""
+}
+
+func (x *Season) GetSeasonTitleYomi() string {
+	if x != nil {
+		return x.SeasonTitleYomi
+	}
+	return ""
+}
+
+func (x *Season) GetSeasonNumber() int32 {
+	if x != nil {
+		return x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *Season) GetShoboiTid() int32 {
+	if x != nil {
+		return x.ShoboiTid
+	}
+	return 0
+}
+
+func (x *Season) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Season) GetFirstYear() int32 {
+	if x != nil {
+		return x.FirstYear
+	}
+	return 0
+}
+
+func (x *Season) GetFirstMonth() int32 {
+	if x != nil {
+		return x.FirstMonth
+	}
+	return 0
+}
+
+func (x *Season) GetFirstEndYear() int32 {
+	if x != nil {
+		return x.FirstEndYear
+	}
+	return 0
+}
+
+func (x *Season) GetFirstEndMonth() int32 {
+	if x != nil {
+		return x.FirstEndMonth
+	}
+	return 0
+}
+
+func (x *Season) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Season) GetEpisodes() []*Episode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+func (x *Season) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Episode struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId      string                 `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	EpisodeNumber  int32                  `protobuf:"varint,3,opt,name=episode_number,json=episodeNumber,proto3" json:"episode_number,omitempty"`
+	Duration       float64                `protobuf:"fixed64,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	DurationString string                 `protobuf:"bytes,5,opt,name=duration_string,json=durationString,proto3" json:"duration_string,omitempty"`
+	Timestamp      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FormatId       string                 `protobuf:"bytes,7,opt,name=format_id,json=formatId,proto3" json:"format_id,omitempty"`
+	Width          int32                  `protobuf:"varint,8,opt,name=width,proto3" json:"width,omitempty"`
+	Height         int32                  `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	DynamicRange   string                 `protobuf:"bytes,10,opt,name=dynamic_range,json=dynamicRange,proto3" json:"dynamic_range,omitempty"`
+	VideoUrl       string                 `protobuf:"bytes,11,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
+	ThumbnailUrl   string                 `protobuf:"bytes,12,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Description    string                 `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Episode) Reset() {
+	*x = Episode{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Episode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Episode) ProtoMessage() {}
+
+func (x *Episode) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Episode.ProtoReflect.Descriptor instead.
+func (*Episode) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Episode) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *Episode) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Episode) GetEpisodeNumber() int32 {
+	if x != nil {
+		return x.EpisodeNumber
+	}
+	return 0
+}
+
+func (x *Episode) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Episode) GetDurationString() string {
+	if x != nil {
+		return x.DurationString
+	}
+	return ""
+}
+
+func (x *Episode) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Episode) GetFormatId() string {
+	if x != nil {
+		return x.FormatId
+	}
+	return ""
+}
+
+func (x *Episode) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Episode) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Episode) GetDynamicRange() string {
+	if x != nil {
+		return x.DynamicRange
+	}
+	return ""
+}
+
+func (x *Episode) GetVideoUrl() string {
+	if x != nil {
+		return x.VideoUrl
+	}
+	return ""
+}
+
+func (x *Episode) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Episode) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Episode) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeriesRequest) Reset() {
+	*x = ListSeriesRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeriesRequest) ProtoMessage() {}
+
+func (x *ListSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeriesRequest.ProtoReflect.Descriptor instead.
+func (*ListSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{3}
+}
+
+type ListSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        []*Series              `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeriesResponse) Reset() {
+	*x = ListSeriesResponse{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeriesResponse) ProtoMessage() {}
+
+func (x *ListSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeriesResponse.ProtoReflect.Descriptor instead.
+func (*ListSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSeriesResponse) GetSeries() []*Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type GetSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId      string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeriesRequest) Reset() {
+	*x = GetSeriesRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeriesRequest) ProtoMessage() {}
+
+func (x *GetSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+type CreateSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId      string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TitleYomi     string                 `protobuf:"bytes,3,opt,name=title_yomi,json=titleYomi,proto3" json:"title_yomi,omitempty"`
+	TitleEn       string                 `protobuf:"bytes,4,opt,name=title_en,json=titleEn,proto3" json:"title_en,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSeriesRequest) Reset() {
+	*x = CreateSeriesRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSeriesRequest) ProtoMessage() {}
+
+func (x *CreateSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSeriesRequest.ProtoReflect.Descriptor instead.
+func (*CreateSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *CreateSeriesRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateSeriesRequest) GetTitleYomi() string {
+	if x != nil {
+		return x.TitleYomi
+	}
+	return ""
+}
+
+func (x *CreateSeriesRequest) GetTitleEn() string {
+	if x != nil {
+		return x.TitleEn
+	}
+	return ""
+}
+
+func (x *CreateSeriesRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId      string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	TitleYomi     *string                `protobuf:"bytes,3,opt,name=title_yomi,json=titleYomi,proto3,oneof" json:"title_yomi,omitempty"`
+	TitleEn       *string                `protobuf:"bytes,4,opt,name=title_en,json=titleEn,proto3,oneof" json:"title_en,omitempty"`
+	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSeriesRequest) Reset() {
+	*x = UpdateSeriesRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeriesRequest) ProtoMessage() {}
+
+func (x *UpdateSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeriesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *UpdateSeriesRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateSeriesRequest) GetTitleYomi() string {
+	if x != nil && x.TitleYomi != nil {
+		return *x.TitleYomi
+	}
+	return ""
+}
+
+func (x *UpdateSeriesRequest) GetTitleEn() string {
+	if x != nil && x.TitleEn != nil {
+		return *x.TitleEn
+	}
+	return ""
+}
+
+func (x *UpdateSeriesRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type BulkCreateSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        []*CreateSeriesRequest `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateSeriesRequest) Reset() {
+	*x = BulkCreateSeriesRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateSeriesRequest) ProtoMessage() {}
+
+func (x *BulkCreateSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateSeriesRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BulkCreateSeriesRequest) GetSeries() []*CreateSeriesRequest {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type ListSeasonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeasonsRequest) Reset() {
+	*x = ListSeasonsRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeasonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonsRequest) ProtoMessage() {}
+
+func (x *ListSeasonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonsRequest.ProtoReflect.Descriptor instead.
+func (*ListSeasonsRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{9}
+}
+
+type ListSeasonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seasons       []*Season              `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeasonsResponse) Reset() {
+	*x = ListSeasonsResponse{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeasonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonsResponse) ProtoMessage() {}
+
+func (x *ListSeasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonsResponse.ProtoReflect.Descriptor instead.
+func (*ListSeasonsResponse) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSeasonsResponse) GetSeasons() []*Season {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+type GetSeasonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeasonRequest) Reset() {
+	*x = GetSeasonRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonRequest) ProtoMessage() {}
+
+func (x *GetSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonRequest.ProtoReflect.Descriptor instead.
+func (*GetSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetSeasonRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+type CreateSeasonRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// Generated from the series ID and season number when empty.
+	SeasonId        string  `protobuf:"bytes,2,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	SeasonTitle     string  `protobuf:"bytes,3,opt,name=season_title,json=seasonTitle,proto3" json:"season_title,omitempty"`
+	SeasonTitleYomi *string `protobuf:"bytes,4,opt,name=season_title_yomi,json=seasonTitleYomi,proto3,oneof" json:"season_title_yomi,omitempty"`
+	SeasonNumber    int32   `protobuf:"varint,5,opt,name=season_number,json=seasonNumber,proto3" json:"season_number,omitempty"`
+	ShoboiTid       *int32  `protobuf:"varint,6,opt,name=shoboi_tid,json=shoboiTid,proto3,oneof" json:"shoboi_tid,omitempty"`
+	Description     *string `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	FirstYear       *int32  `protobuf:"varint,8,opt,name=first_year,json=firstYear,proto3,oneof" json:"first_year,omitempty"`
+	FirstMonth      *int32  `protobuf:"varint,9,opt,name=first_month,json=firstMonth,proto3,oneof" json:"first_month,omitempty"`
+	FirstEndYear    *int32  `protobuf:"varint,10,opt,name=first_end_year,json=firstEndYear,proto3,oneof" json:"first_end_year,omitempty"`
+	FirstEndMonth   *int32  `protobuf:"varint,11,opt,name=first_end_month,json=firstEndMonth,proto3,oneof" json:"first_end_month,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateSeasonRequest) Reset() {
+	*x = CreateSeasonRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSeasonRequest) ProtoMessage() {}
+
+func (x *CreateSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSeasonRequest.ProtoReflect.Descriptor instead.
+func (*CreateSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateSeasonRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *CreateSeasonRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *CreateSeasonRequest) GetSeasonTitle() string {
+	if x != nil {
+		return x.SeasonTitle
+	}
+	return ""
+}
+
+func (x *CreateSeasonRequest) GetSeasonTitleYomi() string {
+	if x != nil && x.SeasonTitleYomi != nil {
+		return *x.SeasonTitleYomi
+	}
+	return ""
+}
+
+func (x *CreateSeasonRequest) GetSeasonNumber() int32 {
+	if x != nil {
+		return x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *CreateSeasonRequest) GetShoboiTid() int32 {
+	if x != nil && x.ShoboiTid != nil {
+		return *x.ShoboiTid
+	}
+	return 0
+}
+
+func (x *CreateSeasonRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateSeasonRequest) GetFirstYear() int32 {
+	if x != nil && x.FirstYear != nil {
+		return *x.FirstYear
+	}
+	return 0
+}
+
+func (x *CreateSeasonRequest) GetFirstMonth() int32 {
+	if x != nil && x.FirstMonth != nil {
+		return *x.FirstMonth
+	}
+	return 0
+}
+
+func (x *CreateSeasonRequest) GetFirstEndYear() int32 {
+	if x != nil && x.FirstEndYear != nil {
+		return *x.FirstEndYear
+	}
+	return 0
+}
+
+func (x *CreateSeasonRequest) GetFirstEndMonth() int32 {
+	if x != nil && x.FirstEndMonth != nil {
+		return *x.FirstEndMonth
+	}
+	return 0
+}
+
+type UpdateSeasonRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId        string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	SeasonTitle     *string                `protobuf:"bytes,2,opt,name=season_title,json=seasonTitle,proto3,oneof" json:"season_title,omitempty"`
+	SeasonTitleYomi *string                `protobuf:"bytes,3,opt,name=season_title_yomi,json=seasonTitleYomi,proto3,oneof" json:"season_title_yomi,omitempty"`
+	SeasonNumber    *int32                 `protobuf:"varint,4,opt,name=season_number,json=seasonNumber,proto3,oneof" json:"season_number,omitempty"`
+	ShoboiTid       *int32                 `protobuf:"varint,5,opt,name=shoboi_tid,json=shoboiTid,proto3,oneof" json:"shoboi_tid,omitempty"`
+	Description     *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	FirstYear       *int32                 `protobuf:"varint,7,opt,name=first_year,json=firstYear,proto3,oneof" json:"first_year,omitempty"`
+	FirstMonth      *int32                 `protobuf:"varint,8,opt,name=first_month,json=firstMonth,proto3,oneof" json:"first_month,omitempty"`
+	FirstEndYear    *int32                 `protobuf:"varint,9,opt,name=first_end_year,json=firstEndYear,proto3,oneof" json:"first_end_year,omitempty"`
+	FirstEndMonth   *int32                 `protobuf:"varint,10,opt,name=first_end_month,json=firstEndMonth,proto3,oneof" json:"first_end_month,omitempty"`
+	// Moves the season to another series.
+	SeriesId      *string `protobuf:"bytes,11,opt,name=series_id,json=seriesId,proto3,oneof" json:"series_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSeasonRequest) Reset() {
+	*x = UpdateSeasonRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeasonRequest) ProtoMessage() {}
+
+func (x *UpdateSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeasonRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateSeasonRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *UpdateSeasonRequest) GetSeasonTitle() string {
+	if x != nil && x.SeasonTitle != nil {
+		return *x.SeasonTitle
+	}
+	return ""
+}
+
+func (x *UpdateSeasonRequest) GetSeasonTitleYomi() string {
+	if x != nil && x.SeasonTitleYomi != nil {
+		return *x.SeasonTitleYomi
+	}
+	return ""
+}
+
+func (x *UpdateSeasonRequest) GetSeasonNumber() int32 {
+	if x != nil && x.SeasonNumber != nil {
+		return *x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *UpdateSeasonRequest) GetShoboiTid() int32 {
+	if x != nil && x.ShoboiTid != nil {
+		return *x.ShoboiTid
+	}
+	return 0
+}
+
+func (x *UpdateSeasonRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSeasonRequest) GetFirstYear() int32 {
+	if x != nil && x.FirstYear != nil {
+		return *x.FirstYear
+	}
+	return 0
+}
+
+func (x *UpdateSeasonRequest) GetFirstMonth() int32 {
+	if x != nil && x.FirstMonth != nil {
+		return *x.FirstMonth
+	}
+	return 0
+}
+
+func (x *UpdateSeasonRequest) GetFirstEndYear() int32 {
+	if x != nil && x.FirstEndYear != nil {
+		return *x.FirstEndYear
+	}
+	return 0
+}
+
+func (x *UpdateSeasonRequest) GetFirstEndMonth() int32 {
+	if x != nil && x.FirstEndMonth != nil {
+		return *x.FirstEndMonth
+	}
+	return 0
+}
+
+func (x *UpdateSeasonRequest) GetSeriesId() string {
+	if x != nil && x.SeriesId != nil {
+		return *x.SeriesId
+	}
+	return ""
+}
+
+type BulkCreateSeasonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seasons       []*CreateSeasonRequest `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateSeasonsRequest) Reset() {
+	*x = BulkCreateSeasonsRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateSeasonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateSeasonsRequest) ProtoMessage() {}
+
+func (x *BulkCreateSeasonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateSeasonsRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateSeasonsRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BulkCreateSeasonsRequest) GetSeasons() []*CreateSeasonRequest {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+type ListEpisodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEpisodesRequest) Reset() {
+	*x = ListEpisodesRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEpisodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpisodesRequest) ProtoMessage() {}
+
+func (x *ListEpisodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEpisodesRequest.ProtoReflect.Descriptor instead.
+func (*ListEpisodesRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{15}
+}
+
+type ListEpisodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Episodes      []*Episode             `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEpisodesResponse) Reset() {
+	*x = ListEpisodesResponse{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEpisodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpisodesResponse) ProtoMessage() {}
+
+func (x *ListEpisodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEpisodesResponse.ProtoReflect.Descriptor instead.
+func (*ListEpisodesResponse) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListEpisodesResponse) GetEpisodes() []*Episode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+type GetEpisodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodeRequest) Reset() {
+	*x = GetEpisodeRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodeRequest) ProtoMessage() {}
+
+func (x *GetEpisodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodeRequest.ProtoReflect.Descriptor instead.
+func (*GetEpisodeRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetEpisodeRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+type CreateEpisodeRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	// Generated from the season ID and episode number when empty.
+	EpisodeId      string                 `protobuf:"bytes,2,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	EpisodeNumber  int32                  `protobuf:"varint,4,opt,name=episode_number,json=episodeNumber,proto3" json:"episode_number,omitempty"`
+	Duration       float64                `protobuf:"fixed64,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	DurationString string                 `protobuf:"bytes,6,opt,name=duration_string,json=durationString,proto3" json:"duration_string,omitempty"`
+	Timestamp      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FormatId       string                 `protobuf:"bytes,8,opt,name=format_id,json=formatId,proto3" json:"format_id,omitempty"`
+	Width          int32                  `protobuf:"varint,9,opt,name=width,proto3" json:"width,omitempty"`
+	Height         int32                  `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+	DynamicRange   string                 `protobuf:"bytes,11,opt,name=dynamic_range,json=dynamicRange,proto3" json:"dynamic_range,omitempty"`
+	Metadata       string                 `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Description    string                 `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateEpisodeRequest) Reset() {
+	*x = CreateEpisodeRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEpisodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEpisodeRequest) ProtoMessage() {}
+
+func (x *CreateEpisodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEpisodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateEpisodeRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateEpisodeRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *CreateEpisodeRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *CreateEpisodeRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateEpisodeRequest) GetEpisodeNumber() int32 {
+	if x != nil {
+		return x.EpisodeNumber
+	}
+	return 0
+}
+
+func (x *CreateEpisodeRequest) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CreateEpisodeRequest) GetDurationString() string {
+	if x != nil {
+		return x.DurationString
+	}
+	return ""
+}
+
+func (x *CreateEpisodeRequest) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *CreateEpisodeRequest) GetFormatId() string {
+	if x != nil {
+		return x.FormatId
+	}
+	return ""
+}
+
+func (x *CreateEpisodeRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CreateEpisodeRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CreateEpisodeRequest) GetDynamicRange() string {
+	if x != nil {
+		return x.DynamicRange
+	}
+	return ""
+}
+
+func (x *CreateEpisodeRequest) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *CreateEpisodeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateEpisodeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId      string                 `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	Title          *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	EpisodeNumber  *int32                 `protobuf:"varint,3,opt,name=episode_number,json=episodeNumber,proto3,oneof" json:"episode_number,omitempty"`
+	Duration       *float64               `protobuf:"fixed64,4,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	DurationString *string                `protobuf:"bytes,5,opt,name=duration_string,json=durationString,proto3,oneof" json:"duration_string,omitempty"`
+	Timestamp      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FormatId       *string                `protobuf:"bytes,7,opt,name=format_id,json=formatId,proto3,oneof" json:"format_id,omitempty"`
+	Width          *int32                 `protobuf:"varint,8,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	Height         *int32                 `protobuf:"varint,9,opt,name=height,proto3,oneof" json:"height,omitempty"`
+	DynamicRange   *string                `protobuf:"bytes,10,opt,name=dynamic_range,json=dynamicRange,proto3,oneof" json:"dynamic_range,omitempty"`
+	Metadata       *string                `protobuf:"bytes,11,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+	Description    *string                `protobuf:"bytes,12,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateEpisodeRequest) Reset() {
+	*x = UpdateEpisodeRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEpisodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEpisodeRequest) ProtoMessage() {}
+
+func (x *UpdateEpisodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEpisodeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEpisodeRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateEpisodeRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *UpdateEpisodeRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateEpisodeRequest) GetEpisodeNumber() int32 {
+	if x != nil && x.EpisodeNumber != nil {
+		return *x.EpisodeNumber
+	}
+	return 0
+}
+
+func (x *UpdateEpisodeRequest) GetDuration() float64 {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return 0
+}
+
+func (x *UpdateEpisodeRequest) GetDurationString() string {
+	if x != nil && x.DurationString != nil {
+		return *x.DurationString
+	}
+	return ""
+}
+
+func (x *UpdateEpisodeRequest) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *UpdateEpisodeRequest) GetFormatId() string {
+	if x != nil && x.FormatId != nil {
+		return *x.FormatId
+	}
+	return ""
+}
+
+func (x *UpdateEpisodeRequest) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
+	}
+	return 0
+}
+
+func (x *UpdateEpisodeRequest) GetHeight() int32 {
+	if x != nil && x.Height != nil {
+		return *x.Height
+	}
+	return 0
+}
+
+func (x *UpdateEpisodeRequest) GetDynamicRange() string {
+	if x != nil && x.DynamicRange != nil {
+		return *x.DynamicRange
+	}
+	return ""
+}
+
+func (x *UpdateEpisodeRequest) GetMetadata() string {
+	if x != nil && x.Metadata != nil {
+		return *x.Metadata
+	}
+	return ""
+}
+
+func (x *UpdateEpisodeRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type BulkCreateEpisodesRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Episodes      []*CreateEpisodeRequest `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateEpisodesRequest) Reset() {
+	*x = BulkCreateEpisodesRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateEpisodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateEpisodesRequest) ProtoMessage() {}
+
+func (x *BulkCreateEpisodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateEpisodesRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateEpisodesRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BulkCreateEpisodesRequest) GetEpisodes() []*CreateEpisodeRequest {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type ImportError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1-based position of the record in the stream.
+	Line          int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportError) Reset() {
+	*x = ImportError{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportError) ProtoMessage() {}
+
+func (x *ImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportError.ProtoReflect.Descriptor instead.
+func (*ImportError) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportError) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ImportError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Batches       int32                  `protobuf:"varint,4,opt,name=batches,proto3" json:"batches,omitempty"`
+	Errors        []*ImportError         `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSummary) Reset() {
+	*x = ImportSummary{}
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSummary) ProtoMessage() {}
+
+func (x *ImportSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_animatrix_v1_catalog_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSummary.ProtoReflect.Descriptor instead.
+func (*ImportSummary) Descriptor() ([]byte, []int) {
+	return file_animatrix_v1_catalog_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportSummary) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetBatches() int32 {
+	if x != nil {
+		return x.Batches
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetErrors() []*ImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+var File_animatrix_v1_catalog_proto protoreflect.FileDescriptor
+
+const file_animatrix_v1_catalog_proto_rawDesc = "" +
+	"\n" +
+	"\x1aanimatrix/v1/catalog.proto\x12\fanimatrix.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xca\x02\n" +
+	"\x06Series\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"title_yomi\x18\x03 \x01(\tR\ttitleYomi\x12\x19\n" +
+	"\btitle_en\x18\x04 \x01(\tR\atitleEn\x12#\n" +
+	"\rthumbnail_url\x18\x05 \x01(\tR\fthumbnailUrl\x12!\n" +
+	"\fportrait_url\x18\x06 \x01(\tR\vportraitUrl\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12.\n" +
+	"\aseasons\x18\b \x03(\v2\x14.animatrix.v1.SeasonR\aseasons\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x98\x04\n" +
+	"\x06Season\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\x12!\n" +
+	"\fseason_title\x18\x03 \x01(\tR\vseasonTitle\x12*\n" +
+	"\x11season_title_yomi\x18\x04 \x01(\tR\x0fseasonTitleYomi\x12#\n" +
+	"\rseason_number\x18\x05 \x01(\x05R\fseasonNumber\x12\x1d\n" +
+	"\n" +
+	"shoboi_tid\x18\x06 \x01(\x05R\tshoboiTid\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"first_year\x18\b \x01(\x05R\tfirstYear\x12\x1f\n" +
+	"\vfirst_month\x18\t \x01(\x05R\n" +
+	"firstMonth\x12$\n" +
+	"\x0efirst_end_year\x18\n" +
+	" \x01(\x05R\ffirstEndYear\x12&\n" +
+	"\x0ffirst_end_month\x18\v \x01(\x05R\rfirstEndMonth\x12#\n" +
+	"\rthumbnail_url\x18\f \x01(\tR\fthumbnailUrl\x121\n" +
+	"\bepisodes\x18\r \x03(\v2\x15.animatrix.v1.EpisodeR\bepisodes\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf3\x03\n" +
+	"\aEpisode\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
+	"\x0eepisode_number\x18\x03 \x01(\x05R\repisodeNumber\x12\x1a\n" +
+	"\bduration\x18\x04 \x01(\x01R\bduration\x12'\n" +
+	"\x0fduration_string\x18\x05 \x01(\tR\x0edurationString\x128\n" +
+	"\ttimestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1b\n" +
+	"\tformat_id\x18\a \x01(\tR\bformatId\x12\x14\n" +
+	"\x05width\x18\b \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\t \x01(\x05R\x06height\x12#\n" +
+	"\rdynamic_range\x18\n" +
+	" \x01(\tR\fdynamicRange\x12\x1b\n" +
+	"\tvideo_url\x18\v \x01(\tR\bvideoUrl\x12#\n" +
+	"\rthumbnail_url\x18\f \x01(\tR\fthumbnailUrl\x12 \n" +
+	"\vdescription\x18\r \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x13\n" +
+	"\x11ListSeriesRequest\"B\n" +
+	"\x12ListSeriesResponse\x12,\n" +
+	"\x06series\x18\x01 \x03(\v2\x14.animatrix.v1.SeriesR\x06series\"/\n" +
+	"\x10GetSeriesRequest\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\"\xa4\x01\n" +
+	"\x13CreateSeriesRequest\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"title_yomi\x18\x03 \x01(\tR\ttitleYomi\x12\x19\n" +
+	"\btitle_en\x18\x04 \x01(\tR\atitleEn\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\xee\x01\n" +
+	"\x13UpdateSeriesRequest\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"title_yomi\x18\x03 \x01(\tH\x01R\ttitleYomi\x88\x01\x01\x12\x1e\n" +
+	"\btitle_en\x18\x04 \x01(\tH\x02R\atitleEn\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x03R\vdescription\x88\x01\x01B\b\n" +
+	"\x06_titleB\r\n" +
+	"\v_title_yomiB\v\n" +
+	"\t_title_enB\x0e\n" +
+	"\f_description\"T\n" +
+	"\x17BulkCreateSeriesRequest\x129\n" +
+	"\x06series\x18\x01 \x03(\v2!.animatrix.v1.CreateSeriesRequestR\x06series\"\x14\n" +
+	"\x12ListSeasonsRequest\"E\n" +
+	"\x13ListSeasonsResponse\x12.\n" +
+	"\aseasons\x18\x01 \x03(\v2\x14.animatrix.v1.SeasonR\aseasons\"/\n" +
+	"\x10GetSeasonRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\"\xb0\x04\n" +
+	"\x13CreateSeasonRequest\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\x12!\n" +
+	"\fseason_title\x18\x03 \x01(\tR\vseasonTitle\x12/\n" +
+	"\x11season_title_yomi\x18\x04 \x01(\tH\x00R\x0fseasonTitleYomi\x88\x01\x01\x12#\n" +
+	"\rseason_number\x18\x05 \x01(\x05R\fseasonNumber\x12\"\n" +
+	"\n" +
+	"shoboi_tid\x18\x06 \x01(\x05H\x01R\tshoboiTid\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\a \x01(\tH\x02R\vdescription\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"first_year\x18\b \x01(\x05H\x03R\tfirstYear\x88\x01\x01\x12$\n" +
+	"\vfirst_month\x18\t \x01(\x05H\x04R\n" +
+	"firstMonth\x88\x01\x01\x12)\n" +
+	"\x0efirst_end_year\x18\n" +
+	" \x01(\x05H\x05R\ffirstEndYear\x88\x01\x01\x12+\n" +
+	"\x0ffirst_end_month\x18\v \x01(\x05H\x06R\rfirstEndMonth\x88\x01\x01B\x14\n" +
+	"\x12_season_title_yomiB\r\n" +
+	"\v_shoboi_tidB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_first_yearB\x0e\n" +
+	"\f_first_monthB\x11\n" +
+	"\x0f_first_end_yearB\x12\n" +
+	"\x10_first_end_month\"\xf0\x04\n" +
+	"\x13UpdateSeasonRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12&\n" +
+	"\fseason_title\x18\x02 \x01(\tH\x00R\vseasonTitle\x88\x01\x01\x12/\n" +
+	"\x11season_title_yomi\x18\x03 \x01(\tH\x01R\x0fseasonTitleYomi\x88\x01\x01\x12(\n" +
+	"\rseason_number\x18\x04 \x01(\x05H\x02R\fseasonNumber\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"shoboi_tid\x18\x05 \x01(\x05H\x03R\tshoboiTid\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x04R\vdescription\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"first_year\x18\a \x01(\x05H\x05R\tfirstYear\x88\x01\x01\x12$\n" +
+	"\vfirst_month\x18\b \x01(\x05H\x06R\n" +
+	"firstMonth\x88\x01\x01\x12)\n" +
+	"\x0efirst_end_year\x18\t \x01(\x05H\aR\ffirstEndYear\x88\x01\x01\x12+\n" +
+	"\x0ffirst_end_month\x18\n" +
+	" \x01(\x05H\bR\rfirstEndMonth\x88\x01\x01\x12 \n" +
+	"\tseries_id\x18\v \x01(\tH\tR\bseriesId\x88\x01\x01B\x0f\n" +
+	"\r_season_titleB\x14\n" +
+	"\x12_season_title_yomiB\x10\n" +
+	"\x0e_season_numberB\r\n" +
+	"\v_shoboi_tidB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_first_yearB\x0e\n" +
+	"\f_first_monthB\x11\n" +
+	"\x0f_first_end_yearB\x12\n" +
+	"\x10_first_end_monthB\f\n" +
+	"\n" +
+	"_series_id\"W\n" +
+	"\x18BulkCreateSeasonsRequest\x12;\n" +
+	"\aseasons\x18\x01 \x03(\v2!.animatrix.v1.CreateSeasonRequestR\aseasons\"\x15\n" +
+	"\x13ListEpisodesRequest\"I\n" +
+	"\x14ListEpisodesResponse\x121\n" +
+	"\bepisodes\x18\x01 \x03(\v2\x15.animatrix.v1.EpisodeR\bepisodes\"2\n" +
+	"\x11GetEpisodeRequest\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\"\xbc\x03\n" +
+	"\x14CreateEpisodeRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x02 \x01(\tR\tepisodeId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12%\n" +
+	"\x0eepisode_number\x18\x04 \x01(\x05R\repisodeNumber\x12\x1a\n" +
+	"\bduration\x18\x05 \x01(\x01R\bduration\x12'\n" +
+	"\x0fduration_string\x18\x06 \x01(\tR\x0edurationString\x128\n" +
+	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1b\n" +
+	"\tformat_id\x18\b \x01(\tR\bformatId\x12\x14\n" +
+	"\x05width\x18\t \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\n" +
+	" \x01(\x05R\x06height\x12#\n" +
+	"\rdynamic_range\x18\v \x01(\tR\fdynamicRange\x12\x1a\n" +
+	"\bmetadata\x18\f \x01(\tR\bmetadata\x12 \n" +
+	"\vdescription\x18\r \x01(\tR\vdescription\"\xe1\x04\n" +
+	"\x14UpdateEpisodeRequest\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12*\n" +
+	"\x0eepisode_number\x18\x03 \x01(\x05H\x01R\repisodeNumber\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x04 \x01(\x01H\x02R\bduration\x88\x01\x01\x12,\n" +
+	"\x0fduration_string\x18\x05 \x01(\tH\x03R\x0edurationString\x88\x01\x01\x128\n" +
+	"\ttimestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12 \n" +
+	"\tformat_id\x18\a \x01(\tH\x04R\bformatId\x88\x01\x01\x12\x19\n" +
+	"\x05width\x18\b \x01(\x05H\x05R\x05width\x88\x01\x01\x12\x1b\n" +
+	"\x06height\x18\t \x01(\x05H\x06R\x06height\x88\x01\x01\x12(\n" +
+	"\rdynamic_range\x18\n" +
+	" \x01(\tH\aR\fdynamicRange\x88\x01\x01\x12\x1f\n" +
+	"\bmetadata\x18\v \x01(\tH\bR\bmetadata\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\f \x01(\tH\tR\vdescription\x88\x01\x01B\b\n" +
+	"\x06_titleB\x11\n" +
+	"\x0f_episode_numberB\v\n" +
+	"\t_durationB\x12\n" +
+	"\x10_duration_stringB\f\n" +
+	"\n" +
+	"_format_idB\b\n" +
+	"\x06_widthB\t\n" +
+	"\a_heightB\x10\n" +
+	"\x0e_dynamic_rangeB\v\n" +
+	"\t_metadataB\x0e\n" +
+	"\f_description\"[\n" +
+	"\x19BulkCreateEpisodesRequest\x12>\n" +
+	"\bepisodes\x18\x01 \x03(\v2\".animatrix.v1.CreateEpisodeRequestR\bepisodes\"%\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"[\n" +
+	"\vImportError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xa4\x01\n" +
+	"\rImportSummary\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12\x18\n" +
+	"\abatches\x18\x04 \x01(\x05R\abatches\x121\n" +
+	"\x06errors\x18\x05 \x03(\v2\x19.animatrix.v1.ImportErrorR\x06errors2\xcb\n" +
+	"\n" +
+	"\aCatalog\x12O\n" +
+	"\n" +
+	"ListSeries\x12\x1f.animatrix.v1.ListSeriesRequest\x1a .animatrix.v1.ListSeriesResponse\x12A\n" +
+	"\tGetSeries\x12\x1e.animatrix.v1.GetSeriesRequest\x1a\x14.animatrix.v1.Series\x12G\n" +
+	"\fCreateSeries\x12!.animatrix.v1.CreateSeriesRequest\x1a\x14.animatrix.v1.Series\x12G\n" +
+	"\fUpdateSeries\x12!.animatrix.v1.UpdateSeriesRequest\x1a\x14.animatrix.v1.Series\x12[\n" +
+	"\x10BulkCreateSeries\x12%.animatrix.v1.BulkCreateSeriesRequest\x1a .animatrix.v1.ListSeriesResponse\x12R\n" +
+	"\vListSeasons\x12 .animatrix.v1.ListSeasonsRequest\x1a!.animatrix.v1.ListSeasonsResponse\x12A\n" +
+	"\tGetSeason\x12\x1e.animatrix.v1.GetSeasonRequest\x1a\x14.animatrix.v1.Season\x12G\n" +
+	"\fCreateSeason\x12!.animatrix.v1.CreateSeasonRequest\x1a\x14.animatrix.v1.Season\x12G\n" +
+	"\fUpdateSeason\x12!.animatrix.v1.UpdateSeasonRequest\x1a\x14.animatrix.v1.Season\x12^\n" +
+	"\x11BulkCreateSeasons\x12&.animatrix.v1.BulkCreateSeasonsRequest\x1a!.animatrix.v1.ListSeasonsResponse\x12U\n" +
+	"\fListEpisodes\x12!.animatrix.v1.ListEpisodesRequest\x1a\".animatrix.v1.ListEpisodesResponse\x12D\n" +
+	"\n" +
+	"GetEpisode\x12\x1f.animatrix.v1.GetEpisodeRequest\x1a\x15.animatrix.v1.Episode\x12J\n" +
+	"\rCreateEpisode\x12\".animatrix.v1.CreateEpisodeRequest\x1a\x15.animatrix.v1.Episode\x12J\n" +
+	"\rUpdateEpisode\x12\".animatrix.v1.UpdateEpisodeRequest\x1a\x15.animatrix.v1.Episode\x12a\n" +
+	"\x12BulkCreateEpisodes\x12'.animatrix.v1.BulkCreateEpisodesRequest\x1a\".animatrix.v1.ListEpisodesResponse\x12S\n" +
+	"\x0eIngestEpisodes\x12\".animatrix.v1.CreateEpisodeRequest\x1a\x1b.animatrix.v1.ImportSummary(\x01\x12G\n" +
+	"\x06Search\x12\x1b.animatrix.v1.SearchRequest\x1a .animatrix.v1.ListSeriesResponseBDZBgithub.com/clustlight/animatrix-api/proto/animatrix/v1;animatrixv1b\x06proto3"
+
+var (
+	file_animatrix_v1_catalog_proto_rawDescOnce sync.Once
+	file_animatrix_v1_catalog_proto_rawDescData []byte
+)
+
+func file_animatrix_v1_catalog_proto_rawDescGZIP() []byte {
+	file_animatrix_v1_catalog_proto_rawDescOnce.Do(func() {
+		file_animatrix_v1_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_animatrix_v1_catalog_proto_rawDesc), len(file_animatrix_v1_catalog_proto_rawDesc)))
+	})
+	return file_animatrix_v1_catalog_proto_rawDescData
+}
+
+var file_animatrix_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_animatrix_v1_catalog_proto_goTypes = []any{
+	(*Series)(nil),                    // 0: animatrix.v1.Series
+	(*Season)(nil),                    // 1: animatrix.v1.Season
+	(*Episode)(nil),                   // 2: animatrix.v1.Episode
+	(*ListSeriesRequest)(nil),         // 3: animatrix.v1.ListSeriesRequest
+	(*ListSeriesResponse)(nil),        // 4: animatrix.v1.ListSeriesResponse
+	(*GetSeriesRequest)(nil),          // 5: animatrix.v1.GetSeriesRequest
+	(*CreateSeriesRequest)(nil),       // 6: animatrix.v1.CreateSeriesRequest
+	(*UpdateSeriesRequest)(nil),       // 7: animatrix.v1.UpdateSeriesRequest
+	(*BulkCreateSeriesRequest)(nil),   // 8: animatrix.v1.BulkCreateSeriesRequest
+	(*ListSeasonsRequest)(nil),        // 9: animatrix.v1.ListSeasonsRequest
+	(*ListSeasonsResponse)(nil),       // 10: animatrix.v1.ListSeasonsResponse
+	(*GetSeasonRequest)(nil),          // 11: animatrix.v1.GetSeasonRequest
+	(*CreateSeasonRequest)(nil),       // 12: animatrix.v1.CreateSeasonRequest
+	(*UpdateSeasonRequest)(nil),       // 13: animatrix.v1.UpdateSeasonRequest
+	(*BulkCreateSeasonsRequest)(nil),  // 14: animatrix.v1.BulkCreateSeasonsRequest
+	(*ListEpisodesRequest)(nil),       // 15: animatrix.v1.ListEpisodesRequest
+	(*ListEpisodesResponse)(nil),      // 16: animatrix.v1.ListEpisodesResponse
+	(*GetEpisodeRequest)(nil),         // 17: animatrix.v1.GetEpisodeRequest
+	(*CreateEpisodeRequest)(nil),      // 18: animatrix.v1.CreateEpisodeRequest
+	(*UpdateEpisodeRequest)(nil),      // 19: animatrix.v1.UpdateEpisodeRequest
+	(*BulkCreateEpisodesRequest)(nil), // 20: animatrix.v1.BulkCreateEpisodesRequest
+	(*SearchRequest)(nil),             // 21: animatrix.v1.SearchRequest
+	(*ImportError)(nil),               // 22: animatrix.v1.ImportError
+	(*ImportSummary)(nil),             // 23: animatrix.v1.ImportSummary
+	(*timestamppb.Timestamp)(nil),     // 24: google.protobuf.Timestamp
+}
+var file_animatrix_v1_catalog_proto_depIdxs = []int32{
+	1,  // 0: animatrix.v1.Series.seasons:type_name -> animatrix.v1.Season
+	24, // 1: animatrix.v1.Series.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 2: animatrix.v1.Season.episodes:type_name -> animatrix.v1.Episode
+	24, // 3: animatrix.v1.Season.updated_at:type_name -> google.protobuf.Timestamp
+	24, // 4: animatrix.v1.Episode.timestamp:type_name -> google.protobuf.Timestamp
+	24, // 5: animatrix.v1.Episode.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: animatrix.v1.ListSeriesResponse.series:type_name -> animatrix.v1.Series
+	6,  // 7: animatrix.v1.BulkCreateSeriesRequest.series:type_name -> animatrix.v1.CreateSeriesRequest
+	1,  // 8: animatrix.v1.ListSeasonsResponse.seasons:type_name -> animatrix.v1.Season
+	12, // 9: animatrix.v1.BulkCreateSeasonsRequest.seasons:type_name -> animatrix.v1.CreateSeasonRequest
+	2,  // 10: animatrix.v1.ListEpisodesResponse.episodes:type_name -> animatrix.v1.Episode
+	24, // 11: animatrix.v1.CreateEpisodeRequest.timestamp:type_name -> google.protobuf.Timestamp
+	24, // 12: animatrix.v1.UpdateEpisodeRequest.timestamp:type_name -> google.protobuf.Timestamp
+	18, // 13: animatrix.v1.BulkCreateEpisodesRequest.episodes:type_name -> animatrix.v1.CreateEpisodeRequest
+	22, // 14: animatrix.v1.ImportSummary.errors:type_name -> animatrix.v1.ImportError
+	3,  // 15: animatrix.v1.Catalog.ListSeries:input_type -> animatrix.v1.ListSeriesRequest
+	5,  // 16: animatrix.v1.Catalog.GetSeries:input_type -> animatrix.v1.GetSeriesRequest
+	6,  // 17: animatrix.v1.Catalog.CreateSeries:input_type -> animatrix.v1.CreateSeriesRequest
+	7,  // 18: animatrix.v1.Catalog.UpdateSeries:input_type -> animatrix.v1.UpdateSeriesRequest
+	8,  // 19: animatrix.v1.Catalog.BulkCreateSeries:input_type -> animatrix.v1.BulkCreateSeriesRequest
+	9,  // 20: animatrix.v1.Catalog.ListSeasons:input_type -> animatrix.v1.ListSeasonsRequest
+	11, // 21: animatrix.v1.Catalog.GetSeason:input_type -> animatrix.v1.GetSeasonRequest
+	12, // 22: animatrix.v1.Catalog.CreateSeason:input_type -> animatrix.v1.CreateSeasonRequest
+	13, // 23: animatrix.v1.Catalog.UpdateSeason:input_type -> animatrix.v1.UpdateSeasonRequest
+	14, // 24: animatrix.v1.Catalog.BulkCreateSeasons:input_type -> animatrix.v1.BulkCreateSeasonsRequest
+	15, // 25: animatrix.v1.Catalog.ListEpisodes:input_type -> animatrix.v1.ListEpisodesRequest
+	17, // 26: animatrix.v1.Catalog.GetEpisode:input_type -> animatrix.v1.GetEpisodeRequest
+	18, // 27: animatrix.v1.Catalog.CreateEpisode:input_type -> animatrix.v1.CreateEpisodeRequest
+	19, // 28: animatrix.v1.Catalog.UpdateEpisode:input_type -> animatrix.v1.UpdateEpisodeRequest
+	20, // 29: animatrix.v1.Catalog.BulkCreateEpisodes:input_type -> animatrix.v1.BulkCreateEpisodesRequest
+	18, // 30: animatrix.v1.Catalog.IngestEpisodes:input_type -> animatrix.v1.CreateEpisodeRequest
+	21, // 31: animatrix.v1.Catalog.Search:input_type -> animatrix.v1.SearchRequest
+	4,  // 32: animatrix.v1.Catalog.ListSeries:output_type -> animatrix.v1.ListSeriesResponse
+	0,  // 33: animatrix.v1.Catalog.GetSeries:output_type -> animatrix.v1.Series
+	0,  // 34: animatrix.v1.Catalog.CreateSeries:output_type -> animatrix.v1.Series
+	0,  // 35: animatrix.v1.Catalog.UpdateSeries:output_type -> animatrix.v1.Series
+	4,  // 36: animatrix.v1.Catalog.BulkCreateSeries:output_type -> animatrix.v1.ListSeriesResponse
+	10, // 37: animatrix.v1.Catalog.ListSeasons:output_type -> animatrix.v1.ListSeasonsResponse
+	1,  // 38: animatrix.v1.Catalog.GetSeason:output_type -> animatrix.v1.Season
+	1,  // 39: animatrix.v1.Catalog.CreateSeason:output_type -> animatrix.v1.Season
+	1,  // 40: animatrix.v1.Catalog.UpdateSeason:output_type -> animatrix.v1.Season
+	10, // 41: animatrix.v1.Catalog.BulkCreateSeasons:output_type -> animatrix.v1.ListSeasonsResponse
+	16, // 42: animatrix.v1.Catalog.ListEpisodes:output_type -> animatrix.v1.ListEpisodesResponse
+	2,  // 43: animatrix.v1.Catalog.GetEpisode:output_type -> animatrix.v1.Episode
+	2,  // 44: animatrix.v1.Catalog.CreateEpisode:output_type -> animatrix.v1.Episode
+	2,  // 45: animatrix.v1.Catalog.UpdateEpisode:output_type -> animatrix.v1.Episode
+	16, // 46: animatrix.v1.Catalog.BulkCreateEpisodes:output_type -> animatrix.v1.ListEpisodesResponse
+	23, // 47: animatrix.v1.Catalog.IngestEpisodes:output_type -> animatrix.v1.ImportSummary
+	4,  // 48: animatrix.v1.Catalog.Search:output_type -> animatrix.v1.ListSeriesResponse
+	32, // [32:49] is the sub-list for method output_type
+	15, // [15:32] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_animatrix_v1_catalog_proto_init() }
+func file_animatrix_v1_catalog_proto_init() {
+	if File_animatrix_v1_catalog_proto != nil {
+		return
+	}
+	file_animatrix_v1_catalog_proto_msgTypes[7].OneofWrappers = []any{}
+	file_animatrix_v1_catalog_proto_msgTypes[12].OneofWrappers = []any{}
+	file_animatrix_v1_catalog_proto_msgTypes[13].OneofWrappers = []any{}
+	file_animatrix_v1_catalog_proto_msgTypes[19].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_animatrix_v1_catalog_proto_rawDesc), len(file_animatrix_v1_catalog_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   24,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_animatrix_v1_catalog_proto_goTypes,
+		DependencyIndexes: file_animatrix_v1_catalog_proto_depIdxs,
+		MessageInfos:      file_animatrix_v1_catalog_proto_msgTypes,
+	}.Build()
+	File_animatrix_v1_catalog_proto = out.File
+	file_animatrix_v1_catalog_proto_goTypes = nil
+	file_animatrix_v1_catalog_proto_depIdxs = nil
+}
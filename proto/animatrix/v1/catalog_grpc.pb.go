@@ -0,0 +1,733 @@
+// Catalog service for ingest workers. Mirrors the /v1 REST API; see
+// internal/rpc for the server. Served without TLS on GRPC_ADDR (default
+// :9090).
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: animatrix/v1/catalog.proto
+
+package animatrixv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Catalog_ListSeries_FullMethodName         = "/animatrix.v1.Catalog/ListSeries"
+	Catalog_GetSeries_FullMethodName          = "/animatrix.v1.Catalog/GetSeries"
+	Catalog_CreateSeries_FullMethodName       = "/animatrix.v1.Catalog/CreateSeries"
+	Catalog_UpdateSeries_FullMethodName       = "/animatrix.v1.Catalog/UpdateSeries"
+	Catalog_BulkCreateSeries_FullMethodName   = "/animatrix.v1.Catalog/BulkCreateSeries"
+	Catalog_ListSeasons_FullMethodName        = "/animatrix.v1.Catalog/ListSeasons"
+	Catalog_GetSeason_FullMethodName          = "/animatrix.v1.Catalog/GetSeason"
+	Catalog_CreateSeason_FullMethodName       = "/animatrix.v1.Catalog/CreateSeason"
+	Catalog_UpdateSeason_FullMethodName       = "/animatrix.v1.Catalog/UpdateSeason"
+	Catalog_BulkCreateSeasons_FullMethodName  = "/animatrix.v1.Catalog/BulkCreateSeasons"
+	Catalog_ListEpisodes_FullMethodName       = "/animatrix.v1.Catalog/ListEpisodes"
+	Catalog_GetEpisode_FullMethodName         = "/animatrix.v1.Catalog/GetEpisode"
+	Catalog_CreateEpisode_FullMethodName      = "/animatrix.v1.Catalog/CreateEpisode"
+	Catalog_UpdateEpisode_FullMethodName      = "/animatrix.v1.Catalog/UpdateEpisode"
+	Catalog_BulkCreateEpisodes_FullMethodName = "/animatrix.v1.Catalog/BulkCreateEpisodes"
+	Catalog_IngestEpisodes_FullMethodName     = "/animatrix.v1.Catalog/IngestEpisodes"
+	Catalog_Search_FullMethodName             = "/animatrix.v1.Catalog/Search"
+)
+
+// CatalogClient is the client API for Catalog service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CatalogClient interface {
+	ListSeries(ctx context.Context, in *ListSeriesRequest, opts ...grpc.CallOption) (*ListSeriesResponse, error)
+	GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*Series, error)
+	CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*Series, error)
+	UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*Series, error)
+	BulkCreateSeries(ctx context.Context, in *BulkCreateSeriesRequest, opts ...grpc.CallOption) (*ListSeriesResponse, error)
+	ListSeasons(ctx context.Context, in *ListSeasonsRequest, opts ...grpc.CallOption) (*ListSeasonsResponse, error)
+	GetSeason(ctx context.Context, in *GetSeasonRequest, opts ...grpc.CallOption) (*Season, error)
+	CreateSeason(ctx context.Context, in *CreateSeasonRequest, opts ...grpc.CallOption) (*Season, error)
+	UpdateSeason(ctx context.Context, in *UpdateSeasonRequest, opts ...grpc.CallOption) (*Season, error)
+	BulkCreateSeasons(ctx context.Context, in *BulkCreateSeasonsRequest, opts ...grpc.CallOption) (*ListSeasonsResponse, error)
+	ListEpisodes(ctx context.Context, in *ListEpisodesRequest, opts ...grpc.CallOption) (*ListEpisodesResponse, error)
+	GetEpisode(ctx context.Context, in *GetEpisodeRequest, opts ...grpc.CallOption) (*Episode, error)
+	CreateEpisode(ctx context.Context, in *CreateEpisodeRequest, opts ...grpc.CallOption) (*Episode, error)
+	UpdateEpisode(ctx context.Context, in *UpdateEpisodeRequest, opts ...grpc.CallOption) (*Episode, error)
+	BulkCreateEpisodes(ctx context.Context, in *BulkCreateEpisodesRequest, opts ...grpc.CallOption) (*ListEpisodesResponse, error)
+	// IngestEpisodes inserts a stream of episodes in batches, like the NDJSON
+	// bulk endpoint, and answers with a summary once the stream is closed.
+	// Invalid records are reported in the summary instead of failing the call.
+	IngestEpisodes(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CreateEpisodeRequest, ImportSummary], error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*ListSeriesResponse, error)
+}
+
+type catalogClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCatalogClient(cc grpc.ClientConnInterface) CatalogClient {
+	return &catalogClient{cc}
+}
+
+func (c *catalogClient) ListSeries(ctx context.Context, in *ListSeriesRequest, opts ...grpc.CallOption) (*ListSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeriesResponse)
+	err := c.cc.Invoke(ctx, Catalog_ListSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*Series, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Series)
+	err := c.cc.Invoke(ctx, Catalog_GetSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*Series, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Series)
+	err := c.cc.Invoke(ctx, Catalog_CreateSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*Series, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Series)
+	err := c.cc.Invoke(ctx, Catalog_UpdateSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) BulkCreateSeries(ctx context.Context, in *BulkCreateSeriesRequest, opts ...grpc.CallOption) (*ListSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeriesResponse)
+	err := c.cc.Invoke(ctx, Catalog_BulkCreateSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) ListSeasons(ctx context.Context, in *ListSeasonsRequest, opts ...grpc.CallOption) (*ListSeasonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeasonsResponse)
+	err := c.cc.Invoke(ctx, Catalog_ListSeasons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) GetSeason(ctx context.Context, in *GetSeasonRequest, opts ...grpc.CallOption) (*Season, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Season)
+	err := c.cc.Invoke(ctx, Catalog_GetSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) CreateSeason(ctx context.Context, in *CreateSeasonRequest, opts ...grpc.CallOption) (*Season, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Season)
+	err := c.cc.Invoke(ctx, Catalog_CreateSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) UpdateSeason(ctx context.Context, in *UpdateSeasonRequest, opts ...grpc.CallOption) (*Season, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Season)
+	err := c.cc.Invoke(ctx, Catalog_UpdateSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) BulkCreateSeasons(ctx context.Context, in *BulkCreateSeasonsRequest, opts ...grpc.CallOption) (*ListSeasonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeasonsResponse)
+	err := c.cc.Invoke(ctx, Catalog_BulkCreateSeasons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) ListEpisodes(ctx context.Context, in *ListEpisodesRequest, opts ...grpc.CallOption) (*ListEpisodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEpisodesResponse)
+	err := c.cc.Invoke(ctx, Catalog_ListEpisodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) GetEpisode(ctx context.Context, in *GetEpisodeRequest, opts ...grpc.CallOption) (*Episode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Episode)
+	err := c.cc.Invoke(ctx, Catalog_GetEpisode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) CreateEpisode(ctx context.Context, in *CreateEpisodeRequest, opts ...grpc.CallOption) (*Episode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Episode)
+	err := c.cc.Invoke(ctx, Catalog_CreateEpisode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) UpdateEpisode(ctx context.Context, in *UpdateEpisodeRequest, opts ...grpc.CallOption) (*Episode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Episode)
+	err := c.cc.Invoke(ctx, Catalog_UpdateEpisode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) BulkCreateEpisodes(ctx context.Context, in *BulkCreateEpisodesRequest, opts ...grpc.CallOption) (*ListEpisodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEpisodesResponse)
+	err := c.cc.Invoke(ctx, Catalog_BulkCreateEpisodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) IngestEpisodes(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CreateEpisodeRequest, ImportSummary], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Catalog_ServiceDesc.Streams[0], Catalog_IngestEpisodes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CreateEpisodeRequest, ImportSummary]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Catalog_IngestEpisodesClient = grpc.ClientStreamingClient[CreateEpisodeRequest, ImportSummary]
+
+func (c *catalogClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*ListSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeriesResponse)
+	err := c.cc.Invoke(ctx, Catalog_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CatalogServer is the server API for Catalog service.
+// All implementations must embed UnimplementedCatalogServer
+// for forward compatibility.
+type CatalogServer interface {
+	ListSeries(context.Context, *ListSeriesRequest) (*ListSeriesResponse, error)
+	GetSeries(context.Context, *GetSeriesRequest) (*Series, error)
+	CreateSeries(context.Context, *CreateSeriesRequest) (*Series, error)
+	UpdateSeries(context.Context, *UpdateSeriesRequest) (*Series, error)
+	BulkCreateSeries(context.Context, *BulkCreateSeriesRequest) (*ListSeriesResponse, error)
+	ListSeasons(context.Context, *ListSeasonsRequest) (*ListSeasonsResponse, error)
+	GetSeason(context.Context, *GetSeasonRequest) (*Season, error)
+	CreateSeason(context.Context, *CreateSeasonRequest) (*Season, error)
+	UpdateSeason(context.Context, *UpdateSeasonRequest) (*Season, error)
+	BulkCreateSeasons(context.Context, *BulkCreateSeasonsRequest) (*ListSeasonsResponse, error)
+	ListEpisodes(context.Context, *ListEpisodesRequest) (*ListEpisodesResponse, error)
+	GetEpisode(context.Context, *GetEpisodeRequest) (*Episode, error)
+	CreateEpisode(context.Context, *CreateEpisodeRequest) (*Episode, error)
+	UpdateEpisode(context.Context, *UpdateEpisodeRequest) (*Episode, error)
+	BulkCreateEpisodes(context.Context, *BulkCreateEpisodesRequest) (*ListEpisodesResponse, error)
+	// IngestEpisodes inserts a stream of episodes in batches, like the NDJSON
+	// bulk endpoint, and answers with a summary once the stream is closed.
+	// Invalid records are reported in the summary instead of failing the call.
+	IngestEpisodes(grpc.ClientStreamingServer[CreateEpisodeRequest, ImportSummary]) error
+	Search(context.Context, *SearchRequest) (*ListSeriesResponse, error)
+	mustEmbedUnimplementedCatalogServer()
+}
+
+// UnimplementedCatalogServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCatalogServer struct{}
+
+func (UnimplementedCatalogServer) ListSeries(context.Context, *ListSeriesRequest) (*ListSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeries not implemented")
+}
+func (UnimplementedCatalogServer) GetSeries(context.Context, *GetSeriesRequest) (*Series, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeries not implemented")
+}
+func (UnimplementedCatalogServer) CreateSeries(context.Context, *CreateSeriesRequest) (*Series, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeries not implemented")
+}
+func (UnimplementedCatalogServer) UpdateSeries(context.Context, *UpdateSeriesRequest) (*Series, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeries not implemented")
+}
+func (UnimplementedCatalogServer) BulkCreateSeries(context.Context, *BulkCreateSeriesRequest) (*ListSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkCreateSeries not implemented")
+}
+func (UnimplementedCatalogServer) ListSeasons(context.Context, *ListSeasonsRequest) (*ListSeasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeasons not implemented")
+}
+func (UnimplementedCatalogServer) GetSeason(context.Context, *GetSeasonRequest) (*Season, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeason not implemented")
+}
+func (UnimplementedCatalogServer) CreateSeason(context.Context, *CreateSeasonRequest) (*Season, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeason not implemented")
+}
+func (UnimplementedCatalogServer) UpdateSeason(context.Context, *UpdateSeasonRequest) (*Season, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeason not implemented")
+}
+func (UnimplementedCatalogServer) BulkCreateSeasons(context.Context, *BulkCreateSeasonsRequest) (*ListSeasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkCreateSeasons not implemented")
+}
+func (UnimplementedCatalogServer) ListEpisodes(context.Context, *ListEpisodesRequest) (*ListEpisodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEpisodes not implemented")
+}
+func (UnimplementedCatalogServer) GetEpisode(context.Context, *GetEpisodeRequest) (*Episode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpisode not implemented")
+}
+func (UnimplementedCatalogServer) CreateEpisode(context.Context, *CreateEpisodeRequest) (*Episode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEpisode not implemented")
+}
+func (UnimplementedCatalogServer) UpdateEpisode(context.Context, *UpdateEpisodeRequest) (*Episode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEpisode not implemented")
+}
+func (UnimplementedCatalogServer) BulkCreateEpisodes(context.Context, *BulkCreateEpisodesRequest) (*ListEpisodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkCreateEpisodes not implemented")
+}
+func (UnimplementedCatalogServer) IngestEpisodes(grpc.ClientStreamingServer[CreateEpisodeRequest, ImportSummary]) error {
+	return status.Errorf(codes.Unimplemented, "method IngestEpisodes not implemented")
+}
+func (UnimplementedCatalogServer) Search(context.Context, *SearchRequest) (*ListSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedCatalogServer) mustEmbedUnimplementedCatalogServer() {}
+func (UnimplementedCatalogServer) testEmbeddedByValue()                 {}
+
+// UnsafeCatalogServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CatalogServer will
+// result in compilation errors.
+type UnsafeCatalogServer interface {
+	mustEmbedUnimplementedCatalogServer()
+}
+
+func RegisterCatalogServer(s grpc.ServiceRegistrar, srv CatalogServer) {
+	// If the following call pancis, it indicates UnimplementedCatalogServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Catalog_ServiceDesc, srv)
+}
+
+func _Catalog_ListSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).ListSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_ListSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).ListSeries(ctx, req.(*ListSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_GetSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).GetSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_GetSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).GetSeries(ctx, req.(*GetSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_CreateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).CreateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_CreateSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).CreateSeries(ctx, req.(*CreateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_UpdateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).UpdateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_UpdateSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).UpdateSeries(ctx, req.(*UpdateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_BulkCreateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCreateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).BulkCreateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_BulkCreateSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).BulkCreateSeries(ctx, req.(*BulkCreateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_ListSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).ListSeasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_ListSeasons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).ListSeasons(ctx, req.(*ListSeasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_GetSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).GetSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_GetSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).GetSeason(ctx, req.(*GetSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_CreateSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).CreateSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_CreateSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).CreateSeason(ctx, req.(*CreateSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_UpdateSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).UpdateSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_UpdateSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).UpdateSeason(ctx, req.(*UpdateSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_BulkCreateSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCreateSeasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).BulkCreateSeasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_BulkCreateSeasons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).BulkCreateSeasons(ctx, req.(*BulkCreateSeasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_ListEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEpisodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).ListEpisodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_ListEpisodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).ListEpisodes(ctx, req.(*ListEpisodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_GetEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpisodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).GetEpisode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_GetEpisode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).GetEpisode(ctx, req.(*GetEpisodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_CreateEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEpisodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).CreateEpisode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_CreateEpisode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).CreateEpisode(ctx, req.(*CreateEpisodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_UpdateEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEpisodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).UpdateEpisode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_UpdateEpisode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).UpdateEpisode(ctx, req.(*UpdateEpisodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_BulkCreateEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCreateEpisodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).BulkCreateEpisodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_BulkCreateEpisodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).BulkCreateEpisodes(ctx, req.(*BulkCreateEpisodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_IngestEpisodes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CatalogServer).IngestEpisodes(&grpc.GenericServerStream[CreateEpisodeRequest, ImportSummary]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Catalog_IngestEpisodesServer = grpc.ClientStreamingServer[CreateEpisodeRequest, ImportSummary]
+
+func _Catalog_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Catalog_ServiceDesc is the grpc.ServiceDesc for Catalog service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Catalog_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "animatrix.v1.Catalog",
+	HandlerType: (*CatalogServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSeries",
+			Handler:    _Catalog_ListSeries_Handler,
+		},
+		{
+			MethodName: "GetSeries",
+			Handler:    _Catalog_GetSeries_Handler,
+		},
+		{
+			MethodName: "CreateSeries",
+			Handler:    _Catalog_CreateSeries_Handler,
+		},
+		{
+			MethodName: "UpdateSeries",
+			Handler:    _Catalog_UpdateSeries_Handler,
+		},
+		{
+			MethodName: "BulkCreateSeries",
+			Handler:    _Catalog_BulkCreateSeries_Handler,
+		},
+		{
+			MethodName: "ListSeasons",
+			Handler:    _Catalog_ListSeasons_Handler,
+		},
+		{
+			MethodName: "GetSeason",
+			Handler:    _Catalog_GetSeason_Handler,
+		},
+		{
+			MethodName: "CreateSeason",
+			Handler:    _Catalog_CreateSeason_Handler,
+		},
+		{
+			MethodName: "UpdateSeason",
+			Handler:    _Catalog_UpdateSeason_Handler,
+		},
+		{
+			MethodName: "BulkCreateSeasons",
+			Handler:    _Catalog_BulkCreateSeasons_Handler,
+		},
+		{
+			MethodName: "ListEpisodes",
+			Handler:    _Catalog_ListEpisodes_Handler,
+		},
+		{
+			MethodName: "GetEpisode",
+			Handler:    _Catalog_GetEpisode_Handler,
+		},
+		{
+			MethodName: "CreateEpisode",
+			Handler:    _Catalog_CreateEpisode_Handler,
+		},
+		{
+			MethodName: "UpdateEpisode",
+			Handler:    _Catalog_UpdateEpisode_Handler,
+		},
+		{
+			MethodName: "BulkCreateEpisodes",
+			Handler:    _Catalog_BulkCreateEpisodes_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Catalog_Search_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "IngestEpisodes",
+			Handler:       _Catalog_IngestEpisodes_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "animatrix/v1/catalog.proto",
+}
@@ -0,0 +1,6 @@
+// Package animatrixv1 holds the messages and gRPC stubs generated from
+// catalog.proto. Run go generate here after changing it; protoc needs
+// protoc-gen-go and protoc-gen-go-grpc on PATH.
+package animatrixv1
+
+//go:generate protoc -I ../.. --go_out=../.. --go_opt=paths=source_relative --go-grpc_out=../.. --go-grpc_opt=paths=source_relative animatrix/v1/catalog.proto